@@ -0,0 +1,13 @@
+package cani
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCanI(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Can I Command Suite")
+}
@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cani
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/access"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "can-i VERB RESOURCE"
+	short = "Check whether you are allowed to perform an action"
+	long  = "Check with OCM whether your OCM roles allow you to perform an action on a type of " +
+		"resource, in a cluster or in your organization. It prints 'yes' or 'no', and fails when the " +
+		"action isn't allowed.\n\n" +
+		"Valid verbs are '%s'.\n" +
+		"Valid resources are '%s'."
+	example = `  # Check whether you can create clusters in your organization
+  rosa can-i create clusters
+
+  # Check whether you can delete the machine pools of cluster "mycluster"
+  rosa can-i delete machinepools -c mycluster`
+)
+
+func NewRosaCanICommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Long: fmt.Sprintf(long, strings.Join(access.Verbs, "', '"),
+			strings.Join(access.Resources, "', '")),
+		Example:   example,
+		Run:       rosa.DefaultRunner(rosa.RuntimeWithOCM(), CanIRunner()),
+		Args:      cobra.ExactArgs(2),
+		ValidArgs: access.Verbs,
+	}
+	ocm.AddOptionalClusterFlag(cmd)
+	return cmd
+}
+
+func CanIRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
+		verb := strings.ToLower(argv[0])
+		err := access.ValidateVerb(verb)
+		if err != nil {
+			return err
+		}
+		resourceType, err := access.ResourceType(argv[1])
+		if err != nil {
+			return err
+		}
+		var cluster *cmv1.Cluster
+		if cmd.Flags().Changed("cluster") {
+			cluster, err = r.OCMClient.GetCluster(r.GetClusterKey(), r.Creator)
+			if err != nil {
+				return err
+			}
+		}
+		result, err := access.Review(r.OCMClient, verb, resourceType, cluster)
+		if err != nil {
+			return fmt.Errorf("Failed to check the permission: %v", err)
+		}
+		if !result.Allowed {
+			fmt.Println("no")
+			return result.Err()
+		}
+		fmt.Println("yes")
+		return nil
+	}
+}
@@ -0,0 +1,95 @@
+package cani
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	amsv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/clierror"
+	"github.com/openshift/rosa/pkg/rosa"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("rosa can-i", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewRosaCanICommand()
+		Expect(cmd).NotTo(BeNil())
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Long).To(ContainSubstring("'get', 'list', 'create', 'update', 'delete'"))
+		Expect(cmd.Run).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("cluster")).NotTo(BeNil())
+	})
+
+	Context("Can I Runner", func() {
+		var (
+			t   *TestingRuntime
+			cmd *cobra.Command
+		)
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			cmd = NewRosaCanICommand()
+		})
+
+		run := func(argv ...string) (string, error) {
+			stdout, _, err := RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+				return CanIRunner()(context.Background(), r, cmd, argv)
+			}, t.RosaRuntime, cmd)
+			return stdout, err
+		}
+
+		It("Answers yes when the action is allowed in the organization", func() {
+			account, err := amsv1.NewAccount().ID("account-1").
+				Organization(amsv1.NewOrganization().ID("org-1").Name("My Org")).Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatResource(account)))
+			t.ApiServer.RouteToHandler(http.MethodPost, SelfAccessReviewPath, ghttp.CombineHandlers(
+				ghttp.VerifyJSON(`{"action": "create", "resource_type": "Cluster", "organization_id": "org-1"}`),
+				RespondWithJSON(http.StatusOK, `{"allowed": true}`),
+			))
+
+			stdout, err := run("create", "clusters")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(Equal("yes\n"))
+		})
+
+		It("Answers no with the reason when the action isn't allowed in the cluster", func() {
+			Expect(cmd.Flags().Set("cluster", MockClusterName)).To(Succeed())
+			cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+			})
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+			t.ApiServer.RouteToHandler(http.MethodPost, SelfAccessReviewPath, ghttp.CombineHandlers(
+				ghttp.VerifyJSON(`{"action": "delete", "resource_type": "MachinePool", "cluster_id": "`+
+					MockClusterID+`"}`),
+				RespondWithJSON(http.StatusOK, `{"allowed": false, "reason": "missing role"}`),
+			))
+
+			stdout, err := run("delete", "machine-pools")
+			Expect(stdout).To(Equal("no\n"))
+			Expect(err).To(MatchError("You are not allowed to delete machine pools in cluster 'cluster': " +
+				"missing role. Ask an administrator of your organization for an OCM role that allows it"))
+			Expect(clierror.ExitCode(err)).To(Equal(6))
+		})
+
+		It("Fails with an invalid verb", func() {
+			_, err := run("patch", "clusters")
+			Expect(err).To(MatchError("Invalid verb 'patch', should be one of " +
+				"'get', 'list', 'create', 'update', 'delete'"))
+		})
+
+		It("Fails with an invalid resource", func() {
+			_, err := run("create", "nodes")
+			Expect(err).To(MatchError("Invalid resource 'nodes', should be one of " +
+				"'clusters', 'machinepools', 'idps', 'addons'"))
+		})
+	})
+})
@@ -0,0 +1,182 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/cleanup"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "cleanup"
+	short = "Delete the ROSA resources that no cluster uses"
+	long  = "Find the operator roles, OIDC providers, OIDC configs, private key secrets and S3 " +
+		"buckets created for ROSA clusters that no cluster uses anymore, like the ones left behind " +
+		"by failed installations or by clusters deleted without their resources, and delete them. " +
+		"Every orphaned resource is confirmed before it is deleted, unless '--yes' is used. " +
+		"Secrets and S3 buckets are only searched in the selected region.\n\n" +
+		"Only the resources created more than '--older-than' ago are reported, so that the " +
+		"resources of the clusters being installed aren't deleted. Only the clusters visible to the " +
+		"current OCM organization are checked, so the resources used by the clusters of other " +
+		"organizations sharing the AWS account are reported as orphaned."
+	example = `  # Report the orphaned resources of the AWS account without deleting them
+  rosa cleanup --account --dry-run
+
+  # Delete the orphaned resources of the AWS account, confirming each of them
+  rosa cleanup --account
+
+  # Delete all the orphaned resources of the AWS account
+  rosa cleanup --account --yes
+
+  # Report the orphaned resources of the AWS account created more than a week ago
+  rosa cleanup --account --dry-run --older-than=168h`
+)
+
+var confirmFn = confirm.Confirm
+
+type options struct {
+	account   bool
+	dryRun    bool
+	olderThan time.Duration
+}
+
+var orphansTable = output.Table[*cleanup.Orphan]{
+	Columns: []output.Column[*cleanup.Orphan]{
+		{Header: "RESOURCE", Value: func(o *cleanup.Orphan) string { return string(o.Kind) }},
+		{Header: "NAME", Value: func(o *cleanup.Orphan) string { return o.Name }},
+		{Header: "REASON", Value: func(o *cleanup.Orphan) string { return o.Reason }},
+		{Header: "CREATED", Value: func(o *cleanup.Orphan) string {
+			if o.CreationDate.IsZero() {
+				return ""
+			}
+			return o.CreationDate.Format("2006-01-02 15:04:05 MST")
+		}},
+		{
+			Header: "INCLUDES",
+			Value:  func(o *cleanup.Orphan) string { return strings.Join(o.Resources, "\n") },
+			Wide:   true,
+		},
+	},
+	Name: func(o *cleanup.Orphan) string { return o.Name },
+}
+
+func NewRosaCleanupCommand() *cobra.Command {
+	options := &options{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), CleanupRunner(options)),
+		Args:    cobra.NoArgs,
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+	flags.BoolVar(
+		&options.account,
+		"account",
+		false,
+		"Search the whole AWS account for orphaned resources.",
+	)
+	flags.BoolVar(
+		&options.dryRun,
+		"dry-run",
+		false,
+		"Report the orphaned resources without deleting them.",
+	)
+	flags.DurationVar(
+		&options.olderThan,
+		"older-than",
+		24*time.Hour,
+		"Only report the resources created more than this long ago. Zero reports all the resources, "+
+			"and can't be used with '--yes'.",
+	)
+	confirm.AddFlag(flags)
+	output.AddTableFlags(cmd)
+	persistentFlags := cmd.PersistentFlags()
+	arguments.AddProfileFlag(persistentFlags)
+	arguments.AddRegionFlag(persistentFlags)
+	cmd.MarkFlagRequired("account")
+	return cmd
+}
+
+func CleanupRunner(options *options) rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		if !options.account {
+			return fmt.Errorf("The '--account' flag is required, it is the only scope that can be cleaned up")
+		}
+		if !options.dryRun && !confirm.Yes() && output.IsStructuredOutput() {
+			return fmt.Errorf("The '--yes' or '--dry-run' flag is required when using structured output")
+		}
+		if options.olderThan < 0 {
+			return fmt.Errorf("The '--older-than' flag can't be negative")
+		}
+		if options.olderThan == 0 && !options.dryRun && confirm.Yes() {
+			return fmt.Errorf("The '--older-than' flag must be positive when using '--yes'")
+		}
+		var createdBefore time.Time
+		if options.olderThan > 0 {
+			createdBefore = time.Now().Add(-options.olderThan)
+		}
+
+		r.Reporter.Infof("Searching orphaned resources in AWS account '%s'", r.Creator.AccountID)
+		orphans, err := cleanup.Scan(r.OCMClient, r.AWSClient, r.Creator.AccountID, createdBefore)
+		if err != nil {
+			return fmt.Errorf("Failed to search orphaned resources: %v", err)
+		}
+		if len(orphans) == 0 && output.IsTableOutput() {
+			r.Reporter.Infof("There are no orphaned resources in AWS account '%s'", r.Creator.AccountID)
+			return nil
+		}
+		err = orphansTable.Print(orphans)
+		if err != nil {
+			return err
+		}
+		if options.dryRun || len(orphans) == 0 {
+			return nil
+		}
+
+		failures := 0
+		for _, orphan := range orphans {
+			if !confirmFn("delete %s '%s'", orphan.Kind, orphan.Name) {
+				continue
+			}
+			err := cleanup.Delete(r.OCMClient, r.AWSClient, orphan)
+			if err != nil {
+				r.Reporter.Errorf("Failed to delete %s '%s': %v", orphan.Kind, orphan.Name, err)
+				failures++
+				continue
+			}
+			r.Reporter.Infof("Deleted %s '%s'", orphan.Kind, orphan.Name)
+		}
+		if failures > 0 {
+			return fmt.Errorf("Failed to delete %d of the orphaned resources", failures)
+		}
+		return nil
+	}
+}
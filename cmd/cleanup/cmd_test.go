@@ -0,0 +1,120 @@
+package cleanup
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+const (
+	providerArn = "arn:aws:iam::123456789012:oidc-provider/oidc.example.com/deleted"
+	bucketName  = "old-oidc-zz9z"
+)
+
+var _ = Describe("rosa cleanup", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewRosaCleanupCommand()
+		Expect(cmd).NotTo(BeNil())
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Run).NotTo(BeNil())
+		for _, flag := range []string{"account", "dry-run", "older-than", "yes", "output"} {
+			Expect(cmd.Flags().Lookup(flag)).NotTo(BeNil())
+		}
+		for _, flag := range []string{"profile", "region"} {
+			Expect(cmd.PersistentFlags().Lookup(flag)).NotTo(BeNil())
+		}
+		Expect(cmd.Flags().Lookup("older-than").DefValue).To(Equal("24h0m0s"))
+	})
+
+	It("Refuses to delete the resources of any age without confirmation", func() {
+		cmd := NewRosaCleanupCommand()
+		Expect(cmd.Flags().Set("yes", "true")).To(Succeed())
+		DeferCleanup(func() {
+			Expect(cmd.Flags().Set("yes", "false")).To(Succeed())
+		})
+
+		_, _, err := RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+			return CleanupRunner(&options{account: true})(context.Background(), r, cmd, nil)
+		}, NewTestRuntime().RosaRuntime, nil)
+		Expect(err).To(MatchError("The '--older-than' flag must be positive when using '--yes'"))
+	})
+
+	Context("Cleanup Runner", func() {
+		var (
+			t         *TestingRuntime
+			mockAWS   *aws.MockClient
+			confirmed []string
+		)
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			mockAWS = t.RosaRuntime.AWSClient.(*aws.MockClient)
+			output.SetOutput("")
+			confirmed = nil
+			confirmFn = func(format string, args ...interface{}) bool {
+				confirmed = append(confirmed, args[1].(string))
+				return args[1] != bucketName
+			}
+			DeferCleanup(func() {
+				confirmFn = confirm.Confirm
+				output.SetOutput("")
+			})
+
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+				FormatList([]*cmv1.OidcConfig{}, cmv1.MarshalOidcConfigList, "OidcConfigList")))
+			mockAWS.EXPECT().ListOperatorRoles("", "", "").Return(map[string][]aws.OperatorRoleDetail{}, nil)
+			mockAWS.EXPECT().ListOidcProviders("", nil).Return([]aws.OidcProviderOutput{{Arn: providerArn}}, nil)
+			t.ApiServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyFormKV("search", "aws.sts.oidc_endpoint_url = 'https://oidc.example.com/deleted'"),
+				RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{})),
+			))
+			mockAWS.EXPECT().GetOpenIDConnectProviderCreateDate(providerArn).Return(
+				time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), nil)
+			mockAWS.EXPECT().ListRedHatManagedSecrets().Return([]aws.ManagedResource{}, nil)
+			mockAWS.EXPECT().ListRedHatManagedS3Buckets().Return([]aws.ManagedResource{{ID: bucketName}}, nil)
+		})
+
+		run := func(options *options) (string, error) {
+			stdout, _, err := RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+				return CleanupRunner(options)(context.Background(), r, cmd, nil)
+			}, t.RosaRuntime, nil)
+			return stdout, err
+		}
+
+		It("Reports the orphaned resources without deleting them in dry run mode", func() {
+			stdout, err := run(&options{account: true, dryRun: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(ContainSubstring(
+				"RESOURCE       NAME                                                              " +
+					"REASON                          CREATED\n" +
+					"OIDC provider  " + providerArn + "  no cluster uses its issuer URL  2026-01-02 03:04:05 UTC\n" +
+					"S3 bucket      " + bucketName + "                                                     " +
+					"no OIDC config uses the bucket  \n"))
+			Expect(confirmed).To(BeEmpty())
+		})
+
+		It("Deletes the confirmed orphaned resources and reports the failures", func() {
+			mockAWS.EXPECT().DeleteOpenIDConnectProvider(providerArn).Return(errors.New("access denied"))
+
+			stdout, err := run(&options{account: true})
+			Expect(err).To(MatchError("Failed to delete 1 of the orphaned resources"))
+			Expect(confirmed).To(Equal([]string{providerArn, bucketName}))
+			Expect(stdout).NotTo(ContainSubstring("Deleted"))
+		})
+	})
+})
@@ -22,8 +22,12 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/cmd/config/defaults"
+	"github.com/openshift/rosa/cmd/config/deletecontext"
 	"github.com/openshift/rosa/cmd/config/get"
+	"github.com/openshift/rosa/cmd/config/listcontexts"
 	"github.com/openshift/rosa/cmd/config/set"
+	"github.com/openshift/rosa/cmd/config/usecontext"
 	"github.com/openshift/rosa/pkg/config"
 	"github.com/openshift/rosa/pkg/properties"
 )
@@ -43,6 +47,13 @@ The following variables are supported:
 
 %s
 
+Named login contexts allow switching between OCM environments and organizations without logging
+out. Log in with 'rosa login --context NAME', switch with 'rosa config use-context NAME', or use the
+global '--context' flag to run a single command against another context.
+
+Default values for the flags of the 'create cluster' and 'create machinepool' commands can be stored
+in '.rosa.yaml' files, see 'rosa config defaults --help'.
+
 Note that "rosa config get access_token" gives whatever the file contains - may be missing or expired;
 you probably want "rosa token" command instead which will obtain a fresh token if needed.
 
@@ -66,6 +77,10 @@ func NewConfigCommand() *cobra.Command {
 	}
 	Cmd.AddCommand(get.Cmd)
 	Cmd.AddCommand(set.Cmd)
+	Cmd.AddCommand(usecontext.Cmd)
+	Cmd.AddCommand(listcontexts.Cmd)
+	Cmd.AddCommand(deletecontext.Cmd)
+	Cmd.AddCommand(defaults.Cmd)
 	return Cmd
 }
 
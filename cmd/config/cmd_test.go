@@ -10,9 +10,12 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/openshift/rosa/cmd/config/defaults"
 	"github.com/openshift/rosa/cmd/config/get"
+	"github.com/openshift/rosa/cmd/config/listcontexts"
 	"github.com/openshift/rosa/cmd/config/set"
 	"github.com/openshift/rosa/pkg/config"
+	"github.com/openshift/rosa/pkg/rosa"
 	"github.com/openshift/rosa/pkg/test"
 )
 
@@ -149,6 +152,19 @@ var _ = Describe("Run Command", Ordered, func() {
 			Expect(err).NotTo(BeNil())
 			Expect(err.Error()).To(Equal("'test' is not a supported setting"))
 		})
+
+		It("Lists contexts", func() {
+			contextsBuf := new(bytes.Buffer)
+			listcontexts.Writer = contextsBuf
+			DeferCleanup(func() {
+				listcontexts.Writer = os.Stdout
+			})
+
+			err = listcontexts.PrintContexts()
+			Expect(err).To(BeNil())
+			Expect(contextsBuf.String()).To(ContainSubstring("CURRENT  NAME     URL    FEDRAMP"))
+			Expect(contextsBuf.String()).To(ContainSubstring("*        default  MyURL  true"))
+		})
 	})
 
 	When("Config file doesn't exist", func() {
@@ -165,4 +181,27 @@ var _ = Describe("Run Command", Ordered, func() {
 			Expect(err).To(BeNil())
 		})
 	})
+
+	When("Defaults files exist", func() {
+		It("Prints the defaults and their files", func() {
+			home := GinkgoT().TempDir()
+			GinkgoT().Setenv("HOME", home)
+			Expect(os.WriteFile(home+"/.rosa.yaml", []byte("defaults:\n  region: us-east-2\n"), 0600)).To(Succeed())
+			wd, err := os.Getwd()
+			Expect(err).To(BeNil())
+			Expect(os.Chdir(home)).To(Succeed())
+			DeferCleanup(os.Chdir, wd)
+
+			defaultsBuf := new(bytes.Buffer)
+			defaults.Writer = defaultsBuf
+			DeferCleanup(func() {
+				defaults.Writer = os.Stdout
+			})
+
+			err = defaults.PrintDefaults(rosa.NewRuntime())
+			Expect(err).To(BeNil())
+			Expect(defaultsBuf.String()).To(ContainSubstring("FLAG    VALUE      SOURCE  PATH"))
+			Expect(defaultsBuf.String()).To(ContainSubstring("region  us-east-2  user    " + home + "/.rosa.yaml"))
+		})
+	})
 })
@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaults
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/clierror"
+	"github.com/openshift/rosa/pkg/defaults"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+var (
+	Writer io.Writer = os.Stdout
+)
+
+var Cmd = NewConfigDefaultsCommand()
+
+func NewConfigDefaultsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "defaults",
+		Short: "Shows the flag defaults of the 'create cluster' and 'create machinepool' commands",
+		Long: fmt.Sprintf("Shows the default values used for the flags of the 'create cluster' and "+
+			"'create machinepool' commands and the file where each one is defined.\n\n"+
+			"Defaults are read from '~/%[1]s' and from the nearest '%[1]s' file found walking up from "+
+			"the working directory, which takes precedence. The keys of the 'defaults' section are "+
+			"flag names, and flags given in the command line always take precedence. The '--replicas', "+
+			"'--compute-nodes', '--enable-autoscaling', '--min-replicas' and '--max-replicas' flags "+
+			"are taken as a group, so none of their defaults is used when any of them is given:\n\n"+
+			"  defaults:\n"+
+			"    region: us-east-2\n"+
+			"    channel-group: stable\n"+
+			"    operator-roles-prefix: payments\n"+
+			"    tags:\n"+
+			"      team: payments", defaults.FileName),
+		Example: `  # Show the defaults and where they come from
+  rosa config defaults`,
+		Args: cobra.NoArgs,
+		Run:  run,
+	}
+	output.AddTableFlags(cmd)
+	return cmd
+}
+
+func run(_ *cobra.Command, _ []string) {
+	r := rosa.NewRuntime()
+
+	err := PrintDefaults(r)
+	if err != nil {
+		r.Reporter.Errorf("%v", err)
+		os.Exit(clierror.ExitCode(err))
+	}
+}
+
+func PrintDefaults(r *rosa.Runtime) error {
+	values, err := defaults.Load()
+	if err != nil {
+		return err
+	}
+	if len(values.List()) == 0 && output.IsTableOutput() {
+		r.Reporter.Infof("There are no defaults, create a '%s' file to define them", defaults.FileName)
+		return nil
+	}
+	return defaultsTable.Write(Writer, values.List())
+}
+
+var defaultsTable = output.Table[*defaults.Value]{
+	Columns: []output.Column[*defaults.Value]{
+		{Header: "FLAG", Value: func(value *defaults.Value) string { return value.Flag }},
+		{Header: "VALUE", Value: func(value *defaults.Value) string { return value.Value }},
+		{Header: "SOURCE", Value: func(value *defaults.Value) string { return value.Source }},
+		{Header: "PATH", Value: func(value *defaults.Value) string { return value.Path }},
+	},
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/config"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -51,7 +52,7 @@ func run(_ *cobra.Command, argv []string) {
 	err := PrintConfig(argv[0])
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 }
 
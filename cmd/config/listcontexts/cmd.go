@@ -0,0 +1,80 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package listcontexts
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/clierror"
+	"github.com/openshift/rosa/pkg/config"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+var (
+	Writer io.Writer = os.Stdout
+)
+
+var Cmd = NewConfigListContextsCommand()
+
+func NewConfigListContextsCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list-contexts",
+		Short: "Lists the login contexts",
+		Long:  "Lists the login contexts stored in the configuration, marking the active one.",
+		Example: `  # List the login contexts
+  rosa config list-contexts`,
+		Args: cobra.NoArgs,
+		Run:  run,
+	}
+}
+
+func run(_ *cobra.Command, _ []string) {
+	r := rosa.NewRuntime()
+
+	err := PrintContexts()
+	if err != nil {
+		r.Reporter.Errorf("%v", err)
+		os.Exit(clierror.ExitCode(err))
+	}
+}
+
+func PrintContexts() error {
+	cfg, err := config.LoadAll()
+	if err != nil {
+		return fmt.Errorf("can't load config: %v", err)
+	}
+	if cfg == nil {
+		cfg = &config.Config{}
+	}
+
+	writer := tabwriter.NewWriter(Writer, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(writer, "CURRENT\tNAME\tURL\tFEDRAMP\n")
+	for _, name := range cfg.ContextNames() {
+		current := ""
+		if name == cfg.ActiveContext() && !config.IsNotValid(cfg) {
+			current = "*"
+		}
+		ctx := cfg.Context(name)
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%t\n", current, name, ctx.URL, ctx.FedRAMP)
+	}
+	return writer.Flush()
+}
@@ -26,6 +26,8 @@ import (
 	"github.com/openshift/rosa/cmd/verify/oc"
 	"github.com/openshift/rosa/cmd/verify/quota"
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/aws/iac"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/fedramp"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
@@ -53,6 +55,7 @@ var args struct {
 	route53RoleArn      string
 	vpcEndpointRoleArn  string
 	externalID          string
+	outputFormat        string
 }
 
 var Cmd = &cobra.Command{
@@ -168,6 +171,7 @@ func init() {
 	)
 
 	interactive.AddModeFlag(Cmd)
+	iac.AddOutputFormatFlag(flags, &args.outputFormat)
 
 	confirm.AddFlag(flags)
 	interactive.AddFlag(flags)
@@ -176,10 +180,16 @@ func init() {
 func run(cmd *cobra.Command, argv []string) {
 	r := rosa.NewRuntime().WithAWS()
 
+	format, err := iac.GetFormat(cmd, args.outputFormat)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
+	}
+
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	var isHcpSharedVpc bool
@@ -191,7 +201,7 @@ func run(cmd *cobra.Command, argv []string) {
 			vpcEndpointRoleArnFlag, route53RoleArnFlag)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -199,14 +209,14 @@ func run(cmd *cobra.Command, argv []string) {
 		err = aws.ARNValidator(args.vpcEndpointRoleArn)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid policy ARN for %s: %s", vpcEndpointRoleArnFlag, err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	if args.route53RoleArn != "" {
 		err = aws.ARNValidator(args.route53RoleArn)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid policy ARN for %s: %s", route53RoleArnFlag, err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -216,7 +226,7 @@ func run(cmd *cobra.Command, argv []string) {
 	err = login.Call(cmd, argv, r.Reporter)
 	if err != nil {
 		r.Reporter.Errorf("Failed to login to OCM: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	r.WithOCM()
 	defer r.Cleanup()
@@ -224,13 +234,13 @@ func run(cmd *cobra.Command, argv []string) {
 	env, err := ocm.GetEnv()
 	if err != nil {
 		r.Reporter.Errorf("Failed to determine OCM environment: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	managedPolicies := args.managed
 	if args.forcePolicyCreation && managedPolicies {
 		r.Reporter.Warnf("Forcing creation of policies only works for unmanaged policies")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if args.hostedCP && cmd.Flags().Changed("version") {
@@ -253,13 +263,13 @@ func run(cmd *cobra.Command, argv []string) {
 			managedPolicies = false
 		} else {
 			r.Reporter.Errorf("Setting `hosted-cp` as unmanaged policies is not supported")
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 	}
 
 	if roles.ClassicManagedPoliciesUnsupportedInEnv(isManagedSet, args.managed, env) {
 		r.Reporter.Errorf("Classic ROSA managed policies are not supported in this environment")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	// Validate AWS credentials for current user
@@ -270,7 +280,7 @@ func run(cmd *cobra.Command, argv []string) {
 	if err != nil {
 		r.OCMClient.LogEvent("ROSAInitCredentialsFailed", nil)
 		r.Reporter.Errorf("Error validating AWS credentials: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if !ok {
 		r.OCMClient.LogEvent("ROSAInitCredentialsInvalid", nil)
@@ -301,7 +311,7 @@ func run(cmd *cobra.Command, argv []string) {
 	policyVersion, err := r.OCMClient.GetPolicyVersion(version, channelGroup)
 	if err != nil {
 		r.Reporter.Errorf("Error getting version: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	r.Reporter.Debugf("Creating account roles compatible with OpenShift versions up to %s", policyVersion)
@@ -320,20 +330,20 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid role prefix: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	if len(prefix) > 32 {
 		r.Reporter.Errorf("Expected a prefix with no more than 32 characters")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 	if !aws.RoleNameRE.MatchString(prefix) {
 		r.Reporter.Errorf("Expected a valid role prefix matching %s", aws.RoleNameRE.String())
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 	if !args.hostedCP && strings.HasSuffix(prefix, "-HCP") {
 		r.Reporter.Errorf("The '-HCP' suffix is reserved for hosted CP managed policies")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	permissionsBoundary := args.permissionsBoundary
@@ -348,7 +358,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid policy ARN for permissions boundary: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -356,7 +366,7 @@ func run(cmd *cobra.Command, argv []string) {
 		err = aws.ARNValidator(permissionsBoundary)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid policy ARN for permissions boundary: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -372,14 +382,14 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid path: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
 	if path != "" && !aws.ARNPath.MatchString(path) {
 		r.Reporter.Errorf("The specified value for path is invalid. " +
 			"It must begin and end with '/' and contain only alphanumeric characters and/or '/' characters.")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if interactive.Enabled() && !cmd.Flags().Changed("external-id") {
@@ -394,32 +404,32 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid STS external ID: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
 	if err := validateAccountRolesSTSExternalID(args.externalID); err != nil {
 		r.Reporter.Errorf("Expected a valid STS external ID: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if interactive.Enabled() {
 		mode, err = interactive.GetOptionMode(cmd, mode, "Role creation mode")
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
 	if args.forcePolicyCreation && mode != interactive.ModeAuto {
 		r.Reporter.Warnf("Forcing creation of policies only works in auto mode")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	policies, err := r.OCMClient.GetPolicies("AccountRole")
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	createClassic := args.classic
@@ -432,7 +442,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		isClassicValueSet = true
 	}
@@ -448,7 +458,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		isHostedCPValueSet = true
 	}
@@ -463,7 +473,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		if !isHcpSharedVpc {
@@ -484,7 +494,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	if interactive.Enabled() && isHcpSharedVpc && !r.Creator.IsGovcloud && createHostedCP {
@@ -499,7 +509,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -511,7 +521,7 @@ func run(cmd *cobra.Command, argv []string) {
 			vpcEndpointRoleArnFlag, route53RoleArnFlag)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -520,7 +530,7 @@ func run(cmd *cobra.Command, argv []string) {
 			vpcEndpointRoleArnFlag, route53RoleArnFlag)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -532,7 +542,7 @@ func run(cmd *cobra.Command, argv []string) {
 
 	if fedramp.Enabled() && isHcpSharedVpc {
 		_ = r.Reporter.Errorf("HCP shared VPC not supported while using a govcloud region")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	input := buildRolesCreationInput(prefix, permissionsBoundary, r.Creator.AccountID, env, policies,
@@ -570,10 +580,14 @@ func run(cmd *cobra.Command, argv []string) {
 			})
 			os.Exit(1)
 		}
-		err = rolesCreator.printCommands(r, input)
+		if format == iac.FormatShell {
+			err = rolesCreator.printCommands(r, input)
+		} else {
+			err = printTemplate(r, rolesCreator, input, format)
+		}
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		if r.Reporter.IsTerminal() {
 			r.Reporter.Infof("All policy files saved to the current directory")
@@ -583,8 +597,20 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
+	}
+}
+
+// printTemplate prints the account roles and policies as infrastructure as code, in a single
+// template also when both classic and hosted CP roles are created.
+func printTemplate(r *rosa.Runtime, rolesCreator creator, input *accountRolesCreationInput, format iac.Format) error {
+	template := &iac.Template{}
+	err := rolesCreator.buildCommands(r, input, template)
+	if err != nil {
+		return err
 	}
+	r.Reporter.Infof("%s:\n", format.Instructions("to create the account roles and policies"))
+	return iac.Print(r.Reporter, format, template)
 }
 
 func validateAccountRolesSTSExternalID(externalID string) error {
@@ -7,7 +7,7 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
 	"github.com/openshift/rosa/pkg/aws"
-	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
+	"github.com/openshift/rosa/pkg/aws/iac"
 	"github.com/openshift/rosa/pkg/aws/tags"
 	"github.com/openshift/rosa/pkg/roles"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -16,6 +16,7 @@ import (
 type creator interface {
 	createRoles(*rosa.Runtime, *accountRolesCreationInput) error
 	getRoleTags(string, *accountRolesCreationInput) map[string]string
+	buildCommands(*rosa.Runtime, *accountRolesCreationInput, *iac.Template) error
 	printCommands(*rosa.Runtime, *accountRolesCreationInput) error
 	skipPermissionFiles() bool
 	getAccountRolesMap() map[string]aws.AccountRole
@@ -129,14 +130,14 @@ func attachManagedPolicies(r *rosa.Runtime, input *accountRolesCreationInput, ro
 	return nil
 }
 
-func (mp *managedPoliciesCreator) printCommands(r *rosa.Runtime, input *accountRolesCreationInput) error {
-	commands := []string{}
+func (mp *managedPoliciesCreator) buildCommands(r *rosa.Runtime, input *accountRolesCreationInput,
+	template *iac.Template) error {
 	for file, role := range aws.AccountRoles {
 		accRoleName := common.GetRoleName(input.prefix, role.Name)
 		iamTags := mp.getRoleTags(file, input)
 
-		createRole := buildCreateRoleCommand(accRoleName, file, iamTags, input)
-		commands = append(commands, createRole)
+		template.AddRole(iac.CreateRoleCommand(accRoleName, fmt.Sprintf("sts_%s_trust_policy.json", file),
+			input.permissionsBoundary, iamTags, input.path))
 
 		policyKeys := aws.GetAccountRolePolicyKeys(file)
 		for _, policyKey := range policyKeys {
@@ -145,13 +146,22 @@ func (mp *managedPoliciesCreator) printCommands(r *rosa.Runtime, input *accountR
 				return err
 			}
 
-			attachRolePolicy := buildAttachRolePolicyCommand(accRoleName, policyARN)
-			commands = append(commands, attachRolePolicy)
+			template.AddAttachment(iac.AttachRolePolicyCommand(accRoleName, policyARN))
 		}
 	}
 
+	return nil
+}
+
+func (mp *managedPoliciesCreator) printCommands(r *rosa.Runtime, input *accountRolesCreationInput) error {
+	template := &iac.Template{}
+	err := mp.buildCommands(r, input, template)
+	if err != nil {
+		return err
+	}
+
 	r.Reporter.Infof("Run the following commands to create the classic account roles and policies:\n")
-	fmt.Println(awscb.JoinCommands(commands) + "\n")
+	fmt.Println(template.Commands() + "\n")
 
 	return nil
 }
@@ -194,28 +204,37 @@ func (up *unmanagedPoliciesCreator) createRoles(r *rosa.Runtime, input *accountR
 	return nil
 }
 
-func (up *unmanagedPoliciesCreator) printCommands(r *rosa.Runtime, input *accountRolesCreationInput) error {
-	commands := []string{}
+func (up *unmanagedPoliciesCreator) buildCommands(r *rosa.Runtime,
+	input *accountRolesCreationInput, template *iac.Template) error {
 	for file, role := range aws.AccountRoles {
 		accRoleName := common.GetRoleName(input.prefix, role.Name)
 		iamTags := up.getRoleTags(file, input)
 
-		createRole := buildCreateRoleCommand(accRoleName, file, iamTags, input)
+		template.AddRole(iac.CreateRoleCommand(accRoleName, fmt.Sprintf("sts_%s_trust_policy.json", file),
+			input.permissionsBoundary, iamTags, input.path))
 
 		policyName := aws.GetPolicyName(accRoleName)
-		policyDocument := fmt.Sprintf("file://sts_%s_permission_policy.json", file)
+		policyDocument := fmt.Sprintf("sts_%s_permission_policy.json", file)
 
-		createPolicy := buildCreatePolicyCommand(policyName, policyDocument, iamTags, input.path)
+		template.AddPolicy(iac.CreatePolicyCommand(policyName, policyDocument, iamTags, input.path))
 
 		policyARN := aws.GetPolicyArnWithSuffix(r.Creator.Partition, input.accountID, accRoleName, input.path)
 
-		attachRolePolicy := buildAttachRolePolicyCommand(accRoleName, policyARN)
+		template.AddAttachment(iac.AttachRolePolicyCommand(accRoleName, policyARN))
+	}
 
-		commands = append(commands, createRole, createPolicy, attachRolePolicy)
+	return nil
+}
+
+func (up *unmanagedPoliciesCreator) printCommands(r *rosa.Runtime, input *accountRolesCreationInput) error {
+	template := &iac.Template{}
+	err := up.buildCommands(r, input, template)
+	if err != nil {
+		return err
 	}
 
 	r.Reporter.Infof("Run the following commands to create the classic account roles and policies:\n")
-	fmt.Println(awscb.JoinCommands(commands) + "\n")
+	fmt.Println(template.Commands() + "\n")
 
 	return nil
 }
@@ -247,6 +266,18 @@ func (db *doubleRolesCreator) createRoles(r *rosa.Runtime, input *accountRolesCr
 	return hcpCreator.createRoles(r, input)
 }
 
+func (db *doubleRolesCreator) buildCommands(r *rosa.Runtime, input *accountRolesCreationInput,
+	template *iac.Template) error {
+	unmanagedCreator := unmanagedPoliciesCreator{}
+	err := unmanagedCreator.buildCommands(r, input, template)
+	if err != nil {
+		return err
+	}
+
+	hcpCreator := hcpManagedPoliciesCreator{}
+	return hcpCreator.buildCommands(r, input, template)
+}
+
 func (db *doubleRolesCreator) printCommands(r *rosa.Runtime, input *accountRolesCreationInput) error {
 	// Build classic account roles command
 	unmanagedCreator := unmanagedPoliciesCreator{}
@@ -414,16 +445,14 @@ func (hcp *hcpManagedPoliciesCreator) createRoles(r *rosa.Runtime, input *accoun
 	return nil
 }
 
-func (hcp *hcpManagedPoliciesCreator) printCommands(r *rosa.Runtime, input *accountRolesCreationInput) error {
-	commands := []string{}
-
+func (hcp *hcpManagedPoliciesCreator) buildCommands(r *rosa.Runtime,
+	input *accountRolesCreationInput, template *iac.Template) error {
 	for file, role := range aws.HCPAccountRoles {
 		accRoleName := common.GetRoleName(input.prefix, role.Name)
 		iamTags := hcp.getRoleTags(file, input)
 
-		createRole := buildCreateRoleCommand(accRoleName, file, iamTags, input)
-
-		commands = append(commands, createRole)
+		template.AddRole(iac.CreateRoleCommand(accRoleName, fmt.Sprintf("sts_%s_trust_policy.json", file),
+			input.permissionsBoundary, iamTags, input.path))
 
 		policyKeys := aws.GetHcpAccountRolePolicyKeys(file)
 		for _, policyKey := range policyKeys {
@@ -437,7 +466,7 @@ func (hcp *hcpManagedPoliciesCreator) printCommands(r *rosa.Runtime, input *acco
 			if isHcpInstallerRole && input.isSharedVpc { // HCP shared VPC (Installer role policies)
 				for _, arn := range []string{args.route53RoleArn, args.vpcEndpointRoleArn} {
 					// Shared VPC role arn (route53)
-					exists, createPolicyCommand, policyName, err := roles.GetHcpSharedVpcPolicyDetails(r, arn)
+					exists, createPolicyCommand, policy, err := roles.GetHcpSharedVpcPolicyDetails(r, arn)
 					if err != nil {
 						return err
 					}
@@ -446,22 +475,30 @@ func (hcp *hcpManagedPoliciesCreator) printCommands(r *rosa.Runtime, input *acco
 					if err != nil {
 						return err
 					}
-					policyArn := aws.GetPolicyArn(r.Creator.Partition, r.Creator.AccountID, policyName, path)
-					attachRolePolicy := buildAttachRolePolicyCommand(accRoleName, policyArn)
+					policyArn := aws.GetPolicyArn(r.Creator.Partition, r.Creator.AccountID, policy.Name, path)
 					if !exists {
-						commands = append(commands, createPolicyCommand)
+						template.AddPolicy(createPolicyCommand, policy)
 					}
-					commands = append(commands, attachRolePolicy)
+					template.AddAttachment(iac.AttachRolePolicyCommand(accRoleName, policyArn))
 				}
 			}
 
-			attachRolePolicy := buildAttachRolePolicyCommand(accRoleName, policyARN)
-			commands = append(commands, attachRolePolicy)
+			template.AddAttachment(iac.AttachRolePolicyCommand(accRoleName, policyARN))
 		}
 	}
 
+	return nil
+}
+
+func (hcp *hcpManagedPoliciesCreator) printCommands(r *rosa.Runtime, input *accountRolesCreationInput) error {
+	template := &iac.Template{}
+	err := hcp.buildCommands(r, input, template)
+	if err != nil {
+		return err
+	}
+
 	r.Reporter.Infof("Run the following commands to create the hosted CP account roles and policies:\n")
-	fmt.Println(awscb.JoinCommands(commands) + "\n")
+	fmt.Println(template.Commands() + "\n")
 
 	return nil
 }
@@ -491,36 +528,6 @@ func getBaseRoleTags(roleType string, input *accountRolesCreationInput) map[stri
 	}
 }
 
-func buildCreateRoleCommand(accRoleName string, file string, iamTags map[string]string,
-	input *accountRolesCreationInput) string {
-	return awscb.NewIAMCommandBuilder().
-		SetCommand(awscb.CreateRole).
-		AddParam(awscb.RoleName, accRoleName).
-		AddParam(awscb.AssumeRolePolicyDocument, fmt.Sprintf("file://sts_%s_trust_policy.json", file)).
-		AddParam(awscb.PermissionsBoundary, input.permissionsBoundary).
-		AddTags(iamTags).
-		AddParam(awscb.Path, input.path).
-		Build()
-}
-
-func buildCreatePolicyCommand(policyName string, policyDocument string, iamTags map[string]string, path string) string {
-	return awscb.NewIAMCommandBuilder().
-		SetCommand(awscb.CreatePolicy).
-		AddParam(awscb.PolicyName, policyName).
-		AddParam(awscb.PolicyDocument, policyDocument).
-		AddTags(iamTags).
-		AddParam(awscb.Path, path).
-		Build()
-}
-
-func buildAttachRolePolicyCommand(accRoleName string, policyARN string) string {
-	return awscb.NewIAMCommandBuilder().
-		SetCommand(awscb.AttachRolePolicy).
-		AddParam(awscb.RoleName, accRoleName).
-		AddParam(awscb.PolicyArn, policyARN).
-		Build()
-}
-
 func attachHcpSharedVpcPolicy(r *rosa.Runtime, sharedVpcRoleArn string, roleName string,
 	defaultPolicyVersion string) error {
 	policyDetails := aws.InterpolatePolicyDocument(r.Creator.Partition, aws.SharedVpcDefaultPolicy, map[string]string{
@@ -17,6 +17,7 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
 	mock "github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/aws/iac"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -356,3 +357,45 @@ func policyWithoutExternalID() string {
 		}]
 	}`
 }
+
+var _ = Describe("Manual commands", func() {
+	It("Describe the resources created by the commands", func() {
+		r := rosa.NewRuntime()
+		r.Creator = &mock.Creator{Partition: "aws", AccountID: "123456789012"}
+		input := buildRolesCreationInput("test", "arn:aws:iam::123456789012:policy/boundary", "123456789012",
+			"production", map[string]*cmv1.AWSSTSPolicy{}, "4.16", "/rosa/", false, "")
+		template := &iac.Template{}
+		err := (&unmanagedPoliciesCreator{}).buildCommands(r, input, template)
+		Expect(err).NotTo(HaveOccurred())
+
+		commands := template.Commands()
+		Expect(template.Unsupported).To(BeEmpty())
+		Expect(template.Roles).To(HaveLen(len(mock.AccountRoles)))
+		Expect(template.Policies).To(HaveLen(len(mock.AccountRoles)))
+		Expect(template.Attachments).To(HaveLen(len(mock.AccountRoles)))
+		for file, role := range mock.AccountRoles {
+			roleName := "test-" + role.Name + "-Role"
+			Expect(template.Roles).To(ContainElement(&iac.Role{
+				Name:                roleName,
+				Path:                "/rosa/",
+				AssumeRolePolicy:    "sts_" + file + "_trust_policy.json",
+				PermissionsBoundary: "arn:aws:iam::123456789012:policy/boundary",
+				Tags:                getBaseRoleTags(file, input),
+			}))
+			Expect(commands).To(ContainSubstring("--assume-role-policy-document file://sts_" + file +
+				"_trust_policy.json"))
+			Expect(template.Policies).To(ContainElement(&iac.Policy{
+				Name:     roleName + "-Policy",
+				Path:     "/rosa/",
+				Document: "sts_" + file + "_permission_policy.json",
+				Tags:     getBaseRoleTags(file, input),
+			}))
+			Expect(commands).To(ContainSubstring("--policy-document file://sts_" + file +
+				"_permission_policy.json"))
+			Expect(template.Attachments).To(ContainElement(&iac.Attachment{
+				Role:      roleName,
+				PolicyARN: "arn:aws:iam::123456789012:policy/rosa/" + roleName + "-Policy",
+			}))
+		}
+	})
+})
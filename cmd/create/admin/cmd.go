@@ -25,6 +25,7 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/object"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
@@ -80,14 +81,14 @@ func run(_ *cobra.Command, _ []string) {
 	if cluster.ExternalAuthConfig().Enabled() {
 		r.Reporter.Errorf(
 			"Creating the 'cluster-admin' user is not supported for clusters with external authentication configured.")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	adminUser, err := r.OCMClient.GetUser(cluster.ID(), ClusterAdminGroupname, ClusterAdminUsername)
 	if err != nil {
 		r.Reporter.Errorf("Failed to get user '%s' in 'cluster-admins' group for cluster '%s'",
 			ClusterAdminUsername, clusterKey)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if adminUser != nil {
 		r.Reporter.Errorf("Cluster '%s' already has '%s' user", clusterKey, ClusterAdminUsername)
@@ -102,7 +103,7 @@ func run(_ *cobra.Command, _ []string) {
 		password, err = idputils.GenerateRandomPassword()
 		if err != nil {
 			r.Reporter.Errorf("Failed to generate a random password")
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	} else {
 		password = passwordArg
@@ -113,7 +114,7 @@ func run(_ *cobra.Command, _ []string) {
 	err = passwordValidator.PasswordValidator(password)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	// Add admin user to the cluster-admins group:
@@ -121,20 +122,20 @@ func run(_ *cobra.Command, _ []string) {
 	user, err := cmv1.NewUser().ID(ClusterAdminUsername).Build()
 	if err != nil {
 		r.Reporter.Errorf("Failed to create user '%s' for cluster '%s'", ClusterAdminUsername, clusterKey)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	_, err = r.OCMClient.CreateUser(cluster.ID(), ClusterAdminGroupname, user)
 	if err != nil {
 		r.Reporter.Errorf("Failed to add user '%s' to cluster '%s': %s",
 			ClusterAdminUsername, clusterKey, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	existingIdp, err := FindClusterAdminIDP(cluster, r)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if existingIdp == nil {
 		// No ClusterAdmin IDP exists, create an Htpasswd IDP
@@ -158,7 +159,7 @@ func run(_ *cobra.Command, _ []string) {
 				ClusterAdminIDPname,
 				clusterKey,
 			)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		// Add HTPasswd IDP to cluster:
@@ -197,7 +198,7 @@ func run(_ *cobra.Command, _ []string) {
 		err = output.Print(outputObject)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		return
 	}
@@ -248,7 +249,7 @@ func FindIDPWithAdmin(cluster *cmv1.Cluster, r *rosa.Runtime) (
 			r.Reporter.Debugf("user list %s: %v", item.Name(), itemUserList)
 			if err != nil {
 				r.Reporter.Errorf("Failed to get user list of the HTPasswd IDP of '%s: %s': %v", item.Name(), r.ClusterKey, err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			if HasClusterAdmin(itemUserList) {
 				return item, itemUserList, nil
@@ -19,6 +19,7 @@ package cluster
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"net"
 	"os"
 	"reflect"
@@ -45,11 +46,15 @@ import (
 	"github.com/openshift/rosa/cmd/create/operatorroles"
 	clusterdescribe "github.com/openshift/rosa/cmd/describe/cluster"
 	installLogs "github.com/openshift/rosa/cmd/logs/install"
+	"github.com/openshift/rosa/pkg/access"
 	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/clusterautoscaler"
 	"github.com/openshift/rosa/pkg/clusterregistryconfig"
+	"github.com/openshift/rosa/pkg/defaults"
+	"github.com/openshift/rosa/pkg/estimate"
 	"github.com/openshift/rosa/pkg/fedramp"
 	"github.com/openshift/rosa/pkg/helper"
 	mpHelpers "github.com/openshift/rosa/pkg/helper/machinepools"
@@ -268,6 +273,10 @@ var args struct {
 
 	// Log Fowarding
 	logFwdConfig string
+
+	// Cluster spec file
+	fromFile string
+	specTags map[string]string
 }
 
 var clusterRegistryConfigArgs *clusterregistryconfig.ClusterRegistryConfigArgs
@@ -286,7 +295,10 @@ func makeCmd() *cobra.Command {
   rosa create cluster --cluster-name=mycluster
 
   # Create a cluster in the us-east-2 region
-  rosa create cluster --cluster-name=mycluster --region=us-east-2`,
+  rosa create cluster --cluster-name=mycluster --region=us-east-2
+
+  # Create a cluster from a spec file, overriding the name stored in it
+  rosa create cluster --from-file=cluster.yaml --cluster-name=mycluster`,
 		Run:  run,
 		Args: cobra.NoArgs,
 	}
@@ -740,7 +752,8 @@ func initFlags(cmd *cobra.Command) {
 		&args.dryRun,
 		"dry-run",
 		false,
-		"Simulate creating the cluster.",
+		"Simulate creating the cluster, estimating the OCM and AWS quota that it uses. Fails when the "+
+			"cluster would go over the available quota.",
 	)
 
 	flags.BoolVar(
@@ -978,6 +991,15 @@ func initFlags(cmd *cobra.Command) {
 		logforwarding.LogFwdConfigHelpMessage,
 	)
 
+	flags.StringVar(
+		&args.fromFile,
+		fromFileFlag,
+		"",
+		"Path to a YAML or JSON cluster spec file, such as one produced by 'rosa export cluster'. "+
+			"Flags set on the command line override the values in the file. Setting any of the replicas "+
+			"or autoscaling flags overrides the whole scaling of the file.",
+	)
+
 	interactive.AddModeFlag(cmd)
 	interactive.AddFlag(flags)
 	output.AddFlag(cmd)
@@ -989,27 +1011,42 @@ func networkTypeCompletion(cmd *cobra.Command, args []string, toComplete string)
 }
 
 func run(cmd *cobra.Command, _ []string) {
+	// The spec file needs to be applied before the runtime is built, as it can set the region
+	if args.fromFile != "" {
+		err := applyClusterSpecFile(cmd, args.fromFile)
+		if err != nil {
+			_ = reporter.CreateReporter().Errorf("%s", err)
+			os.Exit(clierror.ExitCode(err))
+		}
+	}
+
 	r := rosa.NewRuntime().WithAWS().WithOCM()
 	defer r.Cleanup()
 
+	err := access.Preflight(r, ocm.ActionCreate, ocm.ClusterResourceType, nil)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
+	}
+
 	// Validate mode
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if fedramp.Enabled() && args.logFwdConfig != "" {
 		r.Reporter.Errorf("log forwarding is not supported on Govcloud, please remove the '--%s' flag",
 			logforwarding.FlagName)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	for _, val := range userSpecifiedAutoscalerValues {
 		if val.Changed && !args.autoscalingEnabled {
 			r.Reporter.Errorf("Using autoscaling flag '%s', requires flag '--enable-autoscaling'. "+
 				"Please try again with flag", val.Name)
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 	}
 
@@ -1018,7 +1055,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if isHostedCP {
 		if cmd.Flags().Changed("disable-workload-monitoring") {
 			r.Reporter.Errorf(arguments.UwmNotSupportedMessage)
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		validateHcpFlags(cmd, r.Reporter)
 	}
@@ -1033,7 +1070,7 @@ func run(cmd *cobra.Command, _ []string) {
 	awsCreator, err := awsClient.GetCreator()
 	if err != nil {
 		r.Reporter.Errorf("Unable to get IAM credentials: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	shardPinningEnabled := false
@@ -1044,8 +1081,8 @@ func run(cmd *cobra.Command, _ []string) {
 		}
 	}
 
-	isBYOVPC := cmd.Flags().Changed("subnet-ids")
-	isAvailabilityZonesSet := cmd.Flags().Changed("availability-zones")
+	isBYOVPC := defaults.Changed(cmd.Flags(), "subnet-ids")
+	isAvailabilityZonesSet := defaults.Changed(cmd.Flags(), "availability-zones")
 	// Setting subnet IDs is choosing BYOVPC implicitly,
 	// and selecting availability zones is only allowed for non-BYOVPC clusters
 	if isBYOVPC && isAvailabilityZonesSet {
@@ -1083,7 +1120,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid cluster name: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -1094,7 +1131,7 @@ func run(cmd *cobra.Command, _ []string) {
 		r.Reporter.Errorf("Cluster name must consist"+
 			" of no more than %d lowercase alphanumeric characters or '-', "+
 			"start with a letter, and end with an alphanumeric character.", ocm.MaxClusterNameLength)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	// Get cluster domain prefix
@@ -1112,7 +1149,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid domain prefix: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -1123,7 +1160,7 @@ func run(cmd *cobra.Command, _ []string) {
 		r.Reporter.Errorf("Domain prefix must consist"+
 			" of no more than %d lowercase alphanumeric characters or '-', "+
 			"start with a letter, and end with an alphanumeric character.", ocm.MaxClusterDomainPrefixLength)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if clusterHasLongNameWithoutDomainPrefix(clusterName, domainPrefix) {
@@ -1147,7 +1184,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid --hosted-cp value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -1155,7 +1192,7 @@ func run(cmd *cobra.Command, _ []string) {
 		techPreviewMsg, err := r.OCMClient.GetTechnologyPreviewMessage(ocm.HcpProduct, time.Now())
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		if techPreviewMsg != "" {
 			r.Reporter.Infof(techPreviewMsg)
@@ -1176,20 +1213,20 @@ func run(cmd *cobra.Command, _ []string) {
 			clusterAdminPassword, err = idputils.GenerateRandomPassword()
 			if err != nil {
 				r.Reporter.Errorf("Failed to generate a random password")
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		}
 		// validates both user inputted custom password and randomly generated password
 		err = passwordValidator.PasswordValidator(clusterAdminPassword)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		if clusterAdminUser != "" {
 			err = idp.UsernameValidator(clusterAdminUser)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		} else {
 			clusterAdminUser = admin.ClusterAdminUsername
@@ -1206,7 +1243,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		if isClusterAdmin {
 			//clusterAdminUser = idp.GetIdpUserNameFromPrompt(cmd, r, "cluster-admin-user", clusterAdminUser, true)
@@ -1217,13 +1254,13 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid value: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			if !isCustomAdminPassword {
 				clusterAdminPassword, err = idputils.GenerateRandomPassword()
 				if err != nil {
 					r.Reporter.Errorf("Failed to generate a random password")
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 			} else {
 				clusterAdminPassword = idp.GetIdpPasswordFromPrompt(cmd, r,
@@ -1238,7 +1275,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if isHostedCP && cmd.Flags().Changed(arguments.NewDefaultMPLabelsFlag) {
 		r.Reporter.Errorf("Setting the worker machine pool labels is not supported for hosted clusters")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	// Billing Account
@@ -1248,13 +1285,13 @@ func run(cmd *cobra.Command, _ []string) {
 	if err != nil {
 		_ = r.Reporter.Errorf("failed to determine if billing account capability is enabled for user "+
 			"organization: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	isHcpBillingTechPreview, err := r.OCMClient.IsTechnologyPreview(ocm.HcpBillingAccount, time.Now())
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if (isHostedCP && !isHcpBillingTechPreview) || (!isHostedCP && isClassicBillingCapabilityEnabled) {
@@ -1263,13 +1300,13 @@ func run(cmd *cobra.Command, _ []string) {
 			r.Reporter.Errorf("provided billing account number %s is not valid. "+
 				"Rerun the command with a valid billing account number. %s",
 				billingAccount, listBillingAccountMessage)
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 
 		cloudAccounts, err := r.OCMClient.GetBillingAccounts()
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		billingAccounts := ocm.GenerateBillingAccountsList(cloudAccounts)
@@ -1286,7 +1323,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 				if err != nil {
 					r.Reporter.Errorf("expected a valid billing account: '%s'", err)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 
 				billingAccount = aws.ParseOption(billingAccount)
@@ -1318,14 +1355,14 @@ func run(cmd *cobra.Command, _ []string) {
 			billingAccount, err = provideBillingAccount(billingAccounts, awsCreator.AccountID, r)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		}
 
 		err = ocm.ValidateBillingAccount(billingAccount)
 		if err != nil {
 			r.Reporter.Errorf("%v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -1335,7 +1372,7 @@ func run(cmd *cobra.Command, _ []string) {
 			r.Reporter.Errorf(
 				"External authentication configuration is only supported for a Hosted Control Plane cluster.",
 			)
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 	}
 
@@ -1343,7 +1380,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if etcdEncryptionKmsARN != "" && !isHostedCP {
 		r.Reporter.Errorf("etcd encryption kms arn is only allowed for hosted cp")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	// all hosted clusters are sts
@@ -1352,7 +1389,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if isSTS && isIAM {
 		r.Reporter.Errorf("Can't use both STS and mint mode at the same time.")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if interactive.Enabled() && (!isSTS && !isIAM) {
@@ -1364,7 +1401,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid --sts value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		isIAM = !isSTS
 	}
@@ -1382,7 +1419,7 @@ func run(cmd *cobra.Command, _ []string) {
 		err = aws.ARNValidator(permissionsBoundary)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid policy ARN for permissions boundary: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -1390,12 +1427,12 @@ func run(cmd *cobra.Command, _ []string) {
 		if awsCreator.IsSTS {
 			r.Reporter.Errorf("Since your AWS credentials are returning an STS ARN you can only " +
 				"create STS clusters. Otherwise, switch to IAM credentials.")
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		err := awsClient.CheckAdminUserExists(aws.AdminUserName)
 		if err != nil {
 			r.Reporter.Errorf("IAM user '%s' does not exist. Run `rosa init` first", aws.AdminUserName)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		r.Reporter.Debugf("IAM user is valid!")
 	}
@@ -1412,7 +1449,7 @@ func run(cmd *cobra.Command, _ []string) {
 	defaultVersion, versionList, err := versions.GetVersionList(r, channelGroup, isSTS, isHostedCP, isHostedCP, true)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if version == "" {
 		version = defaultVersion
@@ -1427,13 +1464,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid OpenShift version: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	version, err = r.OCMClient.ValidateVersion(version, versionList, channelGroup, isSTS, isHostedCP)
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid OpenShift version: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if err := r.OCMClient.IsVersionCloseToEol(ocm.CloseToEolDays, version, channelGroup); err != nil {
 		r.Reporter.Warnf("%v", err)
@@ -1446,7 +1483,7 @@ func run(cmd *cobra.Command, _ []string) {
 	channels, err := r.OCMClient.GetAvailableChannels(version)
 	if err != nil {
 		r.Reporter.Errorf("Failed to retrieve available channels for version '%s': %s", version, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if interactive.Enabled() {
 		channel, err = interactive.GetOption(interactive.Input{
@@ -1458,7 +1495,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid OpenShift Channel: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -1476,16 +1513,16 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid http tokens value : %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	if err = ocm.ValidateHttpTokensValue(httpTokens); err != nil {
 		r.Reporter.Errorf("Expected a valid http tokens value : %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if err := ocm.ValidateHttpTokensVersion(ocm.GetVersionMinor(version), httpTokens); err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	// warn if mode is used for non sts cluster
@@ -1499,7 +1536,7 @@ func run(cmd *cobra.Command, _ []string) {
 		isValidMode := arguments.IsValidMode(interactive.Modes, mode)
 		if !isValidMode {
 			r.Reporter.Errorf("Invalid --mode '%s'. Allowed values are %s", mode, interactive.Modes)
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 	}
 
@@ -1507,14 +1544,14 @@ func run(cmd *cobra.Command, _ []string) {
 		r.Reporter.Errorf("Cannot watch for STS cluster installation logs in mode 'auto' " +
 			"without also supplying '--yes' option." +
 			"To watch your cluster installation logs, run 'rosa logs install' instead after the cluster has began creating.")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if args.watch && isSTS && mode == interactive.ModeManual {
 		r.Reporter.Errorf("Cannot watch for STS cluster installation logs in mode 'manual'." +
 			"It requires manual commands to be performed as part of the process." +
 			"To watch your cluster installation logs, run 'rosa logs install' after the cluster has began creating.")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	hasRoles := false
@@ -1531,7 +1568,7 @@ func run(cmd *cobra.Command, _ []string) {
 		}
 		if err != nil {
 			r.Reporter.Errorf("Failed to find %s role: %s", role.Name, err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		r.Reporter.Warnf("Account roles not created by ROSA CLI cannot be listed, updated, or upgraded.")
@@ -1572,7 +1609,7 @@ func run(cmd *cobra.Command, _ []string) {
 				})
 				if err != nil {
 					r.Reporter.Errorf("Expected a valid role ARN: %s", err)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 			}
 		} else if len(roleARNs) == 1 {
@@ -1601,7 +1638,7 @@ func run(cmd *cobra.Command, _ []string) {
 			hostedCPPolicies, err := awsClient.HasHostedCPPolicies(roleARN)
 			if err != nil {
 				r.Reporter.Errorf("Failed to determine if cluster has hosted CP policies: %v", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			hasRoles = true
 			for roleType, role := range aws.AccountRoles {
@@ -1620,14 +1657,14 @@ func run(cmd *cobra.Command, _ []string) {
 				}
 				if err != nil {
 					r.Reporter.Errorf("Failed to find %s role: %s", role.Name, err)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 				selectedARN := ""
 				expectedResourceIDForAccRole, rolePrefix, err := getExpectedResourceIDForAccRole(
 					hostedCPPolicies, roleARN, roleType)
 				if err != nil {
 					r.Reporter.Errorf("Failed to get the expected resource ID for role type: %s", roleType)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 				r.Reporter.Debugf(
 					"Using '%s' as the role prefix to retrieve the expected resource ID for role type '%s'",
@@ -1639,7 +1676,7 @@ func run(cmd *cobra.Command, _ []string) {
 					resourceId, err := aws.GetResourceIdFromARN(rARN)
 					if err != nil {
 						r.Reporter.Errorf("Failed to get resource ID from arn. %s", err)
-						os.Exit(1)
+						os.Exit(clierror.ExitCode(err))
 					}
 					lowerCaseResourceIdToCheck := strings.ToLower(resourceId)
 					if lowerCaseResourceIdToCheck == expectedResourceIDForAccRole {
@@ -1693,7 +1730,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid ARN: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -1701,7 +1738,7 @@ func run(cmd *cobra.Command, _ []string) {
 		err = aws.ARNValidator(roleARN)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid Role ARN: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		isSTS = true
 	}
@@ -1714,7 +1751,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if isSTS && externalIDFlagChanged(cmd) {
 		if err := validateChangedSTSExternalIDFlag(externalID); err != nil {
 			r.Reporter.Errorf("Expected a valid STS external ID: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -1734,32 +1771,32 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid ARN: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	if supportRoleARN != "" {
 		err = aws.ARNValidator(supportRoleARN)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid Support Role ARN: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	} else if roleARN != "" {
-		r.Reporter.Errorf("Support Role ARN is required: %s", err)
-		os.Exit(1)
+		r.Reporter.Errorf("Support Role ARN is required")
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if isSTS && roleARN != "" && supportRoleARN != "" {
 		externalIDResolution, err := resolveSTSExternalIDForClusterCreate(awsClient, externalID, roleARN, supportRoleARN)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		externalID = externalIDResolution.ExternalID
 		if err := checkSTSExternalIDResolution(externalIDResolution, externalIDFlagChanged(cmd)); err != nil {
 			r.Reporter.Errorf("The installer and support role trust policies define STS external IDs with no " +
 				"value in common. Align the role trust policies or pass --external-id with a value present in " +
 				"both roles before creating the cluster.")
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		if shouldWarnAmbiguousSTSExternalID(externalIDResolution, externalIDFlagChanged(cmd)) {
 			r.Reporter.Warnf("Could not determine a single STS external ID from the installer and support role " +
@@ -1777,13 +1814,13 @@ func run(cmd *cobra.Command, _ []string) {
 				})
 				if err != nil {
 					r.Reporter.Errorf("Expected a valid STS external ID: %s", err)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 				if externalID != "" {
 					externalID, err = resolveEnteredSTSExternalIDForCluster(awsClient, externalID, roleARN, supportRoleARN)
 					if err != nil {
 						r.Reporter.Errorf("Failed to resolve STS external ID: %s", err)
-						os.Exit(1)
+						os.Exit(clierror.ExitCode(err))
 					}
 				}
 			}
@@ -1808,18 +1845,18 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid control plane IAM role ARN: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		}
 		if controlPlaneRoleARN != "" {
 			err = aws.ARNValidator(controlPlaneRoleARN)
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid control plane instance IAM role ARN: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		} else if roleARN != "" {
-			r.Reporter.Errorf("Control plane instance IAM role ARN is required: %s", err)
-			os.Exit(1)
+			r.Reporter.Errorf("Control plane instance IAM role ARN is required")
+			os.Exit(clierror.Validation.ExitCode())
 		}
 	}
 
@@ -1840,18 +1877,18 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid worker IAM role ARN: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	if workerRoleARN != "" {
 		err = aws.ARNValidator(workerRoleARN)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid worker instance IAM role ARN: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	} else if roleARN != "" {
-		r.Reporter.Errorf("Worker instance IAM role ARN is required: %s", err)
-		os.Exit(1)
+		r.Reporter.Errorf("Worker instance IAM role ARN is required")
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	// combine role arns to list
@@ -1865,32 +1902,32 @@ func run(cmd *cobra.Command, _ []string) {
 	managedPolicies, err := awsClient.HasManagedPolicies(roleARN)
 	if err != nil {
 		r.Reporter.Errorf("Failed to determine if cluster has managed policies: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	// check if role has hosted cp policy via AWS tag value
 	hostedCPPolicies, err := awsClient.HasHostedCPPolicies(roleARN)
 	if err != nil {
 		r.Reporter.Errorf("Failed to determine if cluster has hosted CP policies: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if managedPolicies {
 		rolePrefix, err := getAccountRolePrefix(hostedCPPolicies, roleARN, aws.InstallerAccountRole)
 		if err != nil {
 			r.Reporter.Errorf("Failed to find prefix from account role: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		err = roles.ValidateAccountRolesManagedPolicies(r, rolePrefix, hostedCPPolicies)
 		if err != nil {
 			r.Reporter.Errorf("Failed while validating account roles: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	} else {
 		err = roles.ValidateUnmanagedAccountRoles(roleARNs, awsClient, version)
 		if err != nil {
 			r.Reporter.Errorf("Failed while validating account roles: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -1916,31 +1953,31 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a prefix for the operator IAM roles: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		}
 		if len(operatorRolesPrefix) == 0 {
-			r.Reporter.Errorf("Expected a prefix for the operator IAM roles: %s", err)
-			os.Exit(1)
+			r.Reporter.Errorf("Expected a prefix for the operator IAM roles")
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		if len(operatorRolesPrefix) > 32 {
 			r.Reporter.Errorf("Expected a prefix with no more than 32 characters")
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		if !aws.RoleNameRE.MatchString(operatorRolesPrefix) {
 			r.Reporter.Errorf("Expected valid operator roles prefix matching %s", aws.RoleNameRE.String())
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 
 		credRequests, err := r.OCMClient.GetAllCredRequests()
 		if err != nil {
 			r.Reporter.Errorf("Error getting operator credential request from OCM %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		operatorRoles, err = r.AWSClient.GetOperatorRolesFromAccountByPrefix(operatorRolesPrefix, credRequests)
 		if err != nil {
 			r.Reporter.Errorf("There was a problem retrieving the Operator Roles from AWS: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -1949,12 +1986,12 @@ func run(cmd *cobra.Command, _ []string) {
 		credRequests, err := r.OCMClient.GetCredRequests(isHostedCP)
 		if err != nil {
 			r.Reporter.Errorf("Error getting operator credential request from OCM %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		accRolesPrefix, err := getAccountRolePrefix(hostedCPPolicies, roleARN, aws.InstallerAccountRole)
 		if err != nil {
 			r.Reporter.Errorf("Failed to find prefix from account role: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		if expectedOperatorRolePath != "" && !output.HasFlag() && r.Reporter.IsTerminal() {
 			r.Reporter.Infof("ARN path '%s' detected. This ARN path will be used for subsequent"+
@@ -1967,7 +2004,7 @@ func run(cmd *cobra.Command, _ []string) {
 				isSupported, err := ocm.CheckSupportedVersion(ocm.GetVersionMinor(version), operator.MinVersion())
 				if err != nil {
 					r.Reporter.Errorf("Error validating operator role '%s' version %s", operator.Name(), err)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 				if !isSupported {
 					continue
@@ -1988,13 +2025,13 @@ func run(cmd *cobra.Command, _ []string) {
 				if !strings.Contains(role, ",") {
 					r.Reporter.Errorf("Expected operator IAM roles to be a comma-separated " +
 						"list of name,namespace,role_arn")
-					os.Exit(1)
+					os.Exit(clierror.Validation.ExitCode())
 				}
 				roleData := strings.Split(role, ",")
 				if len(roleData) != 3 {
 					r.Reporter.Errorf("Expected operator IAM roles to be a comma-separated " +
 						"list of name,namespace,role_arn")
-					os.Exit(1)
+					os.Exit(clierror.Validation.ExitCode())
 				}
 				computedOperatorIamRoleList = append(computedOperatorIamRoleList, ocm.OperatorIAMRole{
 					Name:      roleData[0],
@@ -2008,27 +2045,29 @@ func run(cmd *cobra.Command, _ []string) {
 		if err != nil {
 			if !oidcConfig.Reusable() {
 				r.Reporter.Errorf("%v", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			} else {
 				err = ocm.ValidateOperatorRolesMatchOidcProvider(r.Reporter, awsClient, computedOperatorIamRoleList,
 					oidcConfig.IssuerUrl(), ocm.GetVersionMinor(version), expectedOperatorRolePath, managedPolicies, true)
 				if err != nil {
 					r.Reporter.Errorf("%v", err)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 			}
 		}
 		err = validateUniqueIamRoleArnsForStsCluster(roleARNs, computedOperatorIamRoleList)
 		if err != nil {
 			r.Reporter.Errorf(err.Error())
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 	}
 
 	// Custom tags for AWS resources
 	_tags := args.tags
 	tagsList := map[string]string{}
-	if interactive.Enabled() {
+	if len(args.specTags) > 0 {
+		tagsList = maps.Clone(args.specTags)
+	} else if interactive.Enabled() {
 		tagsInput, err := interactive.GetString(interactive.Input{
 			Question: "Tags",
 			Help:     cmd.Flags().Lookup("tags").Usage,
@@ -2040,7 +2079,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid set of tags: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		if len(tagsInput) > 0 {
 			_tags = strings.Split(tagsInput, ",")
@@ -2049,7 +2088,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if len(_tags) > 0 {
 		if err := aws.UserTagValidator(_tags); err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		delim := aws.GetTagsDelimiter(_tags)
 		for _, tag := range _tags {
@@ -2068,7 +2107,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid multi-AZ value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -2086,7 +2125,7 @@ func run(cmd *cobra.Command, _ []string) {
 	region, err := aws.GetRegion(arguments.GetRegion())
 	if err != nil {
 		r.Reporter.Errorf("Error getting region: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	// Filter regions by OCP version for displaying in interactive mode
 	var versionFilter string
@@ -2099,11 +2138,11 @@ func run(cmd *cobra.Command, _ []string) {
 		awsClient, isHostedCP, shardPinningEnabled)
 	if err != nil {
 		r.Reporter.Errorf(fmt.Sprintf("%s", err))
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if region == "" {
 		r.Reporter.Errorf("Expected a valid AWS region")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	} else if found := helper.Contains(regionList, region); isHostedCP && !shardPinningEnabled && !found {
 		r.Reporter.Warnf("Region '%s' not currently available for Hosted Control Plane cluster.", region)
 		interactive.Enable()
@@ -2119,17 +2158,17 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid AWS region: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	if supportsMultiAZ, found := regionAZ[region]; found {
 		if !supportsMultiAZ && multiAZ {
 			r.Reporter.Errorf("Region '%s' does not support multiple availability zones", region)
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 	} else {
 		r.Reporter.Errorf("Region '%s' is not supported for this AWS account", region)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	awsClient, err = aws.NewClient().
@@ -2139,7 +2178,7 @@ func run(cmd *cobra.Command, _ []string) {
 		Build()
 	if err != nil {
 		r.Reporter.Errorf("Failed to create awsClient: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	r.AWSClient = awsClient
 
@@ -2164,7 +2203,7 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				_ = r.Reporter.Errorf("Expected a valid private-link value: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		} else {
 			private, err = interactive.GetBool(interactive.Input{
@@ -2184,7 +2223,7 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				_ = r.Reporter.Errorf("Expected a valid private ingress value: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		}
 	}
@@ -2199,7 +2238,7 @@ func run(cmd *cobra.Command, _ []string) {
 		privateLink = true
 	} else if isSTS && private {
 		r.Reporter.Errorf("Private STS clusters are only supported through AWS PrivateLink")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	} else if !isSTS {
 		privateWarning := "You will not be able to access your cluster until " +
 			"you edit network settings in your cloud provider."
@@ -2211,7 +2250,7 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid private value: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		} else if private {
 			r.Reporter.Warnf("You are choosing to make your cluster private. %s", privateWarning)
@@ -2223,7 +2262,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if isSTS && private && !privateLink {
 		r.Reporter.Errorf("Private STS clusters are only supported through AWS PrivateLink")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if privateLink || isHostedCP {
@@ -2263,7 +2302,7 @@ func run(cmd *cobra.Command, _ []string) {
 				args.logFwdConfig)
 			if err != nil {
 				r.Reporter.Errorf("error parsing log forwarder config '%s': %s", args.logFwdConfig, err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			logFwdS3ConfigObject = yamlObject.S3
 			logFwdCloudWatchConfigObject = yamlObject.CloudWatch
@@ -2271,7 +2310,7 @@ func run(cmd *cobra.Command, _ []string) {
 			interactiveObject, err := interactiveLogForwarding.InteractiveLogForwardingConfig(r.OCMClient)
 			if err != nil {
 				r.Reporter.Errorf("failed to create log fowarder config: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			if interactiveObject.S3 != nil && interactiveObject.S3.S3ConfigBucketName != "" {
 				logFwdS3ConfigObject = interactiveObject.S3
@@ -2295,7 +2334,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid CIDR value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -2312,7 +2351,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid CIDR value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	// Pod CIDR:
@@ -2328,7 +2367,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid CIDR value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -2351,13 +2390,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
 	if isHostedCP && !subnetsProvided && !useExistingVPC {
 		r.Reporter.Errorf("All hosted clusters need a pre-configured VPC. Make sure to specify the subnet ids")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	// For hosted cluster we will need the number of the private subnets the users has selected
@@ -2376,18 +2415,18 @@ func run(cmd *cobra.Command, _ []string) {
 		initialSubnets, err = getInitialValidSubnets(awsClient, idsToFetch, r.Reporter)
 		if err != nil {
 			_ = r.Reporter.Errorf("Failed to get the list of subnets: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		useExistingVPC = true
 		_, machineNetwork, err := net.ParseCIDR(machineCIDR.String())
 		if err != nil {
 			_ = r.Reporter.Errorf("Unable to parse machine CIDR")
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		_, serviceNetwork, err := net.ParseCIDR(serviceCIDR.String())
 		if err != nil {
 			_ = r.Reporter.Errorf("Unable to parse service CIDR")
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		var filterError error
 		subnets, filterError = filterCidrRangeSubnets(initialSubnets, machineNetwork, serviceNetwork, r)
@@ -2408,7 +2447,7 @@ func run(cmd *cobra.Command, _ []string) {
 					"All Hosted Control Plane clusters need a pre-configured VPC. Please check: %s",
 					createVpcForHcpDoc,
 				)
-				os.Exit(1)
+				os.Exit(clierror.Validation.ExitCode())
 			}
 			if ok := confirm.Prompt(false, "Continue with default? A new RH Managed VPC will be created for your cluster"); !ok {
 				os.Exit(1)
@@ -2427,7 +2466,7 @@ func run(cmd *cobra.Command, _ []string) {
 				if slices.Contains(excludedPublicSubnets, subnetArg) {
 					_ = r.Reporter.Errorf("Cluster is set as private, cannot use public '%s'",
 						subnetArg)
-					os.Exit(1)
+					os.Exit(clierror.Validation.ExitCode())
 				}
 
 				// Check if the provided subnet exists in the filtered list
@@ -2488,7 +2527,7 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected valid subnet IDs: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			for i, subnet := range subnetIDs {
 				subnetIDs[i] = aws.ParseOption(subnet)
@@ -2508,7 +2547,7 @@ func run(cmd *cobra.Command, _ []string) {
 			}
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		}
 
@@ -2544,7 +2583,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if len(subnetIDs) == 0 && (isSharedVPC || isHcpSharedVpc) {
 		r.Reporter.Errorf("Installing a cluster into a shared VPC is only supported for BYO VPC clusters")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if isSubnetBelongToSharedVpc(r, awsCreator.AccountID, subnetIDs, mapSubnetIDToSubnet) {
@@ -2553,7 +2592,7 @@ func run(cmd *cobra.Command, _ []string) {
 			r.Reporter.Errorf("Installing a cluster into shared VPC is only supported for cluster "+
 				"which has a name no longer than %d characters or with a cluster domain prefix",
 				ocm.MaxClusterDomainPrefixLength)
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 
 		isSharedVPC = true
@@ -2576,7 +2615,7 @@ func run(cmd *cobra.Command, _ []string) {
 			privateHostedZoneID, err = getPrivateHostedZoneID(cmd, privateHostedZoneID)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 
 			// TODO: We can remove this and replace the above once we deprecate the old flags
@@ -2588,14 +2627,14 @@ func run(cmd *cobra.Command, _ []string) {
 
 				if err != nil {
 					r.Reporter.Errorf("%s", err)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 			}
 
 			sharedVPCRoleARN, err = getSharedVpcRoleArn(cmd, sharedVPCRoleARN)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 
 			// TODO: We can remove this and replace the above once we deprecate the old flags
@@ -2605,14 +2644,14 @@ func run(cmd *cobra.Command, _ []string) {
 				vpcEndpointRoleArn, err = getVpcEndpointRoleArn(cmd, vpcEndpointRoleArn)
 				if err != nil {
 					r.Reporter.Errorf("%s", err)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 			}
 
 			baseDomain, err = getBaseDomain(r, cmd, baseDomain, isHostedCP)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		}
 	}
@@ -2624,17 +2663,17 @@ func run(cmd *cobra.Command, _ []string) {
 				hcpInternalCommunicationHostedZoneId, fedramp.Enabled())
 			if err != nil {
 				r.Reporter.Errorf("Error when validating flags: %v", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		} else {
 			if vpcEndpointRoleArn != "" {
 				r.Reporter.Errorf(hcpSharedVpcFlagOnlyErrorMsg,
 					vpcEndpointRoleArnFlag)
-				os.Exit(1)
+				os.Exit(clierror.Validation.ExitCode())
 			} else if hcpInternalCommunicationHostedZoneId != "" {
 				r.Reporter.Errorf(hcpSharedVpcFlagOnlyErrorMsg,
 					hcpInternalCommunicationHostedZoneIdFlag)
-				os.Exit(1)
+				os.Exit(clierror.Validation.ExitCode())
 			}
 		}
 	}
@@ -2655,20 +2694,20 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid value for select-availability-zones: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 
 			if selectAvailabilityZones {
 				optionsAvailabilityZones, err := awsClient.DescribeAvailabilityZones()
 				if err != nil {
 					r.Reporter.Errorf("Failed to get the list of the availability zone: %s", err)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 
 				availabilityZones, err = selectAvailabilityZonesInteractively(cmd, optionsAvailabilityZones, multiAZ)
 				if err != nil {
 					r.Reporter.Errorf("%s", err)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 			}
 		}
@@ -2677,7 +2716,7 @@ func run(cmd *cobra.Command, _ []string) {
 			err = validateAvailabilityZones(multiAZ, availabilityZones, awsClient)
 			if err != nil {
 				r.Reporter.Errorf(fmt.Sprintf("%s", err))
-				os.Exit(1)
+				os.Exit(clierror.Validation.ExitCode())
 			}
 		}
 	}
@@ -2697,7 +2736,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for enable-customer-managed-key: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -2713,14 +2752,14 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for kms-key-arn: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
 	err = kmsArnRegexpValidator.ValidateKMSKeyARN(&kmsKeyARN)
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid value for kms-key-arn: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	// Compute node instance type:
@@ -2729,7 +2768,7 @@ func run(cmd *cobra.Command, _ []string) {
 		awsClient, externalID)
 	if err != nil {
 		r.Reporter.Errorf(fmt.Sprintf("%s", err))
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if computeMachineType == "" {
 		computeMachineType = defaultComputeMachineType
@@ -2743,17 +2782,17 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid machine type: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	err = computeMachineTypeList.ValidateMachineType(computeMachineType, multiAZ)
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid machine type: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
-	isAutoscalingSet := cmd.Flags().Changed("enable-autoscaling")
-	isReplicasSet := cmd.Flags().Changed("compute-nodes") || cmd.Flags().Changed("replicas")
+	isAutoscalingSet := defaults.Changed(cmd.Flags(), "enable-autoscaling")
+	isReplicasSet := defaults.Changed(cmd.Flags(), "compute-nodes") || defaults.Changed(cmd.Flags(), "replicas")
 
 	// Autoscaling
 	autoscaling := args.autoscalingEnabled
@@ -2766,12 +2805,12 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for enable-autoscaling: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
-	isMinReplicasSet := cmd.Flags().Changed("min-replicas")
-	isMaxReplicasSet := cmd.Flags().Changed("max-replicas")
+	isMinReplicasSet := defaults.Changed(cmd.Flags(), "min-replicas")
+	isMaxReplicasSet := defaults.Changed(cmd.Flags(), "max-replicas")
 
 	minReplicas, maxReplicas := calculateReplicas(
 		isMinReplicasSet,
@@ -2798,7 +2837,7 @@ func run(cmd *cobra.Command, _ []string) {
 		// if the user set compute-nodes and enabled autoscaling
 		if isReplicasSet {
 			r.Reporter.Errorf("Compute-nodes can't be set when autoscaling is enabled")
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		if interactive.Enabled() || !isMinReplicasSet {
 			minReplicas, err = interactive.GetInt(interactive.Input{
@@ -2812,13 +2851,13 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid number of min replicas: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		}
 		err = replicaSizeValidation.MinReplicaValidatorOnClusterCreate()(minReplicas)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		replicaSizeValidation.MinReplicas = minReplicas
 
@@ -2834,13 +2873,13 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid number of max replicas: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		}
 		err = replicaSizeValidation.MaxReplicaValidatorOnClusterCreate()(maxReplicas)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		if isHostedCP {
@@ -2860,22 +2899,18 @@ func run(cmd *cobra.Command, _ []string) {
 				cmd.Flags(), clusterAutoscalerFlagsPrefix, true, autoscalerArgs, autoscalerValidationArgs)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		}
 	}
 
 	// Compute nodes:
-	computeNodes := args.computeNodes
-	// Compute node requirements for multi-AZ clusters are higher
-	if multiAZ && !autoscaling && !isReplicasSet {
-		computeNodes = minReplicas
-	}
+	computeNodes := calculateComputeNodes(cmd, multiAZ, autoscaling, minReplicas)
 	if !autoscaling {
 		// if the user set min/max replicas and hasn't enabled autoscaling
 		if isMinReplicasSet || isMaxReplicasSet {
 			r.Reporter.Errorf("Autoscaling must be enabled in order to set min and max replicas")
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 
 		if interactive.Enabled() {
@@ -2889,13 +2924,13 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid number of compute nodes: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		}
 		err = replicaSizeValidation.MinReplicaValidatorOnClusterCreate()(computeNodes)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -2912,20 +2947,20 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	labelMap, err := mpHelpers.ParseLabels(labels)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	isVersionCompatibleComputeSgIds, err := versions.IsGreaterThanOrEqual(
 		version, ocm.MinVersionForAdditionalComputeSecurityGroupIdsDay1)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem checking version compatibility: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	additionalComputeSecurityGroupIds := args.additionalComputeSecurityGroupIds
@@ -2949,13 +2984,13 @@ func run(cmd *cobra.Command, _ []string) {
 	expiration, err := validateExpiration()
 	if err != nil {
 		r.Reporter.Errorf(fmt.Sprintf("%s", err))
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	// Network Type:
 	if err := validateNetworkType(args.networkType); err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if cmd.Flags().Changed("network-type") && interactive.Enabled() {
 		args.networkType, err = interactive.GetOption(interactive.Input{
@@ -2966,7 +3001,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid network type: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -2986,30 +3021,30 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid host prefix value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	err = hostPrefixValidator(hostPrefix)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	machinePoolRootDisk, err := getMachinePoolRootDisk(r, cmd, version,
 		isHostedCP, defaultMachinePoolRootDiskSize)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	// No CNI
 	if cmd.Flags().Changed("no-cni") && !isHostedCP {
 		r.Reporter.Errorf("Disabling CNI is supported only for Hosted Control Planes")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 	if cmd.Flags().Changed("no-cni") && cmd.Flags().Changed("network-type") {
 		r.Reporter.Errorf("--no-cni and --network-type are mutually exclusive parameters")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 	noCni := args.noCni
 	if cmd.Flags().Changed("no-cni") && interactive.Enabled() {
@@ -3020,7 +3055,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for no CNI: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -3033,7 +3068,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid FIPS value: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -3047,7 +3082,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if etcdEncryptionKmsARN != "" {
 		if cmd.Flags().Changed("etcd-encryption") && !etcdEncryption {
 			r.Reporter.Errorf("etcd encryption cannot be disabled when encryption kms arn is provided")
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		} else {
 			etcdEncryption = true
 		}
@@ -3062,13 +3097,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid etcd-encryption value: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	if fips {
 		if cmd.Flags().Changed("etcd-encryption") && !etcdEncryption {
 			r.Reporter.Errorf("etcd encryption cannot be disabled on clusters with FIPS mode")
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		} else {
 			etcdEncryption = true
 		}
@@ -3086,7 +3121,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for etcd-encryption-kms-arn: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -3096,7 +3131,7 @@ func run(cmd *cobra.Command, _ []string) {
 			"Expected a valid value for etcd-encryption-kms-arn matching %s",
 			kmsArnRegexpValidator.KmsArnRE,
 		)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	disableWorkloadMonitoring := args.disableWorkloadMonitoring
@@ -3108,7 +3143,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			_ = r.Reporter.Errorf("Expected a valid disable-workload-monitoring value: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -3121,7 +3156,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid enable-delete-protection value: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		args.enableDeleteProtection = enableDeleteProtection
 	}
@@ -3136,7 +3171,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid proxy-enabled value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -3151,13 +3186,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid http proxy: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	err = ocm.ValidateHTTPProxy(httpProxy)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if enableProxy && interactive.Enabled() {
@@ -3171,13 +3206,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid https proxy: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	err = ocm.ValidateHTTPSProxy(httpsProxy)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if enableProxy && interactive.Enabled() {
@@ -3192,7 +3227,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid set of no proxy domains/CIDR's: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		noProxySlice = helper.HandleEmptyStringOnSlice(strings.Split(noProxyInput, ","))
 	}
@@ -3201,20 +3236,20 @@ func run(cmd *cobra.Command, _ []string) {
 		duplicate, found := aws.HasDuplicates(noProxySlice)
 		if found {
 			r.Reporter.Errorf("Invalid no-proxy list, duplicate key '%s' found", duplicate)
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		for _, domain := range noProxySlice {
 			err := aws.UserNoProxyValidator(domain)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		}
 	}
 
 	if httpProxy == "" && httpsProxy == "" && len(noProxySlice) > 0 {
 		r.Reporter.Errorf("Expected at least one of the following: http-proxy, https-proxy")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if useExistingVPC && interactive.Enabled() {
@@ -3228,13 +3263,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid additional trust bundle file name: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	err = ocm.ValidateAdditionalTrustBundle(additionalTrustBundleFile)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	// Get certificate contents
@@ -3243,7 +3278,7 @@ func run(cmd *cobra.Command, _ []string) {
 		cert, err := os.ReadFile(additionalTrustBundleFile)
 		if err != nil {
 			r.Reporter.Errorf("Failed to read additional trust bundle file: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		additionalTrustBundle = new(string)
 		*additionalTrustBundle = string(cert)
@@ -3251,13 +3286,13 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if enableProxy && httpProxy == "" && httpsProxy == "" && additionalTrustBundleFile == "" {
 		r.Reporter.Errorf("Expected at least one of the following: http-proxy, https-proxy, additional-trust-bundle")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	// Additional Allowed Principals
 	if cmd.Flags().Changed("additional-allowed-principals") && !isHostedCP {
 		r.Reporter.Errorf("Additional Allowed Principals is supported only for Hosted Control Planes")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 	additionalAllowedPrincipals := args.additionalAllowedPrincipals
 	if isHostedCP && interactive.Enabled() {
@@ -3269,14 +3304,14 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for Additional Allowed Principal ARNs: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		additionalAllowedPrincipals = helper.HandleEmptyStringOnSlice(strings.Split(aapInputs, ","))
 	}
 	if len(additionalAllowedPrincipals) > 0 {
 		if err := roles.ValidateAdditionalAllowedPrincipals(additionalAllowedPrincipals); err != nil {
 			r.Reporter.Errorf(err.Error())
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 	}
 
@@ -3285,7 +3320,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if auditLogRoleARN != "" && !isHostedCP {
 		r.Reporter.Errorf("Audit log forwarding to AWS CloudWatch is only supported for Hosted Control Plane clusters")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if interactive.Enabled() && isHostedCP {
@@ -3296,7 +3331,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		if requestAuditLogForwarding {
 
@@ -3314,7 +3349,7 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid value for audit-log-arn: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		} else {
 			auditLogRoleARN = ""
@@ -3323,31 +3358,31 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if auditLogRoleARN != "" && !aws.RoleArnRE.MatchString(auditLogRoleARN) {
 		r.Reporter.Errorf("Expected a valid value for audit log arn matching %s", aws.RoleArnRE)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	isVersionCompatibleManagedIngressV2, err := versions.IsGreaterThanOrEqual(
 		version, ocm.MinVersionForManagedIngressV2)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem checking version compatibility: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if ingress.IsDefaultIngressSetViaCLI(cmd.Flags()) {
 		if isHostedCP {
 			r.Reporter.Errorf("Updating default ingress settings is not supported for Hosted Control Plane clusters")
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		if !isVersionCompatibleManagedIngressV2 {
 			formattedVersion, err := versions.FormatMajorMinorPatch(ocm.MinVersionForManagedIngressV2)
 			if err != nil {
 				r.Reporter.Errorf(versions.MajorMinorPatchFormattedErrorOutput, err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			r.Reporter.Errorf(
 				"Updating default ingress settings is not supported for versions prior to '%s'",
 				formattedVersion,
 			)
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 	}
 	routeSelector := ""
@@ -3367,13 +3402,13 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				_ = r.Reporter.Errorf("Expected a valid boolean value: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		}
 		if cmd.Flags().Changed(ingress.DefaultIngressRouteSelectorFlag) {
 			if isHostedCP {
 				r.Reporter.Errorf("Updating route selectors is not supported for Hosted Control Plane clusters")
-				os.Exit(1)
+				os.Exit(clierror.Validation.ExitCode())
 			}
 			routeSelector = args.defaultIngressRouteSelectors
 		} else if interactive.Enabled() && !isHostedCP && shouldAskCustomIngress {
@@ -3390,20 +3425,20 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			routeSelector = routeSelectorArg
 		}
 		routeSelectors, err = ingress.GetRouteSelector(routeSelector)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		if cmd.Flags().Changed(ingress.DefaultIngressExcludedNamespacesFlag) {
 			if isHostedCP {
 				r.Reporter.Errorf("Updating excluded namespace is not supported for Hosted Control Plane clusters")
-				os.Exit(1)
+				os.Exit(clierror.Validation.ExitCode())
 			}
 			excludedNamespaces = args.defaultIngressExcludedNamespaces
 		} else if interactive.Enabled() && !isHostedCP && shouldAskCustomIngress {
@@ -3414,7 +3449,7 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			excludedNamespaces = excludedNamespacesArg
 		}
@@ -3423,7 +3458,7 @@ func run(cmd *cobra.Command, _ []string) {
 		if cmd.Flags().Changed(ingress.DefaultIngressWildcardPolicyFlag) {
 			if isHostedCP {
 				r.Reporter.Errorf("Updating Wildcard Policy is not supported for Hosted Control Plane clusters")
-				os.Exit(1)
+				os.Exit(clierror.Validation.ExitCode())
 			}
 			wildcardPolicy = args.defaultIngressWildcardPolicy
 		} else {
@@ -3441,7 +3476,7 @@ func run(cmd *cobra.Command, _ []string) {
 				})
 				if err != nil {
 					r.Reporter.Errorf("Expected a valid Wildcard Policy: %s", err)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 				wildcardPolicy = wildcardPolicyArg
 			}
@@ -3452,7 +3487,7 @@ func run(cmd *cobra.Command, _ []string) {
 				r.Reporter.Errorf(
 					"Updating Namespace Ownership Policy is not supported for Hosted Control Plane clusters",
 				)
-				os.Exit(1)
+				os.Exit(clierror.Validation.ExitCode())
 			}
 			namespaceOwnershipPolicy = args.defaultIngressNamespaceOwnershipPolicy
 		} else {
@@ -3470,7 +3505,7 @@ func run(cmd *cobra.Command, _ []string) {
 				})
 				if err != nil {
 					r.Reporter.Errorf("Expected a valid Namespace Ownership Policy: %s", err)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 				namespaceOwnershipPolicy = namespaceOwnershipPolicyArg
 			}
@@ -3589,7 +3624,7 @@ func run(cmd *cobra.Command, _ []string) {
 		autoscalerConfig, err := clusterautoscaler.CreateAutoscalerConfig(clusterAutoscaler)
 		if err != nil {
 			r.Reporter.Errorf("Failed creating autoscaler configuration: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		clusterConfig.AutoscalerConfig = autoscalerConfig
@@ -3599,7 +3634,7 @@ func run(cmd *cobra.Command, _ []string) {
 		cmd.Flags(), clusterRegistryConfigArgs, isHostedCP, nil)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if clusterRegistryConfigArgs != nil {
 		allowedRegistries, blockedRegistries, insecureRegistries,
@@ -3615,7 +3650,7 @@ func run(cmd *cobra.Command, _ []string) {
 			ca, err := clusterregistryconfig.BuildAdditionalTrustedCAFromInputFile(additionalTrustedCa)
 			if err != nil {
 				r.Reporter.Errorf("Failed to build the additional trusted ca from file %s, got error: %s", additionalTrustedCa, err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			clusterConfig.AdditionalTrustedCa = ca
 			clusterConfig.AdditionalTrustedCaFile = additionalTrustedCa
@@ -3630,7 +3665,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if args.useLocalCredentials {
 		if isSTS {
 			r.Reporter.Errorf("Local credentials are not supported for STS clusters")
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		props = append(props, properties.UseLocalCredentials)
 	}
@@ -3649,7 +3684,7 @@ func run(cmd *cobra.Command, _ []string) {
 	clusterConfig, err = clusterConfigFor(r.Reporter, clusterConfig, awsCreator, awsClient)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if !output.HasFlag() || r.Reporter.IsTerminal() {
@@ -3665,7 +3700,30 @@ func run(cmd *cobra.Command, _ []string) {
 	if !clusterConfig.IsSTS {
 		if err := r.OCMClient.EnsureNoPendingClusters(awsCreator); err != nil {
 			r.Reporter.Errorf("%v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
+		}
+	}
+
+	if args.dryRun {
+		computePool := &estimate.Pool{
+			Name:         "workers",
+			InstanceType: computeMachineType,
+			MinReplicas:  computeNodes,
+			MaxReplicas:  computeNodes,
+			RootDiskSize: defaultMachinePoolRootDiskSize,
+		}
+		if autoscaling {
+			computePool.MinReplicas = minReplicas
+			computePool.MaxReplicas = maxReplicas
+		}
+		if machinePoolRootDisk != nil {
+			computePool.RootDiskSize = machinePoolRootDisk.Size
+		}
+		err = estimate.Run(r, awsClient, &computeMachineTypeList, estimate.ClusterRequest(isHostedCP, multiAZ,
+			computePool, args.masterMachineType, args.infraMachineType))
+		if err != nil {
+			r.Reporter.Errorf("Creating cluster '%s' should fail: %s", clusterName, err)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -3690,7 +3748,7 @@ func run(cmd *cobra.Command, _ []string) {
 		deleteProtection, err := v1.NewDeleteProtection().Enabled(true).Build()
 		if err != nil {
 			r.Reporter.Errorf("Failed to build delete protection request: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		if err := r.OCMClient.UpdateClusterDeleteProtection(cluster.ID(), deleteProtection); err != nil {
 			r.Reporter.Errorf(
@@ -3698,7 +3756,7 @@ func run(cmd *cobra.Command, _ []string) {
 				cluster.ID(),
 				err,
 			)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -3757,7 +3815,7 @@ func run(cmd *cobra.Command, _ []string) {
 					r.Reporter.Debugf("Failed to verify if OIDC provider exists: %s", err)
 				} else {
 					r.Reporter.Errorf("Failed to verify if OIDC provider exists: %s", err)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 			}
 			if !oidcProviderExists {
@@ -3906,7 +3964,7 @@ func handleOidcConfigOptions(r *rosa.Runtime, cmd *cobra.Command, isSTS bool, is
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid value: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			isOidcConfig = _isOidcConfig
 		}
@@ -3926,12 +3984,12 @@ func handleOidcConfigOptions(r *rosa.Runtime, cmd *cobra.Command, isSTS bool, is
 		}
 		r.Reporter.Errorf("Hosted Control Plane requires an OIDC Configuration ID\n" +
 			"Please run `rosa create oidc-config -h` and create one.")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 	oidcConfig, err := r.OCMClient.GetOidcConfig(oidcConfigId)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem retrieving OIDC Config '%s': %v", oidcConfigId, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	return oidcConfig
 }
@@ -3942,7 +4000,7 @@ func filterPrivateSubnets(initialSubnets []ec2types.Subnet, r *rosa.Runtime) ([]
 	publicSubnetMap, err := r.AWSClient.FetchPublicSubnetMap(initialSubnets)
 	if err != nil {
 		r.Reporter.Errorf("Unable to check if subnet have an IGW: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	for _, subnet := range initialSubnets {
 		skip := false
@@ -4457,6 +4515,17 @@ func calculateReplicas(
 	return newMinReplicas, newMaxReplicas
 }
 
+// calculateComputeNodes returns the number of compute nodes of a cluster without autoscaling. The
+// compute node requirements for multi-AZ clusters are higher, so they get the minimum number of
+// replicas unless the number of compute nodes was set, in the command line or in the defaults.
+func calculateComputeNodes(cmd *cobra.Command, multiAZ bool, autoscaling bool, minReplicas int) int {
+	isReplicasSet := defaults.Changed(cmd.Flags(), "compute-nodes") || defaults.Changed(cmd.Flags(), "replicas")
+	if multiAZ && !autoscaling && !isReplicasSet {
+		return minReplicas
+	}
+	return args.computeNodes
+}
+
 func getExpectedResourceIDForAccRole(hostedCPPolicies bool, roleARN string, roleType string) (string, string, error) {
 
 	accountRoles := aws.AccountRoles
@@ -4530,7 +4599,7 @@ func getSecurityGroups(r *rosa.Runtime, cmd *cobra.Command, isVersionCompatibleC
 		if !useExistingVpc {
 			r.Reporter.Errorf("Setting the `%s` flag is only allowed for BYO VPC clusters",
 				interactiveSgs.SgKindFlagMap[kind])
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		if !isVersionCompatibleComputeSgIds {
 			formattedVersion, err := versions.FormatMajorMinorPatch(
@@ -4538,11 +4607,11 @@ func getSecurityGroups(r *rosa.Runtime, cmd *cobra.Command, isVersionCompatibleC
 			)
 			if err != nil {
 				r.Reporter.Errorf(versions.MajorMinorPatchFormattedErrorOutput, err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			r.Reporter.Errorf("Parameter '%s' is not supported prior to version '%s'",
 				interactiveSgs.SgKindFlagMap[kind], formattedVersion)
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 	} else if interactive.Enabled() && isVersionCompatibleComputeSgIds && useExistingVpc {
 		vpcId := ""
@@ -4576,7 +4645,7 @@ func getMachinePoolRootDisk(r *rosa.Runtime, cmd *cobra.Command, version string,
 			formattedVersion, err := versions.FormatMajorMinorPatch(ocm.MinVersionForMachinePoolRootDisk)
 			if err != nil {
 				r.Reporter.Errorf(versions.MajorMinorPatchFormattedErrorOutput, err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			return nil, fmt.Errorf(
 				"updating Worker disk size is not supported for versions prior to '%s'",
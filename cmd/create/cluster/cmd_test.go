@@ -3,6 +3,8 @@ package cluster
 import (
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -20,6 +22,7 @@ import (
 	"github.com/openshift/rosa/cmd/create/admin"
 	mock "github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/defaults"
 	"github.com/openshift/rosa/pkg/logging"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -722,3 +725,35 @@ func mustParseCIDR(s string) *net.IPNet {
 	Expect(err).To(BeNil())
 	return ipnet
 }
+
+var _ = Describe("calculateComputeNodes()", func() {
+	var cmd *cobra.Command
+
+	BeforeEach(func() {
+		cmd = makeCmd()
+		initFlags(cmd)
+		DeferCleanup(func() {
+			args.computeNodes = 2
+			args.subnetIDs = nil
+		})
+	})
+
+	It("uses the minimum replicas of multi-AZ clusters when the replicas are not set", func() {
+		Expect(calculateComputeNodes(cmd, true, false, 3)).To(Equal(3))
+	})
+
+	It("uses the replicas from the defaults", func() {
+		dir := GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(dir, defaults.FileName),
+			[]byte("defaults:\n  replicas: 5\n  subnet-ids: subnet-a,subnet-b\n"), 0600)).To(Succeed())
+		values, err := defaults.LoadFrom(dir, "")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = values.Apply(cmd.Flags())
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(calculateComputeNodes(cmd, true, false, 3)).To(Equal(5))
+		Expect(cmd.Flags().Changed("subnet-ids")).To(BeFalse())
+		Expect(defaults.Changed(cmd.Flags(), "subnet-ids")).To(BeTrue())
+		Expect(args.subnetIDs).To(Equal([]string{"subnet-a", "subnet-b"}))
+	})
+})
@@ -0,0 +1,190 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clusterspec"
+	"github.com/openshift/rosa/pkg/defaults"
+	interactiveSgs "github.com/openshift/rosa/pkg/interactive/securitygroups"
+)
+
+const fromFileFlag = "from-file"
+
+// applyClusterSpecFile loads the cluster spec file and uses its values as the value of every flag
+// that was not explicitly set on the command line, so that flags always take precedence. The file
+// takes precedence over the defaults. Replicas and autoscaling are taken as a whole: when any of
+// the scaling flags is set on the command line the scaling of the file is ignored.
+func applyClusterSpecFile(cmd *cobra.Command, path string) error {
+	file, err := clusterspec.Load(path)
+	if err != nil {
+		return err
+	}
+	values := clusterSpecFlagValues(file.Spec)
+	err = defaults.PrepareScaling(cmd.Flags(), values)
+	if err != nil {
+		return err
+	}
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		flag := cmd.Flags().Lookup(name)
+		if flag == nil {
+			return fmt.Errorf("unable to apply cluster spec file: unknown flag '%s'", name)
+		}
+		if flag.Changed {
+			continue
+		}
+		err = cmd.Flags().Set(name, values[name])
+		if err != nil {
+			return fmt.Errorf("invalid value '%s' in cluster spec file for '--%s': %v", values[name], name, err)
+		}
+	}
+	return applyClusterSpecTags(cmd, file.Spec.Tags)
+}
+
+// applyClusterSpecTags keeps the tags of the spec file as they are, instead of rendering them into
+// the '--tags' flag, so that keys and values containing the flag delimiters are preserved.
+func applyClusterSpecTags(cmd *cobra.Command, tags map[string]string) error {
+	if len(tags) == 0 || cmd.Flags().Changed("tags") {
+		return nil
+	}
+	for _, key := range slices.Sorted(maps.Keys(tags)) {
+		if !aws.UserTagKeyRE.MatchString(key) {
+			return fmt.Errorf("invalid tag key '%s' in cluster spec file: expected a key matching %s",
+				key, aws.UserTagKeyRE.String())
+		}
+		if !aws.UserTagValueRE.MatchString(tags[key]) {
+			return fmt.Errorf("invalid value '%s' in cluster spec file for tag '%s': expected a value matching %s",
+				tags[key], key, aws.UserTagValueRE.String())
+		}
+	}
+	err := defaults.Reset(cmd.Flags(), []string{"tags"})
+	if err != nil {
+		return err
+	}
+	args.specTags = maps.Clone(tags)
+	return nil
+}
+
+// clusterSpecFlagValues translates a cluster spec into the 'create cluster' flags it stands for
+func clusterSpecFlagValues(spec clusterspec.ClusterSpec) map[string]string {
+	values := map[string]string{}
+	setString := func(name, value string) {
+		if value != "" {
+			values[name] = value
+		}
+	}
+	setBool := func(name string, value bool) {
+		if value {
+			values[name] = strconv.FormatBool(value)
+		}
+	}
+	setInt := func(name string, value int) {
+		if value != 0 {
+			values[name] = strconv.Itoa(value)
+		}
+	}
+	setSlice := func(name string, value []string) {
+		if len(value) > 0 {
+			values[name] = strings.Join(value, ",")
+		}
+	}
+
+	setString("cluster-name", spec.Name)
+	setString("domain-prefix", spec.DomainPrefix)
+	setString("region", spec.Region)
+	setBool("hosted-cp", spec.HostedCP)
+	setBool("multi-az", spec.MultiAZ)
+	setSlice("availability-zones", spec.AvailabilityZones)
+	setString("version", spec.Version)
+	setString("channel-group", spec.ChannelGroup)
+	setString("channel", spec.Channel)
+	setBool("fips", spec.FIPS)
+	setBool("etcd-encryption", spec.EtcdEncryption)
+	setString("etcd-encryption-kms-arn", spec.EtcdEncryptionKMSArn)
+	setString("kms-key-arn", spec.KMSKeyArn)
+	setBool("disable-workload-monitoring", spec.DisableWorkloadMonitoring)
+	setBool(enableDeleteProtectionFlagName, spec.EnableDeleteProtection)
+	setString(billingAccountFlag, spec.BillingAccount)
+	setString(Ec2MetadataHttpTokensFlag, spec.Ec2MetadataHttpTokens)
+	setString("audit-log-arn", spec.AuditLogRoleArn)
+	setBool(ExternalAuthProvidersEnabledFlag, spec.ExternalAuthProviders)
+	if spec.STS != nil {
+		if !spec.HostedCP {
+			setBool("sts", true)
+		}
+		setString("role-arn", spec.STS.RoleArn)
+		setString("support-role-arn", spec.STS.SupportRoleArn)
+		setString("controlplane-iam-role-arn", spec.STS.ControlPlaneRoleArn)
+		setString("worker-iam-role-arn", spec.STS.WorkerRoleArn)
+		setString("external-id", spec.STS.ExternalID)
+		setString("operator-roles-prefix", spec.STS.OperatorRolesPrefix)
+		setString("permissions-boundary", spec.STS.PermissionsBoundary)
+		setString(OidcConfigIdFlag, spec.STS.OidcConfigID)
+		setString("mode", spec.STS.Mode)
+	}
+
+	if spec.Network != nil {
+		setString("network-type", spec.Network.Type)
+		setString("machine-cidr", spec.Network.MachineCIDR)
+		setString("service-cidr", spec.Network.ServiceCIDR)
+		setString("pod-cidr", spec.Network.PodCIDR)
+		setInt("host-prefix", spec.Network.HostPrefix)
+		setSlice("subnet-ids", spec.Network.SubnetIDs)
+		setBool(privateFlagName, spec.Network.Private)
+		setBool(privateLinkFlagName, spec.Network.PrivateLink)
+		setBool("default-ingress-private", spec.Network.DefaultIngressPrivate)
+	}
+
+	if spec.Proxy != nil {
+		setString("http-proxy", spec.Proxy.HTTPProxy)
+		setString("https-proxy", spec.Proxy.HTTPSProxy)
+		setSlice("no-proxy", spec.Proxy.NoProxy)
+		setString("additional-trust-bundle-file", spec.Proxy.AdditionalTrustBundleFile)
+	}
+
+	if spec.Compute != nil {
+		setString("compute-machine-type", spec.Compute.MachineType)
+		setInt("replicas", spec.Compute.Replicas)
+		if spec.Compute.Autoscaling != nil {
+			setBool("enable-autoscaling", true)
+			setInt("min-replicas", spec.Compute.Autoscaling.MinReplicas)
+			setInt("max-replicas", spec.Compute.Autoscaling.MaxReplicas)
+		}
+		setString(arguments.NewDefaultMPLabelsFlag, formatClusterSpecLabels(spec.Compute.Labels))
+		setString(workerDiskSizeFlag, spec.Compute.DiskSize)
+		setSlice(interactiveSgs.ComputeSecurityGroupFlag, spec.Compute.SecurityGroupIDs)
+	}
+
+	return values
+}
+
+func formatClusterSpecLabels(labels map[string]string) string {
+	result := []string{}
+	for _, key := range slices.Sorted(maps.Keys(labels)) {
+		result = append(result, key+"="+labels[key])
+	}
+	return strings.Join(result, ",")
+}
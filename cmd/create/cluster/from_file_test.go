@@ -0,0 +1,244 @@
+package cluster
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/clusterspec"
+	"github.com/openshift/rosa/pkg/defaults"
+)
+
+var _ = Describe("Cluster spec file", func() {
+	Context("clusterSpecFlagValues", func() {
+		It("maps the spec onto create cluster flags", func() {
+			values := clusterSpecFlagValues(clusterspec.ClusterSpec{
+				Name:     "mycluster",
+				Region:   "us-east-1",
+				HostedCP: true,
+				Version:  "4.16.2",
+				Tags:     map[string]string{"team": "payments", "env": "dev"},
+				STS: &clusterspec.STSSpec{
+					RoleArn:             "arn:aws:iam::123456789012:role/Installer",
+					OperatorRolesPrefix: "mycluster",
+					OidcConfigID:        "oidc-id",
+				},
+				Network: &clusterspec.NetworkSpec{
+					SubnetIDs: []string{"subnet-1", "subnet-2"},
+					Private:   true,
+				},
+				Compute: &clusterspec.ComputeSpec{
+					MachineType: "m5.xlarge",
+					Labels:      map[string]string{"b": "2", "a": "1"},
+					Autoscaling: &clusterspec.AutoscalingSpec{MinReplicas: 2, MaxReplicas: 4},
+				},
+			})
+			Expect(values).To(Equal(map[string]string{
+				"cluster-name":          "mycluster",
+				"region":                "us-east-1",
+				"hosted-cp":             "true",
+				"version":               "4.16.2",
+				"role-arn":              "arn:aws:iam::123456789012:role/Installer",
+				"operator-roles-prefix": "mycluster",
+				"oidc-config-id":        "oidc-id",
+				"subnet-ids":            "subnet-1,subnet-2",
+				"private":               "true",
+				"compute-machine-type":  "m5.xlarge",
+				"worker-mp-labels":      "a=1,b=2",
+				"enable-autoscaling":    "true",
+				"min-replicas":          "2",
+				"max-replicas":          "4",
+			}))
+		})
+
+		It("sets the sts flag for classic STS clusters", func() {
+			values := clusterSpecFlagValues(clusterspec.ClusterSpec{
+				STS: &clusterspec.STSSpec{RoleArn: "arn:aws:iam::123456789012:role/Installer"},
+			})
+			Expect(values).To(HaveKeyWithValue("sts", "true"))
+		})
+
+		It("doesn't render the tags into the tags flag", func() {
+			values := clusterSpecFlagValues(clusterspec.ClusterSpec{
+				Tags: map[string]string{"team": "payments"},
+			})
+			Expect(values).NotTo(HaveKey("tags"))
+		})
+	})
+
+	Context("applyClusterSpecFile", func() {
+		var cmd *cobra.Command
+		var path string
+
+		BeforeEach(func() {
+			cmd = makeCmd()
+			initFlags(cmd)
+			path = filepath.Join(GinkgoT().TempDir(), "cluster.yaml")
+			Expect(os.WriteFile(path, []byte(`apiVersion: rosa.openshift.io/v1alpha1
+kind: Cluster
+spec:
+  name: from-file
+  version: 4.16.2
+  compute:
+    replicas: 3
+`), 0600)).To(Succeed())
+			DeferCleanup(func() {
+				args.clusterName = ""
+				args.version = ""
+				args.computeNodes = 2
+				args.specTags = nil
+			})
+		})
+
+		It("fills in the flags that are not set", func() {
+			Expect(applyClusterSpecFile(cmd, path)).To(Succeed())
+			Expect(args.clusterName).To(Equal("from-file"))
+			Expect(args.version).To(Equal("4.16.2"))
+			Expect(args.computeNodes).To(Equal(3))
+			Expect(cmd.Flags().Changed("replicas")).To(BeTrue())
+		})
+
+		It("keeps the tags of the file as they are", func() {
+			Expect(os.WriteFile(path, []byte(`apiVersion: rosa.openshift.io/v1alpha1
+kind: Cluster
+spec:
+  name: from-file
+  tags:
+    url: "https://example.com:8443"
+    team: payments team
+`), 0600)).To(Succeed())
+			Expect(applyClusterSpecFile(cmd, path)).To(Succeed())
+			Expect(args.specTags).To(Equal(map[string]string{
+				"url":  "https://example.com:8443",
+				"team": "payments team",
+			}))
+			Expect(cmd.Flags().Changed("tags")).To(BeFalse())
+		})
+
+		It("lets the tags flag override the tags of the file", func() {
+			Expect(os.WriteFile(path, []byte(`apiVersion: rosa.openshift.io/v1alpha1
+kind: Cluster
+spec:
+  tags:
+    team: payments
+`), 0600)).To(Succeed())
+			Expect(cmd.Flags().Set("tags", "team:platform")).To(Succeed())
+			DeferCleanup(func() {
+				args.tags = nil
+			})
+			Expect(applyClusterSpecFile(cmd, path)).To(Succeed())
+			Expect(args.specTags).To(BeNil())
+			Expect(args.tags).To(Equal([]string{"team:platform"}))
+		})
+
+		It("fails for invalid tags in the file", func() {
+			Expect(os.WriteFile(path, []byte(`apiVersion: rosa.openshift.io/v1alpha1
+kind: Cluster
+spec:
+  tags:
+    team: "payments,platform"
+`), 0600)).To(Succeed())
+			err := applyClusterSpecFile(cmd, path)
+			Expect(err).To(MatchError(ContainSubstring("invalid value 'payments,platform' in cluster spec file for tag 'team'")))
+		})
+
+		It("lets flags override the file", func() {
+			Expect(cmd.Flags().Set("cluster-name", "from-flag")).To(Succeed())
+			Expect(applyClusterSpecFile(cmd, path)).To(Succeed())
+			Expect(args.clusterName).To(Equal("from-flag"))
+			Expect(args.version).To(Equal("4.16.2"))
+		})
+
+		It("overrides the defaults", func() {
+			dir := GinkgoT().TempDir()
+			Expect(os.WriteFile(filepath.Join(dir, defaults.FileName),
+				[]byte("defaults:\n  replicas: 5\n  version: 4.15.1\n"), 0600)).To(Succeed())
+			values, err := defaults.LoadFrom(dir, "")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = values.Apply(cmd.Flags())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(applyClusterSpecFile(cmd, path)).To(Succeed())
+			Expect(args.version).To(Equal("4.16.2"))
+			Expect(args.computeNodes).To(Equal(3))
+		})
+
+		It("ignores the scaling of the file when autoscaling is set on the command line", func() {
+			Expect(cmd.Flags().Set("enable-autoscaling", "true")).To(Succeed())
+			Expect(cmd.Flags().Set("min-replicas", "2")).To(Succeed())
+			Expect(cmd.Flags().Set("max-replicas", "6")).To(Succeed())
+			DeferCleanup(func() {
+				args.autoscalingEnabled = false
+				args.minReplicas = 2
+				args.maxReplicas = 2
+			})
+
+			Expect(applyClusterSpecFile(cmd, path)).To(Succeed())
+			Expect(args.computeNodes).To(Equal(2))
+			Expect(cmd.Flags().Changed("replicas")).To(BeFalse())
+			Expect(args.autoscalingEnabled).To(BeTrue())
+			Expect(args.minReplicas).To(Equal(2))
+			Expect(args.maxReplicas).To(Equal(6))
+		})
+
+		It("ignores the autoscaling of the file when replicas are set on the command line", func() {
+			Expect(os.WriteFile(path, []byte(`apiVersion: rosa.openshift.io/v1alpha1
+kind: Cluster
+spec:
+  name: from-file
+  compute:
+    autoscaling:
+      minReplicas: 3
+      maxReplicas: 6
+`), 0600)).To(Succeed())
+			Expect(cmd.Flags().Set("replicas", "4")).To(Succeed())
+
+			Expect(applyClusterSpecFile(cmd, path)).To(Succeed())
+			Expect(args.computeNodes).To(Equal(4))
+			Expect(cmd.Flags().Changed("enable-autoscaling")).To(BeFalse())
+			Expect(cmd.Flags().Changed("min-replicas")).To(BeFalse())
+			Expect(cmd.Flags().Changed("max-replicas")).To(BeFalse())
+		})
+
+		It("replaces the scaling defaults with the scaling of the file", func() {
+			Expect(os.WriteFile(path, []byte(`apiVersion: rosa.openshift.io/v1alpha1
+kind: Cluster
+spec:
+  name: from-file
+  compute:
+    autoscaling:
+      minReplicas: 3
+      maxReplicas: 6
+`), 0600)).To(Succeed())
+			dir := GinkgoT().TempDir()
+			Expect(os.WriteFile(filepath.Join(dir, defaults.FileName),
+				[]byte("defaults:\n  replicas: 5\n"), 0600)).To(Succeed())
+			values, err := defaults.LoadFrom(dir, "")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = values.Apply(cmd.Flags())
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(func() {
+				args.autoscalingEnabled = false
+				args.minReplicas = 2
+				args.maxReplicas = 2
+			})
+
+			Expect(applyClusterSpecFile(cmd, path)).To(Succeed())
+			Expect(cmd.Flags().Changed("replicas")).To(BeFalse())
+			Expect(args.computeNodes).To(Equal(2))
+			Expect(args.autoscalingEnabled).To(BeTrue())
+			Expect(args.minReplicas).To(Equal(3))
+			Expect(args.maxReplicas).To(Equal(6))
+		})
+
+		It("fails on an invalid file", func() {
+			Expect(os.WriteFile(path, []byte("apiVersion: v2\nkind: Cluster\n"), 0600)).To(Succeed())
+			err := applyClusterSpecFile(cmd, path)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unsupported cluster spec apiVersion"))
+		})
+	})
+})
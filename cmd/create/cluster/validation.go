@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/interactive/securitygroups"
 	"github.com/openshift/rosa/pkg/reporter"
 )
@@ -70,16 +71,16 @@ func validateHcpFlags(cmd *cobra.Command, r reporter.Logger) {
 	if cmd.Flag(securitygroups.InfraSecurityGroupFlag).Changed {
 		_ = r.Errorf("Cannot use '%s' flag with Hosted Control Plane clusters, only '%s' is "+
 			"supported", securitygroups.InfraSecurityGroupFlag, securitygroups.ComputeSecurityGroupFlag)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 	if cmd.Flag(securitygroups.ControlPlaneSecurityGroupFlag).Changed {
 		_ = r.Errorf("Cannot use '%s' flag with Hosted Control Plane clusters, only '%s' is "+
 			"supported", securitygroups.ControlPlaneSecurityGroupFlag, securitygroups.ComputeSecurityGroupFlag)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 	if cmd.Flag(privateLinkFlagName).Changed {
 		_ = r.Errorf("Cannot use '%s' flag with Hosted Control Plane clusters, '%s' is the "+
 			"supported equivalent", privateLinkFlagName, privateFlagName)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/openshift/rosa/cmd/create/decision"
 	"github.com/openshift/rosa/cmd/create/dnsdomains"
 	"github.com/openshift/rosa/cmd/create/externalauthprovider"
+	"github.com/openshift/rosa/cmd/create/hibernationschedule"
 	"github.com/openshift/rosa/cmd/create/iamserviceaccount"
 	"github.com/openshift/rosa/cmd/create/idp"
 	"github.com/openshift/rosa/cmd/create/imagemirror"
@@ -42,6 +43,7 @@ import (
 	"github.com/openshift/rosa/cmd/create/tuningconfigs"
 	"github.com/openshift/rosa/cmd/create/userrole"
 	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/defaults"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 )
 
@@ -82,12 +84,16 @@ func init() {
 	decisionCommand := decision.NewCreateDecisionCommand()
 	Cmd.AddCommand(decisionCommand)
 	Cmd.AddCommand(network.NewNetworkCommand())
+	Cmd.AddCommand(hibernationschedule.NewCreateHibernationScheduleCommand())
 
 	flags := Cmd.PersistentFlags()
 	arguments.AddProfileFlag(flags)
 	arguments.AddRegionFlag(flags)
 	confirm.AddFlag(flags)
 
+	// Only the commands that create clusters and machine pools take the defaults:
+	defaults.ApplyToCommands(cluster.Cmd, machinepool)
+
 	globallyAvailableCommands := []*cobra.Command{
 		accountroles.Cmd, operatorroles.Cmd,
 		userrole.Cmd, ocmrole.Cmd,
@@ -11,6 +11,8 @@ import (
 	"github.com/spf13/cobra"
 	errors "github.com/zgalor/weberr"
 
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -20,11 +22,15 @@ const (
 	long    = short
 	example = `  # Create a decision for an Access Request to approve it
   rosa create decision --access-request <access_request_id> --decision Approved
+
+  # Approve all the pending Access Requests of cluster 'foo'
+  rosa create decision --all-pending --cluster foo --decision Approved --justification "Incident 1234"
   `
 )
 
 type Options struct {
 	accessRequest string
+	allPending    bool
 	decision      string
 	justification string
 }
@@ -51,7 +57,14 @@ func NewCreateDecisionCommand() *cobra.Command {
 		"access-request",
 		"a",
 		"",
-		"ID of the Access Request to add decision (required).",
+		"ID of the Access Request to add decision. Either this or '--all-pending' is required.",
+	)
+	flags.BoolVar(
+		&options.allPending,
+		"all-pending",
+		false,
+		"Add the decision to all the pending Access Requests of the cluster given with '--cluster'. "+
+			"Requires a justification.",
 	)
 	flags.StringVarP(
 		&options.decision,
@@ -65,19 +78,26 @@ func NewCreateDecisionCommand() *cobra.Command {
 		"justification",
 		"j",
 		"",
-		"Justification for the decision, required if decision is 'Denied'.",
+		"Justification for the decision, required if decision is 'Denied' or when using '--all-pending'.",
 	)
-	cmd.MarkFlagRequired("access-request")
+	ocm.AddOptionalClusterFlag(cmd)
+	cmd.MarkFlagsMutuallyExclusive("access-request", "all-pending")
 	cmd.MarkFlagRequired("decision")
 	return cmd
 }
 
 func CreateDecisionRunner(options *Options) rosa.CommandRunner {
-	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+	return func(_ context.Context, r *rosa.Runtime, cmd *cobra.Command, _ []string) error {
 		err := ValidateDecisionOptions(options)
 		if err != nil {
 			return err
 		}
+		if options.allPending {
+			if !cmd.Flags().Changed("cluster") {
+				return errors.Errorf("The '--cluster' flag is required when using '--all-pending'")
+			}
+			return createDecisionForAllPending(r, options)
+		}
 		err = r.OCMClient.CreateDecision(options.accessRequest, options.decision, options.justification)
 		if err != nil {
 			return err
@@ -88,7 +108,51 @@ func CreateDecisionRunner(options *Options) rosa.CommandRunner {
 	}
 }
 
+// createDecisionForAllPending adds the decision to every pending Access Request of the cluster.
+// It doesn't stop on failures, so that a single failing Access Request doesn't leave the rest of
+// them undecided.
+func createDecisionForAllPending(r *rosa.Runtime, options *Options) error {
+	cluster := r.FetchCluster()
+	accessRequests, err := r.OCMClient.ListAccessRequest(ocm.AccessRequestFilter{
+		ClusterID: cluster.ID(),
+		States:    []string{string(v1.AccessRequestStatePending)},
+	})
+	if err != nil {
+		return err
+	}
+	if len(accessRequests) == 0 {
+		r.Reporter.Infof("There are no pending Access Requests for cluster '%s'", r.ClusterKey)
+		return nil
+	}
+	decision := cases.Title(language.English, cases.Compact).String(options.decision)
+	if !confirm.Confirm("create decision '%s' for %d pending Access Requests of cluster '%s'",
+		decision, len(accessRequests), r.ClusterKey) {
+		return nil
+	}
+	failures := 0
+	for _, accessRequest := range accessRequests {
+		err = r.OCMClient.CreateDecision(accessRequest.ID(), decision, options.justification)
+		if err != nil {
+			r.Reporter.Errorf("Failed to create the decision for Access Request '%s': %v", accessRequest.ID(), err)
+			failures++
+			continue
+		}
+		r.Reporter.Infof("Successfully created the decision for Access Request '%s'", accessRequest.ID())
+	}
+	if failures > 0 {
+		return errors.Errorf("Failed to create the decision for %d of %d pending Access Requests",
+			failures, len(accessRequests))
+	}
+	return nil
+}
+
 func ValidateDecisionOptions(options *Options) error {
+	if options.accessRequest == "" && !options.allPending {
+		return errors.Errorf("Either 'access-request' or 'all-pending' is required")
+	}
+	if options.allPending && strings.TrimSpace(options.justification) == "" {
+		return errors.Errorf("Non-empty value is required for 'justification' when using 'all-pending'")
+	}
 	decisionStr := cases.Title(language.English, cases.Compact).String(options.decision)
 	switch v1.DecisionDecision(decisionStr) {
 	case v1.DecisionDecisionDenied:
@@ -6,9 +6,13 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
 	v1 "github.com/openshift-online/ocm-sdk-go/accesstransparency/v1"
 	"github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
+	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/output"
 	. "github.com/openshift/rosa/pkg/test"
 )
@@ -90,5 +94,67 @@ var _ = Describe("rosa attach policy", func() {
 			Expect(stdOut).To(Equal("INFO: Successfully created the decision for Access Request 'fake-id'\n"))
 		})
 
+		Context("All pending", func() {
+			var cmd *cobra.Command
+
+			BeforeEach(func() {
+				options = &Options{allPending: true, decision: "approved", justification: "Incident 1234"}
+				cmd = NewCreateDecisionCommand()
+				flags := pflag.NewFlagSet("decision", pflag.ContinueOnError)
+				confirm.AddFlag(flags)
+				Expect(flags.Set("yes", "true")).To(Succeed())
+				DeferCleanup(flags.Set, "yes", "false")
+			})
+
+			It("Returns an error without justification", func() {
+				options.justification = " "
+				err := CreateDecisionRunner(options)(nil, t.RosaRuntime, cmd, nil)
+				Expect(err).To(MatchError(
+					"Non-empty value is required for 'justification' when using 'all-pending'"))
+			})
+
+			It("Returns an error without cluster", func() {
+				err := CreateDecisionRunner(options)(nil, t.RosaRuntime, cmd, nil)
+				Expect(err).To(MatchError("The '--cluster' flag is required when using '--all-pending'"))
+			})
+
+			It("Creates a decision for every pending Access Request of the cluster", func() {
+				Expect(cmd.Flags().Set("cluster", MockClusterID)).To(Succeed())
+				t.SetCluster(MockClusterName, MockCluster(nil))
+				pending := []*v1.AccessRequest{}
+				for _, id := range []string{"request-1", "request-2"} {
+					accessRequest, err := v1.NewAccessRequest().ID(id).
+						Status(v1.NewAccessRequestStatus().State(v1.AccessRequestStatePending)).Build()
+					Expect(err).NotTo(HaveOccurred())
+					pending = append(pending, accessRequest)
+				}
+				decision, err := v1.NewDecision().ID("decision-id").Decision(v1.DecisionDecisionApproved).Build()
+				Expect(err).NotTo(HaveOccurred())
+				t.ApiServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyFormKV("search", fmt.Sprintf("cluster_id='%s' and status.state in ('Pending')",
+							MockClusterID)),
+						testing.RespondWithJSON(http.StatusOK, FormatAccessRequestList(pending)),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost,
+							"/api/access_transparency/v1/access_requests/request-1/decisions"),
+						ghttp.VerifyJSON(`{"kind": "Decision", "decision": "Approved", "justification": "Incident 1234"}`),
+						testing.RespondWithJSON(http.StatusCreated, FormatResource(decision)),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost,
+							"/api/access_transparency/v1/access_requests/request-2/decisions"),
+						testing.RespondWithJSON(http.StatusInternalServerError, "{}"),
+					),
+				)
+
+				t.StdOutReader.Record()
+				err = CreateDecisionRunner(options)(nil, t.RosaRuntime, cmd, nil)
+				Expect(err).To(MatchError("Failed to create the decision for 1 of 2 pending Access Requests"))
+				stdOut, _ := t.StdOutReader.Read()
+				Expect(stdOut).To(Equal("INFO: Successfully created the decision for Access Request 'request-1'\n"))
+			})
+		})
 	})
 })
@@ -23,6 +23,7 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -59,12 +60,12 @@ func run(_ *cobra.Command, _ []string) {
 	domain, err := createDnsDomain(args.hostedCp)
 	if err != nil {
 		r.Reporter.Errorf("Failed to build DNS domain: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	dnsdomain, err := r.OCMClient.CreateDNSDomain(domain)
 	if err != nil {
 		r.Reporter.Errorf("Failed to create dns domain: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	r.Reporter.Infof("DNS domain ‘%s’ has been created.", dnsdomain.ID())
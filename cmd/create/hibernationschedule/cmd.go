@@ -0,0 +1,156 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hibernationschedule
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/hibernation"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/properties"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "hibernation-schedule"
+	short = "Create a recurring hibernation schedule for a cluster"
+	long  = "Create a schedule that hibernates and resumes a cluster at the times matched by two cron " +
+		"expressions. The schedule is stored in a property of the cluster, or in a schedule file when " +
+		"the '--schedule-file' flag is used, and applied by 'rosa hibernation run'. Creating a schedule " +
+		"for a cluster that already has one replaces it."
+	example = `  # Hibernate cluster 'mycluster' every weekday evening and resume it every weekday morning,
+  # so that it also sleeps during the weekend
+  rosa create hibernation-schedule --cluster=mycluster \
+    --hibernate="0 20 * * 1-5" --resume="0 8 * * 1-5" --timezone=Europe/Madrid
+
+  # Store the schedule in a file that 'rosa hibernation run' reads from a CI job
+  rosa create hibernation-schedule --cluster=mycluster \
+    --hibernate="0 20 * * 1-5" --resume="0 8 * * 1-5" --schedule-file=schedules.yaml`
+)
+
+type options struct {
+	hibernate    string
+	resume       string
+	timezone     string
+	scheduleFile string
+}
+
+func NewCreateHibernationScheduleCommand() *cobra.Command {
+	options := &options{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), CreateHibernationScheduleRunner(options)),
+		Args:    cobra.NoArgs,
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+	ocm.AddClusterFlag(cmd)
+	flags.StringVar(
+		&options.hibernate,
+		"hibernate",
+		"",
+		"Cron expression with the times when the cluster is hibernated, for example '0 20 * * 1-5'.",
+	)
+	flags.StringVar(
+		&options.resume,
+		"resume",
+		"",
+		"Cron expression with the times when the cluster is resumed, for example '0 8 * * 1-5'.",
+	)
+	flags.StringVar(
+		&options.timezone,
+		"timezone",
+		"UTC",
+		"IANA timezone used to evaluate the cron expressions, for example 'Europe/Madrid'.",
+	)
+	flags.StringVar(
+		&options.scheduleFile,
+		"schedule-file",
+		"",
+		"Path of a schedule file where the schedule is stored, instead of a property of the cluster.",
+	)
+	return cmd
+}
+
+func CreateHibernationScheduleRunner(options *options) rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		schedule := &hibernation.Schedule{
+			Hibernate: options.hibernate,
+			Resume:    options.resume,
+			Timezone:  options.timezone,
+		}
+		err := schedule.Validate()
+		if err != nil {
+			return err
+		}
+
+		clusterKey := r.GetClusterKey()
+		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+		if err != nil {
+			return err
+		}
+
+		if options.scheduleFile != "" {
+			file, err := hibernation.LoadFile(options.scheduleFile)
+			if err != nil {
+				return err
+			}
+			schedule.Cluster = cluster.Name()
+			file.Set(schedule)
+			err = file.Save(options.scheduleFile)
+			if err != nil {
+				return fmt.Errorf("Failed to save schedule file '%s': %v", options.scheduleFile, err)
+			}
+			r.Reporter.Infof("Hibernation schedule of cluster '%s' saved to file '%s'",
+				clusterKey, options.scheduleFile)
+		} else {
+			value, err := schedule.Property()
+			if err != nil {
+				return err
+			}
+			clusterProperties := maps.Clone(cluster.Properties())
+			if clusterProperties == nil {
+				clusterProperties = map[string]string{}
+			}
+			clusterProperties[properties.HibernationSchedule] = value
+			err = r.OCMClient.UpdateClusterProperties(cluster.ID(), clusterProperties)
+			if err != nil {
+				return fmt.Errorf("Failed to save hibernation schedule of cluster '%s': %v", clusterKey, err)
+			}
+			r.Reporter.Infof("Hibernation schedule of cluster '%s' saved", clusterKey)
+		}
+
+		desired, since, err := schedule.Desired(time.Now())
+		if err != nil {
+			return err
+		}
+		if desired != hibernation.StateNone {
+			r.Reporter.Infof("The schedule expects the cluster to be %s since %s. "+
+				"Run 'rosa hibernation run' to apply it", desired, since.Format(time.RFC3339))
+		}
+		return nil
+	}
+}
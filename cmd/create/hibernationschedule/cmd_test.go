@@ -0,0 +1,93 @@
+package hibernationschedule
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/hibernation"
+	"github.com/openshift/rosa/pkg/rosa"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("create hibernation-schedule", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewCreateHibernationScheduleCommand()
+		Expect(cmd).NotTo(BeNil())
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Run).NotTo(BeNil())
+		for _, flag := range []string{"cluster", "hibernate", "resume", "timezone", "schedule-file"} {
+			Expect(cmd.Flags().Lookup(flag)).NotTo(BeNil())
+		}
+		Expect(cmd.Flags().Lookup("timezone").DefValue).To(Equal("UTC"))
+	})
+
+	Context("Create Hibernation Schedule Runner", func() {
+		var t *TestingRuntime
+		var cluster *cmv1.Cluster
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			cluster = MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+				c.Properties(map[string]string{"rosa_creator_arn": "arn:aws:iam::123456789012:user/dev"})
+			})
+			t.SetCluster("cluster", cluster)
+		})
+
+		run := func(options *options) (string, error) {
+			stdout, _, err := RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+				return CreateHibernationScheduleRunner(options)(context.Background(), r, cmd, nil)
+			}, t.RosaRuntime, nil)
+			return stdout, err
+		}
+
+		It("Rejects an invalid schedule before calling the API", func() {
+			_, err := run(&options{hibernate: "0 20 * * 1-5", resume: "every morning", timezone: "UTC"})
+			Expect(err).To(MatchError(ContainSubstring("invalid resume cron expression 'every morning'")))
+		})
+
+		It("Stores the schedule in a property of the cluster", func() {
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+			t.ApiServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPatch, "/api/clusters_mgmt/v1/clusters/"+MockClusterID),
+				ghttp.VerifyJSON(`{
+					"kind": "Cluster",
+					"properties": {
+						"rosa_creator_arn": "arn:aws:iam::123456789012:user/dev",
+						"rosa_hibernation_schedule": "{\"hibernate\":\"0 20 * * 1-5\",\"resume\":\"0 8 * * 1-5\",`+
+					`\"timezone\":\"Europe/Madrid\"}"
+					}
+				}`),
+				RespondWithJSON(http.StatusOK, FormatResource(cluster)),
+			))
+
+			stdout, err := run(&options{hibernate: "0 20 * * 1-5", resume: "0 8 * * 1-5", timezone: "Europe/Madrid"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stdout).To(ContainSubstring("Hibernation schedule of cluster 'cluster' saved"))
+		})
+
+		It("Stores the schedule in a schedule file", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "schedules.yaml")
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+
+			stdout, err := run(&options{hibernate: "@daily", resume: "@hourly", scheduleFile: path})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stdout).To(ContainSubstring("saved to file '" + path + "'"))
+
+			file, err := hibernation.LoadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(file.Schedules).To(Equal([]*hibernation.Schedule{
+				{Cluster: "cluster", Hibernate: "@daily", Resume: "@hourly"},
+			}))
+		})
+	})
+})
@@ -26,6 +26,8 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
+	pkgidp "github.com/openshift/rosa/pkg/idp"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -83,7 +85,6 @@ var args struct {
 }
 
 var validIdps = []string{"github", "gitlab", "google", "htpasswd", "ldap", "openid"}
-var validMappingMethods = []string{"add", "claim", "generate", "lookup"}
 
 var idRE = regexp.MustCompile(`(?i)^[0-9a-z]+([-_][0-9a-z]+)*$`)
 
@@ -128,7 +129,7 @@ func init() {
 		"claim",
 		fmt.Sprintf(
 			"Specifies how new identities are mapped to users when they log in. Options are %s",
-			validMappingMethods,
+			pkgidp.MappingMethods,
 		),
 	)
 	flags.StringVar(
@@ -336,7 +337,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if cluster.ExternalAuthConfig().Enabled() {
 		r.Reporter.Errorf("Adding IDP is not supported for clusters with external authentication configured.")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	// Grab all the IDP information interactively if necessary
@@ -363,12 +364,12 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid IdP type: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	if idpType == "" {
 		r.Reporter.Errorf("Expected a valid IDP type. Options are: %s", strings.Join(validIdps, ","))
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if idpType != "" {
@@ -380,7 +381,7 @@ func run(cmd *cobra.Command, _ []string) {
 		}
 		if !isValidIdp {
 			r.Reporter.Errorf("Expected a valid IDP type. Options are %s", validIdps)
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 	}
 
@@ -400,7 +401,7 @@ func run(cmd *cobra.Command, _ []string) {
 	err = ValidateIdpName(idpName)
 	if err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	var idpBuilder cmv1.IdentityProviderBuilder
@@ -421,7 +422,7 @@ func run(cmd *cobra.Command, _ []string) {
 	}
 	if err != nil {
 		r.Reporter.Errorf("Failed to create IDP for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	doCreateIDP(idpName, idpBuilder, cluster, clusterKey, r)
@@ -439,7 +440,7 @@ func getIDPName(cmd *cobra.Command, idpName string, r *rosa.Runtime) string {
 	})
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid name for the identity provider: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	return strings.Trim(idpName, " \t")
 }
@@ -472,13 +473,13 @@ func doCreateIDP(
 	idp, err := idpBuilder.Build()
 	if err != nil {
 		r.Reporter.Errorf("Failed to create IDP for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	createdIdp, err := r.OCMClient.CreateIdentityProvider(cluster.ID(), idp)
 	if err != nil {
 		r.Reporter.Errorf("Failed to add IDP to cluster '%s': %s", clusterKey, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	r.Reporter.Infof(
@@ -538,19 +539,19 @@ func getMappingMethod(cmd *cobra.Command, mappingMethod string) (string, error)
 		mappingMethod, err = interactive.GetOption(interactive.Input{
 			Question: "Mapping method",
 			Help:     usage,
-			Options:  validMappingMethods,
+			Options:  pkgidp.MappingMethods,
 			Default:  mappingMethod,
 			Required: true,
 		})
 	}
 	isValidMappingMethod := false
-	for _, validMappingMethod := range validMappingMethods {
+	for _, validMappingMethod := range pkgidp.MappingMethods {
 		if mappingMethod == validMappingMethod {
 			isValidMappingMethod = true
 		}
 	}
 	if !isValidMappingMethod {
-		err = fmt.Errorf("expected a valid mapping method; options are %s", validMappingMethods)
+		err = fmt.Errorf("expected a valid mapping method; options are %s", pkgidp.MappingMethods)
 	}
 	return mappingMethod, err
 }
@@ -562,7 +563,7 @@ func getIdps(r *rosa.Runtime, cluster *cmv1.Cluster) []IdentityProvider {
 	ocmIdps, err := r.OCMClient.GetIdentityProviders(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get identity providers for cluster '%s': %v", cluster.ID(), err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	idps := []IdentityProvider{}
 	for _, idp := range ocmIdps {
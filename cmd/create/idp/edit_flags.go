@@ -0,0 +1,58 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package idp
+
+import (
+	"github.com/spf13/pflag"
+)
+
+// editFlags are the flags of 'rosa create idp' that 'rosa edit idp' accepts, in the order they
+// are added to the command.
+var editFlags = []string{
+	"mapping-method", "client-id", "client-secret", "ca",
+	"hostname", "organizations", "teams",
+	"host-url",
+	"hosted-domain",
+	"url", "insecure", "bind-dn", "bind-password",
+	"id-attributes", "username-attributes", "name-attributes", "email-attributes",
+	"issuer-url", "email-claims", "name-claims", "username-claims", "groups-claims", "extra-scopes",
+}
+
+// AddEditFlags adds the flags of the settings that can be edited, with the same names and
+// descriptions as the flags of 'rosa create idp' but without defaults.
+func AddEditFlags(flags *pflag.FlagSet) {
+	for _, name := range editFlags {
+		usage := Cmd.Flags().Lookup(name).Usage
+		if name == "insecure" {
+			flags.Bool(name, false, usage)
+			continue
+		}
+		flags.String(name, "", usage)
+	}
+}
+
+// ChangedSettings returns the values of the edit flags that were set in the command line.
+func ChangedSettings(flags *pflag.FlagSet) map[string]string {
+	result := map[string]string{}
+	for _, name := range editFlags {
+		flag := flags.Lookup(name)
+		if flag != nil && flag.Changed {
+			result[name] = flag.Value.String()
+		}
+	}
+	return result
+}
@@ -0,0 +1,23 @@
+package idp_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+
+	"github.com/openshift/rosa/cmd/create/idp"
+)
+
+var _ = Describe("Edit flags", func() {
+	Context("ChangedSettings", func() {
+		It("returns only the flags set in the command line", func() {
+			flags := pflag.NewFlagSet("edit", pflag.ContinueOnError)
+			idp.AddEditFlags(flags)
+			Expect(flags.Parse([]string{"--client-id=new", "--insecure"})).To(Succeed())
+			Expect(idp.ChangedSettings(flags)).To(Equal(map[string]string{
+				"client-id": "new",
+				"insecure":  "true",
+			}))
+		})
+	})
+})
@@ -24,8 +24,7 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
-	"github.com/openshift/rosa/pkg/helper"
-	urlHelper "github.com/openshift/rosa/pkg/helper/url"
+	pkgidp "github.com/openshift/rosa/pkg/idp"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/ocm"
 )
@@ -50,14 +49,14 @@ func buildGitlabIdp(cmd *cobra.Command,
 			Required: true,
 			Validators: []interactive.Validator{
 				interactive.IsURL,
-				validateGitlabHostURL,
+				pkgidp.ValidateGitlabHostURL,
 			},
 		})
 		if err != nil {
 			return idpBuilder, fmt.Errorf("expected a valid GitLab provider URL: %s", err)
 		}
 	}
-	err = validateGitlabHostURL(gitlabURL)
+	err = pkgidp.ValidateGitlabHostURL(gitlabURL)
 	if err != nil {
 		return idpBuilder, err
 	}
@@ -155,21 +154,3 @@ func buildGitlabIdp(cmd *cobra.Command,
 
 	return
 }
-
-func validateGitlabHostURL(val interface{}) error {
-	gitlabURL := fmt.Sprintf("%v", val)
-	parsedIssuerURL, err := urlHelper.ParseRequestURI(gitlabURL)
-	if err != nil {
-		return fmt.Errorf("expected a valid GitLab provider URL: %s", err)
-	}
-	if parsedIssuerURL.Scheme != helper.ProtocolHttps {
-		return errors.New("expected GitLab provider URL to use an https:// scheme")
-	}
-	if parsedIssuerURL.RawQuery != "" {
-		return errors.New("GitLab provider URL must not have query parameters")
-	}
-	if parsedIssuerURL.Fragment != "" {
-		return errors.New("GitLab provider URL must not have a fragment")
-	}
-	return nil
-}
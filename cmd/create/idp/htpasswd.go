@@ -18,8 +18,11 @@ package idp
 
 import (
 	"bufio"
+	"encoding/csv"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"strings"
 
 	idputils "github.com/openshift-online/ocm-common/pkg/idp/utils"
@@ -27,6 +30,7 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -79,7 +83,7 @@ func createHTPasswdIDP(cmd *cobra.Command,
 			if err != nil {
 				r.Reporter.Errorf(
 					"Failed to add a user to the HTPasswd IDP of cluster '%s': %v", clusterKey, err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			r.Reporter.Infof("User '%s' added", username)
 		}
@@ -111,7 +115,7 @@ func validateUserArgs(r *rosa.Runtime) {
 		r.Reporter.Errorf("Only one of  'users', 'from-file' or 'username/password' may be specified. \n" +
 			"Choose the option 'users' to add one or more users to the IDP.\n" +
 			"Choose the option 'from-file' to load users from a htpassword file")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 }
 
@@ -132,7 +136,7 @@ func getUserList(cmd *cobra.Command, r *rosa.Runtime) (userList map[string]strin
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid --from-file value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -142,7 +146,7 @@ func getUserList(cmd *cobra.Command, r *rosa.Runtime) (userList map[string]strin
 		if err != nil {
 			r.Reporter.Errorf(
 				"Failed to load Htpasswd file '%s': %v", htpasswdFile, err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		//password in htpasswd are already and do not need to be hashed again in CS
 		hashed = true
@@ -159,13 +163,13 @@ func getUserList(cmd *cobra.Command, r *rosa.Runtime) (userList map[string]strin
 			if !found {
 				r.Reporter.Errorf(
 					"Users should be provided in the format of a comma separate list of user:password")
-				os.Exit(1)
+				os.Exit(clierror.Validation.ExitCode())
 
 			}
 			err := validateHtUsernameAndPassword(u, p)
 			if err != nil {
 				r.Reporter.Errorf(err.Error())
-				os.Exit(1)
+				os.Exit(clierror.Validation.ExitCode())
 			}
 			userList[u] = p
 		}
@@ -179,7 +183,7 @@ func getUserList(cmd *cobra.Command, r *rosa.Runtime) (userList map[string]strin
 		err := validateHtUsernameAndPassword(args.htpasswdUsername, args.htpasswdPassword)
 		if err != nil {
 			r.Reporter.Errorf(err.Error())
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		userList[args.htpasswdUsername] = args.htpasswdPassword
 		return
@@ -315,6 +319,63 @@ func parseHtpasswordFile(usersList *map[string]string, filePath string) error {
 	return nil
 }
 
+// ReadUsersFile loads the users of an HTPasswd IDP from a file. Files with the '.csv' extension
+// contain 'username,password' rows with plain text passwords, optionally preceded by a header.
+// Any other file is read as an htpasswd file, and the returned passwords are hashes.
+func ReadUsersFile(filePath string) (users map[string]string, hashed bool, err error) {
+	users = make(map[string]string)
+	if strings.EqualFold(filepath.Ext(filePath), ".csv") {
+		err = parseUsersCSVFile(users, filePath)
+		return users, false, err
+	}
+	err = parseHtpasswordFile(&users, filePath)
+	if err != nil {
+		return nil, true, err
+	}
+	for username := range users {
+		err = UsernameValidator(username)
+		if err == nil {
+			err = clusterAdminValidator(username)
+		}
+		if err != nil {
+			return nil, true, err
+		}
+	}
+	return users, true, nil
+}
+
+func parseUsersCSVFile(users map[string]string, filePath string) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if line == 1 && strings.EqualFold(record[0], "username") && strings.EqualFold(record[1], "password") {
+			continue
+		}
+		err = validateHtUsernameAndPassword(record[0], record[1])
+		if err != nil {
+			return fmt.Errorf("line %d: %v", line, err)
+		}
+		if _, found := users[record[0]]; found {
+			return fmt.Errorf("line %d: duplicate user '%s'", line, record[0])
+		}
+		users[record[0]] = record[1]
+	}
+}
+
 func validateHtUsernameAndPassword(username, password string) error {
 	err := UsernameValidator(username)
 	if err != nil {
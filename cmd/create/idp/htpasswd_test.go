@@ -19,6 +19,7 @@ package idp
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"reflect"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -85,6 +86,39 @@ var _ = Describe("IDP Tests", func() {
 		)
 	})
 
+	Describe("ReadUsersFile", func() {
+		writeFile := func(name string, content string) string {
+			path := filepath.Join(GinkgoT().TempDir(), name)
+			Expect(os.WriteFile(path, []byte(content), 0600)).To(Succeed())
+			return path
+		}
+
+		It("reads plain text passwords from a CSV file with a header", func() {
+			path := writeFile("users.csv", "username,password\nalice,SecureP@ssword123\n")
+			users, hashed, err := ReadUsersFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hashed).To(BeFalse())
+			Expect(users).To(Equal(map[string]string{"alice": "SecureP@ssword123"}))
+		})
+
+		It("rejects invalid and duplicate users in a CSV file", func() {
+			_, _, err := ReadUsersFile(writeFile("users.csv", "cluster-admin,SecureP@ssword123\n"))
+			Expect(err).To(HaveOccurred())
+			_, _, err = ReadUsersFile(writeFile("users.csv", "alice,short\n"))
+			Expect(err).To(HaveOccurred())
+			_, _, err = ReadUsersFile(writeFile("users.csv",
+				"alice,SecureP@ssword123\nalice,SecureP@ssword456\n"))
+			Expect(err).To(MatchError("line 2: duplicate user 'alice'"))
+		})
+
+		It("reads hashed passwords from an htpasswd file", func() {
+			users, hashed, err := ReadUsersFile(writeFile("users", "alice:$apr1$hRY7OJWH$km1EYH.UIRjp6CzfZQz/g1\n"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hashed).To(BeTrue())
+			Expect(users).To(Equal(map[string]string{"alice": "$apr1$hRY7OJWH$km1EYH.UIRjp6CzfZQz/g1"}))
+		})
+	})
+
 	Describe("Username Validators Tests", func() {
 		It("username with `:` cannot pass clusterAdminValidator", func() {
 			username := "my:admin"
@@ -17,7 +17,6 @@ limitations under the License.
 package idp
 
 import (
-	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -25,7 +24,7 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
-	urlHelper "github.com/openshift/rosa/pkg/helper/url"
+	pkgidp "github.com/openshift/rosa/pkg/idp"
 	"github.com/openshift/rosa/pkg/interactive"
 )
 
@@ -62,14 +61,14 @@ func buildLdapIdp(cmd *cobra.Command,
 			Required: true,
 			Validators: []interactive.Validator{
 				interactive.IsURL,
-				validateLdapURL,
+				pkgidp.ValidateLdapURL,
 			},
 		})
 		if err != nil {
 			return idpBuilder, fmt.Errorf("expected a valid LDAP URL: %s", err)
 		}
 	}
-	err = validateLdapURL(ldapURL)
+	err = pkgidp.ValidateLdapURL(ldapURL)
 	if err != nil {
 		return idpBuilder, err
 	}
@@ -241,15 +240,3 @@ func buildLdapIdp(cmd *cobra.Command,
 
 	return
 }
-
-func validateLdapURL(val interface{}) error {
-	ldapURL := fmt.Sprintf("%v", val)
-	parsedLdapURL, err := urlHelper.ParseRequestURI(ldapURL)
-	if err != nil {
-		return fmt.Errorf("expected a valid LDAP URL: %v", err)
-	}
-	if parsedLdapURL.Scheme != "ldap" && parsedLdapURL.Scheme != "ldaps" {
-		return errors.New("expected LDAP URL to have an ldap:// or ldaps:// scheme")
-	}
-	return nil
-}
@@ -25,8 +25,7 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
-	"github.com/openshift/rosa/pkg/helper"
-	urlHelper "github.com/openshift/rosa/pkg/helper/url"
+	pkgidp "github.com/openshift/rosa/pkg/idp"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/ocm"
 )
@@ -103,7 +102,7 @@ func buildOpenidIdp(cmd *cobra.Command,
 			Required: true,
 			Validators: []interactive.Validator{
 				interactive.IsURL,
-				validateOpenidIssuerURL,
+				pkgidp.ValidateOpenidIssuerURL,
 			},
 		})
 		if err != nil {
@@ -111,7 +110,7 @@ func buildOpenidIdp(cmd *cobra.Command,
 		}
 	}
 
-	err = validateOpenidIssuerURL(issuerURL)
+	err = pkgidp.ValidateOpenidIssuerURL(issuerURL)
 	if err != nil {
 		return idpBuilder, err
 	}
@@ -243,21 +242,3 @@ separated by commas.`,
 
 	return
 }
-
-func validateOpenidIssuerURL(val interface{}) error {
-	issuerURL := fmt.Sprintf("%v", val)
-	parsedIssuerURL, err := urlHelper.ParseRequestURI(issuerURL)
-	if err != nil {
-		return fmt.Errorf("expected a valid OpenID issuer URL: %v", err)
-	}
-	if parsedIssuerURL.Scheme != helper.ProtocolHttps {
-		return errors.New("expected OpenID issuer URL to use an https:// scheme")
-	}
-	if parsedIssuerURL.RawQuery != "" {
-		return errors.New("OpenID issuer URL must not have query parameters")
-	}
-	if parsedIssuerURL.Fragment != "" {
-		return errors.New("OpenID issuer URL must not have a fragment")
-	}
-	return nil
-}
@@ -22,8 +22,10 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/access"
 	"github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/machinepool"
+	"github.com/openshift/rosa/pkg/ocm"
 	mpOpts "github.com/openshift/rosa/pkg/options/machinepool"
 	"github.com/openshift/rosa/pkg/properties"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -64,6 +66,9 @@ func CreateMachinepoolRunner(userOptions *mpOpts.CreateMachinepoolUserOptions) r
 		if err := machinepool.ValidateClusterState(cluster, clusterKey); err != nil {
 			return err
 		}
+		if err := access.Preflight(r, ocm.ActionCreate, ocm.MachinePoolResourceType, cluster); err != nil {
+			return err
+		}
 
 		clusterAutoscaler, err := r.OCMClient.GetClusterAutoscaler(cluster.ID())
 		if err != nil {
@@ -73,12 +78,26 @@ func CreateMachinepoolRunner(userOptions *mpOpts.CreateMachinepoolUserOptions) r
 		val, ok := cluster.Properties()[properties.UseLocalCredentials]
 		useLocalCredentials := ok && val == "true"
 
-		if err := machinepool.ValidateLabels(cmd, options.args); err != nil {
-			return err
+		requests := []machinePoolRequest{{cmd: cmd, options: options.Machinepool()}}
+		if userOptions.FromFile != "" {
+			requests, err = machinePoolRequestsFromFile(cmd, userOptions.FromFile, cluster.Hypershift().Enabled())
+			if err != nil {
+				return err
+			}
+			err = checkExistingMachinePools(r, cluster, requests)
+			if err != nil {
+				return err
+			}
 		}
 
-		if err := machinepool.ValidateImageType(cmd, options.args, cluster); err != nil {
-			return err
+		for _, request := range requests {
+			if err := machinepool.ValidateLabels(request.cmd, request.options); err != nil {
+				return err
+			}
+
+			if err := machinepool.ValidateImageType(request.cmd, request.options, cluster); err != nil {
+				return err
+			}
 		}
 
 		r.AWSClient, err = aws.NewClient().
@@ -90,7 +109,9 @@ func CreateMachinepoolRunner(userOptions *mpOpts.CreateMachinepoolUserOptions) r
 			return fmt.Errorf("failed to create awsClient: %s", err)
 		}
 
-		return newService.service.CreateMachinePoolBasedOnClusterType(r,
-			cmd, clusterKey, cluster, clusterAutoscaler, options.Machinepool())
+		return createMachinePools(requests, func(request machinePoolRequest) error {
+			return newService.service.CreateMachinePoolBasedOnClusterType(r,
+				request.cmd, clusterKey, cluster, clusterAutoscaler, request.options)
+		})
 	}
 }
@@ -1,11 +1,15 @@
 package machinepool
 
 import (
+	"context"
+	"net/http"
+
 	"go.uber.org/mock/gomock"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/machinepool"
@@ -45,6 +49,24 @@ var _ = Describe("Create machine pool", func() {
 			})
 		})
 	})
+
+	Context("CreateMachinepoolRunner", func() {
+		It("should fail before creating anything when the user isn't allowed to create machine pools", func() {
+			t := test.NewTestRuntime()
+			cmd := NewCreateMachinePoolCommand()
+			t.SetCluster(test.MockClusterName, test.MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+			}))
+			t.ApiServer.RouteToHandler(http.MethodPost, test.SelfAccessReviewPath,
+				RespondWithJSON(http.StatusOK, `{"allowed": false}`))
+
+			err := CreateMachinepoolRunner(NewCreateMachinepoolUserOptions())(context.Background(),
+				t.RosaRuntime, cmd, nil)
+			Expect(err).To(MatchError("You are not allowed to create machine pools in cluster 'cluster'. " +
+				"Ask an administrator of your organization for an OCM role that allows it"))
+			Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
 })
 
 var _ = Describe("Validation functions", func() {
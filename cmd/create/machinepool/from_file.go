@@ -0,0 +1,177 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/openshift/rosa/pkg/defaults"
+	"github.com/openshift/rosa/pkg/machinepool"
+	mpOpts "github.com/openshift/rosa/pkg/options/machinepool"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const fromFileFlag = "from-file"
+
+// machinePoolRequest is a single machine pool to create, together with the command whose flags
+// describe it
+type machinePoolRequest struct {
+	cmd     *cobra.Command
+	options *mpOpts.CreateMachinepoolUserOptions
+}
+
+// machinePoolRequestsFromFile loads the machine pool spec file and builds a separate command for
+// each of the machine pools it describes. Every command gets the flags explicitly set on the
+// original command line, the remaining flags take their value from the file, so that flags
+// always take precedence. Replicas and autoscaling are taken as a whole: when any of the scaling
+// flags is set on the command line the scaling of the file is ignored.
+func machinePoolRequestsFromFile(cmd *cobra.Command, path string, hostedCP bool) ([]machinePoolRequest, error) {
+	file, err := machinepool.LoadMachinePoolFile(path)
+	if err != nil {
+		return nil, err
+	}
+	specs := file.Pools()
+	if len(specs) > 1 && cmd.Flags().Changed("name") {
+		return nil, fmt.Errorf("the '--name' flag cannot be used with a machine pool spec file that " +
+			"describes more than one machine pool")
+	}
+
+	requests := []machinePoolRequest{}
+	for _, spec := range specs {
+		err = spec.ValidateTopology(hostedCP)
+		if err != nil {
+			return nil, err
+		}
+		poolCmd, poolOptions := mpOpts.BuildMachinePoolCreateCommandWithOptions()
+		err = copyChangedFlags(cmd.Flags(), poolCmd.Flags())
+		if err != nil {
+			return nil, err
+		}
+		err = applyMachinePoolSpec(poolCmd, spec)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, machinePoolRequest{cmd: poolCmd, options: poolOptions})
+	}
+	return requests, nil
+}
+
+// copyChangedFlags copies the flags given in the command line or set from the defaults. The values
+// that come from the defaults are still known as such in the copy.
+func copyChangedFlags(from *pflag.FlagSet, to *pflag.FlagSet) error {
+	var err error
+	from.VisitAll(func(flag *pflag.Flag) {
+		if err != nil || flag.Name == fromFileFlag || !defaults.Changed(from, flag.Name) {
+			return
+		}
+		target := to.Lookup(flag.Name)
+		if target == nil {
+			return
+		}
+		if defaults.IsDefault(flag) {
+			err = defaults.CopyDefault(flag, target)
+			return
+		}
+		if slice, ok := flag.Value.(pflag.SliceValue); ok {
+			err = target.Value.(pflag.SliceValue).Replace(slice.GetSlice())
+			target.Changed = true
+			return
+		}
+		err = to.Set(flag.Name, flag.Value.String())
+	})
+	return err
+}
+
+// applyMachinePoolSpec uses the values of the spec as the value of every flag of the command that
+// was not explicitly set. The spec takes precedence over the defaults.
+func applyMachinePoolSpec(cmd *cobra.Command, spec *machinepool.MachinePoolSpec) error {
+	values := spec.FlagValues()
+	err := defaults.PrepareScaling(cmd.Flags(), values)
+	if err != nil {
+		return err
+	}
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		flag := cmd.Flags().Lookup(name)
+		if flag == nil {
+			return fmt.Errorf("unable to apply machine pool spec file: unknown flag '%s'", name)
+		}
+		if flag.Changed {
+			continue
+		}
+		err = cmd.Flags().Set(name, values[name])
+		if err != nil {
+			return fmt.Errorf("invalid value '%s' in machine pool spec file for '--%s': %v", values[name], name, err)
+		}
+	}
+	return nil
+}
+
+// checkExistingMachinePools fails when any of the machine pools of the spec file already exists in
+// the cluster, so that no machine pool is created when it would fail for one of them
+func checkExistingMachinePools(r *rosa.Runtime, cluster *cmv1.Cluster, requests []machinePoolRequest) error {
+	existing := map[string]bool{}
+	if cluster.Hypershift().Enabled() {
+		nodePools, err := r.OCMClient.GetNodePools(cluster.ID())
+		if err != nil {
+			return fmt.Errorf("Failed to get machine pools for hosted cluster '%s': %v", r.ClusterKey, err)
+		}
+		for _, nodePool := range nodePools {
+			existing[nodePool.ID()] = true
+		}
+	} else {
+		machinePools, err := r.OCMClient.GetMachinePools(cluster.ID())
+		if err != nil {
+			return fmt.Errorf("Failed to get machine pools for cluster '%s': %v", r.ClusterKey, err)
+		}
+		for _, machinePool := range machinePools {
+			existing[machinePool.ID()] = true
+		}
+	}
+	for _, request := range requests {
+		if existing[request.options.Name] {
+			return fmt.Errorf("Machine pool '%s' already exists in cluster '%s'", request.options.Name,
+				r.ClusterKey)
+		}
+	}
+	return nil
+}
+
+// createMachinePools creates the machine pools in order. Most checks need the state of the cluster
+// and of the AWS account, so they are only done when creating each machine pool; when one of them
+// fails, the error tells which machine pools were already created.
+func createMachinePools(requests []machinePoolRequest, create func(request machinePoolRequest) error) error {
+	created := []string{}
+	for _, request := range requests {
+		err := create(request)
+		if err != nil && len(created) > 0 {
+			return fmt.Errorf("%w. The machine pools created before the failure are '%s'", err,
+				strings.Join(created, "', '"))
+		}
+		if err != nil {
+			return err
+		}
+		created = append(created, request.options.Name)
+	}
+	return nil
+}
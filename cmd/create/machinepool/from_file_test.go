@@ -0,0 +1,165 @@
+package machinepool
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/defaults"
+	"github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Create machine pools from a spec file", func() {
+	writeFile := func(content string) string {
+		path := filepath.Join(GinkgoT().TempDir(), "machinepools.yaml")
+		Expect(os.WriteFile(path, []byte(content), 0600)).To(Succeed())
+		return path
+	}
+
+	listFile := `apiVersion: rosa.openshift.io/v1alpha1
+kind: MachinePoolList
+items:
+- name: a
+  instanceType: m5.xlarge
+  replicas: 2
+  labels:
+    team: a
+- name: b
+  autoscaling:
+    minReplicas: 1
+    maxReplicas: 3
+  version: 4.18.1
+`
+
+	It("builds a command per machine pool with the values of the file", func() {
+		cmd := NewCreateMachinePoolCommand()
+		Expect(cmd.Flags().Set("instance-type", "m6i.xlarge")).To(Succeed())
+		Expect(cmd.Flags().Set("tags", "owner:me")).To(Succeed())
+
+		requests, err := machinePoolRequestsFromFile(cmd, writeFile(listFile), true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requests).To(HaveLen(2))
+
+		Expect(requests[0].options.Name).To(Equal("a"))
+		Expect(requests[0].options.InstanceType).To(Equal("m6i.xlarge"))
+		Expect(requests[0].options.Replicas).To(Equal(2))
+		Expect(requests[0].options.Labels).To(Equal("team=a"))
+		Expect(requests[0].options.Tags).To(Equal([]string{"owner:me"}))
+		Expect(requests[0].cmd.Flags().Changed("replicas")).To(BeTrue())
+
+		Expect(requests[1].options.Name).To(Equal("b"))
+		Expect(requests[1].options.AutoscalingEnabled).To(BeTrue())
+		Expect(requests[1].options.MinReplicas).To(Equal(1))
+		Expect(requests[1].options.MaxReplicas).To(Equal(3))
+		Expect(requests[1].options.Version).To(Equal("4.18.1"))
+		Expect(requests[1].cmd.Flags().Changed("replicas")).To(BeFalse())
+	})
+
+	It("takes the scaling of the command line as a whole", func() {
+		cmd := NewCreateMachinePoolCommand()
+		Expect(cmd.Flags().Set("enable-autoscaling", "true")).To(Succeed())
+		Expect(cmd.Flags().Set("min-replicas", "2")).To(Succeed())
+		Expect(cmd.Flags().Set("max-replicas", "6")).To(Succeed())
+
+		requests, err := machinePoolRequestsFromFile(cmd, writeFile(listFile), true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requests).To(HaveLen(2))
+		for _, request := range requests {
+			Expect(request.cmd.Flags().Changed("replicas")).To(BeFalse())
+			Expect(request.options.AutoscalingEnabled).To(BeTrue())
+			Expect(request.options.MinReplicas).To(Equal(2))
+			Expect(request.options.MaxReplicas).To(Equal(6))
+		}
+
+		cmd = NewCreateMachinePoolCommand()
+		Expect(cmd.Flags().Set("replicas", "4")).To(Succeed())
+
+		requests, err = machinePoolRequestsFromFile(cmd, writeFile(listFile), true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requests).To(HaveLen(2))
+		for _, request := range requests {
+			Expect(request.options.Replicas).To(Equal(4))
+			Expect(request.cmd.Flags().Changed("enable-autoscaling")).To(BeFalse())
+			Expect(request.cmd.Flags().Changed("min-replicas")).To(BeFalse())
+			Expect(request.cmd.Flags().Changed("max-replicas")).To(BeFalse())
+		}
+	})
+
+	It("replaces the defaults with the values of the file", func() {
+		dir := GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(dir, defaults.FileName),
+			[]byte("defaults:\n  replicas: 5\n  instance-type: m6i.xlarge\n  disk-size: 200GiB\n"), 0600)).To(Succeed())
+		values, err := defaults.LoadFrom(dir, "")
+		Expect(err).NotTo(HaveOccurred())
+		cmd := NewCreateMachinePoolCommand()
+		_, err = values.Apply(cmd.Flags())
+		Expect(err).NotTo(HaveOccurred())
+
+		requests, err := machinePoolRequestsFromFile(cmd, writeFile(listFile), true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requests).To(HaveLen(2))
+
+		Expect(requests[0].options.InstanceType).To(Equal("m5.xlarge"))
+		Expect(requests[0].options.Replicas).To(Equal(2))
+		Expect(requests[0].options.RootDiskSize).To(Equal("200GiB"))
+
+		Expect(requests[1].options.InstanceType).To(Equal("m6i.xlarge"))
+		Expect(requests[1].cmd.Flags().Changed("instance-type")).To(BeFalse())
+		Expect(defaults.IsDefault(requests[1].cmd.Flags().Lookup("instance-type"))).To(BeTrue())
+		Expect(requests[1].cmd.Flags().Changed("replicas")).To(BeFalse())
+		Expect(requests[1].options.AutoscalingEnabled).To(BeTrue())
+		Expect(requests[1].options.MinReplicas).To(Equal(1))
+		Expect(requests[1].options.MaxReplicas).To(Equal(3))
+	})
+
+	It("fails when the file uses fields of the other topology", func() {
+		_, err := machinePoolRequestsFromFile(NewCreateMachinePoolCommand(), writeFile(listFile), false)
+		Expect(err).To(MatchError("machine pool 'b' sets fields only supported for Hosted Control Plane " +
+			"clusters: version"))
+	})
+
+	It("fails when the name is set for a list of machine pools", func() {
+		cmd := NewCreateMachinePoolCommand()
+		Expect(cmd.Flags().Set("name", "c")).To(Succeed())
+		_, err := machinePoolRequestsFromFile(cmd, writeFile(listFile), true)
+		Expect(err).To(MatchError(ContainSubstring("the '--name' flag cannot be used")))
+	})
+
+	It("fails before creating anything when a machine pool already exists", func() {
+		t := test.NewTestRuntime()
+		cluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.Hypershift(cmv1.NewHypershift().Enabled(true))
+		})
+		t.RosaRuntime.ClusterKey = test.MockClusterName
+		requests, err := machinePoolRequestsFromFile(NewCreateMachinePoolCommand(), writeFile(listFile), true)
+		Expect(err).NotTo(HaveOccurred())
+		nodePool := test.MockNodePool(func(n *cmv1.NodePoolBuilder) { n.ID("b") })
+		t.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, test.FormatNodePoolList([]*cmv1.NodePool{nodePool})))
+
+		err = checkExistingMachinePools(t.RosaRuntime, cluster, requests)
+		Expect(err).To(MatchError("Machine pool 'b' already exists in cluster '" + test.MockClusterName + "'"))
+	})
+
+	It("reports the machine pools created before a failure", func() {
+		requests, err := machinePoolRequestsFromFile(NewCreateMachinePoolCommand(), writeFile(listFile), true)
+		Expect(err).NotTo(HaveOccurred())
+		created := []string{}
+		err = createMachinePools(requests, func(request machinePoolRequest) error {
+			if request.options.Name == "b" {
+				return fmt.Errorf("Failed to add machine pool to hosted cluster")
+			}
+			created = append(created, request.options.Name)
+			return nil
+		})
+		Expect(created).To(Equal([]string{"a"}))
+		Expect(err).To(MatchError("Failed to add machine pool to hosted cluster. " +
+			"The machine pools created before the failure are 'a'"))
+	})
+})
@@ -13,6 +13,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/aws/tags"
 	"github.com/openshift/rosa/pkg/interactive"
 	helper "github.com/openshift/rosa/pkg/network"
 	"github.com/openshift/rosa/pkg/ocm"
@@ -20,7 +21,7 @@ import (
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
-const defaultTemplate = "rosa-quickstart-default-vpc"
+const defaultTemplate = helper.DefaultTemplate
 
 func NewNetworkCommand() *cobra.Command {
 	cmd, options := opts.BuildNetworkCommandWithOptions()
@@ -149,6 +150,11 @@ func NetworkRunner(userOptions *opts.NetworkUserOptions) rosa.CommandRunner {
 		if err != nil {
 			return err
 		}
+		// The template tag is how 'rosa list network' and 'rosa delete network' find the stacks
+		// created by this command:
+		if _, ok := parsedTags[tags.NetworkTemplate]; !ok {
+			parsedTags[tags.NetworkTemplate] = templateCommand
+		}
 		service := helper.NewNetworkService()
 
 		mode, err := interactive.GetMode()
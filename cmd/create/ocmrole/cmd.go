@@ -31,6 +31,7 @@ import (
 	"github.com/openshift/rosa/pkg/aws"
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
@@ -129,20 +130,20 @@ func run(cmd *cobra.Command, _ []string) {
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	env, err := ocm.GetEnv()
 	if err != nil {
 		r.Reporter.Errorf("Failed to determine OCM environment: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	// Determine if Classic ROSA managed policies are enabled
 	isManagedSet := cmd.Flags().Changed("managed-policies") || cmd.Flags().Changed("mp")
 	if roles.ClassicManagedPoliciesUnsupportedInEnv(isManagedSet, args.managed, env) {
 		r.Reporter.Errorf("Classic ROSA managed policies are not supported in this environment")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 	managedPolicies := args.managed
 
@@ -169,16 +170,16 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid role prefix: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	if len(prefix) > 32 {
 		r.Reporter.Errorf("Expected a prefix with no more than 32 characters")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 	if !aws.RoleNameRE.MatchString(prefix) {
 		r.Reporter.Errorf("Expected a valid role prefix matching %s", aws.RoleNameRE.String())
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	profile := internalocmrole.DetermineProfile(args.admin, args.noConsole)
@@ -187,7 +188,7 @@ func run(cmd *cobra.Command, _ []string) {
 		profile, err = promptProfile(cmd, profile)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid --admin value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -207,7 +208,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid policy ARN for permissions boundary: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -215,7 +216,7 @@ func run(cmd *cobra.Command, _ []string) {
 		err = aws.ARNValidator(permissionsBoundary)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid policy ARN for permissions boundary: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -231,21 +232,21 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid path: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
 	if path != "" && !aws.ARNPath.MatchString(path) {
 		r.Reporter.Errorf("The specified value for path is invalid. " +
 			"It must begin and end with '/' and contain only alphanumeric characters and/or '/' characters.")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if interactive.Enabled() {
 		mode, err = interactive.GetOptionMode(cmd, mode, "Role creation mode")
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -253,7 +254,7 @@ func run(cmd *cobra.Command, _ []string) {
 	orgID, externalID, err := r.OCMClient.GetCurrentOrganization()
 	if err != nil {
 		r.Reporter.Errorf("Failed to get organization account: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	roleNameRequested := aws.GetOCMRoleName(prefix, aws.OCMRole, externalID)
@@ -261,7 +262,7 @@ func run(cmd *cobra.Command, _ []string) {
 	existsOnOCM, _, selectedARN, err := r.OCMClient.CheckRoleExists(orgID, roleNameRequested, r.Creator.AccountID)
 	if err != nil {
 		r.Reporter.Errorf("Error checking existing ocm-role: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if existsOnOCM {
 		r.Reporter.Errorf("Only one ocm-role can be created per AWS account '%s' per organization '%s'.\n"+
@@ -273,7 +274,7 @@ func run(cmd *cobra.Command, _ []string) {
 	policies, err := r.OCMClient.GetPolicies("OCMRole")
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	// Validate no-console policy availability before any operations
@@ -340,13 +341,13 @@ func run(cmd *cobra.Command, _ []string) {
 		)
 		if err != nil {
 			r.Reporter.Errorf("Failed to generate commands for manual mode: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		fmt.Println(commands)
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 }
 
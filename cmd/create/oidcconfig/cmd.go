@@ -33,7 +33,9 @@ import (
 	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/aws"
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
+	"github.com/openshift/rosa/pkg/aws/iac"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/constants"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/interactive"
@@ -49,6 +51,7 @@ var args struct {
 	userPrefix       string
 	managed          bool
 	installerRoleArn string
+	outputFormat     string
 }
 
 var Cmd = &cobra.Command{
@@ -107,6 +110,7 @@ func init() {
 	)
 
 	interactive.AddModeFlag(Cmd)
+	iac.AddOutputFormatFlag(flags, &args.outputFormat)
 
 	confirm.AddFlag(flags)
 	interactive.AddFlag(flags)
@@ -138,17 +142,28 @@ func run(cmd *cobra.Command, _ []string) {
 	r := rosa.NewRuntime().WithAWS().WithOCM()
 	defer r.Cleanup()
 
+	if args.rawFiles && cmd.Flags().Changed(iac.OutputFormatFlag) {
+		r.Reporter.Warnf("--%s param is not supported alongside --%s param", rawFilesFlag, iac.OutputFormatFlag)
+		os.Exit(clierror.Validation.ExitCode())
+	}
+
+	format, err := iac.GetFormat(cmd, args.outputFormat)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
+	}
+
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	// Get AWS region
 	region, err := aws.GetRegion(arguments.GetRegion())
 	if err != nil {
 		r.Reporter.Errorf("Error getting region: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	args.region = region
 
@@ -160,17 +175,17 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if args.rawFiles && mode != "" {
 		r.Reporter.Warnf("--%s param is not supported alongside --mode param.", rawFilesFlag)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if args.rawFiles && args.installerRoleArn != "" {
 		r.Reporter.Warnf("--%s param is not supported alongside --%s param", rawFilesFlag, constants.InstallerRoleArnFlag)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if args.rawFiles && args.managed {
 		r.Reporter.Warnf("--%s param is not supported alongside --%s param", rawFilesFlag, managedFlag)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if !args.rawFiles && interactive.Enabled() && !cmd.Flags().Changed("mode") {
@@ -183,23 +198,23 @@ func run(cmd *cobra.Command, _ []string) {
 		mode, err = interactive.GetOptionMode(cmd, mode, question)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid %s: %s", question, err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
 	if output.HasFlag() && mode != "" && mode != interactive.ModeAuto {
 		r.Reporter.Warnf("--output param is not supported outside auto mode.")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if args.managed && args.userPrefix != "" {
 		r.Reporter.Warnf("--%s param is not supported for managed OIDC config", userPrefixFlag)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if args.managed && args.installerRoleArn != "" {
 		r.Reporter.Warnf("--%s param is not supported for managed OIDC config", constants.InstallerRoleArnFlag)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if !args.managed {
@@ -227,7 +242,7 @@ func run(cmd *cobra.Command, _ []string) {
 				})
 				if err != nil {
 					r.Reporter.Errorf("Expected a valid prefix for the configuration: %s", err)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 				args.userPrefix = prefix
 			}
@@ -239,7 +254,7 @@ func run(cmd *cobra.Command, _ []string) {
 				err := aws.ARNValidator(args.installerRoleArn)
 				if err != nil {
 					r.Reporter.Errorf("Expected a valid ARN: %s", err)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 				roleExists, _, err := r.AWSClient.CheckRoleExists(roleName)
 				if err != nil {
@@ -248,7 +263,7 @@ func run(cmd *cobra.Command, _ []string) {
 						args.installerRoleArn,
 						err,
 					)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 				if !roleExists {
 					r.Reporter.Errorf("Role '%s' does not exist", args.installerRoleArn)
@@ -258,7 +273,7 @@ func run(cmd *cobra.Command, _ []string) {
 					roleName, aws.InstallerAccountRole, constants.MinorVersionForGetSecret)
 				if err != nil {
 					r.Reporter.Errorf("There was a problem listing role tags: %v", err)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 				if !isValid {
 					r.Reporter.Errorf(
@@ -276,7 +291,7 @@ func run(cmd *cobra.Command, _ []string) {
 		if len([]rune(args.userPrefix)) > maxLengthUserPrefix {
 			r.Reporter.Errorf("Expected a valid prefix for the configuration: "+
 				"length of prefix is limited to %d characters", maxLengthUserPrefix)
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 	}
 
@@ -285,14 +300,14 @@ func run(cmd *cobra.Command, _ []string) {
 		oidcConfigInput, err = oidcconfigs.BuildOidcConfigInput(args.userPrefix, args.region)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
-	oidcConfigStrategy, err := getOidcConfigStrategy(mode, &oidcConfigInput)
+	oidcConfigStrategy, err := getOidcConfigStrategy(mode, &oidcConfigInput, format)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	oidcConfigId := oidcConfigStrategy.execute(r)
 	if !args.rawFiles {
@@ -304,13 +319,18 @@ func run(cmd *cobra.Command, _ []string) {
 			if err != nil {
 				r.Reporter.Errorf("Unable to attempt creation of OIDC provider; oidc config ID"+
 					" not found / not created successfully: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		} else {
 			r.Reporter.Infof("To create the OIDC provider, please run 'rosa create oidc-provider' with the ID " +
 				"of the OIDC config or cluster you want to associate it with.")
 			os.Exit(0)
 		}
+		err = oidcprovider.Cmd.Flags().Set(iac.OutputFormatFlag, string(format))
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(clierror.ExitCode(err))
+		}
 		oidcprovider.Cmd.Run(oidcprovider.Cmd, providerArgs)
 		arguments.DisableRegionDeprecationWarning = false // enable region deprecation again
 	}
@@ -348,19 +368,19 @@ func (s *CreateUnmanagedOidcConfigRawStrategy) execute(r *rosa.Runtime) string {
 	err := helper.SaveDocument(string(privateKey), privateKeyFilename)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem saving private key to a file: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	discoveryDocumentFilename := fmt.Sprintf("discovery-document-%s.json", bucketName)
 	err = helper.SaveDocument(discoveryDocument, discoveryDocumentFilename)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem saving discovery document to a file: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	jwksFilename := fmt.Sprintf("jwks-%s.json", bucketName)
 	err = helper.SaveDocument(string(jwks[:]), jwksFilename)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem saving JSON Web Key Set to a file: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if !output.HasFlag() && r.Reporter.IsTerminal() {
 		r.Reporter.Infof(
@@ -442,14 +462,14 @@ func (s *CreateUnmanagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) string
 	err := r.AWSClient.CreateS3Bucket(bucketName, args.region)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem creating S3 bucket '%s': %s", bucketName, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	err = r.AWSClient.PutPublicReadObjectInS3Bucket(
 		bucketName, strings.NewReader(discoveryDocument), discoveryDocumentKey)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem populating discovery "+
 			"document to S3 bucket '%s': %s", bucketName, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	err = r.AWSClient.PutPublicReadObjectInS3Bucket(bucketName, bytes.NewReader(jwks), jwksKey)
 	if err != nil {
@@ -458,12 +478,12 @@ func (s *CreateUnmanagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) string
 		}
 		r.Reporter.Errorf("There was a problem populating JWKS "+
 			"to S3 bucket '%s': %s", bucketName, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	secretARN, err := r.AWSClient.CreateSecretInSecretsManager(privateKeySecretName, string(privateKey[:]))
 	if err != nil {
 		r.Reporter.Errorf("There was a problem saving private key to secrets manager: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	oidcConfig, err := v1.NewOidcConfig().
 		Managed(false).
@@ -488,7 +508,7 @@ func (s *CreateUnmanagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) string
 		err = output.Print(oidcConfig)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		os.Exit(0)
 	}
@@ -504,10 +524,37 @@ func (s *CreateUnmanagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) string
 
 type CreateUnmanagedOidcConfigManualStrategy struct {
 	oidcConfig *oidcconfigs.OidcConfigInput
+	format     iac.Format
 }
 
 func (s *CreateUnmanagedOidcConfigManualStrategy) execute(r *rosa.Runtime) string {
-	commands := []string{}
+	template, err := s.commands()
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
+	}
+
+	if s.format != iac.FormatShell && r.Reporter.IsTerminal() {
+		r.Reporter.Infof("%s:\n", s.format.Instructions("to create the OIDC configuration"))
+	}
+	err = iac.Print(r.Reporter, s.format, template)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
+	}
+	if r.Reporter.IsTerminal() {
+		r.Reporter.Infof("Please run commands above to generate OIDC compliant configuration in your AWS account. " +
+			"To register this OIDC Configuration, please run the following command:\n" +
+			"rosa register oidc-config\n" +
+			"For more information please refer to the documentation")
+	}
+	return ""
+}
+
+// commands saves the documents of the OIDC configuration to the current directory and returns the
+// template with the commands that create its resources from them.
+func (s *CreateUnmanagedOidcConfigManualStrategy) commands() (*iac.Template, error) {
+	template := &iac.Template{}
 	bucketName := s.oidcConfig.BucketName
 	discoveryDocument := s.oidcConfig.DiscoveryDocument
 	jwks := s.oidcConfig.Jwks
@@ -516,100 +563,119 @@ func (s *CreateUnmanagedOidcConfigManualStrategy) execute(r *rosa.Runtime) strin
 	privateKeySecretName := s.oidcConfig.PrivateKeySecretName
 	err := helper.SaveDocument(string(privateKey), privateKeyFilename)
 	if err != nil {
-		r.Reporter.Errorf("There was a problem saving private key to a file: %s", err)
-		os.Exit(1)
+		return nil, fmt.Errorf("There was a problem saving private key to a file: %v", err)
 	}
 	createBucketConfig := ""
 	if args.region != aws.DefaultRegion {
 		createBucketConfig = fmt.Sprintf("LocationConstraint=%s", args.region)
 	}
+	bucket := &iac.Bucket{
+		Name:   bucketName,
+		Region: args.region,
+		Tags:   map[string]string{tags.RedHatManaged: tags.True},
+		PublicAccessBlock: map[string]bool{
+			"BlockPublicAcls":       true,
+			"IgnorePublicAcls":      true,
+			"BlockPublicPolicy":     false,
+			"RestrictPublicBuckets": false,
+		},
+	}
 	createS3BucketCommand := awscb.NewS3ApiCommandBuilder().
 		SetCommand(awscb.CreateBucket).
 		AddParam(awscb.Bucket, bucketName).
 		AddParam(awscb.CreateBucketConfiguration, createBucketConfig).
 		AddParam(awscb.Region, args.region).
 		Build()
-	commands = append(commands, createS3BucketCommand)
+	template.AddBucket(createS3BucketCommand, bucket)
 
 	putBucketTaggingCommand := awscb.NewS3ApiCommandBuilder().
 		SetCommand(awscb.PutBucketTagging).
 		AddParam(awscb.Bucket, bucketName).
-		AddParam(awscb.Tagging, fmt.Sprintf("'TagSet=[{Key=%s,Value=%s}]'", tags.RedHatManaged, tags.True)).
+		AddParam(awscb.Tagging, awscb.BucketTagging(bucket.Tags)).
 		Build()
-	commands = append(commands, putBucketTaggingCommand)
+	template.Add(putBucketTaggingCommand)
 
 	PutPublicAccessBlockCommand := awscb.NewS3ApiCommandBuilder().
 		SetCommand(awscb.PutPublicAccessBlock).
 		AddParam(awscb.Bucket, bucketName).
-		AddParam(awscb.PublicAccessBlockConfiguration,
-			"BlockPublicAcls=true,IgnorePublicAcls=true,BlockPublicPolicy=false,RestrictPublicBuckets=false").
+		AddParam(awscb.PublicAccessBlockConfiguration, awscb.PublicAccessBlock(bucket.PublicAccessBlock)).
 		Build()
-	commands = append(commands, PutPublicAccessBlockCommand)
+	template.Add(PutPublicAccessBlockCommand)
 
 	readOnlyPolicyFilename := fmt.Sprintf("readOnlyPolicy-%s.json", bucketName)
 	err = helper.SaveDocument(fmt.Sprintf(aws.ReadOnlyAnonUserPolicyTemplate, bucketName), readOnlyPolicyFilename)
 	if err != nil {
-		r.Reporter.Errorf("There was a problem saving bucket policy document to a file: %s", err)
-		os.Exit(1)
+		return nil, fmt.Errorf("There was a problem saving bucket policy document to a file: %v", err)
 	}
 	putBucketBucketPolicyCommand := awscb.NewS3ApiCommandBuilder().
 		SetCommand(awscb.PutBucketPolicy).
 		AddParam(awscb.Bucket, bucketName).
 		AddParam(awscb.Policy, fmt.Sprintf("file://%s", readOnlyPolicyFilename)).
 		Build()
-	commands = append(commands, putBucketBucketPolicyCommand)
-	commands = append(commands, fmt.Sprintf("rm %s", readOnlyPolicyFilename))
+	bucket.Policy = readOnlyPolicyFilename
+	template.Add(putBucketBucketPolicyCommand)
+	template.Add(fmt.Sprintf("rm %s", readOnlyPolicyFilename))
 
 	discoveryDocumentFilename := fmt.Sprintf("discovery-document-%s.json", bucketName)
 	err = helper.SaveDocument(discoveryDocument, discoveryDocumentFilename)
 	if err != nil {
-		r.Reporter.Errorf("There was a problem saving discovery document to a file: %s", err)
-		os.Exit(1)
+		return nil, fmt.Errorf("There was a problem saving discovery document to a file: %v", err)
+	}
+	discoveryDocumentObject := &iac.Object{
+		Key:    discoveryDocumentKey,
+		Source: discoveryDocumentFilename,
+		Tags:   map[string]string{tags.RedHatManaged: tags.True},
 	}
 	putDiscoveryDocumentCommand := awscb.NewS3ApiCommandBuilder().
 		SetCommand(awscb.PutObject).
-		AddParam(awscb.Body, fmt.Sprintf("./%s", discoveryDocumentFilename)).
+		AddParam(awscb.Body, fmt.Sprintf("./%s", discoveryDocumentObject.Source)).
 		AddParam(awscb.Bucket, bucketName).
-		AddParam(awscb.Key, discoveryDocumentKey).
-		AddParam(awscb.Tagging, fmt.Sprintf("'%s=%s'", tags.RedHatManaged, tags.True)).
+		AddParam(awscb.Key, discoveryDocumentObject.Key).
+		AddParam(awscb.Tagging, awscb.ObjectTagging(discoveryDocumentObject.Tags)).
 		Build()
-	commands = append(commands, putDiscoveryDocumentCommand)
-	commands = append(commands, fmt.Sprintf("rm %s", discoveryDocumentFilename))
+	bucket.Objects = append(bucket.Objects, discoveryDocumentObject)
+	template.Add(putDiscoveryDocumentCommand)
+	template.Add(fmt.Sprintf("rm %s", discoveryDocumentFilename))
 	jwksFilename := fmt.Sprintf("jwks-%s.json", bucketName)
 	err = helper.SaveDocument(string(jwks[:]), jwksFilename)
 	if err != nil {
-		r.Reporter.Errorf("There was a problem saving JSON Web Key Set to a file: %s", err)
-		os.Exit(1)
+		return nil, fmt.Errorf("There was a problem saving JSON Web Key Set to a file: %v", err)
+	}
+	jwksObject := &iac.Object{
+		Key:    jwksKey,
+		Source: jwksFilename,
+		Tags:   map[string]string{tags.RedHatManaged: tags.True},
 	}
 	putJwksCommand := awscb.NewS3ApiCommandBuilder().
 		SetCommand(awscb.PutObject).
-		AddParam(awscb.Body, fmt.Sprintf("./%s", jwksFilename)).
+		AddParam(awscb.Body, fmt.Sprintf("./%s", jwksObject.Source)).
 		AddParam(awscb.Bucket, bucketName).
-		AddParam(awscb.Key, jwksKey).
-		AddParam(awscb.Tagging, fmt.Sprintf("'%s=%s'", tags.RedHatManaged, tags.True)).
+		AddParam(awscb.Key, jwksObject.Key).
+		AddParam(awscb.Tagging, awscb.ObjectTagging(jwksObject.Tags)).
 		Build()
-	commands = append(commands, putJwksCommand)
-	commands = append(commands, fmt.Sprintf("rm %s", jwksFilename))
+	bucket.Objects = append(bucket.Objects, jwksObject)
+	template.Add(putJwksCommand)
+	template.Add(fmt.Sprintf("rm %s", jwksFilename))
+	secret := &iac.Secret{
+		Name:        privateKeySecretName,
+		Description: fmt.Sprintf("Secret for %s", bucketName),
+		Region:      args.region,
+		Source:      privateKeyFilename,
+		Tags: map[string]string{
+			tags.RedHatManaged: "true",
+		},
+	}
 	createSecretCommand := awscb.NewSecretsManagerCommandBuilder().
 		SetCommand(awscb.CreateSecret).
-		AddParam(awscb.Name, privateKeySecretName).
-		AddParam(awscb.SecretString, fmt.Sprintf("file://%s", privateKeyFilename)).
-		AddParam(awscb.Description, fmt.Sprintf("\"Secret for %s\"", bucketName)).
-		AddParam(awscb.Region, args.region).
-		AddTags(map[string]string{
-			tags.RedHatManaged: "true",
-		}).
+		AddParam(awscb.Name, secret.Name).
+		AddParam(awscb.SecretString, fmt.Sprintf("file://%s", secret.Source)).
+		AddParam(awscb.Description, fmt.Sprintf("\"%s\"", secret.Description)).
+		AddParam(awscb.Region, secret.Region).
+		AddTags(secret.Tags).
 		Build()
-	commands = append(commands, createSecretCommand)
-	commands = append(commands, fmt.Sprintf("rm %s", privateKeyFilename))
-	fmt.Println(awscb.JoinCommands(commands))
-	if r.Reporter.IsTerminal() {
-		r.Reporter.Infof("Please run commands above to generate OIDC compliant configuration in your AWS account. " +
-			"To register this OIDC Configuration, please run the following command:\n" +
-			"rosa register oidc-config\n" +
-			"For more information please refer to the documentation")
-	}
-	return ""
+	template.AddSecret(createSecretCommand, secret)
+	template.Add(fmt.Sprintf("rm %s", privateKeyFilename))
+	return template, nil
 }
 
 type CreateManagedOidcConfigAutoStrategy struct {
@@ -628,7 +694,7 @@ func (s *CreateManagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) string {
 	oidcConfig, err := v1.NewOidcConfig().Managed(true).Build()
 	if err != nil {
 		r.Reporter.Errorf("There was a problem building the managed OIDC Configuration: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	oidcConfig, err = r.OCMClient.CreateOidcConfig(oidcConfig)
 	if err != nil {
@@ -636,14 +702,14 @@ func (s *CreateManagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) string {
 			spin.Stop()
 		}
 		r.Reporter.Errorf("There was a problem registering your managed OIDC Configuration: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	s.oidcConfigInput.IssuerUrl = oidcConfig.IssuerUrl()
 	if output.HasFlag() {
 		err = output.Print(oidcConfig)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		os.Exit(0)
 	}
@@ -670,7 +736,8 @@ func (s *CreateManagedOidcConfigAutoStrategy) executeNoExit(r *rosa.Runtime) (st
 	return oidcConfig.ID(), nil
 }
 
-func getOidcConfigStrategy(mode string, input *oidcconfigs.OidcConfigInput) (CreateOidcConfigStrategy, error) {
+func getOidcConfigStrategy(mode string, input *oidcconfigs.OidcConfigInput,
+	format iac.Format) (CreateOidcConfigStrategy, error) {
 	if args.rawFiles {
 		return &CreateUnmanagedOidcConfigRawStrategy{oidcConfig: input}, nil
 	}
@@ -681,7 +748,7 @@ func getOidcConfigStrategy(mode string, input *oidcconfigs.OidcConfigInput) (Cre
 	case interactive.ModeAuto:
 		return &CreateUnmanagedOidcConfigAutoStrategy{oidcConfig: input}, nil
 	case interactive.ModeManual:
-		return &CreateUnmanagedOidcConfigManualStrategy{oidcConfig: input}, nil
+		return &CreateUnmanagedOidcConfigManualStrategy{oidcConfig: input, format: format}, nil
 	default:
 		return nil, weberr.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
 	}
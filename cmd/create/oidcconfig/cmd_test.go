@@ -8,6 +8,8 @@ import (
 	"github.com/openshift-online/ocm-common/pkg/rosa/oidcconfigs"
 	. "github.com/openshift-online/ocm-sdk-go/testing"
 
+	"github.com/openshift/rosa/pkg/aws/iac"
+	"github.com/openshift/rosa/pkg/aws/tags"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/test"
 )
@@ -25,7 +27,7 @@ var _ = Describe("Create OIDC Config", func() {
 		It("returns raw strategy when rawFiles is true", func() {
 			args.rawFiles = true
 
-			strategy, err := getOidcConfigStrategy(interactive.ModeAuto, input)
+			strategy, err := getOidcConfigStrategy(interactive.ModeAuto, input, iac.FormatShell)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(strategy).To(BeAssignableToTypeOf(&CreateUnmanagedOidcConfigRawStrategy{}))
 		})
@@ -33,25 +35,26 @@ var _ = Describe("Create OIDC Config", func() {
 		It("returns managed auto strategy when managed is true", func() {
 			args.managed = true
 
-			strategy, err := getOidcConfigStrategy(interactive.ModeAuto, input)
+			strategy, err := getOidcConfigStrategy(interactive.ModeAuto, input, iac.FormatShell)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(strategy).To(BeAssignableToTypeOf(&CreateManagedOidcConfigAutoStrategy{}))
 		})
 
 		It("returns unmanaged auto strategy for auto mode", func() {
-			strategy, err := getOidcConfigStrategy(interactive.ModeAuto, input)
+			strategy, err := getOidcConfigStrategy(interactive.ModeAuto, input, iac.FormatShell)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(strategy).To(BeAssignableToTypeOf(&CreateUnmanagedOidcConfigAutoStrategy{}))
 		})
 
 		It("returns unmanaged manual strategy for manual mode", func() {
-			strategy, err := getOidcConfigStrategy(interactive.ModeManual, input)
+			strategy, err := getOidcConfigStrategy(interactive.ModeManual, input, iac.FormatTerraform)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(strategy).To(BeAssignableToTypeOf(&CreateUnmanagedOidcConfigManualStrategy{}))
+			Expect(strategy.(*CreateUnmanagedOidcConfigManualStrategy).format).To(Equal(iac.FormatTerraform))
 		})
 
 		It("returns error for invalid mode", func() {
-			_, err := getOidcConfigStrategy("invalid", input)
+			_, err := getOidcConfigStrategy("invalid", input, iac.FormatShell)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("Invalid mode"))
 		})
@@ -97,4 +100,71 @@ var _ = Describe("Create OIDC Config", func() {
 			Expect(err.Error()).To(ContainSubstring("managed OIDC Configuration"))
 		})
 	})
+
+	Context("UnmanagedOidcConfigManualStrategy.commands", func() {
+		BeforeEach(func() {
+			GinkgoT().Chdir(GinkgoT().TempDir())
+			args.region = "us-east-2"
+			DeferCleanup(func() {
+				args.region = ""
+			})
+		})
+
+		It("describes the resources created by the commands", func() {
+			strategy := &CreateUnmanagedOidcConfigManualStrategy{oidcConfig: &oidcconfigs.OidcConfigInput{
+				BucketName:           "oidc-bucket",
+				DiscoveryDocument:    `{"issuer": "https://oidc-bucket.s3.us-east-2.amazonaws.com"}`,
+				Jwks:                 []byte(`{"keys": []}`),
+				PrivateKey:           []byte("private-key"),
+				PrivateKeyFilename:   "oidc-bucket.key",
+				PrivateKeySecretName: "oidc-bucket-secret",
+			}}
+			template, err := strategy.commands()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(template.Unsupported).To(BeEmpty())
+			Expect(template.Buckets).To(HaveLen(1))
+			bucket := template.Buckets[0]
+			Expect(bucket.Name).To(Equal("oidc-bucket"))
+			Expect(bucket.Region).To(Equal("us-east-2"))
+			Expect(bucket.Tags).To(Equal(map[string]string{tags.RedHatManaged: tags.True}))
+			Expect(bucket.PublicAccessBlock).To(Equal(map[string]bool{
+				"BlockPublicAcls":       true,
+				"IgnorePublicAcls":      true,
+				"BlockPublicPolicy":     false,
+				"RestrictPublicBuckets": false,
+			}))
+			Expect(bucket.Policy).To(Equal("readOnlyPolicy-oidc-bucket.json"))
+			Expect(bucket.Objects).To(HaveLen(2))
+			Expect(*bucket.Objects[0]).To(Equal(iac.Object{
+				Key:    discoveryDocumentKey,
+				Source: "discovery-document-oidc-bucket.json",
+				Tags:   map[string]string{tags.RedHatManaged: tags.True},
+			}))
+			Expect(*bucket.Objects[1]).To(Equal(iac.Object{
+				Key:    jwksKey,
+				Source: "jwks-oidc-bucket.json",
+				Tags:   map[string]string{tags.RedHatManaged: tags.True},
+			}))
+			Expect(template.Secrets).To(HaveLen(1))
+			Expect(*template.Secrets[0]).To(Equal(iac.Secret{
+				Name:        "oidc-bucket-secret",
+				Description: "Secret for oidc-bucket",
+				Region:      "us-east-2",
+				Source:      "oidc-bucket.key",
+				Tags:        map[string]string{tags.RedHatManaged: "true"},
+			}))
+			for _, name := range []string{bucket.Policy, bucket.Objects[0].Source, bucket.Objects[1].Source,
+				template.Secrets[0].Source} {
+				Expect(name).To(BeAnExistingFile())
+			}
+			commands := template.Commands()
+			Expect(commands).To(ContainSubstring("--tagging 'TagSet=[{Key=red-hat-managed,Value=true}]'"))
+			Expect(commands).To(ContainSubstring("--public-access-block-configuration BlockPublicAcls=true," +
+				"BlockPublicPolicy=false,IgnorePublicAcls=true,RestrictPublicBuckets=false"))
+			Expect(commands).To(ContainSubstring("--policy file://readOnlyPolicy-oidc-bucket.json"))
+			Expect(commands).To(ContainSubstring("--body ./jwks-oidc-bucket.json"))
+			Expect(commands).To(ContainSubstring("--secret-string file://oidc-bucket.key"))
+		})
+	})
 })
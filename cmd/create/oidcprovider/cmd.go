@@ -26,7 +26,9 @@ import (
 
 	"github.com/openshift/rosa/pkg/aws"
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
+	"github.com/openshift/rosa/pkg/aws/iac"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	interactiveOidc "github.com/openshift/rosa/pkg/interactive/oidc"
@@ -54,6 +56,7 @@ const (
 var args struct {
 	oidcConfigId    string
 	oidcEndpointUrl string
+	outputFormat    string
 }
 
 func init() {
@@ -69,6 +72,7 @@ func init() {
 
 	ocm.AddOptionalClusterFlag(Cmd)
 	interactive.AddModeFlag(Cmd)
+	iac.AddOutputFormatFlag(flags, &args.outputFormat)
 
 	confirm.AddFlag(flags)
 	interactive.AddFlag(flags)
@@ -98,13 +102,19 @@ func run(cmd *cobra.Command, argv []string) {
 	if cmd.Flag("cluster").Changed && cmd.Flag(OidcConfigIdFlag).Changed {
 		r.Reporter.Errorf("A cluster key for STS cluster and an OIDC Config ID " +
 			"cannot be specified alongside each other.")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
+	}
+
+	format, err := iac.GetFormat(cmd, args.outputFormat)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	// Determine if interactive mode is needed
@@ -120,7 +130,7 @@ func run(cmd *cobra.Command, argv []string) {
 		cluster = r.FetchCluster()
 		if !ocm.IsSts(cluster) {
 			r.Reporter.Errorf("Cluster '%s' is not an STS cluster.", clusterKey)
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 	}
 
@@ -128,7 +138,7 @@ func run(cmd *cobra.Command, argv []string) {
 		mode, err = interactive.GetOptionMode(cmd, mode, "OIDC provider creation mode")
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid OIDC provider creation mode: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -145,7 +155,7 @@ func run(cmd *cobra.Command, argv []string) {
 			oidcConfig, err := r.OCMClient.GetOidcConfig(args.oidcConfigId)
 			if err != nil {
 				r.Reporter.Errorf("There was a problem retrieving OIDC Config '%s': %v", args.oidcConfigId, err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			oidcEndpointURL = oidcConfig.IssuerUrl()
 		}
@@ -163,7 +173,7 @@ func run(cmd *cobra.Command, argv []string) {
 			r.Reporter.Debugf("Failed to verify if OIDC provider exists: %s", err)
 		} else {
 			r.Reporter.Errorf("Failed to verify if OIDC provider exists: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	if oidcProviderExists {
@@ -207,25 +217,29 @@ func run(cmd *cobra.Command, argv []string) {
 			ocm.Response:  ocm.Success,
 		})
 	case interactive.ModeManual:
-		commands, err := buildCommands(r, oidcEndpointURL, clusterId)
+		template, err := buildCommands(r, oidcEndpointURL, clusterId)
 		if err != nil {
 			r.Reporter.Errorf("There was an error building the list of resources: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 			r.OCMClient.LogEvent("ROSACreateOIDCProviderModeManual", map[string]string{
 				ocm.ClusterID: clusterKey,
 				ocm.Response:  ocm.Failure,
 			})
 		}
 		if r.Reporter.IsTerminal() {
-			r.Reporter.Infof("Run the following commands to create the OIDC provider:\n")
+			r.Reporter.Infof("%s:\n", format.Instructions("to create the OIDC provider"))
 		}
 		r.OCMClient.LogEvent("ROSACreateOIDCProviderModeManual", map[string]string{
 			ocm.ClusterID: clusterKey,
 		})
-		fmt.Println(commands)
+		err = iac.Print(r.Reporter, format, template)
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(clierror.ExitCode(err))
+		}
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 }
 
@@ -267,16 +281,16 @@ func createProvider(r *rosa.Runtime, oidcEndpointUrl string, clusterId string, i
 	return nil
 }
 
-func buildCommands(r *rosa.Runtime, oidcEndpointUrl string, clusterId string) (string, error) {
-	commands := []string{}
+func buildCommands(r *rosa.Runtime, oidcEndpointUrl string, clusterId string) (*iac.Template, error) {
+	template := &iac.Template{}
 
 	input, err := cmv1.NewOidcThumbprintInput().OidcConfigId(args.oidcConfigId).ClusterId(clusterId).Build()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	thumbprint, err := r.OCMClient.FetchOidcThumbprint(input)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	r.Reporter.Debugf("Using thumbprint '%s'", thumbprint.Thumbprint())
 
@@ -287,16 +301,21 @@ func buildCommands(r *rosa.Runtime, oidcEndpointUrl string, clusterId string) (s
 		iamTags[tags.ClusterID] = clusterId
 	}
 
-	clientIdList := strings.Join([]string{aws.OIDCClientIDOpenShift, aws.OIDCClientIDSTSAWS}, " ")
+	clientIds := []string{aws.OIDCClientIDOpenShift, aws.OIDCClientIDSTSAWS}
 
 	createOpenIDConnectProvider := awscb.NewIAMCommandBuilder().
 		SetCommand(awscb.CreateOpenIdConnectProvider).
 		AddParam(awscb.Url, oidcEndpointUrl).
-		AddParam(awscb.ClientIdList, clientIdList).
+		AddParam(awscb.ClientIdList, strings.Join(clientIds, " ")).
 		AddParam(awscb.ThumbprintList, thumbprint.Thumbprint()).
 		AddTags(iamTags).
 		Build()
-	commands = append(commands, createOpenIDConnectProvider)
-
-	return awscb.JoinCommands(commands), nil
+	template.AddOIDCProvider(createOpenIDConnectProvider, &iac.OIDCProvider{
+		URL:         oidcEndpointUrl,
+		ClientIDs:   clientIds,
+		Thumbprints: []string{thumbprint.Thumbprint()},
+		Tags:        iamTags,
+	})
+
+	return template, nil
 }
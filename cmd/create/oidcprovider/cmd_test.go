@@ -11,6 +11,8 @@ import (
 	. "github.com/openshift-online/ocm-sdk-go/testing"
 
 	awsClient "github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/aws/iac"
+	"github.com/openshift/rosa/pkg/aws/tags"
 	"github.com/openshift/rosa/pkg/test"
 )
 
@@ -115,4 +117,31 @@ var _ = Describe("Create OIDC Provider", func() {
 			Expect(err.Error()).To(ContainSubstring("access denied"))
 		})
 	})
+
+	Context("buildCommands", func() {
+		It("describes the provider created by the command", func() {
+			t := test.NewTestRuntime()
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, `{
+				"kind": "OidcThumbprint",
+				"thumbprint": "0123456789abcdef0123456789abcdef01234567",
+				"cluster_id": "cluster-123"
+			}`))
+
+			template, err := buildCommands(t.RosaRuntime, "https://oidc.example.com/abc123", "cluster-123")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(template.Unsupported).To(BeEmpty())
+			Expect(template.Commands()).To(ContainSubstring("--client-id-list " + awsClient.OIDCClientIDOpenShift +
+				" " + awsClient.OIDCClientIDSTSAWS))
+			Expect(template.OIDCProviders).To(ConsistOf(&iac.OIDCProvider{
+				URL:         "https://oidc.example.com/abc123",
+				ClientIDs:   []string{awsClient.OIDCClientIDOpenShift, awsClient.OIDCClientIDSTSAWS},
+				Thumbprints: []string{"0123456789abcdef0123456789abcdef01234567"},
+				Tags: map[string]string{
+					tags.RedHatManaged: tags.True,
+					tags.ClusterID:     "cluster-123",
+				},
+			}))
+		})
+	})
 })
@@ -10,7 +10,9 @@ import (
 
 	"github.com/openshift/rosa/pkg/aws"
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
+	"github.com/openshift/rosa/pkg/aws/iac"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/ocm"
@@ -35,7 +37,7 @@ type operatorRolesInput struct {
 }
 
 func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
-	permissionsBoundary string, mode string,
+	permissionsBoundary string, mode string, format iac.Format,
 	policies map[string]*cmv1.AWSSTSPolicy,
 	defaultPolicyVersion string, isHcpSharedVpc bool) error {
 	clusterKey := r.GetClusterKey()
@@ -44,7 +46,7 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 	vpcEndpointRoleArn := args.vpcEndpointRoleArn
 	if cluster.AWS().STS().RoleARN() == "" {
 		r.Reporter.Errorf("Cluster '%s' is not an STS cluster.", clusterKey)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	// Check to see if IAM operator roles have already created
@@ -54,7 +56,7 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 			r.Reporter.Debugf("Failed to verify if operator roles exist: '%v'", err)
 		} else {
 			r.Reporter.Errorf("Failed to verify if operator roles exist: '%v'", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -68,13 +70,13 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 	credRequests, err := r.OCMClient.GetCredRequests(cluster.Hypershift().Enabled())
 	if err != nil {
 		r.Reporter.Errorf("Error getting operator credential request from OCM '%v'", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	managedPolicies := cluster.AWS().STS().ManagedPolicies()
 	if args.forcePolicyCreation && managedPolicies {
 		r.Reporter.Warnf("Forcing creation of policies only works for unmanaged policies")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	switch mode {
@@ -96,13 +98,13 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 		roleName, err := aws.GetInstallerAccountRoleName(cluster)
 		if err != nil {
 			r.Reporter.Errorf("Expected parsing role account role '%s': '%v'", cluster.AWS().STS().RoleARN(), err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		path, err := aws.GetPathFromAccountRole(cluster, aws.AccountRoles[aws.InstallerAccountRole].Name)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid path for '%s': '%v'", cluster.AWS().STS().RoleARN(), err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		if path != "" && !output.HasFlag() && r.Reporter.IsTerminal() {
 			r.Reporter.Infof("ARN path '%s' detected in installer role '%s'. "+
@@ -117,7 +119,7 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 		accountRoleVersion, err = r.AWSClient.GetAccountRoleVersion(roleName)
 		if err != nil {
 			r.Reporter.Errorf("Error getting account role version '%v'", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		err = createRoles(r, operatorRolesInput{
 			prefix:              operatorRolePolicyPrefix,
@@ -151,11 +153,11 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 			ocm.Response:  ocm.Success,
 		})
 	case interactive.ModeManual:
-		commands, err := buildCommands(r, env, operatorRolePolicyPrefix, permissionsBoundary, defaultPolicyVersion,
+		template, err := buildCommands(r, env, operatorRolePolicyPrefix, permissionsBoundary, defaultPolicyVersion,
 			cluster, policies, credRequests, managedPolicies, hostedCPPolicies, route53RoleArn, vpcEndpointRoleArn)
 		if err != nil {
 			r.Reporter.Errorf("There was an error building the list of resources: '%v'", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 			r.OCMClient.LogEvent("ROSACreateOperatorRolesModeManual", map[string]string{
 				ocm.ClusterID: clusterKey,
 				ocm.Response:  ocm.Failure,
@@ -163,16 +165,20 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 		}
 		if r.Reporter.IsTerminal() {
 			r.Reporter.Infof("All policy files saved to the current directory")
-			r.Reporter.Infof("Run the following commands to create the operator roles:\n")
+			r.Reporter.Infof("%s:\n", format.Instructions("to create the operator roles"))
 		}
 		r.OCMClient.LogEvent("ROSACreateOperatorRolesModeManual", map[string]string{
 			ocm.ClusterID: clusterKey,
 		})
-		fmt.Println(commands)
+		err = iac.Print(r.Reporter, format, template)
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(clierror.ExitCode(err))
+		}
 
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are '%s'", interactive.Modes)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 	return nil
 }
@@ -187,7 +193,7 @@ func createRoles(r *rosa.Runtime, createInput operatorRolesInput) error {
 			isSupported, err := ocm.CheckSupportedVersion(ocm.GetVersionMinor(ver.ID()), operator.MinVersion())
 			if err != nil {
 				r.Reporter.Errorf("Error validating operator role '%s' version %s", operator.Name(), err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			if !isSupported {
 				continue
@@ -315,7 +321,8 @@ func createRoles(r *rosa.Runtime, createInput operatorRolesInput) error {
 func buildCommands(r *rosa.Runtime, env string,
 	prefix string, permissionsBoundary string, defaultPolicyVersion string, cluster *cmv1.Cluster,
 	policies map[string]*cmv1.AWSSTSPolicy, credRequests map[string]*cmv1.STSOperator,
-	managedPolicies bool, hostedCPPolicies bool, route53RoleArn string, vpcEndpointRoleArn string) (string, error) {
+	managedPolicies bool, hostedCPPolicies bool, route53RoleArn string,
+	vpcEndpointRoleArn string) (*iac.Template, error) {
 	sharedVpcRoleArn := cluster.AWS().PrivateHostedZoneRoleARN()
 	isSharedVpc := sharedVpcRoleArn != ""
 	var policyDetails = make(map[string]roles.ManualSharedVpcPolicyDetails)
@@ -324,11 +331,11 @@ func buildCommands(r *rosa.Runtime, env string,
 		err := aws.GenerateOperatorRolePolicyFiles(r.Reporter, policies, credRequests, sharedVpcRoleArn, r.Creator.Partition)
 		if err != nil {
 			r.Reporter.Errorf("There was an error generating the policy files: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
-	commands := []string{}
+	template := &iac.Template{}
 
 	for credrequest, operator := range credRequests {
 		ver := cluster.Version()
@@ -336,7 +343,7 @@ func buildCommands(r *rosa.Runtime, env string,
 			isSupported, err := ocm.CheckSupportedVersion(ocm.GetVersionMinor(ver.ID()), operator.MinVersion())
 			if err != nil {
 				r.Reporter.Errorf("Error validating operator role '%s' version %s", operator.Name(), err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			if !isSupported {
 				continue
@@ -345,7 +352,7 @@ func buildCommands(r *rosa.Runtime, env string,
 		roleName, _ := aws.FindOperatorRoleNameBySTSOperator(cluster, operator)
 		path, err := aws.GetPathFromAccountRole(cluster, aws.AccountRoles[aws.InstallerAccountRole].Name)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
 		var policyARN string
@@ -353,7 +360,7 @@ func buildCommands(r *rosa.Runtime, env string,
 			policyARN, err = aws.GetManagedPolicyARN(policies, aws.GetOperatorPolicyKey(
 				credrequest, hostedCPPolicies, isSharedVpc))
 			if err != nil {
-				return "", err
+				return nil, err
 			}
 		} else {
 			policyARN = computePolicyARN(*r.Creator, prefix, operator.Namespace(), operator.Name(), path)
@@ -366,30 +373,23 @@ func buildCommands(r *rosa.Runtime, env string,
 				tags.RedHatManaged:      helper.True,
 			}
 			operatorPolicyKey := aws.GetOperatorPolicyKey(credrequest, hostedCPPolicies, isSharedVpc)
-			fileName := fmt.Sprintf("file://%s.json", operatorPolicyKey)
+			fileName := fmt.Sprintf("%s.json", operatorPolicyKey)
 			_, err = r.AWSClient.IsPolicyExists(policyARN)
 			if err != nil {
-				createPolicy := awscb.NewIAMCommandBuilder().
-					SetCommand(awscb.CreatePolicy).
-					AddParam(awscb.PolicyName, name).
-					AddParam(awscb.PolicyDocument, fileName).
-					AddTags(iamTags).
-					AddParam(awscb.Path, path).
-					Build()
-				commands = append(commands, createPolicy)
+				template.AddPolicy(iac.CreatePolicyCommand(name, fileName, iamTags, path))
 			} else if isSharedVpc && credrequest == aws.IngressOperatorCloudCredentialsRoleType {
 				err := validateIngressOperatorPolicyOverride(r, policyARN, sharedVpcRoleArn, prefix)
 				if err != nil {
-					return "", err
+					return nil, err
 				}
 
 				createPolicyVersion := awscb.NewIAMCommandBuilder().
 					SetCommand(awscb.CreatePolicyVersion).
 					AddParam(awscb.PolicyArn, policyARN).
-					AddParam(awscb.PolicyDocument, fileName).
+					AddParam(awscb.PolicyDocument, fmt.Sprintf("file://%s", fileName)).
 					AddParamNoValue(awscb.SetAsDefault).
 					Build()
-				commands = append(commands, createPolicyVersion)
+				template.AddUnsupported(createPolicyVersion)
 			}
 		}
 
@@ -397,7 +397,7 @@ func buildCommands(r *rosa.Runtime, env string,
 		policy, err := aws.GenerateOperatorRolePolicyDoc(r.Creator.Partition, cluster,
 			r.Creator.AccountID, operator, policyDetail)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
 		filename := fmt.Sprintf("operator_%s_policy", credrequest)
@@ -405,7 +405,7 @@ func buildCommands(r *rosa.Runtime, env string,
 		r.Reporter.Debugf("Saving '%s' to the current directory", filename)
 		err = helper.SaveDocument(policy, filename)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		iamTags := map[string]string{
 			tags.OperatorNamespace: operator.Namespace(),
@@ -421,42 +421,29 @@ func buildCommands(r *rosa.Runtime, env string,
 		if hostedCPPolicies {
 			iamTags[tags.HypershiftPolicies] = helper.True
 		}
-		createRole := awscb.NewIAMCommandBuilder().
-			SetCommand(awscb.CreateRole).
-			AddParam(awscb.RoleName, roleName).
-			AddParam(awscb.AssumeRolePolicyDocument, fmt.Sprintf("file://%s", filename)).
-			AddParam(awscb.PermissionsBoundary, permissionsBoundary).
-			AddTags(iamTags).
-			AddParam(awscb.Path, path).
-			Build()
+		template.AddRole(iac.CreateRoleCommand(roleName, filename, permissionsBoundary, iamTags, path))
 
-		attachRolePolicy := awscb.NewIAMCommandBuilder().
-			SetCommand(awscb.AttachRolePolicy).
-			AddParam(awscb.RoleName, roleName).
-			AddParam(awscb.PolicyArn, policyARN).
-			Build()
-
-		var attachSharedVpcRolePolicy string
-		var policyCommands []string
+		template.AddAttachment(iac.AttachRolePolicyCommand(roleName, policyARN))
 
 		if isSharedVpc { // HCP Shared VPC policy attachment
 
 			// Precreate HCP shared VPC policies for less memory usage + time to execute
 			// Shared VPC role arn (route53)
 			if _, ok := policyDetails[aws.IngressOperatorCloudCredentialsRoleType]; !ok {
-				exists, createPolicyCommand, policyName, err := roles.GetHcpSharedVpcPolicyDetails(r, sharedVpcRoleArn)
+				exists, createPolicyCommand, policy, err := roles.GetHcpSharedVpcPolicyDetails(r, sharedVpcRoleArn)
 				if err != nil {
-					return "", err
+					return nil, err
 				}
 
 				sharedVpcRolePath, err := aws.GetPathFromARN(sharedVpcRoleArn)
 				if err != nil {
-					return "", err
+					return nil, err
 				}
 
 				policyDetails[aws.IngressOperatorCloudCredentialsRoleType] = roles.ManualSharedVpcPolicyDetails{
 					Command:       createPolicyCommand,
-					Name:          policyName,
+					Policy:        policy,
+					Name:          policy.Name,
 					AlreadyExists: exists,
 					Path:          sharedVpcRolePath,
 				}
@@ -464,19 +451,20 @@ func buildCommands(r *rosa.Runtime, env string,
 			// VPC endpoint role arn
 			if _, ok := policyDetails[aws.ControlPlaneCloudCredentialsRoleType]; !ok {
 
-				exists, createPolicyCommand, policyName, err := roles.GetHcpSharedVpcPolicyDetails(r, vpcEndpointRoleArn)
+				exists, createPolicyCommand, policy, err := roles.GetHcpSharedVpcPolicyDetails(r, vpcEndpointRoleArn)
 				if err != nil {
-					return "", err
+					return nil, err
 				}
 
 				vpcEndpointRolePath, err := aws.GetPathFromARN(vpcEndpointRoleArn)
 				if err != nil {
-					return "", err
+					return nil, err
 				}
 
 				policyDetails[aws.ControlPlaneCloudCredentialsRoleType] = roles.ManualSharedVpcPolicyDetails{
 					Command:       createPolicyCommand,
-					Name:          policyName,
+					Policy:        policy,
+					Name:          policy.Name,
 					AlreadyExists: exists,
 					Path:          vpcEndpointRolePath,
 				}
@@ -490,7 +478,7 @@ func buildCommands(r *rosa.Runtime, env string,
 				if details, ok := policyDetails[credrequest]; ok {
 					policies = append(policies, policyDetails[credrequest].Name)
 					if !policyDetails[credrequest].AlreadyExists { // Skip creation if already exists
-						policyCommands = append(policyCommands, policyDetails[credrequest].Command)
+						template.AddPolicy(policyDetails[credrequest].Command, policyDetails[credrequest].Policy)
 						// Allow only one creation command for this policy to be printed
 						details.AlreadyExists = true
 						policyDetails[credrequest] = details
@@ -500,7 +488,7 @@ func buildCommands(r *rosa.Runtime, env string,
 				for i, details := range policyDetails {
 					policies = append(policies, details.Name)
 					if !details.AlreadyExists {
-						policyCommands = append(policyCommands, details.Command)
+						template.AddPolicy(details.Command, details.Policy)
 						// Allow only one creation command for this policy to be printed
 						details.AlreadyExists = true
 						policyDetails[i] = details
@@ -512,23 +500,15 @@ func buildCommands(r *rosa.Runtime, env string,
 			for _, policy := range policies {
 				details, err := roles.GetPolicyDetailsByName(policyDetails, policy)
 				if err != nil {
-					return "", err
+					return nil, err
 				}
 				arn := aws.GetPolicyArn(r.Creator.Partition, r.Creator.AccountID, policy, details.Path)
 
-				attachSharedVpcRolePolicy = awscb.NewIAMCommandBuilder().
-					SetCommand(awscb.AttachRolePolicy).
-					AddParam(awscb.RoleName, roleName).
-					AddParam(awscb.PolicyArn, arn).
-					Build()
-				policyCommands = append(policyCommands, attachSharedVpcRolePolicy)
+				template.AddAttachment(iac.AttachRolePolicyCommand(roleName, arn))
 			}
 		}
-		commands = append(commands, createRole, attachRolePolicy)
-		commands = append(commands, policyCommands...)
-
 	}
-	return awscb.JoinCommands(commands), nil
+	return template, nil
 }
 
 func validateOperatorRoles(r *rosa.Runtime, cluster *cmv1.Cluster) ([]string, error) {
@@ -12,7 +12,9 @@ import (
 
 	"github.com/openshift/rosa/pkg/aws"
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
+	"github.com/openshift/rosa/pkg/aws/iac"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/helper"
 	urlHelper "github.com/openshift/rosa/pkg/helper/url"
 	"github.com/openshift/rosa/pkg/interactive"
@@ -38,7 +40,7 @@ func handleOperatorRolesPrefixOptions(r *rosa.Runtime, cmd *cobra.Command) {
 	})
 	if err != nil {
 		r.Reporter.Errorf("Expected a prefix for the operator IAM roles: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	args.prefix = operatorRolesPrefix
 
@@ -55,7 +57,7 @@ func handleOperatorRolesPrefixOptions(r *rosa.Runtime, cmd *cobra.Command) {
 	})
 	if err != nil {
 		_ = r.Reporter.Errorf("Expected a valid --hosted-cp value: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	args.hostedCp = isHostedCP
@@ -85,18 +87,18 @@ func CreateOperatorRoles(
 	args.vpcEndpointRoleArn = vpcEndpointRoleArn
 
 	return HandleOperatorRoleCreationByPrefix(
-		r, env, permissionsBoundary, mode, policies, defaultPolicyVersion, isSharedVpc,
+		r, env, permissionsBoundary, mode, iac.FormatShell, policies, defaultPolicyVersion, isSharedVpc,
 	)
 }
 
 func HandleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
-	permissionsBoundary string, mode string,
+	permissionsBoundary string, mode string, format iac.Format,
 	policies map[string]*cmv1.AWSSTSPolicy,
 	defaultPolicyVersion string, isSharedVpc bool) error {
 	oidcConfig, err := r.OCMClient.GetOidcConfig(args.oidcConfigId)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem retrieving OIDC Config '%s': %v", args.oidcConfigId, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	includeHostedCpSet := args.hostedCp
 	operatorRolesPrefix := args.prefix
@@ -110,12 +112,12 @@ func HandleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 	installerRoleName, err := aws.GetResourceIdFromARN(installerRoleArn)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	path, err := aws.GetPathFromARN(installerRoleArn)
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid path for '%s': %v", installerRoleArn, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if path != "" && !output.HasFlag() && r.Reporter.IsTerminal() {
 		r.Reporter.Infof("ARN path '%s' detected in installer role '%s'. "+
@@ -133,24 +135,24 @@ func HandleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 	credRequests, err := r.OCMClient.GetCredRequests(includeHostedCpSet)
 	if err != nil {
 		r.Reporter.Errorf("Error getting operator credential request from OCM %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	managedPolicies, err := r.AWSClient.HasManagedPolicies(installerRoleArn)
 	if err != nil {
 		r.Reporter.Errorf("Failed to determine if cluster has managed policies: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	awsCreator, err := r.AWSClient.GetCreator()
 	if err != nil {
 		r.Reporter.Errorf("Unable to get IAM credentials: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	operatorIAMRoleList, err := convertCredRequestsOperatorRolesIntoV1OperatorIAMRole(credRequests,
 		args.prefix, awsCreator, path)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	var hostedCPPolicies bool
@@ -158,7 +160,7 @@ func HandleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 		hostedCPPolicies, err = r.AWSClient.HasHostedCPPolicies(args.installerRoleArn)
 		if err != nil {
 			r.Reporter.Errorf("Failed to determine if the Installer role ARN has hosted CP policies: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		if !hostedCPPolicies {
@@ -172,13 +174,13 @@ func HandleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 	operatorRolesList, err := convertV1OperatorIAMRoleIntoOcmOperatorIamRole(operatorIAMRoleList)
 	if err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	err = ocm.ValidateOperatorRolesMatchOidcProvider(r.Reporter, r.AWSClient,
 		operatorRolesList, oidcConfig.IssuerUrl(), "4.0", path, managedPolicies, true)
 	if err != nil && !awserr.IsNoSuchEntityException(err) {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	switch mode {
@@ -226,7 +228,7 @@ func HandleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 			ocm.Response:            ocm.Success,
 		})
 	case interactive.ModeManual:
-		commands, err := buildCommandsFromPrefix(r, env,
+		template, err := buildCommandsFromPrefix(r, env,
 			operatorRolePolicyPrefix, permissionsBoundary,
 			defaultPolicyVersion, policies,
 			credRequests, managedPolicies,
@@ -234,7 +236,7 @@ func HandleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 			oidcEndpointUrl, hostedCPPolicies, sharedVpcRoleArn, sharedVpcEndpointRoleArn)
 		if err != nil {
 			r.Reporter.Errorf("There was an error building the list of resources: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 			r.OCMClient.LogEvent("ROSACreateOperatorRolesModeManual", map[string]string{
 				ocm.OperatorRolesPrefix: operatorRolesPrefix,
 				ocm.Response:            ocm.Failure,
@@ -242,15 +244,19 @@ func HandleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 		}
 		if r.Reporter.IsTerminal() {
 			r.Reporter.Infof("All policy files saved to the current directory")
-			r.Reporter.Infof("Run the following commands to create the operator roles:\n")
+			r.Reporter.Infof("%s:\n", format.Instructions("to create the operator roles"))
 		}
 		r.OCMClient.LogEvent("ROSACreateOperatorRolesModeManual", map[string]string{
 			ocm.OperatorRolesPrefix: operatorRolesPrefix,
 		})
-		fmt.Println(commands)
+		err = iac.Print(r.Reporter, format, template)
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(clierror.ExitCode(err))
+		}
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 	return nil
 }
@@ -277,29 +283,29 @@ func validateArgumentsOperatorRolesCreationByPrefix(r *rosa.Runtime, operatorRol
 	oidcEndpointUrl string, installerRoleArn string) {
 	if len(operatorRolesPrefix) == 0 {
 		r.Reporter.Errorf("Expected a prefix for the operator IAM roles")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 	if len(operatorRolesPrefix) > 32 {
 		r.Reporter.Errorf("Expected a prefix with no more than 32 characters")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 	if !aws.RoleNameRE.MatchString(operatorRolesPrefix) {
 		r.Reporter.Errorf("Expected valid operator roles prefix matching %s", aws.RoleNameRE.String())
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 	parsedURI, err := urlHelper.ParseRequestURI(oidcEndpointUrl)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if parsedURI.Scheme != helper.ProtocolHttps {
 		r.Reporter.Errorf("Expected OIDC endpoint URL '%s' to use an https:// scheme", oidcEndpointUrl)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 	err = aws.ARNValidator(installerRoleArn)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 }
 
@@ -434,25 +440,26 @@ func buildCommandsFromPrefix(r *rosa.Runtime, env string,
 	policies map[string]*cmv1.AWSSTSPolicy, credRequests map[string]*cmv1.STSOperator,
 	managedPolicies bool, path string,
 	operatorIAMRoleList []*cmv1.OperatorIAMRole,
-	oidcEndpointUrl string, hostedCPPolicies bool, sharedVpcRoleArn string, vpcEndpointRoleArn string) (string, error) {
+	oidcEndpointUrl string, hostedCPPolicies bool, sharedVpcRoleArn string,
+	vpcEndpointRoleArn string) (*iac.Template, error) {
 	if !managedPolicies {
 		err := aws.GenerateOperatorRolePolicyFiles(r.Reporter, policies, credRequests, sharedVpcRoleArn, r.Creator.Partition)
 		if err != nil {
 			r.Reporter.Errorf("There was an error generating the policy files: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
 	isSharedVpc := sharedVpcRoleArn != ""
 	var policyDetails = make(map[string]roles.ManualSharedVpcPolicyDetails)
 
-	commands := []string{}
+	template := &iac.Template{}
 
 	for credrequest, operator := range credRequests {
 		roleArn := aws.FindOperatorRoleBySTSOperator(operatorIAMRoleList, operator)
 		roleName, err := aws.GetResourceIdFromARN(roleArn)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
 		var policyARN string
@@ -460,7 +467,7 @@ func buildCommandsFromPrefix(r *rosa.Runtime, env string,
 			policyARN, err = aws.GetManagedPolicyARN(policies, aws.GetOperatorPolicyKey(
 				credrequest, hostedCPPolicies, false))
 			if err != nil {
-				return "", err
+				return nil, err
 			}
 		} else {
 			policyARN = computePolicyARN(*r.Creator, prefix, operator.Namespace(), operator.Name(), path)
@@ -473,30 +480,23 @@ func buildCommandsFromPrefix(r *rosa.Runtime, env string,
 				tags.RedHatManaged:      helper.True,
 			}
 			operatorPolicyKey := aws.GetOperatorPolicyKey(credrequest, hostedCPPolicies, isSharedVpc)
-			fileName := fmt.Sprintf("file://%s.json", operatorPolicyKey)
+			fileName := fmt.Sprintf("%s.json", operatorPolicyKey)
 			_, err = r.AWSClient.IsPolicyExists(policyARN)
 			if err != nil {
-				createPolicy := awscb.NewIAMCommandBuilder().
-					SetCommand(awscb.CreatePolicy).
-					AddParam(awscb.PolicyName, name).
-					AddParam(awscb.PolicyDocument, fileName).
-					AddTags(iamTags).
-					AddParam(awscb.Path, path).
-					Build()
-				commands = append(commands, createPolicy)
+				template.AddPolicy(iac.CreatePolicyCommand(name, fileName, iamTags, path))
 			} else if isSharedVpc && credrequest == aws.IngressOperatorCloudCredentialsRoleType {
 				err := validateIngressOperatorPolicyOverride(r, policyARN, sharedVpcRoleArn, prefix)
 				if err != nil {
-					return "", err
+					return nil, err
 				}
 
 				createPolicyVersion := awscb.NewIAMCommandBuilder().
 					SetCommand(awscb.CreatePolicyVersion).
 					AddParam(awscb.PolicyArn, policyARN).
-					AddParam(awscb.PolicyDocument, fileName).
+					AddParam(awscb.PolicyDocument, fmt.Sprintf("file://%s", fileName)).
 					AddParamNoValue(awscb.SetAsDefault).
 					Build()
-				commands = append(commands, createPolicyVersion)
+				template.AddUnsupported(createPolicyVersion)
 			}
 		}
 
@@ -504,7 +504,7 @@ func buildCommandsFromPrefix(r *rosa.Runtime, env string,
 		policy, err := aws.GenerateOperatorRolePolicyDocByOidcEndpointUrl(r.Creator.Partition, oidcEndpointUrl,
 			r.Creator.AccountID, operator, policyDetail)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
 		filename := fmt.Sprintf("operator_%s_policy", credrequest)
@@ -512,7 +512,7 @@ func buildCommandsFromPrefix(r *rosa.Runtime, env string,
 		r.Reporter.Debugf("Saving '%s' to the current directory", filename)
 		err = helper.SaveDocument(policy, filename)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		iamTags := map[string]string{
 			tags.OperatorNamespace: operator.Namespace(),
@@ -525,42 +525,29 @@ func buildCommandsFromPrefix(r *rosa.Runtime, env string,
 		if hostedCPPolicies {
 			iamTags[tags.HypershiftPolicies] = helper.True
 		}
-		createRole := awscb.NewIAMCommandBuilder().
-			SetCommand(awscb.CreateRole).
-			AddParam(awscb.RoleName, roleName).
-			AddParam(awscb.AssumeRolePolicyDocument, fmt.Sprintf("file://%s", filename)).
-			AddParam(awscb.PermissionsBoundary, permissionsBoundary).
-			AddTags(iamTags).
-			AddParam(awscb.Path, path).
-			Build()
+		template.AddRole(iac.CreateRoleCommand(roleName, filename, permissionsBoundary, iamTags, path))
 
-		attachRolePolicy := awscb.NewIAMCommandBuilder().
-			SetCommand(awscb.AttachRolePolicy).
-			AddParam(awscb.RoleName, roleName).
-			AddParam(awscb.PolicyArn, policyARN).
-			Build()
-
-		var attachSharedVpcRolePolicy string
-		var policyCommands []string
+		template.AddAttachment(iac.AttachRolePolicyCommand(roleName, policyARN))
 
 		if isSharedVpc { // HCP Shared VPC policy attachment
 
 			// Precreate HCP shared VPC policies for less memory usage + time to execute
 			// Shared VPC role arn (route53)
 			if _, ok := policyDetails[aws.IngressOperatorCloudCredentialsRoleType]; !ok {
-				exists, createPolicyCommand, policyName, err := roles.GetHcpSharedVpcPolicyDetails(r, sharedVpcRoleArn)
+				exists, createPolicyCommand, policy, err := roles.GetHcpSharedVpcPolicyDetails(r, sharedVpcRoleArn)
 				if err != nil {
-					return "", err
+					return nil, err
 				}
 
 				sharedVpcRolePath, err := aws.GetPathFromARN(sharedVpcRoleArn)
 				if err != nil {
-					return "", err
+					return nil, err
 				}
 
 				policyDetails[aws.IngressOperatorCloudCredentialsRoleType] = roles.ManualSharedVpcPolicyDetails{
 					Command:       createPolicyCommand,
-					Name:          policyName,
+					Policy:        policy,
+					Name:          policy.Name,
 					AlreadyExists: exists,
 					Path:          sharedVpcRolePath,
 				}
@@ -568,19 +555,20 @@ func buildCommandsFromPrefix(r *rosa.Runtime, env string,
 			// VPC endpoint role arn
 			if _, ok := policyDetails[aws.ControlPlaneCloudCredentialsRoleType]; !ok {
 
-				exists, createPolicyCommand, policyName, err := roles.GetHcpSharedVpcPolicyDetails(r, vpcEndpointRoleArn)
+				exists, createPolicyCommand, policy, err := roles.GetHcpSharedVpcPolicyDetails(r, vpcEndpointRoleArn)
 				if err != nil {
-					return "", err
+					return nil, err
 				}
 
 				vpcEndpointRolePath, err := aws.GetPathFromARN(vpcEndpointRoleArn)
 				if err != nil {
-					return "", err
+					return nil, err
 				}
 
 				policyDetails[aws.ControlPlaneCloudCredentialsRoleType] = roles.ManualSharedVpcPolicyDetails{
 					Command:       createPolicyCommand,
-					Name:          policyName,
+					Policy:        policy,
+					Name:          policy.Name,
 					AlreadyExists: exists,
 					Path:          vpcEndpointRolePath,
 				}
@@ -594,7 +582,7 @@ func buildCommandsFromPrefix(r *rosa.Runtime, env string,
 				if details, ok := policyDetails[credrequest]; ok {
 					policies = append(policies, policyDetails[credrequest].Name)
 					if !policyDetails[credrequest].AlreadyExists { // Skip creation if already exists
-						policyCommands = append(policyCommands, policyDetails[credrequest].Command)
+						template.AddPolicy(policyDetails[credrequest].Command, policyDetails[credrequest].Policy)
 						// Allow only one creation command for this policy to be printed
 						details.AlreadyExists = true
 						policyDetails[credrequest] = details
@@ -604,7 +592,7 @@ func buildCommandsFromPrefix(r *rosa.Runtime, env string,
 				for i, details := range policyDetails {
 					policies = append(policies, details.Name)
 					if !details.AlreadyExists {
-						policyCommands = append(policyCommands, details.Command)
+						template.AddPolicy(details.Command, details.Policy)
 						// Allow only one creation command for this policy to be printed
 						details.AlreadyExists = true
 						policyDetails[i] = details
@@ -616,21 +604,13 @@ func buildCommandsFromPrefix(r *rosa.Runtime, env string,
 			for _, policy := range policies {
 				details, err := roles.GetPolicyDetailsByName(policyDetails, policy)
 				if err != nil {
-					return "", err
+					return nil, err
 				}
 				arn := aws.GetPolicyArn(r.Creator.Partition, r.Creator.AccountID, policy, details.Path)
 
-				attachSharedVpcRolePolicy = awscb.NewIAMCommandBuilder().
-					SetCommand(awscb.AttachRolePolicy).
-					AddParam(awscb.RoleName, roleName).
-					AddParam(awscb.PolicyArn, arn).
-					Build()
-				policyCommands = append(policyCommands, attachSharedVpcRolePolicy)
+				template.AddAttachment(iac.AttachRolePolicyCommand(roleName, arn))
 			}
 		}
-		commands = append(commands, createRole, attachRolePolicy)
-		commands = append(commands, policyCommands...)
-
 	}
-	return awscb.JoinCommands(commands), nil
+	return template, nil
 }
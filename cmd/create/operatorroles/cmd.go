@@ -25,6 +25,8 @@ import (
 
 	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/aws/iac"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
@@ -51,6 +53,7 @@ var args struct {
 	sharedVpcRoleArn    string
 	channelGroup        string
 	vpcEndpointRoleArn  string
+	outputFormat        string
 }
 
 var Cmd = &cobra.Command{
@@ -156,6 +159,7 @@ func init() {
 		"'--%s' in future versions of ROSA.", hostedZoneRoleArnFlag))
 
 	interactive.AddModeFlag(Cmd)
+	iac.AddOutputFormatFlag(flags, &args.outputFormat)
 	confirm.AddFlag(flags)
 	interactive.AddFlag(flags)
 }
@@ -188,7 +192,7 @@ func run(cmd *cobra.Command, argv []string) {
 			vpcEndpointRoleArnFlag, hostedZoneRoleArnFlag)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -196,27 +200,33 @@ func run(cmd *cobra.Command, argv []string) {
 		err = aws.ARNValidator(args.vpcEndpointRoleArn)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid policy ARN for %s: %s", vpcEndpointRoleArnFlag, err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	if args.sharedVpcRoleArn != "" {
 		err = aws.ARNValidator(args.sharedVpcRoleArn)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid policy ARN for %s: %s", hostedZoneRoleArnFlag, err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
 	env, err := ocm.GetEnv()
 	if err != nil {
 		r.Reporter.Errorf("Failed to determine OCM environment: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
+	}
+
+	format, err := iac.GetFormat(cmd, args.outputFormat)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	// Determine if interactive mode is needed
@@ -226,30 +236,30 @@ func run(cmd *cobra.Command, argv []string) {
 
 	if !cmd.Flag("cluster").Changed && !cmd.Flag(PrefixFlag).Changed && !isProgmaticallyCalled {
 		r.Reporter.Errorf("Either a cluster key for STS cluster or an operator roles prefix must be specified.")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if cmd.Flag("cluster").Changed && cmd.Flag(PrefixFlag).Changed {
 		r.Reporter.Errorf("A cluster key for STS cluster and an operator roles prefix " +
 			"cannot be specified alongside each other.")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if cmd.Flag("cluster").Changed && cmd.Flag(OidcConfigIdFlag).Changed {
 		r.Reporter.Errorf("A cluster key for STS cluster and an OIDC configuration ID " +
 			"cannot be specified alongside each other.")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if !args.hostedCp && args.installerRoleArn != "" {
 		managedPolicies, err := r.AWSClient.HasManagedPolicies(args.installerRoleArn)
 		if err != nil {
 			r.Reporter.Errorf("Failed to determine if cluster has managed policies: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		if managedPolicies {
 			r.Reporter.Errorf("The managed policies are not supported for classic operator-roles.")
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 	}
 
@@ -260,14 +270,14 @@ func run(cmd *cobra.Command, argv []string) {
 
 	if args.forcePolicyCreation && mode != interactive.ModeAuto {
 		r.Reporter.Warnf("Forcing creation of policies only works in auto mode")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if interactive.Enabled() && !isProgmaticallyCalled {
 		mode, err = interactive.GetOptionMode(cmd, mode, "Role creation mode")
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -287,7 +297,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid policy ARN for permissions boundary: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -302,7 +312,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		if !isHcpSharedVpc {
@@ -323,7 +333,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	if interactive.Enabled() && isHcpSharedVpc && !r.Creator.IsGovcloud {
@@ -338,7 +348,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -346,50 +356,50 @@ func run(cmd *cobra.Command, argv []string) {
 		err = aws.ARNValidator(permissionsBoundary)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid policy ARN for permissions boundary: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
 	policies, err := r.OCMClient.GetPolicies("OperatorRole")
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if args.prefix != "" {
 		if args.oidcConfigId == "" {
 			r.Reporter.Errorf("%s is mandatory for %s param flow.", OidcConfigIdFlag, PrefixFlag)
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 
 		if args.installerRoleArn == "" {
 			r.Reporter.Errorf("%s is mandatory for %s param flow.", InstallerRoleArnFlag, PrefixFlag)
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		channelGroup := args.channelGroup
 		latestPolicyVersion, err := r.OCMClient.GetLatestVersion(channelGroup)
 		if err != nil {
 			r.Reporter.Errorf("Error getting latest version: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		err = HandleOperatorRoleCreationByPrefix(r, env, permissionsBoundary,
-			mode, policies, latestPolicyVersion, isHcpSharedVpc)
+			mode, format, policies, latestPolicyVersion, isHcpSharedVpc)
 		if err != nil {
 			r.Reporter.Errorf("Error creating operator roles: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		return
 	}
 	latestPolicyVersion, err := r.OCMClient.GetLatestVersion(cluster.Version().ChannelGroup())
 	if err != nil {
 		r.Reporter.Errorf("Error getting latest version: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	err = handleOperatorRoleCreationByClusterKey(r, env, permissionsBoundary,
-		mode, policies, latestPolicyVersion, isHcpSharedVpc)
+		mode, format, policies, latestPolicyVersion, isHcpSharedVpc)
 	if err != nil {
 		r.Reporter.Errorf("Error creating operator roles: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 }
 
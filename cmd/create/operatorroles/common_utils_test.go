@@ -8,6 +8,9 @@ import (
 	errors "github.com/zgalor/weberr"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/aws/iac"
+	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -58,3 +61,46 @@ var _ = Describe("Create dns domain", func() {
 		})
 	})
 })
+
+var _ = Describe("Manual commands", func() {
+	It("Describe the resources created by the commands", func() {
+		iamTags := map[string]string{
+			tags.OperatorNamespace: "openshift-ingress-operator",
+			tags.OperatorName:      "cloud-credentials",
+			tags.RedHatManaged:     helper.True,
+		}
+		policyARN := "arn:aws:iam::123456789012:policy/prefix/mycluster-openshift-ingress-operator-cloud-crede"
+		template := &iac.Template{}
+		template.AddPolicy(iac.CreatePolicyCommand("mycluster-openshift-ingress-operator-cloud-crede",
+			"openshift_ingress_operator_cloud_credentials_policy.json", iamTags, "/prefix/"))
+		template.AddRole(iac.CreateRoleCommand("mycluster-openshift-ingress-operator-cloud-credentials",
+			"operator_ingress_operator_cloud_credentials_policy.json",
+			"arn:aws:iam::123456789012:policy/boundary", iamTags, "/prefix/"))
+		template.AddAttachment(iac.AttachRolePolicyCommand("mycluster-openshift-ingress-operator-cloud-credentials",
+			policyARN))
+		Expect(template.Unsupported).To(BeEmpty())
+		Expect(template.Commands()).To(ContainSubstring(
+			"--policy-document file://openshift_ingress_operator_cloud_credentials_policy.json"))
+		Expect(template.Commands()).To(ContainSubstring(
+			"--assume-role-policy-document file://operator_ingress_operator_cloud_credentials_policy.json"))
+		Expect(template.Policies).To(HaveLen(1))
+		Expect(*template.Policies[0]).To(Equal(iac.Policy{
+			Name:     "mycluster-openshift-ingress-operator-cloud-crede",
+			Path:     "/prefix/",
+			Document: "openshift_ingress_operator_cloud_credentials_policy.json",
+			Tags:     iamTags,
+		}))
+		Expect(template.Roles).To(HaveLen(1))
+		Expect(*template.Roles[0]).To(Equal(iac.Role{
+			Name:                "mycluster-openshift-ingress-operator-cloud-credentials",
+			Path:                "/prefix/",
+			AssumeRolePolicy:    "operator_ingress_operator_cloud_credentials_policy.json",
+			PermissionsBoundary: "arn:aws:iam::123456789012:policy/boundary",
+			Tags:                iamTags,
+		}))
+		Expect(template.Attachments).To(ConsistOf(&iac.Attachment{
+			Role:      "mycluster-openshift-ingress-operator-cloud-credentials",
+			PolicyARN: policyARN,
+		}))
+	})
+})
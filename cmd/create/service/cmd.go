@@ -31,6 +31,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/helper/roles"
 	"github.com/openshift/rosa/pkg/info"
 	"github.com/openshift/rosa/pkg/ocm"
@@ -151,13 +152,13 @@ func run(cmd *cobra.Command, argv []string) {
 	if args.ServiceType == "" {
 		r.Reporter.Errorf("Service type not specified.")
 		cmd.Help()
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if args.ClusterName == "" {
 		r.Reporter.Errorf("Cluster name not specified.")
 		cmd.Help()
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	// Get AWS region
@@ -165,7 +166,7 @@ func run(cmd *cobra.Command, argv []string) {
 	args.AwsRegion, err = aws.GetRegion(arguments.GetRegion())
 	if err != nil {
 		r.Reporter.Errorf("Error getting region: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	r.Reporter.Debugf("Using AWS region: %q", args.AwsRegion)
 
@@ -183,7 +184,7 @@ func run(cmd *cobra.Command, argv []string) {
 	version, err := r.OCMClient.ManagedServiceVersionInquiry(args.ServiceType)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	versionMajorMinor := ocm.GetVersionMinor(version)
 
@@ -191,7 +192,7 @@ func run(cmd *cobra.Command, argv []string) {
 	addOn, err := r.OCMClient.GetAddOn(args.ServiceType)
 	if err != nil {
 		r.Reporter.Errorf("Failed to get add-on %q: %s", args.ServiceType, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	parameters := addOn.Parameters()
 
@@ -204,7 +205,7 @@ func run(cmd *cobra.Command, argv []string) {
 			flag := cmd.Flags().Lookup(param.ID())
 			if param.Required() && (flag == nil || flag.Value.String() == "") {
 				r.Reporter.Errorf("Required parameter --%s missing", param.ID())
-				os.Exit(1)
+				os.Exit(clierror.Validation.ExitCode())
 			}
 			if flag != nil {
 
@@ -250,7 +251,7 @@ func run(cmd *cobra.Command, argv []string) {
 		}
 		r.Reporter.Errorf("Cannot create managed service with the following unknown flags: (%s)",
 			flagList)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	// BYO-VPC Logic
@@ -263,7 +264,7 @@ func run(cmd *cobra.Command, argv []string) {
 		subnets, err := r.AWSClient.ListSubnets()
 		if err != nil {
 			r.Reporter.Errorf("Failed to get the list of subnets: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		mapSubnetToAZ := make(map[string]string)
@@ -318,7 +319,7 @@ func run(cmd *cobra.Command, argv []string) {
 	roleARNs, err := r.AWSClient.FindRoleARNs(aws.InstallerAccountRole, versionMajorMinor)
 	if err != nil {
 		r.Reporter.Errorf("Failed to find %s role: %s", role.Name, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if len(roleARNs) > 1 {
@@ -347,7 +348,7 @@ func run(cmd *cobra.Command, argv []string) {
 		rolePrefix, err := getAccountRolePrefix(roleARN, role)
 		if err != nil {
 			r.Reporter.Errorf("Failed to find prefix from %q account role", role.Name)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		r.Reporter.Debugf("Using %q as the role prefix", rolePrefix)
 
@@ -359,7 +360,7 @@ func run(cmd *cobra.Command, argv []string) {
 			roleARNs, err := r.AWSClient.FindRoleARNs(roleType, versionMajorMinor)
 			if err != nil {
 				r.Reporter.Errorf("Failed to find %s role: %s", role.Name, err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			selectedARN := ""
 			for _, rARN := range roleARNs {
@@ -397,7 +398,7 @@ func run(cmd *cobra.Command, argv []string) {
 	path, err := aws.GetPathFromARN(roleARN)
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid path for  '%s': %v", roleARN, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	// operator role logic.
@@ -408,7 +409,7 @@ func run(cmd *cobra.Command, argv []string) {
 	credRequests, err := r.OCMClient.GetCredRequests(false)
 	if err != nil {
 		r.Reporter.Errorf("Error getting operator credential request from OCM %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	for _, operator := range credRequests {
@@ -417,7 +418,7 @@ func run(cmd *cobra.Command, argv []string) {
 			isSupported, err := ocm.CheckSupportedVersion(ocm.GetVersionMinor(version), operator.MinVersion())
 			if err != nil {
 				r.Reporter.Errorf("Error validating operator role %q version %s", operator.Name(), err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			if !isSupported {
 				continue
@@ -436,12 +437,12 @@ func run(cmd *cobra.Command, argv []string) {
 		name, err := aws.GetResourceIdFromARN(role.RoleARN)
 		if err != nil {
 			r.Reporter.Errorf("Error validating role: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		err = r.AWSClient.ValidateRoleNameAvailable(name)
 		if err != nil {
 			r.Reporter.Errorf("Error validating role: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -452,7 +453,7 @@ func run(cmd *cobra.Command, argv []string) {
 	service, err := r.OCMClient.CreateManagedService(args)
 	if err != nil {
 		r.Reporter.Errorf("Failed to create managed service: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	r.Reporter.Infof("Service created!\n\n\tService ID: %s\n", service.ID())
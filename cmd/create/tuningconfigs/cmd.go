@@ -23,6 +23,7 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/input"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/ocm"
@@ -91,7 +92,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid name: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -109,20 +110,20 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid spec path: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
 	tuningConfig, err := buildTuningConfigFromInputFile(specPath, name, clusterKey)
 	if err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	_, err = r.OCMClient.CreateTuningConfig(cluster.ID(), tuningConfig)
 	if err != nil {
 		r.Reporter.Errorf("Failed to add tuning config to cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	r.Reporter.Infof("Tuning config '%s' has been created on cluster '%s'.", name, clusterKey)
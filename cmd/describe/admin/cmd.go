@@ -23,6 +23,7 @@ import (
 	"github.com/spf13/cobra"
 
 	cadmin "github.com/openshift/rosa/cmd/create/admin"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -57,7 +58,7 @@ func run(_ *cobra.Command, _ []string) {
 		r.Reporter.Errorf(
 			"Describing the 'cluster-admin' user is not supported for clusters with external authentication configured.",
 		)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	// Try to find an existing htpasswd identity provider and
@@ -65,7 +66,7 @@ func run(_ *cobra.Command, _ []string) {
 	existingClusterAdminIdp, _, err := cadmin.FindIDPWithAdmin(cluster, r)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if existingClusterAdminIdp != nil {
 		r.Reporter.Infof("There is '%s' user on cluster '%s'. To login, run the following command:\n"+
@@ -31,6 +31,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/helper/rolepolicybindings"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
@@ -126,19 +127,19 @@ func run(cmd *cobra.Command, argv []string) {
 		scheduledUpgrade, upgradeState, err = r.OCMClient.GetScheduledUpgrade(cluster.ID())
 		if err != nil {
 			r.Reporter.Errorf("Failed to get scheduled upgrades for cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		if output.HasFlag() {
 			f, err := formatCluster(cluster, scheduledUpgrade, upgradeState, displayName, migrations)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			err = output.Print(f)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			return
 		}
@@ -146,19 +147,19 @@ func run(cmd *cobra.Command, argv []string) {
 		controlPlaneScheduledUpgrade, err = r.OCMClient.GetControlPlaneScheduledUpgrade(cluster.ID())
 		if err != nil {
 			r.Reporter.Errorf("Failed to get scheduled upgrades for cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		if output.HasFlag() {
 			f, err := formatClusterHypershift(cluster, controlPlaneScheduledUpgrade, displayName, migrations)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			err = output.Print(f)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			return
 		}
@@ -168,7 +169,7 @@ func run(cmd *cobra.Command, argv []string) {
 	creatorARN, err := arn.Parse(cluster.Properties()[ocmConsts.CreatorArn])
 	if err != nil {
 		r.Reporter.Errorf("Failed to parse creator ARN for cluster '%s'", clusterKey)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	phase := ""
 
@@ -249,7 +250,7 @@ func run(cmd *cobra.Command, argv []string) {
 	}
 	if err != nil {
 		r.Reporter.Errorf("Failed to get machine pools for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	// Print short cluster description:
@@ -347,7 +348,7 @@ func run(cmd *cobra.Command, argv []string) {
 			rolePolicyBindings, err := r.OCMClient.ListRolePolicyBindings(cluster.ID(), true)
 			if err != nil {
 				r.Reporter.Errorf("Failed to get rolePolicyBinding: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			rolePolicyDetails = rolepolicybindings.TransformToRolePolicyDetails(rolePolicyBindings)
 		}
@@ -359,7 +360,7 @@ func run(cmd *cobra.Command, argv []string) {
 				"                            -")
 			if err != nil {
 				r.Reporter.Errorf(err.Error())
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			str = str + policyStr
 		}
@@ -377,7 +378,7 @@ func run(cmd *cobra.Command, argv []string) {
 					"                            -")
 				if err != nil {
 					r.Reporter.Errorf(err.Error())
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 				str = str + policyStr
 			}
@@ -395,7 +396,7 @@ func run(cmd *cobra.Command, argv []string) {
 						"                            -")
 					if err != nil {
 						r.Reporter.Errorf(err.Error())
-						os.Exit(1)
+						os.Exit(clierror.ExitCode(err))
 					}
 					str = str + policyStr
 				}
@@ -410,7 +411,7 @@ func run(cmd *cobra.Command, argv []string) {
 						"                            -")
 					if err != nil {
 						r.Reporter.Errorf(err.Error())
-						os.Exit(1)
+						os.Exit(clierror.ExitCode(err))
 					}
 					str = str + policyStr
 				}
@@ -428,7 +429,7 @@ func run(cmd *cobra.Command, argv []string) {
 						"   -")
 					if err != nil {
 						r.Reporter.Errorf(err.Error())
-						os.Exit(1)
+						os.Exit(clierror.ExitCode(err))
 					}
 					str = str + policyStr
 				}
@@ -584,7 +585,7 @@ func run(cmd *cobra.Command, argv []string) {
 				if err != nil {
 					r.Reporter.Errorf("Failed to get allowlist with id '%s': %v",
 						cluster.RegistryConfig().PlatformAllowlist().ID(), err)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 			}
 			registryConfigOutput := getClusterRegistryConfig(cluster, allowlist)
@@ -596,7 +597,7 @@ func run(cmd *cobra.Command, argv []string) {
 		zeroEgressStatus, err := getZeroEgressStatus(cluster)
 		if err != nil {
 			r.Reporter.Errorf("Failed to get zero egress info: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		if zeroEgressStatus != "" {
 			str = fmt.Sprintf("%s"+"%s\n", str, zeroEgressStatus)
@@ -616,7 +617,7 @@ func run(cmd *cobra.Command, argv []string) {
 	limitedSupportReasons, err := r.OCMClient.GetLimitedSupportReasons(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get limited support reasons for cluster '%s': %v", cluster.ID(), err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if len(limitedSupportReasons) > 0 {
 		str = fmt.Sprintf("%s"+"Limited Support:\n"+"%s", str, getLimitedSupportReasons(limitedSupportReasons))
@@ -625,7 +626,7 @@ func run(cmd *cobra.Command, argv []string) {
 	inflightChecks, err := r.OCMClient.GetInflightChecks(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get inflight checks for cluster '%s': %v", cluster.ID(), err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if len(inflightChecks) > 0 {
 		summaries := []string{}
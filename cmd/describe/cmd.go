@@ -27,12 +27,15 @@ import (
 	"github.com/openshift/rosa/cmd/describe/cluster"
 	"github.com/openshift/rosa/cmd/describe/externalauthprovider"
 	"github.com/openshift/rosa/cmd/describe/iamserviceaccount"
+	"github.com/openshift/rosa/cmd/describe/idp"
 	"github.com/openshift/rosa/cmd/describe/ingress"
 	"github.com/openshift/rosa/cmd/describe/installation"
 	"github.com/openshift/rosa/cmd/describe/kubeletconfig"
 	"github.com/openshift/rosa/cmd/describe/logforwarders"
 	"github.com/openshift/rosa/cmd/describe/machinepool"
+	"github.com/openshift/rosa/cmd/describe/network"
 	"github.com/openshift/rosa/cmd/describe/service"
+	"github.com/openshift/rosa/cmd/describe/servicelog"
 	"github.com/openshift/rosa/cmd/describe/tuningconfigs"
 	"github.com/openshift/rosa/cmd/describe/upgrade"
 	"github.com/openshift/rosa/pkg/arguments"
@@ -50,6 +53,8 @@ func init() {
 	ingressCommand := ingress.NewDescribeIngressCommand()
 	kubeletconfig := kubeletconfig.NewDescribeKubeletConfigCommand()
 	accessrequestCommand := accessrequest.NewDescribeAccessRequestCommand()
+	idpCommand := idp.NewDescribeIdpCommand()
+	serviceLogCommand := servicelog.NewDescribeServiceLogCommand()
 	cmds := []*cobra.Command{
 		addon.Cmd, admin.Cmd, cluster.Cmd, iamserviceaccount.Cmd, service.Cmd,
 		installation.Cmd, upgrade.Cmd, tuningconfigs.Cmd,
@@ -57,6 +62,7 @@ func init() {
 		autoscaler.NewDescribeAutoscalerCommand(), ingressCommand,
 		externalauthprovider.Cmd, breakglasscredential.Cmd,
 		accessrequestCommand, logforwarders.NewDescribeLogForwarderCommand(),
+		network.NewDescribeNetworkCommand(), idpCommand, serviceLogCommand,
 	}
 	for _, cmd := range cmds {
 		Cmd.AddCommand(cmd)
@@ -72,7 +78,7 @@ func init() {
 		admin.Cmd, breakglasscredential.Cmd,
 		externalauthprovider.Cmd, installation.Cmd,
 		kubeletconfig, upgrade.Cmd, ingressCommand,
-		accessrequestCommand,
+		accessrequestCommand, idpCommand, serviceLogCommand,
 	}
 	arguments.MarkRegionDeprecated(Cmd, globallyAvailableCommands)
 }
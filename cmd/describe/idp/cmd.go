@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package idp
+
+import (
+	"context"
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	pkgidp "github.com/openshift/rosa/pkg/idp"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "idp NAME"
+	short = "Show details of an identity provider of a cluster"
+	long  = "Show the settings of an identity provider of a cluster. Secrets like client secrets and bind " +
+		"passwords are never shown."
+	example = `  # Describe the identity provider named 'github-1' of cluster 'mycluster'
+  rosa describe idp github-1 --cluster=mycluster`
+)
+
+func NewDescribeIdpCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), DescribeIdpRunner()),
+		Args:    cobra.ExactArgs(1),
+	}
+
+	ocm.AddClusterFlag(cmd)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func DescribeIdpRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, argv []string) error {
+		clusterKey := r.GetClusterKey()
+		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+		if err != nil {
+			return err
+		}
+		if cluster.ExternalAuthConfig().Enabled() {
+			return fmt.Errorf("Describing identity providers is not supported for clusters " +
+				"with external authentication configured.")
+		}
+
+		r.Reporter.Debugf("Loading identity provider '%s' for cluster '%s'", argv[0], clusterKey)
+		idp, exists, err := r.OCMClient.FindIdentityProvider(cluster.ID(), argv[0])
+		if err != nil {
+			return fmt.Errorf("Failed to get identity providers for cluster '%s': %v", clusterKey, err)
+		}
+		if !exists {
+			return fmt.Errorf("Failed to get identity provider '%s' for cluster '%s'", argv[0], clusterKey)
+		}
+
+		if output.HasFlag() {
+			return output.Print(idp)
+		}
+
+		authURL, err := ocm.GetOAuthURL(cluster, idp)
+		if err != nil {
+			r.Reporter.Warnf("Error building OAuth URL for %s: %v", idp.Name(), err)
+		}
+		users := []string{}
+		if idp.Type() == cmv1.IdentityProviderTypeHtpasswd {
+			userList, err := r.OCMClient.GetHTPasswdUserList(cluster.ID(), idp.ID())
+			if err != nil {
+				return fmt.Errorf("Failed to get users of identity provider '%s': %v", idp.Name(), err)
+			}
+			userList.Each(func(user *cmv1.HTPasswdUser) bool {
+				users = append(users, user.Username())
+				return true
+			})
+		}
+		fmt.Print(pkgidp.DescribeIdentityProvider(idp, authURL, users))
+		return nil
+	}
+}
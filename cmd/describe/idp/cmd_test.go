@@ -0,0 +1,79 @@
+package idp
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("describe idp", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewDescribeIdpCommand()
+		Expect(cmd).NotTo(BeNil())
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Args).NotTo(BeNil())
+		Expect(cmd.Run).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("cluster")).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("output")).NotTo(BeNil())
+	})
+
+	Context("Describe Idp Runner", func() {
+		var t *TestingRuntime
+		var cluster *cmv1.Cluster
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			output.SetOutput("")
+			cluster = MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+			})
+			t.SetCluster("cluster", cluster)
+		})
+
+		AfterEach(func() {
+			output.SetOutput("")
+		})
+
+		It("Returns an error if the identity provider doesn't exist", func() {
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatIDPList([]*cmv1.IdentityProvider{})))
+
+			err := DescribeIdpRunner()(context.Background(), t.RosaRuntime, nil, []string{"github-1"})
+			Expect(err).To(MatchError("Failed to get identity provider 'github-1' for cluster 'cluster'"))
+		})
+
+		It("Describes an htpasswd identity provider with its users", func() {
+			idp, err := cmv1.NewIdentityProvider().ID("idp-1").Name("htpasswd-1").
+				Type(cmv1.IdentityProviderTypeHtpasswd).MappingMethod(cmv1.IdentityProviderMappingMethodClaim).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			user, err := cmv1.NewHTPasswdUser().ID("user-1").Username("admin").Build()
+			Expect(err).ToNot(HaveOccurred())
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatIDPList([]*cmv1.IdentityProvider{idp})))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatHtpasswdUserList([]*cmv1.HTPasswdUser{user})))
+
+			stdout, _, err := RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+				return DescribeIdpRunner()(context.Background(), r, cmd, []string{"htpasswd-1"})
+			}, t.RosaRuntime, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stdout).To(Equal(`
+ID:                   idp-1
+Name:                 htpasswd-1
+Type:                 HTPasswd
+Mapping method:       claim
+Users:                admin
+`))
+		})
+	})
+})
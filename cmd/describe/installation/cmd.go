@@ -23,6 +23,7 @@ import (
 	asv1 "github.com/openshift-online/ocm-sdk-go/addonsmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -69,19 +70,19 @@ func run(_ *cobra.Command, _ []string) {
 	if args.clusterKey == "" {
 		r.Reporter.Errorf(
 			"Expected the cluster to be specified with the --cluster flag")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 	ocm.SetClusterKey(args.clusterKey)
 
 	if args.installationKey == "" {
 		r.Reporter.Errorf(
 			"Expected the add-on installation to be specified with the --addon flag")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if err := describeAddonInstallation(r, args.installationKey); err != nil {
 		r.Reporter.Errorf("Failed to describe add-on installation: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 }
 
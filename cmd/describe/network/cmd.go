@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/network"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use     = "network"
+	short   = "Show details of a network stack"
+	long    = "Show details of a CloudFormation stack created with 'rosa create network'."
+	example = `  # Describe the network stack named "mynetwork"
+  rosa describe network mynetwork
+
+  # Alternative: using the --name flag
+  rosa describe network --name mynetwork`
+)
+
+var aliases = []string{"networks"}
+
+type describeOptions struct {
+	name string
+}
+
+// description is the structured output of the command.
+type description struct {
+	*network.Stack
+	Resources []network.StackResource `json:"resources"`
+}
+
+func NewDescribeNetworkCommand() *cobra.Command {
+	options := &describeOptions{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Aliases: aliases,
+		Args:    cobra.MaximumNArgs(1),
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithAWS(), DescribeNetworkRunner(options)),
+	}
+
+	cmd.Flags().StringVar(
+		&options.name,
+		"name",
+		"",
+		"Name of the network stack to describe",
+	)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func DescribeNetworkRunner(options *describeOptions) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
+		name := options.name
+		if len(argv) == 1 && !cmd.Flag("name").Changed {
+			name = argv[0]
+		}
+		if name == "" {
+			return fmt.Errorf("Network stack name is required. Specify it as an argument or use the --name flag")
+		}
+
+		stack, err := network.GetStack(ctx, r.AWSClient, name)
+		if err != nil {
+			return err
+		}
+		resources, err := network.ListStackResources(ctx, r.AWSClient, stack.ID)
+		if err != nil {
+			return err
+		}
+
+		if output.HasFlag() {
+			return output.Print(description{Stack: stack, Resources: resources})
+		}
+
+		fmt.Print(describeStack(stack, resources))
+		return nil
+	}
+}
+
+func describeStack(stack *network.Stack, resources []network.StackResource) string {
+	var result strings.Builder
+	fmt.Fprintf(&result, "\n"+
+		"Name:                       %s\n"+
+		"ID:                         %s\n"+
+		"Status:                     %s\n",
+		stack.Name,
+		stack.ID,
+		stack.Status,
+	)
+	if stack.StatusReason != "" {
+		fmt.Fprintf(&result, "Status reason:              %s\n", stack.StatusReason)
+	}
+	fmt.Fprintf(&result, ""+
+		"Template:                   %s\n"+
+		"Created:                    %s\n"+
+		"VPC ID:                     %s\n"+
+		"Public subnets:             %s\n"+
+		"Private subnets:            %s\n",
+		stack.Template,
+		stack.CreationTime.Format("Jan _2 2006 15:04:05 MST"),
+		stack.VpcID,
+		strings.Join(stack.PublicSubnets, ", "),
+		strings.Join(stack.PrivateSubnets, ", "),
+	)
+
+	if len(stack.Tags) > 0 {
+		result.WriteString("Tags:\n")
+		for _, key := range slices.Sorted(maps.Keys(stack.Tags)) {
+			fmt.Fprintf(&result, "  - %s: %s\n", key, stack.Tags[key])
+		}
+	}
+
+	if len(resources) > 0 {
+		result.WriteString("Resources:\n")
+		for _, resource := range resources {
+			fmt.Fprintf(&result, "  - %s (%s): %s %s\n",
+				resource.LogicalID, resource.Type, resource.PhysicalID, resource.Status)
+		}
+	}
+
+	return result.String()
+}
@@ -0,0 +1,50 @@
+package network
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift/rosa/pkg/network"
+)
+
+var _ = Describe("Describe network", func() {
+	It("Creates the command correctly", func() {
+		cmd := NewDescribeNetworkCommand()
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Aliases).To(ContainElements(aliases))
+		Expect(cmd.Flags().Lookup("name")).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("output")).NotTo(BeNil())
+	})
+
+	It("Describes the stack and its resources", func() {
+		stack := &network.Stack{
+			Name:           "net",
+			ID:             "arn:aws:cloudformation:us-east-1:123:stack/net/1",
+			Status:         "CREATE_COMPLETE",
+			Template:       "rosa-quickstart-default-vpc",
+			VpcID:          "vpc-1",
+			PublicSubnets:  []string{"subnet-a", "subnet-b"},
+			PrivateSubnets: []string{"subnet-c"},
+			CreationTime:   time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+			Tags:           map[string]string{"rosa_network_template": "rosa-quickstart-default-vpc"},
+		}
+		resources := []network.StackResource{
+			{LogicalID: "VPC", PhysicalID: "vpc-1", Type: "AWS::EC2::VPC", Status: "CREATE_COMPLETE"},
+		}
+		Expect(describeStack(stack, resources)).To(Equal("\n" +
+			"Name:                       net\n" +
+			"ID:                         arn:aws:cloudformation:us-east-1:123:stack/net/1\n" +
+			"Status:                     CREATE_COMPLETE\n" +
+			"Template:                   rosa-quickstart-default-vpc\n" +
+			"Created:                    Jan  2 2026 03:04:05 UTC\n" +
+			"VPC ID:                     vpc-1\n" +
+			"Public subnets:             subnet-a, subnet-b\n" +
+			"Private subnets:            subnet-c\n" +
+			"Tags:\n" +
+			"  - rosa_network_template: rosa-quickstart-default-vpc\n" +
+			"Resources:\n" +
+			"  - VPC (AWS::EC2::VPC): vpc-1 CREATE_COMPLETE\n"))
+	})
+})
@@ -22,6 +22,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -58,7 +59,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if args.ID == "" {
 		r.Reporter.Errorf("id not specified.")
 		cmd.Help()
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	// Try to find the cluster:
@@ -66,7 +67,7 @@ func run(cmd *cobra.Command, _ []string) {
 	service, err := r.OCMClient.GetManagedService(args)
 	if err != nil {
 		r.Reporter.Errorf("Failed to get service with id %q: %v", args.ID, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	fmt.Printf(`%-28s%s
@@ -0,0 +1,128 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicelog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use     = "service-log"
+	short   = "Show details of a service log entry"
+	long    = short
+	example = `  # Describe the service log entry with id <service_log_id>
+  rosa describe service-log --id <service_log_id>`
+)
+
+type Options struct {
+	id string
+}
+
+func NewOptions() *Options {
+	return &Options{}
+}
+
+func NewDescribeServiceLogCommand() *cobra.Command {
+	options := NewOptions()
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: []string{"servicelog"},
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), DescribeServiceLogRunner(options)),
+		Args:    cobra.NoArgs,
+	}
+	flags := cmd.Flags()
+	flags.StringVar(
+		&options.id,
+		"id",
+		"",
+		"ID of the service log entry, as listed by 'rosa list service-logs -o wide' (required).",
+	)
+	cmd.MarkFlagRequired("id")
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func DescribeServiceLogRunner(options *Options) rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		logEntry, exists, err := r.OCMClient.GetServiceLog(options.id)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("The service log entry with id '%s' does not exist", options.id)
+		}
+
+		if output.HasFlag() {
+			return output.Print(logEntry)
+		}
+		fmt.Print(printLogEntry(logEntry))
+		return nil
+	}
+}
+
+func printLogEntry(logEntry *slv1.LogEntry) string {
+	outputMsg := fmt.Sprintf("\n"+
+		"ID:                                %s\n"+
+		"Cluster ID:                        %s\n"+
+		"Service:                           %s\n"+
+		"Severity:                          %s\n"+
+		"Type:                              %s\n"+
+		"Timestamp:                         %s\n"+
+		"Created By:                        %s\n"+
+		"Summary:                           %s\n",
+		logEntry.ID(),
+		logEntry.ClusterID(),
+		logEntry.ServiceName(),
+		logEntry.Severity(),
+		logEntry.LogType(),
+		logEntry.Timestamp().Format(time.UnixDate),
+		logEntry.CreatedBy(),
+		logEntry.Summary(),
+	)
+	if len(logEntry.DocReferences()) > 0 {
+		outputMsg = outputMsg + "Doc References:                    \n"
+		for _, reference := range logEntry.DocReferences() {
+			outputMsg = outputMsg + fmt.Sprintf("  - %s\n", reference)
+		}
+	}
+	if logEntry.Description() != "" {
+		outputMsg = outputMsg + "Description:\n\n" + indent(logEntry.Description()) + "\n"
+	}
+	return outputMsg
+}
+
+func indent(text string) string {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = "  " + line
+		}
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
@@ -0,0 +1,97 @@
+package servicelog
+
+import (
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+	"github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("rosa describe service-log", func() {
+	Context("Create Command", func() {
+		It("Returns Command", func() {
+			cmd := NewDescribeServiceLogCommand()
+			Expect(cmd).NotTo(BeNil())
+
+			Expect(cmd.Use).To(Equal(use))
+			Expect(cmd.Example).To(Equal(example))
+			Expect(cmd.Short).To(Equal(short))
+			Expect(cmd.Long).To(Equal(long))
+			Expect(cmd.Args).NotTo(BeNil())
+			Expect(cmd.Run).NotTo(BeNil())
+
+			Expect(cmd.Flags().Lookup("id")).NotTo(BeNil())
+			Expect(cmd.Flags().Lookup("output")).NotTo(BeNil())
+		})
+	})
+
+	Context("Execute command", func() {
+		var (
+			t       *TestingRuntime
+			options *Options
+		)
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			options = &Options{
+				id: "mock-id",
+			}
+			output.SetOutput("")
+		})
+
+		AfterEach(func() {
+			output.SetOutput("")
+		})
+
+		It("Returns an error if the service log entry is not found", func() {
+			t.ApiServer.AppendHandlers(testing.RespondWithJSON(http.StatusNotFound, ""))
+			err := DescribeServiceLogRunner(options)(nil, t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError("The service log entry with id 'mock-id' does not exist"))
+		})
+
+		It("Describes the service log entry", func() {
+			logEntry, err := slv1.NewLogEntry().
+				ID("mock-id").
+				ClusterID("mock-cluster-id").
+				ServiceName("SREManualAction").
+				Severity(slv1.SeverityWarning).
+				LogType(slv1.LogTypeClusterStateUpdates).
+				Timestamp(time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)).
+				CreatedBy("mock-sre").
+				Summary("Action required: review the cluster network").
+				Description("The cluster network is misconfigured.\nSee the documentation for details.").
+				DocReferences("https://docs.example.com/network").
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.AppendHandlers(testing.RespondWithJSON(http.StatusOK, FormatResource(logEntry)))
+
+			t.StdOutReader.Record()
+			err = DescribeServiceLogRunner(options)(nil, t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(Equal(`
+ID:                                mock-id
+Cluster ID:                        mock-cluster-id
+Service:                           SREManualAction
+Severity:                          Warning
+Type:                              cluster-state-updates
+Timestamp:                         Fri Oct 16 12:00:00 UTC 2026
+Created By:                        mock-sre
+Summary:                           Action required: review the cluster network
+Doc References:                    
+  - https://docs.example.com/network
+Description:
+
+  The cluster network is misconfigured.
+  See the documentation for details.
+
+`))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package servicelog
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestDescribeServiceLog(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Describe Service Log Suite")
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/input"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
@@ -68,14 +69,14 @@ func run(_ *cobra.Command, argv []string) {
 	tuningConfig, err := r.OCMClient.FindTuningConfigByName(cluster.ID(), tuningConfigName)
 	if err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if output.HasFlag() {
 		err = output.Print(tuningConfig)
 		if err != nil {
 			r.Reporter.Errorf("%v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		os.Exit(0)
 	}
@@ -84,7 +85,7 @@ func run(_ *cobra.Command, argv []string) {
 	tuningConfigSpec, err := json.MarshalIndent(tuningConfig.Spec(), "                            ", "  ")
 	if err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	r.Reporter.Debugf("Describing tuning config '%s' on cluster '%s'", tuningConfig.Name(), clusterKey)
@@ -0,0 +1,13 @@
+package cluster
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestDiffCluster(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Diff Cluster Suite")
+}
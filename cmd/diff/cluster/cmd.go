@@ -0,0 +1,186 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/clusterdiff"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "cluster"
+	short = "Compare a cluster with another cluster or with a spec file"
+	long  = "Compare the configuration of a cluster with another cluster or with a spec file.\n\n" +
+		"Only the settings chosen when creating or editing the clusters are compared: the cluster " +
+		"itself, its machine pools, identity providers, ingresses and autoscaler. Identifiers, " +
+		"timestamps and status are left out.\n\n" +
+		"The spec file can be produced by 'rosa export cluster', or be a 'ClusterSnapshot' document " +
+		"that also lists the machine pools, identity providers, ingresses and autoscaler. The settings " +
+		"that are not in the spec file are not compared."
+	example = `  # Compare two clusters
+  rosa diff cluster --cluster=mycluster --with-cluster=othercluster
+
+  # Compare a cluster with the spec file it was created from
+  rosa diff cluster --cluster=mycluster --spec=mycluster.yaml
+
+  # Compare two clusters ignoring their names and STS settings, as JSON
+  rosa diff cluster -c mycluster --with-cluster=othercluster --ignore=cluster.name,cluster.sts -o json`
+)
+
+type diffOptions struct {
+	withCluster string
+	spec        string
+	ignore      []string
+}
+
+// result is the output of the command when a structured output is requested
+type result struct {
+	From    string               `json:"from"`
+	To      string               `json:"to"`
+	Changes []clusterdiff.Change `json:"changes"`
+}
+
+func NewDiffClusterCommand() *cobra.Command {
+	options := &diffOptions{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), DiffClusterRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(
+		&options.withCluster,
+		"with-cluster",
+		"",
+		"Name or ID of the cluster to compare with.",
+	)
+	flags.StringVar(
+		&options.spec,
+		"spec",
+		"",
+		"Path of the spec file to compare with.",
+	)
+	flags.StringSliceVar(
+		&options.ignore,
+		"ignore",
+		nil,
+		"Paths of the settings that aren't compared, for example 'cluster.name' or 'machinePools[workers]'.",
+	)
+	cmd.MarkFlagsMutuallyExclusive("with-cluster", "spec")
+	ocm.AddClusterFlag(cmd)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func DiffClusterRunner(options *diffOptions) rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		if options.withCluster == "" && options.spec == "" {
+			return fmt.Errorf("Either '--with-cluster' or '--spec' is required")
+		}
+
+		clusterKey := r.GetClusterKey()
+		cluster := r.FetchCluster()
+		from, err := snapshot(r, cluster)
+		if err != nil {
+			return fmt.Errorf("Failed to get the configuration of cluster '%s': %v", clusterKey, err)
+		}
+
+		var to *clusterdiff.Snapshot
+		var target string
+		if options.spec != "" {
+			target = fmt.Sprintf("spec file '%s'", options.spec)
+			to, err = clusterdiff.Load(options.spec)
+			if err != nil {
+				return fmt.Errorf("Failed to load spec file: %v", err)
+			}
+		} else {
+			target = fmt.Sprintf("cluster '%s'", options.withCluster)
+			other, err := r.OCMClient.GetCluster(options.withCluster, r.Creator)
+			if err != nil {
+				return fmt.Errorf("Failed to get cluster '%s': %w", options.withCluster, err)
+			}
+			to, err = snapshot(r, other)
+			if err != nil {
+				return fmt.Errorf("Failed to get the configuration of cluster '%s': %v", options.withCluster, err)
+			}
+		}
+
+		changes, err := clusterdiff.Compare(from, to, clusterdiff.Options{
+			Partial: options.spec != "",
+			Ignore:  options.ignore,
+		})
+		if err != nil {
+			return fmt.Errorf("Failed to compare cluster '%s' with %s: %v", clusterKey, target, err)
+		}
+
+		if output.HasFlag() {
+			return output.Print(&result{
+				From:    clusterKey,
+				To:      target,
+				Changes: changes,
+			})
+		}
+		if len(changes) == 0 {
+			r.Reporter.Infof("No differences found between cluster '%s' and %s", clusterKey, target)
+			return nil
+		}
+		r.Reporter.Infof("Differences between cluster '%s' and %s:", clusterKey, target)
+		for _, change := range changes {
+			fmt.Println(change)
+		}
+		return nil
+	}
+}
+
+// snapshot fetches the resources of the cluster and builds its snapshot
+func snapshot(r *rosa.Runtime, cluster *cmv1.Cluster) (*clusterdiff.Snapshot, error) {
+	var err error
+	resources := &clusterdiff.Resources{}
+	if ocm.IsHyperShiftCluster(cluster) {
+		resources.NodePools, err = r.OCMClient.GetNodePools(cluster.ID())
+	} else {
+		resources.MachinePools, err = r.OCMClient.GetMachinePools(cluster.ID())
+	}
+	if err != nil {
+		return nil, err
+	}
+	resources.IdentityProviders, err = r.OCMClient.GetIdentityProviders(cluster.ID())
+	if err != nil {
+		return nil, err
+	}
+	resources.Ingresses, err = r.OCMClient.GetIngresses(cluster.ID())
+	if err != nil {
+		return nil, err
+	}
+	resources.Autoscaler, err = r.OCMClient.GetClusterAutoscaler(cluster.ID())
+	if err != nil {
+		return nil, err
+	}
+	return clusterdiff.FromCluster(cluster, resources), nil
+}
@@ -0,0 +1,137 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/test"
+)
+
+const spec = `
+apiVersion: rosa.openshift.io/v1alpha1
+kind: ClusterSnapshot
+spec:
+  cluster:
+    version: 4.16.2
+  machinePools:
+  - id: worker
+    replicas: 3
+`
+
+var _ = Describe("Diff cluster", func() {
+	It("Returns Command", func() {
+		cmd := NewDiffClusterCommand()
+		Expect(cmd).NotTo(BeNil())
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Long).To(Equal(long))
+		Expect(cmd.Example).To(Equal(example))
+		Expect(cmd.Run).NotTo(BeNil())
+		for _, flag := range []string{"cluster", "with-cluster", "spec", "ignore", "output"} {
+			Expect(cmd.Flags().Lookup(flag)).NotTo(BeNil())
+		}
+	})
+
+	Context("DiffClusterRunner", func() {
+		var t *test.TestingRuntime
+		var options *diffOptions
+
+		mockCluster := func(version string) *cmv1.Cluster {
+			return test.MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.Version(cmv1.NewVersion().RawID(version).ChannelGroup("stable"))
+			})
+		}
+
+		mockMachinePool := func(replicas int) *cmv1.MachinePool {
+			machinePool, err := cmv1.NewMachinePool().ID("worker").InstanceType("m5.xlarge").
+				Replicas(replicas).Build()
+			Expect(err).NotTo(HaveOccurred())
+			return machinePool
+		}
+
+		// respondWithResources adds the responses to the requests made to build the snapshot of a
+		// classic cluster
+		respondWithResources := func(replicas int) {
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK,
+					test.FormatMachinePoolList([]*cmv1.MachinePool{mockMachinePool(replicas)})),
+				RespondWithJSON(http.StatusOK, test.FormatIDPList([]*cmv1.IdentityProvider{})),
+				RespondWithJSON(http.StatusOK, test.FormatIngressList([]*cmv1.Ingress{})),
+				RespondWithJSON(http.StatusNotFound, "{}"),
+			)
+		}
+
+		run := func() (string, error) {
+			stdout, _, err := test.RunWithOutputCapture(func(r *rosa.Runtime, _ *cobra.Command) error {
+				return DiffClusterRunner(options)(context.Background(), r, nil, nil)
+			}, t.RosaRuntime, nil)
+			return stdout, err
+		}
+
+		BeforeEach(func() {
+			t = test.NewTestRuntime()
+			options = &diffOptions{}
+			output.SetOutput("")
+			DeferCleanup(func() { output.SetOutput("") })
+			t.SetCluster(test.MockClusterName, mockCluster("4.15.9"))
+		})
+
+		It("Requires something to compare with", func() {
+			_, err := run()
+			Expect(err).To(MatchError("Either '--with-cluster' or '--spec' is required"))
+		})
+
+		It("Compares with another cluster", func() {
+			options.withCluster = "other"
+			respondWithResources(3)
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, test.FormatClusterList([]*cmv1.Cluster{mockCluster("4.16.2")})),
+			)
+			respondWithResources(6)
+			stdout, err := run()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(ContainSubstring("Differences between cluster 'cluster' and cluster 'other':"))
+			Expect(stdout).To(HaveSuffix("~ cluster.version: 4.15.9 -> 4.16.2\n" +
+				"~ machinePools[worker].replicas: 3 -> 6\n"))
+		})
+
+		It("Compares with a spec file as JSON", func() {
+			options.spec = filepath.Join(GinkgoT().TempDir(), "spec.yaml")
+			Expect(os.WriteFile(options.spec, []byte(spec), 0600)).To(Succeed())
+			output.SetOutput(output.JSON)
+			respondWithResources(3)
+			stdout, err := run()
+			Expect(err).NotTo(HaveOccurred())
+			var result map[string]interface{}
+			Expect(json.Unmarshal([]byte(stdout), &result)).To(Succeed())
+			Expect(result["from"]).To(Equal(test.MockClusterName))
+			Expect(result["changes"]).To(Equal([]interface{}{
+				map[string]interface{}{
+					"path": "cluster.version",
+					"type": "changed",
+					"from": "4.15.9",
+					"to":   "4.16.2",
+				},
+			}))
+		})
+
+		It("Fails when the spec file is invalid", func() {
+			options.spec = filepath.Join(GinkgoT().TempDir(), "spec.yaml")
+			Expect(os.WriteFile(options.spec, []byte("kind: Other\n"), 0600)).To(Succeed())
+			respondWithResources(3)
+			_, err := run()
+			Expect(err).To(MatchError(ContainSubstring("Failed to load spec file")))
+		})
+	})
+})
@@ -0,0 +1,38 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/cmd/diff/cluster"
+	"github.com/openshift/rosa/pkg/arguments"
+)
+
+func NewRosaDiffCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Compare the configuration of resources",
+		Long:  "Compare the configuration of a resource with another resource or with a spec file",
+		Args:  cobra.NoArgs,
+	}
+	cmd.AddCommand(cluster.NewDiffClusterCommand())
+	flags := cmd.PersistentFlags()
+	arguments.AddProfileFlag(flags)
+	arguments.AddRegionFlag(flags)
+	return cmd
+}
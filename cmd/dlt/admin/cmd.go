@@ -25,6 +25,7 @@ import (
 	"github.com/openshift/rosa/cmd/create/admin"
 	cadmin "github.com/openshift/rosa/cmd/create/admin"
 	"github.com/openshift/rosa/cmd/create/idp"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -41,7 +42,7 @@ func (d *DeleteAdminIDP) deleteAdmin(r *rosa.Runtime, identityProvider *cmv1.Ide
 	if err != nil {
 		r.Reporter.Errorf("Failed to delete htpasswd idp '%s' of cluster '%s': %s",
 			identityProvider.ID(), r.ClusterKey, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 }
 
@@ -56,20 +57,19 @@ func (d *DeleteUserAdminFromIDP) deleteAdmin(r *rosa.Runtime, identityProvider *
 	if err != nil {
 		r.Reporter.Errorf("Failed to delete '%s' user from htpasswd idp users list of cluster '%s': %s",
 			cadmin.ClusterAdminUsername, r.ClusterKey, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	users, err := r.OCMClient.GetHTPasswdUserList(clusterID, identityProvider.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to list htpasswd idp users of cluster '%s': %s",
 			r.ClusterKey, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	htpasswdIdentityProvider, ok := identityProvider.GetHtpasswd()
 	if !ok {
-		r.Reporter.Errorf("Failed to get htpasswd idp of cluster '%s': %s",
-			r.ClusterKey, err)
+		r.Reporter.Errorf("Failed to get htpasswd idp of cluster '%s'", r.ClusterKey)
 		os.Exit(1)
 	}
 
@@ -79,7 +79,7 @@ func (d *DeleteUserAdminFromIDP) deleteAdmin(r *rosa.Runtime, identityProvider *
 		if err != nil {
 			r.Reporter.Errorf("Failed to delete htpasswd idp '%s' of cluster '%s': %s",
 				identityProvider.ID(), r.ClusterKey, err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 }
@@ -111,7 +111,7 @@ func run(_ *cobra.Command, _ []string) {
 	if cluster.ExternalAuthConfig().Enabled() {
 		r.Reporter.Errorf(
 			"Deleting the 'cluster-admin' user is not supported for clusters with external authentication configured.")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	// Try to find the htpasswd identity provider:
@@ -119,7 +119,7 @@ func run(_ *cobra.Command, _ []string) {
 	clusterAdminIDP, _, err := cadmin.FindIDPWithAdmin(cluster, r)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if clusterAdminIDP == nil {
@@ -134,7 +134,7 @@ func run(_ *cobra.Command, _ []string) {
 		err := r.OCMClient.DeleteUser(clusterID, admin.ClusterAdminGroupname, cadmin.ClusterAdminUsername)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		deletionStrategy := getAdminUserDeletionStrategy(r, clusterAdminIDP)
@@ -0,0 +1,305 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"fmt"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	cadmin "github.com/openshift/rosa/cmd/create/admin"
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/wait"
+)
+
+// uninstallPollInterval is the time between two checks of the cluster while waiting for it to be
+// uninstalled
+var uninstallPollInterval = wait.DefaultInterval
+
+const (
+	resultDeleted = "Deleted"
+	resultSkipped = "Skipped"
+	resultFailed  = "Failed"
+)
+
+// cascadeResult is the outcome of deleting one of the resources that belong to the cluster
+type cascadeResult struct {
+	Resource string
+	Name     string
+	Result   string
+	Reason   string
+}
+
+func deleted(resource string, name string) cascadeResult {
+	return cascadeResult{Resource: resource, Name: name, Result: resultDeleted}
+}
+
+func skipped(resource string, name string, format string, args ...interface{}) cascadeResult {
+	return cascadeResult{Resource: resource, Name: name, Result: resultSkipped, Reason: fmt.Sprintf(format, args...)}
+}
+
+func failed(resource string, name string, err error) cascadeResult {
+	return cascadeResult{Resource: resource, Name: name, Result: resultFailed, Reason: err.Error()}
+}
+
+var cascadeResultsTable = output.Table[cascadeResult]{
+	Columns: []output.Column[cascadeResult]{
+		{Header: "RESOURCE", Value: func(c cascadeResult) string { return c.Resource }},
+		{Header: "NAME", Value: func(c cascadeResult) string { return c.Name }},
+		{Header: "RESULT", Value: func(c cascadeResult) string { return c.Result }},
+		{Header: "REASON", Value: func(c cascadeResult) string { return c.Reason }},
+	},
+}
+
+// revokeAdminAccess revokes the break-glass credentials of a cluster with external authentication,
+// or removes the 'cluster-admin' user of any other cluster, so that they stop working before the
+// uninstallation finishes.
+func revokeAdminAccess(r *rosa.Runtime, cluster *cmv1.Cluster) cascadeResult {
+	if cluster.ExternalAuthConfig().Enabled() {
+		resource := "Break glass credentials"
+		credentials, err := r.OCMClient.GetBreakGlassCredentials(cluster.ID())
+		if err != nil {
+			return failed(resource, cluster.ID(), err)
+		}
+		if len(credentials) == 0 {
+			return skipped(resource, cluster.ID(), "the cluster has no break glass credentials")
+		}
+		err = r.OCMClient.DeleteBreakGlassCredentials(cluster.ID())
+		if err != nil {
+			return failed(resource, cluster.ID(), err)
+		}
+		return deleted(resource, cluster.ID())
+	}
+
+	resource := "Admin user"
+	user, err := r.OCMClient.GetUser(cluster.ID(), cadmin.ClusterAdminGroupname, cadmin.ClusterAdminUsername)
+	if err != nil {
+		return failed(resource, cadmin.ClusterAdminUsername, err)
+	}
+	if user == nil {
+		return skipped(resource, cadmin.ClusterAdminUsername, "the cluster has no '%s' user",
+			cadmin.ClusterAdminUsername)
+	}
+	err = r.OCMClient.DeleteUser(cluster.ID(), cadmin.ClusterAdminGroupname, cadmin.ClusterAdminUsername)
+	if err != nil {
+		return failed(resource, cadmin.ClusterAdminUsername, err)
+	}
+	return deleted(resource, cadmin.ClusterAdminUsername)
+}
+
+// waitForUninstall blocks until the cluster doesn't exist anymore
+func waitForUninstall(r *rosa.Runtime, cluster *cmv1.Cluster, clusterKey string, timeout time.Duration) error {
+	options := &wait.Options{
+		For:      "deleted",
+		Interval: uninstallPollInterval,
+		Timeout:  timeout,
+	}
+	waiter := wait.NewWaiter(
+		r.Reporter,
+		options,
+		fmt.Sprintf("cluster '%s' to be uninstalled", clusterKey),
+		func(cluster *cmv1.Cluster) (bool, error) {
+			if cluster == nil {
+				return true, nil
+			}
+			if cluster.State() == cmv1.ClusterStateError {
+				return false, fmt.Errorf("the cluster is in 'error' state")
+			}
+			return false, nil
+		},
+		func(cluster *cmv1.Cluster) string {
+			if cluster == nil {
+				return fmt.Sprintf("Cluster '%s' has been uninstalled", clusterKey)
+			}
+			return fmt.Sprintf("Cluster '%s' is in '%s' state", clusterKey, cluster.State())
+		},
+	)
+	_, err := r.OCMClient.PollCluster(cluster.ID(), options.Interval, options.Timeout, waiter.Done)
+	return waiter.Result(err)
+}
+
+// deleteOperatorRoles deletes the operator roles of an uninstalled cluster. Roles created for a
+// reusable OIDC config are found by their prefix, and are kept when another cluster uses the
+// same prefix.
+func deleteOperatorRoles(r *rosa.Runtime, cluster *cmv1.Cluster) []cascadeResult {
+	resource := "Operator roles"
+	var roleNames []string
+	if ocm.IsOidcConfigReusable(cluster) {
+		prefix := cluster.AWS().STS().OperatorRolePrefix()
+		used, err := r.OCMClient.HasAClusterUsingOperatorRolesPrefix(prefix)
+		if err != nil {
+			return []cascadeResult{failed(resource, prefix, err)}
+		}
+		if used {
+			return []cascadeResult{skipped(resource, prefix, "used by other clusters")}
+		}
+		credRequests, err := r.OCMClient.GetAllCredRequests()
+		if err != nil {
+			return []cascadeResult{failed(resource, prefix, err)}
+		}
+		roleNames, err = r.AWSClient.GetOperatorRolesFromAccountByPrefix(prefix, credRequests)
+		if err != nil {
+			return []cascadeResult{failed(resource, prefix, err)}
+		}
+	} else {
+		credRequests, err := r.OCMClient.GetCredRequests(cluster.Hypershift().Enabled())
+		if err != nil {
+			return []cascadeResult{failed(resource, cluster.ID(), err)}
+		}
+		roleNames, err = r.AWSClient.GetOperatorRolesFromAccountByClusterID(cluster.ID(), credRequests)
+		if err != nil {
+			return []cascadeResult{failed(resource, cluster.ID(), err)}
+		}
+	}
+	if len(roleNames) == 0 {
+		return []cascadeResult{skipped(resource, cluster.AWS().STS().OperatorRolePrefix(), "not found")}
+	}
+
+	resource = "Operator role"
+	_, roleARN, err := r.AWSClient.CheckRoleExists(roleNames[0])
+	if err != nil {
+		return []cascadeResult{failed(resource, roleNames[0], err)}
+	}
+	managedPolicies, err := r.AWSClient.HasManagedPolicies(roleARN)
+	if err != nil {
+		return []cascadeResult{failed(resource, roleNames[0], err)}
+	}
+	results := []cascadeResult{}
+	for _, roleName := range roleNames {
+		r.Reporter.Infof("Deleting operator role '%s'", roleName)
+		_, err := r.AWSClient.DeleteOperatorRole(roleName, managedPolicies, false)
+		if err != nil {
+			results = append(results, failed(resource, roleName, err))
+			continue
+		}
+		results = append(results, deleted(resource, roleName))
+	}
+	return results
+}
+
+// deleteOIDCProvider deletes the OIDC provider of an uninstalled cluster. The provider of a
+// reusable OIDC config is kept when another cluster of the account uses it.
+func deleteOIDCProvider(r *rosa.Runtime, cluster *cmv1.Cluster) cascadeResult {
+	resource := "OIDC provider"
+	endpointURL := cluster.AWS().STS().OIDCEndpointURL()
+	var providerARN string
+	var err error
+	if ocm.IsOidcConfigReusable(cluster) {
+		used, err := r.OCMClient.HasAClusterUsingOidcProvider(endpointURL, r.Creator.AccountID)
+		if err != nil {
+			return failed(resource, endpointURL, err)
+		}
+		if used {
+			return skipped(resource, endpointURL, "used by other clusters")
+		}
+		providerARN, err = r.AWSClient.GetOpenIDConnectProviderByOidcEndpointUrl(endpointURL)
+		if err != nil {
+			return failed(resource, endpointURL, err)
+		}
+	} else {
+		providerARN, err = r.AWSClient.GetOpenIDConnectProviderByClusterIdTag(cluster.ID())
+		if err != nil {
+			return failed(resource, endpointURL, err)
+		}
+	}
+	if providerARN == "" {
+		return skipped(resource, endpointURL, "not found")
+	}
+	r.Reporter.Infof("Deleting OIDC provider '%s'", providerARN)
+	err = r.AWSClient.DeleteOpenIDConnectProvider(providerARN)
+	if err != nil {
+		return failed(resource, providerARN, err)
+	}
+	return deleted(resource, providerARN)
+}
+
+// deleteOIDCConfig deletes the reusable OIDC config of an uninstalled cluster, including the
+// private key secret and the S3 bucket of an unmanaged config, unless another cluster uses it.
+func deleteOIDCConfig(r *rosa.Runtime, cluster *cmv1.Cluster) cascadeResult {
+	resource := "OIDC config"
+	if !ocm.IsOidcConfigReusable(cluster) {
+		return skipped(resource, "", "the cluster doesn't use a reusable OIDC config")
+	}
+	oidcConfigID := cluster.AWS().STS().OidcConfig().ID()
+	oidcConfig, err := r.OCMClient.GetOidcConfig(oidcConfigID)
+	if err != nil {
+		return failed(resource, oidcConfigID, err)
+	}
+	used, err := r.OCMClient.HasAClusterUsingOidcEndpointUrl(oidcConfig.IssuerUrl())
+	if err != nil {
+		return failed(resource, oidcConfigID, err)
+	}
+	if used {
+		return skipped(resource, oidcConfigID, "used by other clusters")
+	}
+	r.Reporter.Infof("Deleting OIDC config '%s'", oidcConfigID)
+	if !oidcConfig.Managed() {
+		bucketName, err := aws.GetBucketNameFromSecretArn(oidcConfig.SecretArn())
+		if err != nil {
+			return failed(resource, oidcConfigID, err)
+		}
+		err = r.AWSClient.DeleteSecretInSecretsManager(oidcConfig.SecretArn())
+		if err != nil {
+			return failed(resource, oidcConfigID, fmt.Errorf("failed to delete private key secret: %v", err))
+		}
+		err = r.AWSClient.DeleteS3Bucket(bucketName)
+		if err != nil {
+			return failed(resource, oidcConfigID, fmt.Errorf("failed to delete S3 bucket '%s': %v", bucketName, err))
+		}
+	}
+	err = r.OCMClient.DeleteOidcConfig(oidcConfigID)
+	if err != nil {
+		return failed(resource, oidcConfigID, err)
+	}
+	return deleted(resource, oidcConfigID)
+}
+
+// deleteClusterResources deletes the AWS resources left behind by an uninstalled STS cluster
+func deleteClusterResources(r *rosa.Runtime, cluster *cmv1.Cluster, deleteOidcConfig bool) []cascadeResult {
+	results := deleteOperatorRoles(r, cluster)
+	results = append(results, deleteOIDCProvider(r, cluster))
+	if deleteOidcConfig {
+		results = append(results, deleteOIDCConfig(r, cluster))
+	}
+	return results
+}
+
+// printCascadeSummary prints the results and returns an error when any of the deletions failed
+func printCascadeSummary(r *rosa.Runtime, clusterKey string, results []cascadeResult) error {
+	if len(results) == 0 {
+		return nil
+	}
+	r.Reporter.Infof("Summary of the resources of cluster '%s':", clusterKey)
+	err := cascadeResultsTable.Print(results)
+	if err != nil {
+		return err
+	}
+	failures := 0
+	for _, result := range results {
+		if result.Result == resultFailed {
+			failures++
+		}
+	}
+	if failures > 0 {
+		return fmt.Errorf("failed to delete %d of the resources of cluster '%s'", failures, clusterKey)
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+package cluster
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/interactive"
+	"github.com/openshift/rosa/pkg/test"
+	"github.com/openshift/rosa/pkg/wait"
+)
+
+const (
+	emptyCredRequests = `{"kind": "STSCredentialRequestList", "page": 1, "size": 0, "total": 0, "items": []}`
+	notFound          = `{"kind": "Error", "id": "404", "reason": "not found"}`
+)
+
+var _ = Describe("Delete cluster with cascade", func() {
+	var (
+		t       *test.TestingRuntime
+		mockAWS *aws.MockClient
+	)
+
+	stubConfirm := func(string, ...interface{}) bool { return true }
+	stubLogs := func(string) {}
+
+	appendDeleteHandlers := func(cluster *cmv1.Cluster) {
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+			fmt.Sprintf(`{"kind": "ClusterStatus", "id": "%s", "state": "ready"}`, cluster.ID())))
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, test.FormatClusterList([]*cmv1.Cluster{cluster})))
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, ""))
+	}
+
+	BeforeEach(func() {
+		t = test.NewTestRuntime()
+		mockAWS = t.RosaRuntime.AWSClient.(*aws.MockClient)
+		args.bestEffort = false
+		args.watch = false
+		args.cascade = true
+		args.deleteOidcConfig = false
+		args.revokeAdminAccess = false
+		args.timeout = wait.DefaultTimeout
+		interactive.SetEnabled(false)
+		DeferCleanup(func() {
+			args.cascade = false
+			args.deleteOidcConfig = false
+			args.revokeAdminAccess = false
+		})
+	})
+
+	It("requires cascade to delete the OIDC config", func() {
+		args.cascade = false
+		args.deleteOidcConfig = true
+		err := runWithRuntime(t.RosaRuntime, stubConfirm, stubLogs)
+		Expect(err).To(MatchError("the '--delete-oidc-config' flag requires '--cascade'"))
+	})
+
+	It("deletes the operator roles and the OIDC provider once the cluster is uninstalled", func() {
+		cluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.State(cmv1.ClusterStateReady)
+			c.AWS(cmv1.NewAWS().STS(cmv1.NewSTS().
+				RoleARN("arn:aws:iam::123456789012:role/Installer").
+				OIDCEndpointURL("https://oidc.example.com/abc").
+				OperatorRolePrefix("my-prefix")))
+		})
+		t.SetCluster(cluster.ID(), cluster)
+		appendDeleteHandlers(cluster)
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusNotFound, notFound))
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, emptyCredRequests))
+
+		mockAWS.EXPECT().GetOperatorRolesFromAccountByClusterID(cluster.ID(), gomock.Any()).
+			Return([]string{"my-prefix-openshift-ingress", "my-prefix-kube-system-csi"}, nil)
+		mockAWS.EXPECT().CheckRoleExists("my-prefix-openshift-ingress").
+			Return(true, "arn:aws:iam::123456789012:role/my-prefix-openshift-ingress", nil)
+		mockAWS.EXPECT().HasManagedPolicies("arn:aws:iam::123456789012:role/my-prefix-openshift-ingress").
+			Return(false, nil)
+		mockAWS.EXPECT().DeleteOperatorRole("my-prefix-openshift-ingress", false, false).Return(nil, nil)
+		mockAWS.EXPECT().DeleteOperatorRole("my-prefix-kube-system-csi", false, false).
+			Return(nil, errors.New("access denied"))
+		mockAWS.EXPECT().GetOpenIDConnectProviderByClusterIdTag(cluster.ID()).Return("arn:provider", nil)
+		mockAWS.EXPECT().DeleteOpenIDConnectProvider("arn:provider").Return(nil)
+
+		stdout, _, err := captureRun(func() error {
+			return runWithRuntime(t.RosaRuntime, stubConfirm, stubLogs)
+		})
+		Expect(err).To(MatchError(fmt.Sprintf("failed to delete 1 of the resources of cluster '%s'", cluster.ID())))
+		Expect(stdout).To(ContainSubstring("has been uninstalled"))
+		Expect(stdout).To(ContainSubstring(
+			"RESOURCE       NAME                         RESULT   REASON\n" +
+				"Operator role  my-prefix-openshift-ingress  Deleted  \n" +
+				"Operator role  my-prefix-kube-system-csi    Failed   access denied\n" +
+				"OIDC provider  arn:provider                 Deleted  \n"))
+		Expect(stdout).NotTo(ContainSubstring("rosa delete operator-roles"))
+	})
+
+	It("keeps the resources of a reusable OIDC config that other clusters use", func() {
+		cluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.State(cmv1.ClusterStateReady)
+			c.AWS(cmv1.NewAWS().STS(cmv1.NewSTS().
+				RoleARN("arn:aws:iam::123456789012:role/Installer").
+				OIDCEndpointURL("https://oidc.example.com/shared").
+				OperatorRolePrefix("shared").
+				OidcConfig(cmv1.NewOidcConfig().ID("oidc-shared").Reusable(true))))
+		})
+		other := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.ID("other-cluster")
+		})
+		oidcConfig, err := cmv1.NewOidcConfig().ID("oidc-shared").
+			IssuerUrl("https://oidc.example.com/shared").Managed(true).Build()
+		Expect(err).NotTo(HaveOccurred())
+		args.deleteOidcConfig = true
+		t.SetCluster(cluster.ID(), cluster)
+		appendDeleteHandlers(cluster)
+		t.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusNotFound, notFound),
+			ghttp.CombineHandlers(
+				ghttp.VerifyFormKV("search", "aws.sts.operator_iam_roles.role_arn like '%/shared-%'"),
+				RespondWithJSON(http.StatusOK, test.FormatClusterList([]*cmv1.Cluster{other})),
+			),
+			RespondWithJSON(http.StatusOK, test.FormatClusterList([]*cmv1.Cluster{other})),
+			RespondWithJSON(http.StatusOK, test.FormatResource(oidcConfig)),
+			RespondWithJSON(http.StatusOK, test.FormatClusterList([]*cmv1.Cluster{other})),
+		)
+
+		stdout, _, err := captureRun(func() error {
+			return runWithRuntime(t.RosaRuntime, stubConfirm, stubLogs)
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stdout).To(ContainSubstring(
+			"Operator roles  shared                           Skipped  used by other clusters\n" +
+				"OIDC provider   https://oidc.example.com/shared  Skipped  used by other clusters\n" +
+				"OIDC config     oidc-shared                      Skipped  used by other clusters\n"))
+	})
+
+	It("deletes an unused unmanaged OIDC config with its secret and bucket", func() {
+		cluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.AWS(cmv1.NewAWS().STS(cmv1.NewSTS().
+				OidcConfig(cmv1.NewOidcConfig().ID("oidc-unused").Reusable(true))))
+		})
+		secretArn := "arn:aws:secretsmanager:us-east-1:123456789012:secret:rosa-private-key-mine-oidc-ab1c-XyZ123"
+		oidcConfig, err := cmv1.NewOidcConfig().ID("oidc-unused").
+			IssuerUrl("https://mine-oidc-ab1c.s3.amazonaws.com").SecretArn(secretArn).Build()
+		Expect(err).NotTo(HaveOccurred())
+		t.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, test.FormatResource(oidcConfig)),
+			RespondWithJSON(http.StatusOK, test.FormatClusterList([]*cmv1.Cluster{})),
+			RespondWithJSON(http.StatusNoContent, ""),
+		)
+		mockAWS.EXPECT().DeleteSecretInSecretsManager(secretArn).Return(nil)
+		mockAWS.EXPECT().DeleteS3Bucket("mine-oidc-ab1c").Return(nil)
+
+		Expect(deleteOIDCConfig(t.RosaRuntime, cluster)).To(Equal(deleted("OIDC config", "oidc-unused")))
+	})
+
+	It("stops waiting when the uninstallation fails", func() {
+		cluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.State(cmv1.ClusterStateError)
+		})
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, test.FormatResource(cluster)))
+
+		err := waitForUninstall(t.RosaRuntime, cluster, "mycluster", wait.DefaultTimeout)
+		Expect(err).To(MatchError(
+			"Stopped waiting for cluster 'mycluster' to be uninstalled: the cluster is in 'error' state"))
+	})
+
+	Context("revokeAdminAccess", func() {
+		It("removes the cluster-admin user", func() {
+			cluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {})
+			user, err := cmv1.NewUser().ID("cluster-admin").Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, test.FormatResource(user)),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf(
+						"/api/clusters_mgmt/v1/clusters/%s/groups/cluster-admins/users/cluster-admin", cluster.ID())),
+					RespondWithJSON(http.StatusNoContent, ""),
+				),
+			)
+			Expect(revokeAdminAccess(t.RosaRuntime, cluster)).To(Equal(deleted("Admin user", "cluster-admin")))
+		})
+
+		It("skips a cluster without the cluster-admin user", func() {
+			cluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {})
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusNotFound, notFound))
+			Expect(revokeAdminAccess(t.RosaRuntime, cluster)).To(Equal(
+				skipped("Admin user", "cluster-admin", "the cluster has no 'cluster-admin' user")))
+		})
+
+		It("revokes the break glass credentials of a cluster with external authentication", func() {
+			cluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.ExternalAuthConfig(cmv1.NewExternalAuthConfig().Enabled(true))
+			})
+			credential, err := cmv1.NewBreakGlassCredential().ID("credential").Username("admin").Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, test.FormatList([]*cmv1.BreakGlassCredential{credential},
+					cmv1.MarshalBreakGlassCredentialList, "BreakGlassCredentialList")),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf(
+						"/api/clusters_mgmt/v1/clusters/%s/break_glass_credentials", cluster.ID())),
+					RespondWithJSON(http.StatusNoContent, ""),
+				),
+			)
+			Expect(revokeAdminAccess(t.RosaRuntime, cluster)).To(Equal(
+				deleted("Break glass credentials", cluster.ID())))
+		})
+	})
+})
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
@@ -28,10 +29,12 @@ import (
 	"github.com/openshift/rosa/cmd/dlt/operatorrole"
 	uninstallLogs "github.com/openshift/rosa/cmd/logs/uninstall"
 	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/wait"
 )
 
 var args struct {
@@ -39,6 +42,12 @@ var args struct {
 	watch      bool
 	bestEffort bool
 	mode       string
+
+	// Delete the operator roles and the OIDC provider once the cluster is uninstalled
+	cascade           bool
+	deleteOidcConfig  bool
+	revokeAdminAccess bool
+	timeout           time.Duration
 }
 
 var Cmd = &cobra.Command{
@@ -46,7 +55,13 @@ var Cmd = &cobra.Command{
 	Short: "Delete cluster",
 	Long:  "Delete cluster.",
 	Example: `  # Delete a cluster named "mycluster"
-  rosa delete cluster --cluster=mycluster`,
+  rosa delete cluster --cluster=mycluster
+
+  # Delete a cluster and, once it is uninstalled, its operator roles and OIDC provider
+  rosa delete cluster --cluster=mycluster --cascade
+
+  # Also delete its reusable OIDC config, and revoke the admin access right away
+  rosa delete cluster --cluster=mycluster --cascade --delete-oidc-config --revoke-admin-access`,
 	Run:  run,
 	Args: cobra.NoArgs,
 }
@@ -74,6 +89,37 @@ func init() {
 		false,
 		"Watch cluster uninstallation logs.",
 	)
+
+	flags.BoolVar(
+		&args.cascade,
+		"cascade",
+		false,
+		"Wait for the cluster to be uninstalled and then delete its operator roles and OIDC provider. "+
+			"Resources that are still used by other clusters are kept.",
+	)
+
+	flags.BoolVar(
+		&args.deleteOidcConfig,
+		"delete-oidc-config",
+		false,
+		"Also delete the reusable OIDC config of the cluster when no other cluster uses it. "+
+			"Requires '--cascade'.",
+	)
+
+	flags.BoolVar(
+		&args.revokeAdminAccess,
+		"revoke-admin-access",
+		false,
+		"Before uninstalling, revoke the break glass credentials of a cluster with external "+
+			"authentication, or remove the 'cluster-admin' user, so that they stop working immediately.",
+	)
+
+	flags.DurationVar(
+		&args.timeout,
+		"timeout",
+		wait.DefaultTimeout,
+		"Maximum time to wait for the cluster to be uninstalled when using '--cascade'.",
+	)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -85,7 +131,7 @@ func run(_ *cobra.Command, _ []string) {
 	})
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 }
 
@@ -94,12 +140,19 @@ func runWithRuntime(
 	confirmFn func(string, ...interface{}) bool,
 	uninstallLogsFn func(string),
 ) error {
+	if args.deleteOidcConfig && !args.cascade {
+		return fmt.Errorf("the '--delete-oidc-config' flag requires '--cascade'")
+	}
+	if args.cascade && args.timeout <= 0 {
+		return fmt.Errorf("the '--timeout' flag must be a positive duration")
+	}
+
 	clusterKey := r.GetClusterKey()
 	cluster := r.FetchCluster()
 
 	if err := ensureDeleteProtectionDisabled(cluster, clusterKey); err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if args.bestEffort {
@@ -107,15 +160,50 @@ func runWithRuntime(
 			" in AWS account '%s'. These resources will need to be deleted manually.", clusterKey, r.Creator.AccountID)
 	}
 
-	if !confirmFn("delete cluster %s", clusterKey) {
+	cascade := args.cascade && ocm.IsSts(cluster)
+	if args.cascade && !cascade {
+		r.Reporter.Infof("Cluster '%s' doesn't use AWS STS, it has no operator roles or OIDC provider to delete",
+			clusterKey)
+	}
+	if cascade {
+		if !confirmFn("delete cluster %s and, once it is uninstalled, its operator roles and OIDC provider",
+			clusterKey) {
+			return nil
+		}
+	} else if !confirmFn("delete cluster %s", clusterKey) {
 		return nil
 	}
 
+	results := []cascadeResult{}
+	if args.revokeAdminAccess {
+		results = append(results, revokeAdminAccess(r, cluster))
+	}
+
 	err := handleClusterDelete(r, cluster, clusterKey, args.bestEffort)
 	if err != nil {
 		return fmt.Errorf("failed to delete cluster '%s': %w", clusterKey, err)
 	}
 
+	if cascade {
+		if args.watch {
+			arguments.DisableRegionDeprecationWarning = true // disable region deprecation warning
+			uninstallLogsFn(clusterKey)
+			arguments.DisableRegionDeprecationWarning = false // enable region deprecation again
+		}
+		r.Reporter.Infof("Waiting for cluster '%s' to be uninstalled", clusterKey)
+		err = waitForUninstall(r, cluster, clusterKey, args.timeout)
+		if err != nil {
+			r.Reporter.Infof("Once the cluster is uninstalled use the following commands to remove the "+
+				"remaining aws resources:\n%s", buildCommands(cluster))
+			return err
+		}
+		results = append(results, deleteClusterResources(r, cluster, args.deleteOidcConfig)...)
+		return printCascadeSummary(r, clusterKey, results)
+	}
+
+	// A failure to revoke the admin access doesn't prevent printing the clean up instructions:
+	summaryErr := printCascadeSummary(r, clusterKey, results)
+
 	if cluster.AWS().STS().RoleARN() != "" {
 		interactive.Enable()
 		r.Reporter.Infof(
@@ -147,7 +235,7 @@ func runWithRuntime(
 		)
 	}
 
-	return nil
+	return summaryErr
 }
 
 // ensureDeleteProtectionDisabled returns an error if delete protection is enabled on the cluster.
@@ -50,6 +50,9 @@ var _ = Describe("Delete cluster", func() {
 		clusterId = test.MockClusterID
 		args.bestEffort = false
 		args.watch = false
+		args.cascade = false
+		args.deleteOidcConfig = false
+		args.revokeAdminAccess = false
 		interactive.SetEnabled(false)
 		arguments.DisableRegionDeprecationWarning = false
 	})
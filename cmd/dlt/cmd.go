@@ -32,6 +32,7 @@ import (
 	"github.com/openshift/rosa/cmd/dlt/kubeletconfig"
 	"github.com/openshift/rosa/cmd/dlt/logforwarder"
 	"github.com/openshift/rosa/cmd/dlt/machinepool"
+	"github.com/openshift/rosa/cmd/dlt/network"
 	"github.com/openshift/rosa/cmd/dlt/ocmrole"
 	"github.com/openshift/rosa/cmd/dlt/oidcconfig"
 	"github.com/openshift/rosa/cmd/dlt/oidcprovider"
@@ -79,6 +80,7 @@ func init() {
 	logForwarderCommand := logforwarder.NewDeleteLogForwarderCommand()
 	Cmd.AddCommand(logForwarderCommand)
 	Cmd.AddCommand(externalauthprovider.Cmd)
+	Cmd.AddCommand(network.NewDeleteNetworkCommand())
 
 	flags := Cmd.PersistentFlags()
 	arguments.AddProfileFlag(flags)
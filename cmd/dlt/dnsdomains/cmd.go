@@ -22,6 +22,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -54,7 +55,7 @@ func run(_ *cobra.Command, argv []string) {
 	if err != nil {
 		r.Reporter.Errorf("Failed to delete dns domain '%s': %s",
 			id, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	r.Reporter.Infof("Successfully deleted dns domain '%s'", id)
 }
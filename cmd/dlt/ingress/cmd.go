@@ -24,6 +24,7 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -68,7 +69,7 @@ func run(_ *cobra.Command, argv []string) {
 			"Ingress identifier '%s' isn't valid: it must contain between three and five lowercase letters or digits",
 			ingressID,
 		)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	clusterKey := r.GetClusterKey()
@@ -79,7 +80,7 @@ func run(_ *cobra.Command, argv []string) {
 	ingresses, err := r.OCMClient.GetIngresses(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get ingresses for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	var ingress *cmv1.Ingress
@@ -105,7 +106,7 @@ func run(_ *cobra.Command, argv []string) {
 		if err != nil {
 			r.Reporter.Errorf("Failed to delete ingress '%s' on cluster '%s': %s",
 				ingress.ID(), clusterKey, err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		r.Reporter.Infof("Successfully deleted ingress '%s' from cluster '%s'", ingressID, clusterKey)
 	}
@@ -0,0 +1,140 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cfTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/network"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use     = "network"
+	short   = "Delete a network stack"
+	long    = "Delete a CloudFormation stack created with 'rosa create network', unless a cluster uses its subnets."
+	example = `  # Delete the network stack named "mynetwork"
+  rosa delete network mynetwork
+
+  # Delete the network stack and wait until all its resources are deleted
+  rosa delete network mynetwork --watch --yes`
+)
+
+var aliases = []string{"networks"}
+
+var confirmFn = confirm.Confirm
+
+type deleteOptions struct {
+	name  string
+	watch bool
+}
+
+func NewDeleteNetworkCommand() *cobra.Command {
+	options := &deleteOptions{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Aliases: aliases,
+		Args:    cobra.MaximumNArgs(1),
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), DeleteNetworkRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(
+		&options.name,
+		"name",
+		"",
+		"Name of the network stack to delete",
+	)
+	flags.BoolVarP(
+		&options.watch,
+		"watch",
+		"w",
+		false,
+		"Watch the deletion of the resources of the stack until it completes.",
+	)
+	return cmd
+}
+
+func DeleteNetworkRunner(options *deleteOptions) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
+		name := options.name
+		if len(argv) == 1 && !cmd.Flag("name").Changed {
+			name = argv[0]
+		}
+		if name == "" {
+			return fmt.Errorf("Network stack name is required. Specify it as an argument or use the --name flag")
+		}
+
+		stack, err := network.GetStack(ctx, r.AWSClient, name)
+		if err != nil {
+			return err
+		}
+		resources, err := network.ListStackResources(ctx, r.AWSClient, stack.ID)
+		if err != nil {
+			return err
+		}
+
+		subnets := network.SubnetIDs(stack, resources)
+		r.Reporter.Debugf("Checking if any cluster uses the subnets of stack '%s': %s",
+			stack.Name, strings.Join(subnets, ", "))
+		clusters, err := r.OCMClient.GetClustersUsingSubnets(r.AWSClient.GetRegion(), subnets)
+		if err != nil {
+			return fmt.Errorf("Failed to check the clusters using the subnets of stack '%s': %v", stack.Name, err)
+		}
+		if len(clusters) > 0 {
+			names := make([]string, len(clusters))
+			for i, cluster := range clusters {
+				names[i] = fmt.Sprintf("'%s' (%s)", cluster.Name(), cluster.ID())
+			}
+			return fmt.Errorf("Network stack '%s' can't be deleted because its subnets are used by clusters %s",
+				stack.Name, strings.Join(names, ", "))
+		}
+
+		if stack.Status == string(cfTypes.StackStatusDeleteInProgress) {
+			r.Reporter.Infof("Network stack '%s' is already being deleted", stack.Name)
+		} else {
+			if !confirmFn("delete network stack %s", stack.Name) {
+				return nil
+			}
+			err = r.AWSClient.DeleteCFStack(ctx, stack.ID)
+			if err != nil {
+				return fmt.Errorf("Failed to delete network stack '%s': %v", stack.Name, err)
+			}
+			r.Reporter.Infof("Network stack '%s' will start deleting now", stack.Name)
+		}
+
+		if !options.watch {
+			r.Reporter.Infof("To watch the deletion, run 'rosa delete network %s --watch'", stack.Name)
+			return nil
+		}
+		err = network.WatchStackDeletion(ctx, r.AWSClient, stack.ID, r.Reporter)
+		if err != nil {
+			return err
+		}
+		r.Reporter.Infof("Network stack '%s' has been deleted", stack.Name)
+		return nil
+	}
+}
@@ -0,0 +1,98 @@
+package network
+
+import (
+	"context"
+	"net/http"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cfTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Delete network", func() {
+	It("Creates the command correctly", func() {
+		cmd := NewDeleteNetworkCommand()
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Flags().Lookup("name")).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("watch")).NotTo(BeNil())
+	})
+})
+
+var _ = Describe("Delete network runner", func() {
+	const stackID = "arn:aws:cloudformation:us-east-1:123:stack/net/1"
+
+	var (
+		t          *TestingRuntime
+		mockClient *aws.MockClient
+		options    *deleteOptions
+		stack      cfTypes.Stack
+		resources  []cfTypes.StackResource
+	)
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+		mockClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+		t.RosaRuntime.AWSClient = mockClient
+		options = &deleteOptions{name: "net"}
+		confirmFn = func(string, ...interface{}) bool { return true }
+
+		stack = cfTypes.Stack{
+			StackName:   awssdk.String("net"),
+			StackId:     awssdk.String(stackID),
+			StackStatus: cfTypes.StackStatusCreateComplete,
+			Tags: []cfTypes.Tag{{
+				Key:   awssdk.String(tags.NetworkTemplate),
+				Value: awssdk.String("rosa-quickstart-default-vpc"),
+			}},
+		}
+		resources = []cfTypes.StackResource{{
+			LogicalResourceId:  awssdk.String("SubnetPrivate1"),
+			PhysicalResourceId: awssdk.String("subnet-1"),
+			ResourceType:       awssdk.String("AWS::EC2::Subnet"),
+		}}
+		mockClient.EXPECT().GetCFStack(gomock.Any(), "net").Return(&stack, nil)
+		mockClient.EXPECT().DescribeCFStackResources(gomock.Any(), stackID).Return(&resources, nil)
+		mockClient.EXPECT().GetRegion().Return("us-east-1").AnyTimes()
+	})
+
+	AfterEach(func() {
+		confirmFn = confirm.Confirm
+	})
+
+	It("Refuses to delete a stack whose subnets are used by a cluster", func() {
+		cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.AWS(cmv1.NewAWS().SubnetIDs("subnet-0", "subnet-1"))
+		})
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+
+		err := DeleteNetworkRunner(options)(context.Background(), t.RosaRuntime, NewDeleteNetworkCommand(), nil)
+		Expect(err).To(MatchError(ContainSubstring(
+			"Network stack 'net' can't be deleted because its subnets are used by clusters 'cluster' (")))
+	})
+
+	It("Deletes the stack and watches the deletion", func() {
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{})))
+		deleted := stack
+		deleted.StackStatus = cfTypes.StackStatusDeleteComplete
+		mockClient.EXPECT().DeleteCFStack(gomock.Any(), stackID).Return(nil)
+		mockClient.EXPECT().GetCFStack(gomock.Any(), stackID).Return(&deleted, nil)
+		options.watch = true
+
+		t.StdOutReader.Record()
+		err := DeleteNetworkRunner(options)(context.Background(), t.RosaRuntime, NewDeleteNetworkCommand(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		stdOut, _ := t.StdOutReader.Read()
+		Expect(stdOut).To(Equal("" +
+			"INFO: Network stack 'net' will start deleting now\n" +
+			"INFO: Network stack 'net' has been deleted\n"))
+	})
+})
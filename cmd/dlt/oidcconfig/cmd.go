@@ -19,7 +19,6 @@ package oidcconfig
 import (
 	"fmt"
 	"os"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -31,6 +30,7 @@ import (
 	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/aws"
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	interactiveOidc "github.com/openshift/rosa/pkg/interactive/oidc"
@@ -50,8 +50,7 @@ var Cmd = &cobra.Command{
 
 const (
 	//nolint
-	OidcConfigIdFlag          = "oidc-config-id"
-	prefixForPrivateKeySecret = "rosa-private-key-"
+	OidcConfigIdFlag = "oidc-config-id"
 )
 
 var args struct {
@@ -82,14 +81,14 @@ func run(cmd *cobra.Command, _ []string) {
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	// Get AWS region
 	region, err := aws.GetRegion(arguments.GetRegion())
 	if err != nil {
 		r.Reporter.Errorf("Error getting region: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	args.region = region
 
@@ -102,7 +101,7 @@ func run(cmd *cobra.Command, _ []string) {
 		mode, err = interactive.GetOptionMode(cmd, mode, "OIDC Config deletion mode")
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid OIDC provider creation mode: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -114,7 +113,7 @@ func run(cmd *cobra.Command, _ []string) {
 	oidcConfigStrategy, err := getOidcConfigStrategy(mode, oidcConfigInput)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	oidcConfigStrategy.execute(r)
 	arguments.DisableRegionDeprecationWarning = true // disable region deprecation warning
@@ -141,7 +140,7 @@ func buildOidcConfigInput(r *rosa.Runtime) OidcConfigInput {
 	oidcConfig, err := r.OCMClient.GetOidcConfig(args.oidcConfigId)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem retrieving the OIDC Config '%s': %v", args.oidcConfigId, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	secretArn := oidcConfig.SecretArn()
 	bucketName := ""
@@ -150,20 +149,12 @@ func buildOidcConfigInput(r *rosa.Runtime) OidcConfigInput {
 		if args.region != parsedSecretArn.Region {
 			r.Reporter.Errorf("Secret region '%s' differs from chosen region '%s', "+
 				"please run the command supplying region parameter.", parsedSecretArn.Region, args.region)
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
-		secretResourceName, err := aws.GetResourceIdFromSecretArn(secretArn)
+		bucketName, err = aws.GetBucketNameFromSecretArn(secretArn)
 		if err != nil {
 			r.Reporter.Errorf("There was a problem parsing secret ARN '%s' : %v", secretArn, err)
-			os.Exit(1)
-		}
-		// The secret when creating from ROSA options has the following format
-		// rosa-private-key-<prefix>-oidc-<random-hash-length-4>-<random-aws-created-hash>
-		// The bucket is expected to be <prefix>-oidc-<random-hash-length-4>
-		bucketName = strings.TrimPrefix(secretResourceName, prefixForPrivateKeySecret)
-		index := strings.LastIndex(bucketName, "-")
-		if index != -1 {
-			bucketName = bucketName[:index]
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -171,7 +162,7 @@ func buildOidcConfigInput(r *rosa.Runtime) OidcConfigInput {
 	hasClusterUsingOidcConfig, err := r.OCMClient.HasAClusterUsingOidcEndpointUrl(issuerUrl)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem checking if any clusters are using OIDC config '%s' : %v", issuerUrl, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if hasClusterUsingOidcConfig {
 		r.Reporter.Errorf("There are clusters using OIDC config '%s', can't delete the configuration", issuerUrl)
@@ -207,12 +198,12 @@ func (s *deleteUnmanagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) {
 	err := r.AWSClient.DeleteSecretInSecretsManager(privateKeySecretArn)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem deleting private key from secrets manager: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	err = r.AWSClient.DeleteS3Bucket(bucketName)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem deleting S3 bucket '%s': %s", bucketName, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if spin != nil {
 		spin.Stop()
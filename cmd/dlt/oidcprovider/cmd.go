@@ -24,6 +24,7 @@ import (
 	errors "github.com/zgalor/weberr"
 
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/helper"
 	urlHelper "github.com/openshift/rosa/pkg/helper/url"
 	"github.com/openshift/rosa/pkg/interactive"
@@ -89,7 +90,7 @@ func run(cmd *cobra.Command, argv []string) {
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	// Determine if interactive mode is needed
@@ -102,7 +103,7 @@ func run(cmd *cobra.Command, argv []string) {
 		mode, err = interactive.GetOptionMode(cmd, mode, "OIDC provider deletion mode")
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid OIDC provider deletion mode: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -119,7 +120,7 @@ func run(cmd *cobra.Command, argv []string) {
 				os.Exit(1)
 			}
 			r.Reporter.Errorf("Error validating cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		if sub != nil {
@@ -129,10 +130,10 @@ func run(cmd *cobra.Command, argv []string) {
 		if err != nil {
 			if errors.GetType(err) != errors.NotFound {
 				r.Reporter.Errorf("Error validating cluster '%s': %v", clusterKey, err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			} else if sub == nil {
 				r.Reporter.Errorf("Failed to get cluster '%s': %v", r.ClusterKey, err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 
 		}
@@ -145,7 +146,7 @@ func run(cmd *cobra.Command, argv []string) {
 		providerArn, err = r.AWSClient.GetOpenIDConnectProviderByClusterIdTag(sub.ClusterID())
 		if err != nil {
 			r.Reporter.Errorf("Failed to get the OIDC provider for cluster '%s'.", clusterKey)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		if providerArn == "" {
 			r.Reporter.Infof("Cluster '%s' doesn't have OIDC provider associated with it. "+
@@ -164,23 +165,23 @@ func run(cmd *cobra.Command, argv []string) {
 			oidcConfig, err := r.OCMClient.GetOidcConfig(args.oidcConfigId)
 			if err != nil {
 				r.Reporter.Errorf("There was a problem retrieving OIDC Config '%s': %v", args.oidcConfigId, err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			oidcEndpointUrl = oidcConfig.IssuerUrl()
 		}
 		parsedURI, err := urlHelper.ParseRequestURI(oidcEndpointUrl)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid OIDC endpoint URL '%s': %v", oidcEndpointUrl, err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		if parsedURI.Scheme != helper.ProtocolHttps {
 			r.Reporter.Errorf("Expected OIDC endpoint URL '%s' to use an https:// scheme", oidcEndpointUrl)
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		providerArn, err = r.AWSClient.GetOpenIDConnectProviderByOidcEndpointUrl(oidcEndpointUrl)
 		if err != nil {
 			r.Reporter.Errorf("Failed to get the OIDC provider for endpoint URL '%s': %v", oidcEndpointUrl, err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		if providerArn == "" {
 			r.Reporter.Infof("Provider '%s' not found.", oidcEndpointUrl)
@@ -192,7 +193,7 @@ func run(cmd *cobra.Command, argv []string) {
 		if err != nil {
 			r.Reporter.Errorf("There was a problem checking if any clusters are using OIDC provider '%s' : %v",
 				oidcEndpointUrl, err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		if hasClusterUsingOidcProvider {
 			r.Reporter.Errorf("There are clusters using OIDC config '%s', can't delete the provider", oidcEndpointUrl)
@@ -208,7 +209,7 @@ func run(cmd *cobra.Command, argv []string) {
 		err := r.AWSClient.DeleteOpenIDConnectProvider(providerArn)
 		if err != nil {
 			r.Reporter.Errorf("There was an error deleting the OIDC provider: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		r.Reporter.Infof("Successfully deleted the OIDC provider %s", providerArn)
 	case interactive.ModeManual:
@@ -220,7 +221,7 @@ func run(cmd *cobra.Command, argv []string) {
 		fmt.Println(commands)
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 }
 
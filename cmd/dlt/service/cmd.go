@@ -24,6 +24,7 @@ import (
 	msv1 "github.com/openshift-online/ocm-sdk-go/servicemgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -62,7 +63,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if args.ID == "" {
 		r.Reporter.Errorf("id not specified.")
 		cmd.Help()
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if !confirm.Confirm("delete service with id '%s'", args.ID) {
@@ -74,14 +75,14 @@ func run(cmd *cobra.Command, _ []string) {
 	service, err := r.OCMClient.GetManagedService(ocm.DescribeManagedServiceArgs{ID: args.ID})
 	if err != nil {
 		r.Reporter.Errorf("Failed to get Managed Service: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	r.Reporter.Debugf("Deleting service with id %q", args.ID)
 	_, err = r.OCMClient.DeleteManagedService(args)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	r.Reporter.Infof("Service %q will start uninstalling now", args.ID)
 
@@ -24,6 +24,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/cobra/doc"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -82,7 +83,7 @@ func run(cmd *cobra.Command, _ []string) {
 	r := rosa.NewRuntime()
 	if err != nil {
 		r.Reporter.Errorf("Failed to generate documents: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	r.Reporter.Infof("Documents generated successfully on '%s'", args.dir)
@@ -0,0 +1,373 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openshift/rosa/cmd/verify/oc"
+	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/config"
+	"github.com/openshift/rosa/pkg/doctor"
+	"github.com/openshift/rosa/pkg/helper"
+	"github.com/openshift/rosa/pkg/info"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/version"
+)
+
+// tokenExpiryWarning is how long before the expiry of the OCM token the check starts warning
+const tokenExpiryWarning = 7 * 24 * time.Hour
+
+// elbServiceLinkedRole is the role that AWS creates the first time a load balancer is created in
+// the account, which the installer needs to exist
+const elbServiceLinkedRole = "AWSServiceRoleForElasticLoadBalancing"
+
+// The functions used to reach outside of the runtime, replaced in the tests
+var (
+	loadConfig      = config.Load
+	newRosaVersion  = version.NewRosaVersion
+	ocVersionOutput = oc.VersionOutput
+)
+
+// newChecks creates the clients used by the checks and returns the checks. The clients are
+// created beforehand because the checks run concurrently, and the checks that need a client that
+// couldn't be created are skipped.
+func newChecks(r *rosa.Runtime) []doctor.Check {
+	ocmErr := connectOCM(r)
+	awsErr := connectAWS(r)
+	withOCM := func(run func(context.Context) doctor.Result) func(context.Context) doctor.Result {
+		if ocmErr != nil {
+			return skip("the OCM login failed")
+		}
+		return run
+	}
+	withAWS := func(run func(context.Context) doctor.Result) func(context.Context) doctor.Result {
+		if awsErr != nil {
+			return skip("the AWS credentials failed")
+		}
+		return run
+	}
+	withBoth := func(run func(context.Context) doctor.Result) func(context.Context) doctor.Result {
+		return withOCM(withAWS(run))
+	}
+
+	return []doctor.Check{
+		{Name: "OCM login", Run: checkOCMLogin(r, ocmErr)},
+		{Name: "OCM token", Run: checkOCMToken},
+		{Name: "AWS credentials", Run: checkAWSCredentials(r, awsErr)},
+		{Name: "ROSA CLI", Run: checkROSAVersion},
+		{Name: "OpenShift CLI", Run: checkOCVersion},
+		{Name: "AWS permissions", Run: withBoth(checkPermissions(r))},
+		{Name: "AWS quota", Run: withAWS(checkQuota(r))},
+		{Name: "Account roles", Run: withAWS(checkAccountRoles(r))},
+		{Name: "OCM role", Run: withBoth(checkOCMRole(r))},
+		{Name: "User role", Run: withBoth(checkUserRole(r))},
+		{Name: "ELB service-linked role", Run: withAWS(checkELBServiceLinkedRole(r))},
+		{Name: "Hosted control planes", Run: withBoth(checkHostedCPRegion(r))},
+	}
+}
+
+func skip(reason string) func(context.Context) doctor.Result {
+	return func(context.Context) doctor.Result {
+		return doctor.Skip("Skipped because %s", reason)
+	}
+}
+
+func connectOCM(r *rosa.Runtime) error {
+	if r.OCMClient != nil {
+		return nil
+	}
+	var err error
+	r.OCMClient, err = ocm.NewClient().
+		Logger(r.Logger).
+		Build()
+	return err
+}
+
+func connectAWS(r *rosa.Runtime) error {
+	if r.AWSClient != nil {
+		return nil
+	}
+	region, err := aws.GetRegion(arguments.GetRegion())
+	if err != nil {
+		return err
+	}
+	r.AWSClient, err = aws.NewClient().
+		Logger(r.Logger).
+		Region(region).
+		Build()
+	return err
+}
+
+func checkOCMLogin(r *rosa.Runtime, ocmErr error) func(context.Context) doctor.Result {
+	return func(context.Context) doctor.Result {
+		if ocmErr != nil {
+			return doctor.Fail("rosa login", "%v", ocmErr)
+		}
+		account, err := r.OCMClient.GetCurrentAccount()
+		if err != nil {
+			return doctor.Fail("rosa login", "Failed to get the current account: %v", err)
+		}
+		return doctor.Pass("Logged in as '%s' of organization '%s'", account.Username(),
+			account.Organization().Name())
+	}
+}
+
+func checkOCMToken(context.Context) doctor.Result {
+	cfg, err := loadConfig()
+	if err != nil {
+		return doctor.Fail("rosa login", "Failed to load the configuration: %v", err)
+	}
+	if cfg == nil {
+		return doctor.Fail("rosa login", "Not logged in")
+	}
+	expiry, err := cfg.TokenExpiry()
+	if err != nil {
+		return doctor.Fail("rosa login", "%v", err)
+	}
+	if expiry.IsZero() {
+		return doctor.Pass("The credentials don't expire")
+	}
+	left := time.Until(expiry)
+	if left <= 0 {
+		return doctor.Fail("rosa login", "The token expired on %s", expiry.Format(time.RFC3339))
+	}
+	if left < tokenExpiryWarning {
+		return doctor.Warn("rosa login", "The token expires in %s", left.Round(time.Minute))
+	}
+	return doctor.Pass("The token expires on %s", expiry.Format(time.RFC3339))
+}
+
+func checkAWSCredentials(r *rosa.Runtime, awsErr error) func(context.Context) doctor.Result {
+	return func(context.Context) doctor.Result {
+		if awsErr != nil {
+			return doctor.Fail("aws configure", "%v", awsErr)
+		}
+		creator := r.Creator
+		if creator == nil {
+			var err error
+			creator, err = r.AWSClient.GetCreator()
+			if err != nil {
+				return doctor.Fail("aws configure", "Failed to get the AWS caller identity: %v", err)
+			}
+		}
+		return doctor.Pass("Using AWS account '%s' in region '%s' as '%s'", creator.AccountID,
+			r.AWSClient.GetRegion(), creator.ARN)
+	}
+}
+
+func checkROSAVersion(context.Context) doctor.Result {
+	rosaVersion, err := newRosaVersion()
+	if err != nil {
+		return doctor.Warn("", "Failed to check the latest version: %v", err)
+	}
+	latestVersion, isLatest, err := rosaVersion.IsLatest(info.DefaultVersion)
+	if err != nil {
+		return doctor.Warn("", "Failed to check the latest version: %v", err)
+	}
+	if !isLatest {
+		return doctor.Warn(fmt.Sprintf("Download it from %s", version.ConsoleLatestFolder),
+			"Version '%s' is available, the current version is '%s'", latestVersion, info.DefaultVersion)
+	}
+	return doctor.Pass("Version '%s' is the latest", info.DefaultVersion)
+}
+
+func checkOCVersion(ctx context.Context) doctor.Result {
+	output, err := ocVersionOutput(ctx)
+	if output == nil && err != nil {
+		return doctor.Warn("rosa download oc", "The OpenShift command-line tool is not installed")
+	}
+	if err != nil {
+		return doctor.Warn("rosa download oc", "Failed to get the version: %v", err)
+	}
+	ocVersion, supported := oc.ParseVersion(output)
+	if !supported {
+		return doctor.Warn("rosa download oc", "%s isn't supported", ocVersion)
+	}
+	return doctor.Pass("%s", ocVersion)
+}
+
+func checkPermissions(r *rosa.Runtime) func(context.Context) doctor.Result {
+	return func(context.Context) doctor.Result {
+		policies, err := r.OCMClient.GetPolicies("OSDSCPPolicy")
+		if err != nil {
+			return doctor.Fail("rosa verify permissions", "Failed to get the SCP policies: %v", err)
+		}
+		ok, err := r.AWSClient.ValidateSCP(nil, policies)
+		if err != nil {
+			return doctor.Fail("Make sure that an organizational SCP doesn't prevent the checks",
+				"Failed to validate the SCP policies: %v", err)
+		}
+		if !ok {
+			return doctor.Warn("rosa verify permissions", "The SCP policies may prevent non-STS installations")
+		}
+		return doctor.Pass("The SCP policies allow the installation")
+	}
+}
+
+func checkQuota(r *rosa.Runtime) func(context.Context) doctor.Result {
+	return func(context.Context) doctor.Result {
+		reports := r.AWSClient.VerifyQuotas(aws.QuotaRequirements, aws.ClassicTopology,
+			aws.ClusterSizes[aws.DefaultClusterSize])
+		insufficient := []string{}
+		errors := 0
+		for _, report := range reports {
+			switch report.Status {
+			case aws.QuotaStatusInsufficient:
+				insufficient = append(insufficient, report.QuotaName)
+			case aws.QuotaStatusError:
+				errors++
+			}
+		}
+		if len(insufficient) > 0 {
+			return doctor.Fail("rosa verify quota --request-increase", "Insufficient quota: %s",
+				strings.Join(insufficient, ", "))
+		}
+		if errors > 0 {
+			return doctor.Warn("rosa verify quota", "Failed to verify %d of the quotas", errors)
+		}
+		return doctor.Pass("The %d quotas are enough for a %s cluster", len(reports), aws.DefaultClusterSize)
+	}
+}
+
+func checkAccountRoles(r *rosa.Runtime) func(context.Context) doctor.Result {
+	return func(context.Context) doctor.Result {
+		roles, err := r.AWSClient.ListAccountRoles("")
+		if err != nil {
+			return doctor.Fail("rosa list account-roles", "Failed to list the account roles: %v", err)
+		}
+		prefixes := map[string]bool{}
+		for _, role := range roles {
+			if role.RoleType == aws.InstallerAccountRoleType {
+				prefixes[role.RolePrefix] = true
+			}
+		}
+		if len(prefixes) == 0 {
+			return doctor.Fail("rosa create account-roles --mode auto", "There are no account roles")
+		}
+		return doctor.Pass("Found the account roles with prefix %s", quoteSorted(prefixes))
+	}
+}
+
+func checkOCMRole(r *rosa.Runtime) func(context.Context) doctor.Result {
+	return func(context.Context) doctor.Result {
+		roles, err := r.AWSClient.ListOCMRoles()
+		if err != nil {
+			return doctor.Fail("rosa list ocm-roles", "Failed to list the OCM roles: %v", err)
+		}
+		if len(roles) == 0 {
+			return doctor.Fail("rosa create ocm-role", "There are no OCM roles")
+		}
+		orgID, _, err := r.OCMClient.GetCurrentOrganization()
+		if err != nil {
+			return doctor.Fail("rosa login", "Failed to get the current organization: %v", err)
+		}
+		linkedRoles, err := r.OCMClient.GetOrganizationLinkedOCMRoles(orgID)
+		if err != nil {
+			return doctor.Fail("rosa list ocm-roles", "Failed to get the linked OCM roles: %v", err)
+		}
+		linked := helper.SliceToMap(linkedRoles)
+		for _, role := range roles {
+			if linked[role.RoleARN] {
+				return doctor.Pass("Role '%s' is linked to organization '%s'", role.RoleARN, orgID)
+			}
+		}
+		return doctor.Fail(fmt.Sprintf("rosa link ocm-role --role-arn %s", roles[0].RoleARN),
+			"None of the OCM roles is linked to organization '%s'", orgID)
+	}
+}
+
+func checkUserRole(r *rosa.Runtime) func(context.Context) doctor.Result {
+	return func(context.Context) doctor.Result {
+		roles, err := r.AWSClient.ListUserRoles()
+		if err != nil {
+			return doctor.Fail("rosa list user-roles", "Failed to list the user roles: %v", err)
+		}
+		if len(roles) == 0 {
+			return doctor.Fail("rosa create user-role", "There are no user roles")
+		}
+		account, err := r.OCMClient.GetCurrentAccount()
+		if err != nil {
+			return doctor.Fail("rosa login", "Failed to get the current account: %v", err)
+		}
+		linkedRoles, err := r.OCMClient.GetAccountLinkedUserRoles(account.ID())
+		if err != nil {
+			return doctor.Fail("rosa list user-roles", "Failed to get the linked user roles: %v", err)
+		}
+		linked := helper.SliceToMap(linkedRoles)
+		for _, role := range roles {
+			if linked[role.RoleARN] {
+				return doctor.Pass("Role '%s' is linked to user '%s'", role.RoleARN, account.Username())
+			}
+		}
+		return doctor.Fail(fmt.Sprintf("rosa link user-role --role-arn %s", roles[0].RoleARN),
+			"None of the user roles of the AWS account is linked to user '%s'", account.Username())
+	}
+}
+
+func checkELBServiceLinkedRole(r *rosa.Runtime) func(context.Context) doctor.Result {
+	return func(context.Context) doctor.Result {
+		exists, _, err := r.AWSClient.CheckRoleExists(elbServiceLinkedRole)
+		if err != nil {
+			return doctor.Fail("", "Failed to get role '%s': %v", elbServiceLinkedRole, err)
+		}
+		if !exists {
+			return doctor.Fail("aws iam create-service-linked-role --aws-service-name "+
+				"elasticloadbalancing.amazonaws.com", "Role '%s' doesn't exist", elbServiceLinkedRole)
+		}
+		return doctor.Pass("Role '%s' exists", elbServiceLinkedRole)
+	}
+}
+
+func checkHostedCPRegion(r *rosa.Runtime) func(context.Context) doctor.Result {
+	return func(context.Context) doctor.Result {
+		region := r.AWSClient.GetRegion()
+		regions, err := r.OCMClient.GetFilteredRegionsByVersion("", "", r.AWSClient, "")
+		if err != nil {
+			return doctor.Fail("rosa list regions", "Failed to list the regions: %v", err)
+		}
+		for _, cloudRegion := range regions {
+			if cloudRegion.ID() != region {
+				continue
+			}
+			if !cloudRegion.Enabled() {
+				return doctor.Fail("rosa list regions", "Region '%s' isn't enabled", region)
+			}
+			if !cloudRegion.SupportsHypershift() {
+				return doctor.Warn("rosa list regions --hosted-cp",
+					"Region '%s' doesn't support hosted control planes", region)
+			}
+			return doctor.Pass("Region '%s' supports hosted control planes", region)
+		}
+		return doctor.Fail("rosa list regions", "Region '%s' isn't available for the AWS account", region)
+	}
+}
+
+func quoteSorted(set map[string]bool) string {
+	values := make([]string, 0, len(set))
+	for value := range set {
+		values = append(values, fmt.Sprintf("'%s'", value))
+	}
+	sort.Strings(values)
+	return strings.Join(values, ", ")
+}
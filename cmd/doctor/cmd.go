@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/doctor"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "doctor"
+	short = "Check that the environment and the accounts are ready to create clusters"
+	long  = "Check the tools, the OCM login, the AWS credentials, permissions and quota, the account, " +
+		"OCM and user roles and the links between the AWS account and the OCM organization and user, " +
+		"all at once. Every check passes, warns or fails, and the ones that don't pass include the " +
+		"command that fixes them. The command fails when any check fails, so it can be used to gate " +
+		"CI pipelines."
+	example = `  # Check the readiness of the environment and of the accounts
+  rosa doctor
+
+  # Check the readiness of another region, as JSON
+  rosa doctor --region us-west-2 -o json`
+)
+
+var resultsTable = output.Table[doctor.Result]{
+	Columns: []output.Column[doctor.Result]{
+		{Header: "STATUS", Value: func(r doctor.Result) string { return strings.ToUpper(string(r.Status)) }},
+		{Header: "CHECK", Value: func(r doctor.Result) string { return r.Check }},
+		{Header: "MESSAGE", Value: func(r doctor.Result) string { return r.Message }},
+		{Header: "REMEDIATION", Value: func(r doctor.Result) string { return r.Remediation }},
+	},
+	Name: func(r doctor.Result) string { return r.Check },
+}
+
+func NewRosaDoctorCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Run:     rosa.DefaultRunner(rosa.DefaultRuntime(), DoctorRunner()),
+		Args:    cobra.NoArgs,
+	}
+	output.AddTableFlags(cmd)
+	flags := cmd.PersistentFlags()
+	arguments.AddProfileFlag(flags)
+	arguments.AddRegionFlag(flags)
+	return cmd
+}
+
+func DoctorRunner() rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		results := doctor.Run(ctx, newChecks(r))
+		err := resultsTable.Print(results)
+		if err != nil {
+			return err
+		}
+		failures := doctor.Count(results, doctor.StatusFail)
+		if failures > 0 {
+			return fmt.Errorf("%d of the %d checks failed", failures, len(results))
+		}
+		return nil
+	}
+}
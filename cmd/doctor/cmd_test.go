@@ -0,0 +1,152 @@
+package doctor
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	amsv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/cmd/verify/oc"
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/config"
+	"github.com/openshift/rosa/pkg/doctor"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+const ocmRoleArn = "arn:aws:iam::123456789012:role/ManagedOpenShift-OCM-Role-1234"
+
+const userRoleArn = "arn:aws:iam::123456789012:role/ManagedOpenShift-User-foo-Role"
+
+var _ = Describe("rosa doctor", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewRosaDoctorCommand()
+		Expect(cmd).NotTo(BeNil())
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Run).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("output")).NotTo(BeNil())
+		for _, flag := range []string{"profile", "region"} {
+			Expect(cmd.PersistentFlags().Lookup(flag)).NotTo(BeNil())
+		}
+	})
+
+	Context("Checks", func() {
+		var (
+			t       *TestingRuntime
+			mockAWS *aws.MockClient
+		)
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			mockAWS = t.RosaRuntime.AWSClient.(*aws.MockClient)
+		})
+
+		It("Uses the clients of the runtime", func() {
+			checks := newChecks(t.RosaRuntime)
+			Expect(checks).To(HaveLen(12))
+			Expect(checks[0].Name).To(Equal("OCM login"))
+		})
+
+		It("Warns when the OCM token is about to expire", func() {
+			loadConfig = func() (*config.Config, error) {
+				return &config.Config{RefreshToken: MakeTokenString("Refresh", 2*time.Hour)}, nil
+			}
+			DeferCleanup(func() { loadConfig = config.Load })
+
+			result := checkOCMToken(context.Background())
+			Expect(result.Status).To(Equal(doctor.StatusWarn))
+			Expect(result.Message).To(Equal("The token expires in 2h0m0s"))
+			Expect(result.Remediation).To(Equal("rosa login"))
+		})
+
+		It("Fails when the OCM token expired", func() {
+			loadConfig = func() (*config.Config, error) {
+				return &config.Config{RefreshToken: MakeTokenString("Refresh", -time.Hour)}, nil
+			}
+			DeferCleanup(func() { loadConfig = config.Load })
+
+			result := checkOCMToken(context.Background())
+			Expect(result.Status).To(Equal(doctor.StatusFail))
+			Expect(result.Message).To(HavePrefix("The token expired on "))
+		})
+
+		It("Warns when the OpenShift CLI isn't supported", func() {
+			ocVersionOutput = func(context.Context) ([]byte, error) {
+				return []byte("Client Version: 3.11.0\n"), nil
+			}
+			DeferCleanup(func() { ocVersionOutput = oc.VersionOutput })
+
+			result := checkOCVersion(context.Background())
+			Expect(result).To(Equal(doctor.Warn("rosa download oc", "Client Version: 3.11.0 isn't supported")))
+		})
+
+		It("Fails with the insufficient quotas", func() {
+			mockAWS.EXPECT().VerifyQuotas(aws.QuotaRequirements, aws.ClassicTopology,
+				aws.ClusterSizes[aws.DefaultClusterSize]).Return([]*aws.QuotaReport{
+				{QuotaName: "Running On-Demand Standard instances", Status: aws.QuotaStatusInsufficient},
+				{QuotaName: "EC2-VPC Elastic IPs", Status: aws.QuotaStatusOK},
+			})
+
+			result := checkQuota(t.RosaRuntime)(context.Background())
+			Expect(result).To(Equal(doctor.Fail("rosa verify quota --request-increase",
+				"Insufficient quota: Running On-Demand Standard instances")))
+		})
+
+		It("Fails when there are no account roles", func() {
+			mockAWS.EXPECT().ListAccountRoles("").Return([]aws.Role{
+				{RoleType: aws.SupportAccountRoleType, RolePrefix: "ManagedOpenShift"},
+			}, nil)
+
+			result := checkAccountRoles(t.RosaRuntime)(context.Background())
+			Expect(result).To(Equal(doctor.Fail("rosa create account-roles --mode auto", "There are no account roles")))
+		})
+
+		It("Fails with the link command when the OCM role isn't linked to the organization", func() {
+			mockAWS.EXPECT().ListOCMRoles().Return([]aws.Role{{RoleARN: ocmRoleArn}}, nil)
+			account, err := amsv1.NewAccount().ID("account-1").Username("foo").
+				Organization(amsv1.NewOrganization().ID("org-1")).Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatResource(account)))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusNotFound, "{}"))
+
+			result := checkOCMRole(t.RosaRuntime)(context.Background())
+			Expect(result).To(Equal(doctor.Fail("rosa link ocm-role --role-arn "+ocmRoleArn,
+				"None of the OCM roles is linked to organization 'org-1'")))
+		})
+
+		It("Fails with the link command when the user role isn't linked to the user", func() {
+			mockAWS.EXPECT().ListUserRoles().Return([]aws.Role{{RoleARN: userRoleArn}}, nil)
+			account, err := amsv1.NewAccount().ID("account-1").Username("foo").Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatResource(account)))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusNotFound, "{}"))
+
+			result := checkUserRole(t.RosaRuntime)(context.Background())
+			Expect(result).To(Equal(doctor.Fail("rosa link user-role --role-arn "+userRoleArn,
+				"None of the user roles of the AWS account is linked to user 'foo'")))
+		})
+
+		It("Fails when the ELB service-linked role doesn't exist", func() {
+			mockAWS.EXPECT().CheckRoleExists(elbServiceLinkedRole).Return(false, "", nil)
+
+			result := checkELBServiceLinkedRole(t.RosaRuntime)(context.Background())
+			Expect(result.Status).To(Equal(doctor.StatusFail))
+			Expect(result.Remediation).To(Equal(
+				"aws iam create-service-linked-role --aws-service-name elasticloadbalancing.amazonaws.com"))
+		})
+
+		It("Fails when the AWS credentials can't be used", func() {
+			t.RosaRuntime.Creator = nil
+			mockAWS.EXPECT().GetCreator().Return(nil, errors.New("no credentials"))
+
+			result := checkAWSCredentials(t.RosaRuntime, nil)(context.Background())
+			Expect(result).To(Equal(doctor.Fail("aws configure",
+				"Failed to get the AWS caller identity: no credentials")))
+		})
+	})
+})
@@ -27,6 +27,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/ocm"
@@ -83,13 +84,13 @@ func run(cmd *cobra.Command, argv []string) {
 	addonParameters, err := r.OCMClient.GetAddOnParameters(cluster.ID(), addOnID)
 	if err != nil {
 		r.Reporter.Errorf("Failed to get add-on '%s' parameters: %v", addOnID, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	addOnInstallation, err := r.OCMClient.GetAddOnInstallation(cluster.ID(), addOnID)
 	if err != nil {
 		r.Reporter.Errorf("Failed to get add-on '%s' installation: %v", addOnID, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if addonParameters.Len() == 0 {
@@ -106,7 +107,7 @@ func run(cmd *cobra.Command, argv []string) {
 			flag := cmd.Flags().Lookup(param.ID())
 			if flag != nil && !param.Editable() {
 				r.Reporter.Errorf("Parameter '%s' on addon '%s' cannot be modified", param.ID(), addOnID)
-				os.Exit(1)
+				os.Exit(clierror.Validation.ExitCode())
 			}
 			return true
 		})
@@ -158,7 +159,7 @@ func run(cmd *cobra.Command, argv []string) {
 			val, err = interactive.GetAddonArgument(*param, dflt)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		}
 		val = strings.Trim(val, " ")
@@ -166,13 +167,13 @@ func run(cmd *cobra.Command, argv []string) {
 			isValid, err := regexp.MatchString(param.Validation(), val)
 			if err != nil || !isValid {
 				r.Reporter.Errorf("Expected %v to match /%s/", val, param.Validation())
-				os.Exit(1)
+				os.Exit(clierror.Validation.ExitCode())
 			}
 		}
 
 		if len(options) > 0 && !helper.Contains(values, val) {
 			r.Reporter.Errorf("Expected %v to match one of the options /%v/", val, values)
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		addonArguments = append(addonArguments, ocm.AddOnParam{Key: param.ID(), Val: val})
 
@@ -183,7 +184,7 @@ func run(cmd *cobra.Command, argv []string) {
 	err = r.OCMClient.UpdateAddOnInstallation(cluster.ID(), addOnID, addonArguments)
 	if err != nil {
 		r.Reporter.Errorf("Failed to update add-on installation '%s' for cluster '%s': %v", addOnID, clusterKey, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	r.Reporter.Infof("Add-on '%s' is now updating. To check the status run 'rosa list addons -c %s'", addOnID, clusterKey)
 }
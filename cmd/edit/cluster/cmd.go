@@ -28,6 +28,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/clusterregistryconfig"
 	"github.com/openshift/rosa/pkg/fedramp"
 	"github.com/openshift/rosa/pkg/helper"
@@ -265,7 +266,7 @@ func run(cmd *cobra.Command, _ []string) {
 	err := runWithRuntime(r, cmd)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 }
 
@@ -298,26 +299,26 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 
 	if aws.IsHostedCP(cluster) && cmd.Flags().Changed("disable-workload-monitoring") {
 		r.Reporter.Errorf(arguments.UwmNotSupportedMessage)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	// Validate flags:
 	expiration, err := validateExpiration()
 	if err != nil {
 		r.Reporter.Errorf(fmt.Sprintf("%s", err))
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	ovnInternalSubnets, err := validateOvnInternalSubnetConfiguration()
 	if err != nil {
 		r.Reporter.Errorf(fmt.Sprintf("%s", err))
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	networkType, err := validateNetworkType()
 	if err != nil {
 		r.Reporter.Errorf(fmt.Sprintf("%s", err))
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if interactive.Enabled() {
@@ -368,7 +369,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 			len(noProxySlice) > 0 ||
 			(additionalTrustBundleFile != nil && *additionalTrustBundleFile != "")) {
 		r.Reporter.Errorf("Cluster-wide proxy is not supported on clusters using the default VPC")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	var additionalAllowedPrincipals []string
@@ -391,7 +392,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 	privateWarning, err = warnUserForOAuthHCPVisibility(r, clusterKey, cluster, privateWarning)
 	if err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if interactive.Enabled() {
 		privateValue, err = interactive.GetBool(interactive.Input{
@@ -401,7 +402,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid private value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		private = &privateValue
 	} else if privateValue {
@@ -430,7 +431,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		})
 		if err != nil {
 			_ = r.Reporter.Errorf("Expected a valid disable-workload-monitoring value: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		disableWorkloadMonitoring = &disableWorkloadMonitoringValue
 	} else if disableWorkloadMonitoringValue && !isHostedCP {
@@ -451,7 +452,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid proxy-enabled value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		enableProxy = enableProxyValue
 	}
@@ -485,7 +486,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid http proxy: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		if def != httpProxyValue && httpProxyValue == input.DoubleQuotesToRemove {
@@ -500,7 +501,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		err = ocm.ValidateHTTPProxy(*httpProxy)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -524,7 +525,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid https proxy: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		if def != httpsProxyValue && httpsProxyValue == input.DoubleQuotesToRemove {
 			//user entered double quotes ("") to remove the existing value
@@ -538,7 +539,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		err = ocm.ValidateHTTPSProxy(*httpsProxy)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -555,13 +556,13 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid set of no proxy domains/CIDR's: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		noProxySlice = helper.HandleEmptyStringOnSlice(strings.Split(noProxyInput, ","))
 	}
 	if isExpectedHTTPProxyOrHTTPSProxy(httpProxy, httpsProxy, noProxySlice, cluster) {
 		r.Reporter.Errorf("Expected at least one of the following: http-proxy, https-proxy")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if len(noProxySlice) > 0 {
@@ -572,13 +573,13 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		duplicate, found := aws.HasDuplicates(noProxySlice)
 		if found {
 			r.Reporter.Errorf("Invalid no-proxy list, duplicate key '%s' found", duplicate)
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		for _, domain := range noProxySlice {
 			err := aws.UserNoProxyValidator(domain)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		}
 	}
@@ -593,7 +594,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid -update-additional-trust-bundle value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		updateAdditionalTrustBundle = updateAdditionalTrustBundleValue
 	}
@@ -616,7 +617,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid additional trust bundle file name: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		if len(additionalTrustBundleFileValue) == 0 {
@@ -634,7 +635,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		err = ocm.ValidateAdditionalTrustBundle(*additionalTrustBundleFile)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -648,7 +649,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid update-additional-allowed-principals value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		updateAdditionalAllowedPrincipals = updateAdditionalAllowedPrincipalsValue
 	}
@@ -660,7 +661,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for Additional Allowed Principal ARNs: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		additionalAllowedPrincipals = helper.HandleEmptyStringOnSlice(strings.Split(aapInputs, ","))
 	}
@@ -671,7 +672,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		} else {
 			if err := roles.ValidateAdditionalAllowedPrincipals(additionalAllowedPrincipals); err != nil {
 				r.Reporter.Errorf(err.Error())
-				os.Exit(1)
+				os.Exit(clierror.Validation.ExitCode())
 			}
 		}
 	}
@@ -680,13 +681,13 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 	auditLogRole, err := setAuditLogForwarding(r, cmd, cluster, args.auditLogRoleARN)
 	if err != nil {
 		_ = r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if interactive.Enabled() && aws.IsHostedCP(cluster) {
 		auditLogRole, err = auditLogInteractivePrompt(r, cmd, cluster)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	// AutoNode configuration
@@ -694,19 +695,19 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 	if fedramp.Enabled() && (cmd.Flags().Changed(autonode.AutoNodeFlagName) ||
 		cmd.Flags().Changed(autonode.AutoNodeIAMRoleArnFlagName)) {
 		_ = r.Reporter.Errorf("AutoNode is not supported for govcloud clusters")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	autoNodeConfig, err := autonode.SetAutoNode(r, cmd, cluster, args.autonode, args.autoNodeRoleARN)
 	if err != nil {
 		_ = r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if interactive.Enabled() && aws.IsHostedCP(cluster) {
 		interactiveAutoNodeConfig, err := autonode.InteractivePrompt(r, cmd, cluster)
 		if err != nil {
 			_ = r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		if interactiveAutoNodeConfig != nil {
 			if interactiveAutoNodeConfig.AutoNodeMode != "" {
@@ -749,7 +750,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 				cert, err := os.ReadFile(*additionalTrustBundleFile)
 				if err != nil {
 					r.Reporter.Errorf("Failed to read additional trust bundle file: %s", err)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 				*clusterConfig.AdditionalTrustBundle = string(cert)
 			}
@@ -764,7 +765,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		cmd.Flags(), clusterRegistryConfigArgs, aws.IsHostedCP(cluster), cluster)
 	if err != nil {
 		_ = r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if clusterRegistryConfigArgs != nil {
 		allowedRegistries, blockedRegistries, insecureRegistries,
@@ -782,7 +783,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 			}
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		}
 	}
@@ -817,7 +818,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -826,12 +827,12 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		newDeleteProtection, err := cmv1.NewDeleteProtection().Enabled(deleteProtection).Build()
 		if err != nil {
 			r.Reporter.Errorf("Failed to build delete protection: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		if err := r.OCMClient.UpdateClusterDeleteProtection(cluster.ID(), newDeleteProtection); err != nil {
 			r.Reporter.Errorf("Failed to update cluster delete protection: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -852,7 +853,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		if migrateNetworkType && interactive.Enabled() {
@@ -863,7 +864,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid value: %v", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 
 			ovnSubnets, _ := interactive.GetString(interactive.Input{
@@ -887,7 +888,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		} else if confirm.Yes() {
 			migrateNetworkType = true
@@ -906,7 +907,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 	if err != nil {
 		_ = r.Reporter.Errorf("Failed to determine if billing account capability is enabled for user "+
 			"organization: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	var billingAccount string
@@ -917,7 +918,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 			if billingAccount != "" && !ocm.IsValidAWSAccount(billingAccount) {
 				_ = r.Reporter.Errorf("provided billing account number %s is not valid. "+
 					"Rerun the command with a valid billing account number", billingAccount)
-				os.Exit(1)
+				os.Exit(clierror.Validation.ExitCode())
 			}
 		} else {
 			billingAccount = cluster.AWS().BillingAccountID()
@@ -928,7 +929,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 			cloudAccounts, err := r.OCMClient.GetBillingAccounts()
 			if err != nil {
 				_ = r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 
 			billingAccounts := ocm.GenerateBillingAccountsList(cloudAccounts)
@@ -944,7 +945,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 
 				if err != nil {
 					_ = r.Reporter.Errorf("Expected a valid billing account: '%s'", err)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 
 				billingAccount = aws.ParseOption(billingAccount)
@@ -953,7 +954,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 			err = ocm.ValidateBillingAccount(billingAccount)
 			if err != nil {
 				_ = r.Reporter.Errorf("%v", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 
 			// Get contract info
@@ -992,7 +993,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 	err = r.OCMClient.UpdateCluster(cluster.ID(), r.Creator, clusterConfig)
 	if err != nil {
 		r.Reporter.Errorf("Failed to update cluster: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	r.Reporter.Infof("Updated cluster '%s'", clusterKey)
 	return nil
@@ -22,6 +22,7 @@ import (
 	"github.com/openshift/rosa/cmd/edit/addon"
 	"github.com/openshift/rosa/cmd/edit/autoscaler"
 	"github.com/openshift/rosa/cmd/edit/cluster"
+	"github.com/openshift/rosa/cmd/edit/idp"
 	"github.com/openshift/rosa/cmd/edit/imagemirror"
 	"github.com/openshift/rosa/cmd/edit/ingress"
 	"github.com/openshift/rosa/cmd/edit/kubeletconfig"
@@ -54,6 +55,8 @@ func init() {
 	Cmd.AddCommand(kubeletConfig)
 	imageMirrorCommand := imagemirror.NewEditImageMirrorCommand()
 	Cmd.AddCommand(imageMirrorCommand)
+	idpCommand := idp.NewEditIdpCommand()
+	Cmd.AddCommand(idpCommand)
 	logForwarderCommand := logforwarder.NewEditLogForwarderCommand()
 	Cmd.AddCommand(logForwarderCommand)
 
@@ -70,6 +73,7 @@ func init() {
 		service.Cmd, cluster.Cmd,
 		imageMirrorCommand, ingress.Cmd, kubeletConfig,
 		logForwarderCommand, machinepoolCommand, tuningconfigs.Cmd,
+		idpCommand,
 	}
 	arguments.MarkRegionDeprecated(Cmd, globallyAvailableCommands)
 }
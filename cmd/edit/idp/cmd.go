@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package idp
+
+import (
+	"context"
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	createidp "github.com/openshift/rosa/cmd/create/idp"
+	pkgidp "github.com/openshift/rosa/pkg/idp"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "idp NAME"
+	short = "Edit an identity provider of a cluster"
+	long  = "Edit the settings of an identity provider of a cluster. The flags are the same as the ones " +
+		"of 'rosa create idp' for the type of the identity provider. The changes are shown before " +
+		"they are applied."
+	example = `  # Change the client secret of the GitHub identity provider named 'github-1'
+  rosa edit idp github-1 --cluster=mycluster --client-secret=<secret>
+
+  # Restrict the access of an OpenID identity provider to a different set of groups claims
+  rosa edit idp openid-1 --cluster=mycluster --groups-claims=groups,roles`
+)
+
+var previewTable = output.Table[pkgidp.Change]{
+	Columns: []output.Column[pkgidp.Change]{
+		{Header: "SETTING", Value: func(c pkgidp.Change) string { return c.Setting }},
+		{Header: "CURRENT", Value: func(c pkgidp.Change) string { return c.Current }},
+		{Header: "NEW", Value: func(c pkgidp.Change) string { return c.New }},
+	},
+}
+
+func NewEditIdpCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), EditIdpRunner()),
+		Args:    cobra.ExactArgs(1),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+	ocm.AddClusterFlag(cmd)
+	createidp.AddEditFlags(flags)
+	return cmd
+}
+
+func EditIdpRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
+		values := createidp.ChangedSettings(cmd.Flags())
+		if len(values) == 0 {
+			return fmt.Errorf("At least one setting of the identity provider must be changed")
+		}
+
+		clusterKey := r.GetClusterKey()
+		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+		if err != nil {
+			return err
+		}
+		if cluster.State() != cmv1.ClusterStateReady {
+			return fmt.Errorf("Cluster '%s' is not yet ready", clusterKey)
+		}
+		if cluster.ExternalAuthConfig().Enabled() {
+			return fmt.Errorf("Editing identity providers is not supported for clusters " +
+				"with external authentication configured.")
+		}
+
+		idp, exists, err := r.OCMClient.FindIdentityProvider(cluster.ID(), argv[0])
+		if err != nil {
+			return fmt.Errorf("Failed to get identity providers for cluster '%s': %v", clusterKey, err)
+		}
+		if !exists {
+			return fmt.Errorf("Failed to get identity provider '%s' for cluster '%s'", argv[0], clusterKey)
+		}
+
+		patch, changes, err := pkgidp.EditIdentityProvider(idp, values)
+		if err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			r.Reporter.Infof("Identity provider '%s' on cluster '%s' already has the given settings",
+				idp.Name(), clusterKey)
+			return nil
+		}
+
+		r.Reporter.Infof("The following settings of identity provider '%s' will be changed:", idp.Name())
+		fmt.Println()
+		err = previewTable.Print(changes)
+		if err != nil {
+			return err
+		}
+		fmt.Println()
+		if !confirm.Confirm("update identity provider '%s' on cluster '%s'", idp.Name(), clusterKey) {
+			return nil
+		}
+
+		r.Reporter.Debugf("Updating identity provider '%s' on cluster '%s'", idp.ID(), clusterKey)
+		_, err = r.OCMClient.UpdateIdentityProvider(cluster.ID(), idp.ID(), patch)
+		if err != nil {
+			return fmt.Errorf("Failed to update identity provider '%s' on cluster '%s': %v",
+				idp.Name(), clusterKey, err)
+		}
+		r.Reporter.Infof("Identity provider '%s' has been updated on cluster '%s'", idp.Name(), clusterKey)
+		return nil
+	}
+}
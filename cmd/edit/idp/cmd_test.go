@@ -0,0 +1,116 @@
+package idp
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("edit idp", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewEditIdpCommand()
+		Expect(cmd).NotTo(BeNil())
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Args).NotTo(BeNil())
+		Expect(cmd.Run).NotTo(BeNil())
+		for _, flag := range []string{"cluster", "mapping-method", "client-secret", "bind-password", "groups-claims"} {
+			Expect(cmd.Flags().Lookup(flag)).NotTo(BeNil())
+		}
+		Expect(cmd.Flags().Lookup("insecure").Value.Type()).To(Equal("bool"))
+	})
+
+	Context("Edit Idp Runner", func() {
+		var t *TestingRuntime
+		var cmd *cobra.Command
+		var cluster *cmv1.Cluster
+		var idp *cmv1.IdentityProvider
+
+		BeforeEach(func() {
+			cmd = NewEditIdpCommand()
+			t = NewTestRuntime()
+			output.SetOutput("")
+			cluster = MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+			})
+			t.SetCluster("cluster", cluster)
+			var err error
+			idp, err = cmv1.NewIdentityProvider().ID("idp-1").Name("google-1").
+				Type(cmv1.IdentityProviderTypeGoogle).MappingMethod(cmv1.IdentityProviderMappingMethodClaim).
+				Google(cmv1.NewGoogleIdentityProvider().ClientID("client").HostedDomain("example.com")).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			output.SetOutput("")
+		})
+
+		It("Returns an error if no setting is changed", func() {
+			err := EditIdpRunner()(context.Background(), t.RosaRuntime, cmd, []string{"google-1"})
+			Expect(err).To(MatchError("At least one setting of the identity provider must be changed"))
+		})
+
+		It("Returns an error if the cluster is not ready", func() {
+			cluster = MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateInstalling)
+			})
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+			Expect(cmd.Flags().Set("client-id", "new")).To(Succeed())
+
+			err := EditIdpRunner()(context.Background(), t.RosaRuntime, cmd, []string{"google-1"})
+			Expect(err).To(MatchError("Cluster 'cluster' is not yet ready"))
+		})
+
+		It("Returns an error if a flag doesn't apply to the type", func() {
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatIDPList([]*cmv1.IdentityProvider{idp})))
+			Expect(cmd.Flags().Set("issuer-url", "https://example.com")).To(Succeed())
+
+			err := EditIdpRunner()(context.Background(), t.RosaRuntime, cmd, []string{"google-1"})
+			Expect(err).To(MatchError("the '--issuer-url' flag doesn't apply to Google identity providers"))
+		})
+
+		It("Shows the changes and patches the identity provider", func() {
+			yesFlags := pflag.NewFlagSet("confirm", pflag.ContinueOnError)
+			confirm.AddFlag(yesFlags)
+			Expect(yesFlags.Set("yes", "true")).To(Succeed())
+			defer func() { _ = yesFlags.Set("yes", "false") }()
+
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatIDPList([]*cmv1.IdentityProvider{idp})))
+			t.ApiServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPatch,
+					"/api/clusters_mgmt/v1/clusters/"+MockClusterID+"/identity_providers/idp-1"),
+				ghttp.VerifyJSON(`{
+					"kind": "IdentityProvider",
+					"type": "GoogleIdentityProvider",
+					"google": {"hosted_domain": "example.org"}
+				}`),
+				RespondWithJSON(http.StatusOK, FormatResource(idp)),
+			))
+			Expect(cmd.Flags().Set("hosted-domain", "example.org")).To(Succeed())
+			Expect(cmd.Flags().Set("client-id", "client")).To(Succeed())
+
+			stdout, _, err := RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+				return EditIdpRunner()(context.Background(), r, cmd, []string{"google-1"})
+			}, t.RosaRuntime, cmd)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stdout).To(ContainSubstring("SETTING        CURRENT      NEW\n" +
+				"Hosted domain  example.com  example.org\n"))
+			Expect(stdout).To(ContainSubstring("Identity provider 'google-1' has been updated on cluster 'cluster'"))
+		})
+	})
+})
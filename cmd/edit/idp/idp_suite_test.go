@@ -0,0 +1,13 @@
+package idp
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestEditIdp(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Edit Idp Suite")
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	utils "github.com/openshift/rosa/pkg/helper"
 	helper "github.com/openshift/rosa/pkg/ingress"
 	"github.com/openshift/rosa/pkg/interactive"
@@ -162,7 +163,7 @@ func run(cmd *cobra.Command, argv []string) {
 			"Ingress identifier '%s' isn't valid: it must contain between three and five lowercase letters or digits",
 			ingressKey,
 		)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	clusterKey := r.GetClusterKey()
@@ -181,7 +182,7 @@ func run(cmd *cobra.Command, argv []string) {
 		hasLegacyIngressSupport, err = r.OCMClient.HasLegacyIngressSupport(cluster)
 		if err != nil {
 			r.Reporter.Errorf("There was a problem checking version compatibility: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -191,12 +192,12 @@ func run(cmd *cobra.Command, argv []string) {
 				"New ingress attributes %s can't be supplied for Hosted Control Plane clusters",
 				utils.SliceToSortedString(exclusivelyIngressV2Flags),
 			)
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		} else if hasLegacyIngressSupport {
 			r.Reporter.Errorf("New ingress attributes %s can't be supplied for legacy supported clusters."+
 				" For more information on how to be supported please check: %s",
 				utils.SliceToSortedString(exclusivelyIngressV2Flags), ingressV2DocLink)
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 	}
 
@@ -218,7 +219,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid private value: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		private = &privArg
 	}
@@ -231,7 +232,7 @@ func run(cmd *cobra.Command, argv []string) {
 		err := r.OCMClient.UpdateCluster(clusterKey, r.Creator, clusterConfig)
 		if err != nil {
 			r.Reporter.Errorf("Failed to update cluster API on cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		r.Reporter.Infof("Updated ingress '%s' on cluster '%s'", ingressKey, clusterKey)
 		os.Exit(0)
@@ -240,18 +241,18 @@ func run(cmd *cobra.Command, argv []string) {
 	ingress, err := r.OCMClient.GetIngress(cluster.ID(), ingressKey)
 	if err != nil {
 		r.Reporter.Errorf("Failed to fetch ingress: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	var routeSelector *string
 	if cmd.Flags().Changed(routeSelectorFlag) || cmd.Flags().Changed(labelMatchFlag) {
 		if ocm.IsHyperShiftCluster(cluster) {
 			r.Reporter.Errorf("Updating route selectors is not supported for Hosted Control Plane clusters")
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		if ingress.Default() && hasLegacyIngressSupport {
 			r.Reporter.Errorf("Updating route selectors for default ingress is not allowed for legacy ingress support")
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		routeSelector = &args.routeSelector
 	} else if interactive.Enabled() && !ocm.IsHyperShiftCluster(cluster) &&
@@ -269,7 +270,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		routeSelector = &routeSelectorArg
 	}
@@ -278,11 +279,11 @@ func run(cmd *cobra.Command, argv []string) {
 	if cmd.Flags().Changed(lbTypeFlag) {
 		if ocm.IsHyperShiftCluster(cluster) {
 			r.Reporter.Errorf("Updating Load Balancer Type is not supported for Hosted Control Plane clusters")
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		if ocm.IsSts(cluster) && hasLegacyIngressSupport {
 			r.Reporter.Errorf("Updating Load Balancer Type is not supported for STS clusters on legacy ingress support")
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		lbType = &args.lbType
 	} else if interactive.Enabled() && (!ocm.IsHyperShiftCluster(cluster) &&
@@ -299,7 +300,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid Load Balancer type: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		lbType = &lbTypeArg
 	}
@@ -313,7 +314,7 @@ func run(cmd *cobra.Command, argv []string) {
 		if cmd.Flags().Changed(excludedNamespacesFlag) {
 			if ocm.IsHyperShiftCluster(cluster) {
 				r.Reporter.Errorf("Updating excluded namespace is not supported for Hosted Control Plane clusters")
-				os.Exit(1)
+				os.Exit(clierror.Validation.ExitCode())
 			}
 			excludedNamespaces = &args.excludedNamespaces
 		} else if isInteractiveEnabledAndNotHcp {
@@ -324,14 +325,14 @@ func run(cmd *cobra.Command, argv []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			excludedNamespaces = &excludedNamespacesArg
 		}
 		if cmd.Flags().Changed(wildcardPolicyFlag) {
 			if ocm.IsHyperShiftCluster(cluster) {
 				r.Reporter.Errorf("Updating Wildcard Policy is not supported for Hosted Control Plane clusters")
-				os.Exit(1)
+				os.Exit(clierror.Validation.ExitCode())
 			}
 			wildcardPolicy = &args.wildcardPolicy
 		} else if isInteractiveEnabledAndNotHcp {
@@ -343,7 +344,7 @@ func run(cmd *cobra.Command, argv []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid Wildcard Policy: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			wildcardPolicy = &wildcardPolicyArg
 		}
@@ -352,7 +353,7 @@ func run(cmd *cobra.Command, argv []string) {
 				r.Reporter.Errorf(
 					"Updating Namespace Ownership Policy is not supported for Hosted Control Plane clusters",
 				)
-				os.Exit(1)
+				os.Exit(clierror.Validation.ExitCode())
 			}
 			namespaceOwnershipPolicy = &args.namespaceOwnershipPolicy
 		} else if isInteractiveEnabledAndNotHcp {
@@ -364,7 +365,7 @@ func run(cmd *cobra.Command, argv []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid Namespace Ownership Policy: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			namespaceOwnershipPolicy = &namespaceOwnershipPolicyArg
 		}
@@ -375,12 +376,12 @@ func run(cmd *cobra.Command, argv []string) {
 	if cmd.Flags().Changed(componentRoutesFlag) {
 		if !canEditComponentRoutes {
 			r.Reporter.Errorf("Updating component routes is not supported for legacy ingress clusters")
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		componentRoutes, err = parseComponentRoutesForAllowed(args.componentRoutes, allowedComponentRoutes(isHypershift))
 		if err != nil {
 			r.Reporter.Errorf("An error occurred whilst parsing the supplied component routes: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	} else if interactive.Enabled() && canEditComponentRoutes {
 		componentRoutes = map[string]*cmv1.ComponentRouteBuilder{}
@@ -401,7 +402,7 @@ func run(cmd *cobra.Command, argv []string) {
 					})
 					if err != nil {
 						r.Reporter.Errorf("Expected a valid component route '%s': %s", parameterName, err)
-						os.Exit(1)
+						os.Exit(clierror.ExitCode(err))
 					}
 					switch parameterName {
 					case hostnameParameter:
@@ -439,7 +440,7 @@ func run(cmd *cobra.Command, argv []string) {
 			routeSelectors, err = helper.GetRouteSelector(*routeSelector)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		}
 		ingressBuilder = ingressBuilder.RouteSelectors(routeSelectors)
@@ -472,7 +473,7 @@ func run(cmd *cobra.Command, argv []string) {
 	ingress, err = ingressBuilder.Build()
 	if err != nil {
 		r.Reporter.Errorf("Failed to create ingress for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	sameRouteSelectors := routeSelector == nil || reflect.DeepEqual(curRouteSelectors, ingress.RouteSelectors())
@@ -503,7 +504,7 @@ func run(cmd *cobra.Command, argv []string) {
 	if err != nil {
 		r.Reporter.Errorf("Failed to update ingress '%s' on cluster '%s': %s",
 			ingress.ID(), clusterKey, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	r.Reporter.Infof("Updated ingress '%s' on cluster '%s'", ingress.ID(), clusterKey)
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -60,13 +61,13 @@ func run(cmd *cobra.Command, argv []string) {
 	err := arguments.ParseKnownFlags(cmd, argv, false)
 	if err != nil {
 		r.Reporter.Errorf("Failed to parse flags: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if args.ID == "" {
 		r.Reporter.Errorf("Service id not specified.")
 		cmd.Help()
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	// Try to find the service:
@@ -74,13 +75,13 @@ func run(cmd *cobra.Command, argv []string) {
 	service, err := r.OCMClient.GetManagedService(ocm.DescribeManagedServiceArgs{ID: args.ID})
 	if err != nil {
 		r.Reporter.Errorf("Failed to get service %q: %v", args.ID, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	addOn, err := r.OCMClient.GetAddOn(service.Service())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get add-on %q: %s", service.Service(), err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	addonParameters := addOn.Parameters()
@@ -92,7 +93,7 @@ func run(cmd *cobra.Command, argv []string) {
 	err = arguments.ParseKnownFlags(cmd, argv, true)
 	if err != nil {
 		r.Reporter.Errorf("Failed to parse flags: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	args.Parameters = map[string]string{}
@@ -109,7 +110,7 @@ func run(cmd *cobra.Command, argv []string) {
 	err = r.OCMClient.UpdateManagedService(args)
 	if err != nil {
 		r.Reporter.Errorf("Failed to update service %q: %v", args.ID, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	r.Reporter.Infof("Service %q is now updating. To check the status run 'rosa describe service --id %s'",
 		args.ID, args.ID)
@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/clusterspec"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use     = "cluster"
+	short   = "Export the spec of a cluster"
+	long    = "Export the spec of an existing cluster as a file that can be passed to 'rosa create cluster --from-file'."
+	example = `  # Export the spec of a cluster named "mycluster" to a file
+  rosa export cluster --cluster=mycluster > mycluster.yaml
+
+  # Create a copy of the cluster with a different name
+  rosa create cluster --from-file=mycluster.yaml --cluster-name=mycluster-copy`
+)
+
+func NewExportClusterCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), ExportClusterRunner()),
+	}
+
+	ocm.AddClusterFlag(cmd)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func ExportClusterRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		cluster := r.FetchCluster()
+		ingresses, err := r.OCMClient.GetIngresses(cluster.ID())
+		if err != nil {
+			return fmt.Errorf("Failed to get ingresses for cluster '%s': %v", r.ClusterKey, err)
+		}
+		file := clusterspec.FromCluster(cluster, ingresses)
+
+		if output.HasFlag() {
+			return output.Print(file)
+		}
+
+		content, err := file.Marshal()
+		if err != nil {
+			return fmt.Errorf("Failed to export cluster '%s': %v", r.ClusterKey, err)
+		}
+		fmt.Print(string(content))
+		return nil
+	}
+}
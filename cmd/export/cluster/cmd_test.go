@@ -0,0 +1,95 @@
+package cluster
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Export cluster", func() {
+	It("Returns Command", func() {
+		cmd := NewExportClusterCommand()
+		Expect(cmd).NotTo(BeNil())
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Long).To(Equal(long))
+		Expect(cmd.Example).To(Equal(example))
+		Expect(cmd.Run).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("cluster")).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("output")).NotTo(BeNil())
+	})
+
+	Context("ExportClusterRunner", func() {
+		var t *test.TestingRuntime
+
+		respondWithIngress := func(listening cmv1.ListeningMethod) {
+			ingress, err := cmv1.NewIngress().ID("a1b2").Default(true).Listening(listening).Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+				test.FormatIngressList([]*cmv1.Ingress{ingress})))
+		}
+
+		BeforeEach(func() {
+			t = test.NewTestRuntime()
+			output.SetOutput("")
+			cluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.Region(cmv1.NewCloudRegion().ID("us-east-1"))
+				c.Version(cmv1.NewVersion().RawID("4.16.2").ChannelGroup("stable"))
+				c.Hypershift(cmv1.NewHypershift().Enabled(true))
+			})
+			t.SetCluster(test.MockClusterName, cluster)
+			DeferCleanup(func() { output.SetOutput("") })
+		})
+
+		It("prints the cluster spec as YAML by default", func() {
+			respondWithIngress(cmv1.ListeningMethodExternal)
+			stdout, _, err := test.RunWithOutputCapture(func(r *rosa.Runtime, _ *cobra.Command) error {
+				return ExportClusterRunner()(context.Background(), r, nil, nil)
+			}, t.RosaRuntime, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(ContainSubstring("apiVersion: rosa.openshift.io/v1alpha1"))
+			Expect(stdout).To(ContainSubstring("kind: Cluster"))
+			Expect(stdout).To(ContainSubstring("region: us-east-1"))
+			Expect(stdout).To(ContainSubstring("hostedCP: true"))
+			Expect(stdout).To(ContainSubstring("version: 4.16.2"))
+			Expect(stdout).NotTo(ContainSubstring("defaultIngressPrivate"))
+		})
+
+		It("exports a private default ingress", func() {
+			respondWithIngress(cmv1.ListeningMethodInternal)
+			stdout, _, err := test.RunWithOutputCapture(func(r *rosa.Runtime, _ *cobra.Command) error {
+				return ExportClusterRunner()(context.Background(), r, nil, nil)
+			}, t.RosaRuntime, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(ContainSubstring("defaultIngressPrivate: true"))
+		})
+
+		It("fails when the ingresses can't be fetched", func() {
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusInternalServerError, "{}"))
+			_, _, err := test.RunWithOutputCapture(func(r *rosa.Runtime, _ *cobra.Command) error {
+				return ExportClusterRunner()(context.Background(), r, nil, nil)
+			}, t.RosaRuntime, nil)
+			Expect(err).To(MatchError(ContainSubstring("Failed to get ingresses for cluster")))
+		})
+
+		It("prints the cluster spec as JSON", func() {
+			output.SetOutput(output.JSON)
+			respondWithIngress(cmv1.ListeningMethodExternal)
+			stdout, _, err := test.RunWithOutputCapture(func(r *rosa.Runtime, _ *cobra.Command) error {
+				return ExportClusterRunner()(context.Background(), r, nil, nil)
+			}, t.RosaRuntime, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(ContainSubstring(`"apiVersion": "rosa.openshift.io/v1alpha1"`))
+			Expect(stdout).To(ContainSubstring(`"channelGroup": "stable"`))
+		})
+	})
+})
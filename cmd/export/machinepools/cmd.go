@@ -0,0 +1,126 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/machinepool"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "machinepools"
+	short = "Export the spec of the machine pools of a cluster"
+	long  = "Export the spec of the machine pools of an existing cluster as a file that can be passed to " +
+		"'rosa create machinepool --from-file'. The default machine pools, created together with the " +
+		"cluster, are skipped. The subnet, availability zone, security groups and version of the " +
+		"machine pools are only exported with the '--include-cluster-specific' flag, as they can't be " +
+		"used in other clusters."
+	example = `  # Export the machine pools of a cluster named "mycluster" to a file
+  rosa export machinepools --cluster=mycluster > machinepools.yaml
+
+  # Create the same machine pools in another cluster
+  rosa create machinepool --cluster=othercluster --from-file=machinepools.yaml
+
+  # Export the machine pools with their subnets, to recreate them in the same cluster
+  rosa export machinepools --cluster=mycluster --include-cluster-specific > machinepools.yaml`
+)
+
+type options struct {
+	includeClusterSpecific bool
+}
+
+func NewExportMachinePoolsCommand() *cobra.Command {
+	options := &options{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Aliases: []string{"machinepool", "machine-pools", "machine-pool"},
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), ExportMachinePoolsRunner(options)),
+	}
+
+	ocm.AddClusterFlag(cmd)
+	cmd.Flags().BoolVar(
+		&options.includeClusterSpecific,
+		"include-cluster-specific",
+		false,
+		"Also export the subnet, availability zone, security groups and version of the machine pools.",
+	)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func ExportMachinePoolsRunner(options *options) rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		cluster := r.FetchCluster()
+
+		specs := []*machinepool.MachinePoolSpec{}
+		if cluster.Hypershift().Enabled() {
+			nodePools, err := r.OCMClient.GetNodePools(cluster.ID())
+			if err != nil {
+				return fmt.Errorf("Failed to get machine pools for hosted cluster '%s': %v", r.ClusterKey, err)
+			}
+			for _, nodePool := range nodePools {
+				specs = append(specs, machinepool.SpecFromNodePool(nodePool))
+			}
+		} else {
+			machinePools, err := r.OCMClient.GetMachinePools(cluster.ID())
+			if err != nil {
+				return fmt.Errorf("Failed to get machine pools for cluster '%s': %v", r.ClusterKey, err)
+			}
+			for _, machinePool := range machinePools {
+				specs = append(specs, machinepool.SpecFromMachinePool(machinePool))
+			}
+		}
+		exported := []*machinepool.MachinePoolSpec{}
+		for _, spec := range specs {
+			if machinepool.IsDefaultMachinePool(spec.Name) {
+				r.Reporter.Warnf("Skipping machine pool '%s', default machine pools are created together "+
+					"with the cluster", spec.Name)
+				continue
+			}
+			if !options.includeClusterSpecific {
+				spec.ClearClusterSpecificFields()
+			}
+			exported = append(exported, spec)
+		}
+		if len(exported) == 0 {
+			return fmt.Errorf("Cluster '%s' has no machine pools other than the default ones", r.ClusterKey)
+		}
+		file := machinepool.NewMachinePoolListFile(exported)
+
+		if output.HasFlag() {
+			return output.Print(file)
+		}
+
+		content, err := file.Marshal()
+		if err != nil {
+			return fmt.Errorf("Failed to export machine pools of cluster '%s': %v", r.ClusterKey, err)
+		}
+		fmt.Print(string(content))
+		return nil
+	}
+}
@@ -0,0 +1,116 @@
+package machinepools
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Export machine pools", func() {
+	It("Returns Command", func() {
+		cmd := NewExportMachinePoolsCommand()
+		Expect(cmd).NotTo(BeNil())
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Long).To(Equal(long))
+		Expect(cmd.Example).To(Equal(example))
+		Expect(cmd.Run).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("cluster")).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("include-cluster-specific")).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("output")).NotTo(BeNil())
+	})
+
+	Context("ExportMachinePoolsRunner", func() {
+		var t *test.TestingRuntime
+
+		BeforeEach(func() {
+			t = test.NewTestRuntime()
+			output.SetOutput("")
+			DeferCleanup(func() { output.SetOutput("") })
+		})
+
+		run := func(options *options) (string, string, error) {
+			return test.RunWithOutputCapture(func(r *rosa.Runtime, _ *cobra.Command) error {
+				return ExportMachinePoolsRunner(options)(context.Background(), r, nil, nil)
+			}, t.RosaRuntime, nil)
+		}
+
+		hostedNodePools := func() []*cmv1.NodePool {
+			t.SetCluster(test.MockClusterName, test.MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.Hypershift(cmv1.NewHypershift().Enabled(true))
+			}))
+			return []*cmv1.NodePool{
+				test.MockNodePool(func(n *cmv1.NodePoolBuilder) {
+					n.ID("workers-0").Replicas(2).Subnet("subnet-a").
+						AWSNodePool(cmv1.NewAWSNodePool().InstanceType("m5.xlarge"))
+				}),
+				test.MockNodePool(func(n *cmv1.NodePoolBuilder) {
+					n.ID("gpu").Replicas(2).Subnet("subnet-a").Version(cmv1.NewVersion().RawID("4.16.1")).
+						AWSNodePool(cmv1.NewAWSNodePool().InstanceType("g4dn.xlarge").
+							AdditionalSecurityGroupIds("sg-1"))
+				}),
+			}
+		}
+
+		It("exports the node pools of a hosted cluster without the cluster specific fields", func() {
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, test.FormatNodePoolList(hostedNodePools())))
+
+			stdout, stderr, err := run(&options{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(ContainSubstring("kind: MachinePoolList"))
+			Expect(stdout).To(ContainSubstring("- instanceType: g4dn.xlarge"))
+			Expect(stdout).To(ContainSubstring("  name: gpu"))
+			Expect(stdout).NotTo(ContainSubstring("workers-0"))
+			Expect(stdout).NotTo(ContainSubstring("subnet"))
+			Expect(stdout).NotTo(ContainSubstring("securityGroupIDs"))
+			Expect(stdout).NotTo(ContainSubstring("version"))
+			Expect(stderr).To(ContainSubstring("Skipping machine pool 'workers-0'"))
+		})
+
+		It("exports the cluster specific fields when requested", func() {
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, test.FormatNodePoolList(hostedNodePools())))
+
+			stdout, _, err := run(&options{includeClusterSpecific: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(ContainSubstring("  subnet: subnet-a"))
+			Expect(stdout).To(ContainSubstring("  securityGroupIDs:\n  - sg-1"))
+			Expect(stdout).To(ContainSubstring("  version: 4.16.1"))
+		})
+
+		It("exports the machine pools of a classic cluster as JSON", func() {
+			output.SetOutput(output.JSON)
+			t.SetCluster(test.MockClusterName, test.MockCluster(nil))
+			machinePool, err := cmv1.NewMachinePool().ID("infra").InstanceType("m5.xlarge").Replicas(3).Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, test.FormatMachinePoolList([]*cmv1.MachinePool{machinePool})))
+
+			stdout, _, err := run(&options{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(ContainSubstring(`"kind": "MachinePoolList"`))
+			Expect(stdout).To(ContainSubstring(`"replicas": 3`))
+		})
+
+		It("fails when the cluster only has the default machine pools", func() {
+			t.SetCluster(test.MockClusterName, test.MockCluster(nil))
+			machinePool, err := cmv1.NewMachinePool().ID("worker").InstanceType("m5.xlarge").Replicas(3).Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, test.FormatMachinePoolList([]*cmv1.MachinePool{machinePool})))
+
+			_, _, err = run(&options{})
+			Expect(err).To(MatchError(ContainSubstring("has no machine pools other than the default ones")))
+		})
+	})
+})
@@ -24,6 +24,8 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/cmd/create/idp"
+	"github.com/openshift/rosa/pkg/access"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -84,13 +86,13 @@ func run(_ *cobra.Command, argv []string) {
 			"Username '%s' isn't valid: it must contain only letters, digits, dashes and underscores",
 			username,
 		)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 	if username == idp.ClusterAdminUsername {
 		r.Reporter.Errorf("Username '%s' is reserved for `rosa create/delete admin` command. "+
 			"Run `rosa create admin -c %s` to create user '%s'",
 			idp.ClusterAdminUsername, clusterKey, idp.ClusterAdminUsername)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	role := argv[0]
@@ -109,7 +111,7 @@ func run(_ *cobra.Command, argv []string) {
 	}
 	if !isRoleValid {
 		r.Reporter.Errorf("Expected at least one of %s", validRoles)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	cluster := r.FetchCluster()
@@ -117,11 +119,16 @@ func run(_ *cobra.Command, argv []string) {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
 		os.Exit(1)
 	}
+	err := access.Preflight(r, ocm.ActionUpdate, ocm.ClusterResourceType, cluster)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
+	}
 
 	user, err := cmv1.NewUser().ID(username).Build()
 	if err != nil {
 		r.Reporter.Errorf("Failed to create user '%s' for cluster '%s'", username, clusterKey)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	r.Reporter.Debugf("Adding user '%s' to group '%s' in cluster '%s'", username, role, clusterKey)
@@ -129,7 +136,7 @@ func run(_ *cobra.Command, argv []string) {
 	if err != nil {
 		r.Reporter.Errorf("Failed to grant '%s' to user '%s' to cluster '%s': %s",
 			role, username, clusterKey, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	r.Reporter.Infof("Granted role '%s' to user '%s' on cluster '%s'", role, username, clusterKey)
@@ -0,0 +1,205 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package run
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/hibernation"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/properties"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "run"
+	short = "Hibernate and resume clusters following their schedules"
+	long  = "Hibernate or resume the clusters whose hibernation schedule selects a different state. " +
+		"The state selected by a schedule is the one of its cron expression that matched last, so " +
+		"running this command periodically, for example from a CI job, applies the schedules even " +
+		"when some runs are missed. Clusters are only hibernated when they are ready and only " +
+		"resumed when they are hibernating, clusters in any other state are skipped, like the " +
+		"clusters of a schedule file that can't be found."
+	example = `  # Show what would be done for the clusters with a hibernation schedule property
+  rosa hibernation run --dry-run
+
+  # Apply the schedules of a schedule file
+  rosa hibernation run --schedule-file=schedules.yaml`
+)
+
+type options struct {
+	scheduleFile string
+	dryRun       bool
+}
+
+var decisionsTable = output.Table[*hibernation.Decision]{
+	Columns: []output.Column[*hibernation.Decision]{
+		{Header: "CLUSTER", Value: func(d *hibernation.Decision) string { return d.Cluster.Name() }},
+		{Header: "STATE", Value: func(d *hibernation.Decision) string { return string(d.Cluster.State()) }},
+		{Header: "SCHEDULED", Value: scheduled},
+		{Header: "ACTION", Value: func(d *hibernation.Decision) string { return string(d.Action) }},
+		{Header: "MESSAGE", Value: func(d *hibernation.Decision) string { return d.Message }},
+		{Header: "SCHEDULE", Value: func(d *hibernation.Decision) string { return d.Schedule.String() }, Wide: true},
+	},
+}
+
+func scheduled(d *hibernation.Decision) string {
+	if d.Desired == hibernation.StateNone {
+		return ""
+	}
+	return fmt.Sprintf("%s since %s", d.Desired, d.Since.Format(time.RFC3339))
+}
+
+func NewHibernationRunCommand() *cobra.Command {
+	options := &options{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), HibernationRunRunner(options)),
+		Args:    cobra.NoArgs,
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+	flags.StringVar(
+		&options.scheduleFile,
+		"schedule-file",
+		"",
+		"Path of the schedule file with the schedules to apply. When not given, the schedules are "+
+			"read from the properties of the clusters.",
+	)
+	flags.BoolVar(
+		&options.dryRun,
+		"dry-run",
+		false,
+		"Report the actions without hibernating or resuming any cluster.",
+	)
+	output.AddTableFlags(cmd)
+	return cmd
+}
+
+func HibernationRunRunner(options *options) rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		var decisions []*hibernation.Decision
+		var err error
+		failures := 0
+		now := time.Now()
+		if options.scheduleFile != "" {
+			decisions, failures, err = decideFromFile(r, options.scheduleFile, now)
+		} else {
+			decisions, err = decideFromProperties(r, now)
+		}
+		if err != nil {
+			return err
+		}
+		if len(decisions) == 0 {
+			r.Reporter.Infof("There are no clusters with a hibernation schedule")
+			return nil
+		}
+
+		if !options.dryRun {
+			failures += hibernation.Apply(r.OCMClient, decisions)
+		}
+		err = decisionsTable.Print(decisions)
+		if err != nil {
+			return err
+		}
+		if options.dryRun {
+			r.Reporter.Infof("Dry run: no cluster has been hibernated or resumed")
+		}
+		if failures > 0 {
+			return fmt.Errorf("Failed to hibernate or resume %d clusters", failures)
+		}
+		return nil
+	}
+}
+
+// decideFromFile evaluates the schedules of the file. Clusters that can't be found are skipped, so
+// that one of them doesn't prevent applying the schedules of the others. Clusters that can't be
+// retrieved for any other reason are also skipped, but they are returned as failures.
+func decideFromFile(r *rosa.Runtime, path string, now time.Time) ([]*hibernation.Decision, int, error) {
+	file, err := hibernation.LoadFile(path)
+	if err != nil {
+		return nil, 0, err
+	}
+	decisions := []*hibernation.Decision{}
+	failures := 0
+	for _, schedule := range file.Schedules {
+		cluster, err := r.OCMClient.GetCluster(schedule.Cluster, r.Creator)
+		if err != nil {
+			if errors.GetType(err) != errors.NotFound {
+				failures++
+			}
+			decisions = append(decisions, missing(schedule, err))
+			continue
+		}
+		decisions = append(decisions, hibernation.Decide(cluster, schedule, now))
+	}
+	return decisions, failures, nil
+}
+
+func decideFromProperties(r *rosa.Runtime, now time.Time) ([]*hibernation.Decision, error) {
+	clusters, err := r.OCMClient.ListClusters(r.Creator, ocm.ClusterListOptions{
+		Search: fmt.Sprintf("properties.%s LIKE '%%'", properties.HibernationSchedule),
+	})
+	if err != nil {
+		return nil, err
+	}
+	decisions := []*hibernation.Decision{}
+	for _, cluster := range clusters {
+		value, ok := cluster.Properties()[properties.HibernationSchedule]
+		if !ok {
+			continue
+		}
+		schedule, err := hibernation.ParseProperty(value)
+		if err != nil {
+			decisions = append(decisions, invalid(cluster, err))
+			continue
+		}
+		decisions = append(decisions, hibernation.Decide(cluster, schedule, now))
+	}
+	return decisions, nil
+}
+
+func missing(schedule *hibernation.Schedule, err error) *hibernation.Decision {
+	// Only the name is needed to report the decision:
+	cluster, _ := cmv1.NewCluster().Name(schedule.Cluster).Build()
+	return &hibernation.Decision{
+		Cluster:  cluster,
+		Schedule: schedule,
+		Action:   hibernation.ActionSkip,
+		Message:  err.Error(),
+	}
+}
+
+func invalid(cluster *cmv1.Cluster, err error) *hibernation.Decision {
+	return &hibernation.Decision{
+		Cluster:  cluster,
+		Schedule: &hibernation.Schedule{},
+		Action:   hibernation.ActionSkip,
+		Message:  err.Error(),
+	}
+}
@@ -0,0 +1,142 @@
+package run
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("hibernation run", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewHibernationRunCommand()
+		Expect(cmd).NotTo(BeNil())
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Run).NotTo(BeNil())
+		for _, flag := range []string{"schedule-file", "dry-run", "output"} {
+			Expect(cmd.Flags().Lookup(flag)).NotTo(BeNil())
+		}
+	})
+
+	Context("Hibernation Run Runner", func() {
+		var t *TestingRuntime
+		var clusters []*cmv1.Cluster
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			output.SetOutput("")
+			build := func(id string, state cmv1.ClusterState, schedule string) *cmv1.Cluster {
+				cluster, err := cmv1.NewCluster().ID(id).Name(id).State(state).
+					Properties(map[string]string{"rosa_hibernation_schedule": schedule}).Build()
+				Expect(err).ToNot(HaveOccurred())
+				return cluster
+			}
+			// The schedules always select hibernation, so that the result doesn't depend on the time:
+			always := `{"hibernate":"* * * * *","resume":"0 0 29 2 *"}`
+			clusters = []*cmv1.Cluster{
+				build("ready", cmv1.ClusterStateReady, always),
+				build("asleep", cmv1.ClusterStateHibernating, always),
+				build("installing", cmv1.ClusterStateInstalling, always),
+				build("broken", cmv1.ClusterStateReady, `{"hibernate":"never"}`),
+			}
+		})
+
+		AfterEach(func() {
+			output.SetOutput("")
+		})
+
+		run := func(options *options) (string, error) {
+			stdout, _, err := RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+				return HibernationRunRunner(options)(context.Background(), r, cmd, nil)
+			}, t.RosaRuntime, nil)
+			return stdout, err
+		}
+
+		It("Reports the actions without applying them in dry run mode", func() {
+			t.ApiServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters"),
+				RespondWithJSON(http.StatusOK, FormatClusterList(clusters)),
+			))
+
+			stdout, err := run(&options{dryRun: true})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(1))
+			Expect(stdout).To(MatchRegexp(`ready +ready +hibernating since \S+ +hibernate`))
+			Expect(stdout).To(MatchRegexp(`asleep +hibernating +hibernating since \S+ +none`))
+			Expect(stdout).To(MatchRegexp(`installing +installing +hibernating since \S+ +skip +` +
+				`cluster is in 'installing' state`))
+			Expect(stdout).To(MatchRegexp(`broken +ready +skip +invalid hibernate cron expression 'never'`))
+			Expect(stdout).To(ContainSubstring("Dry run: no cluster has been hibernated or resumed"))
+		})
+
+		It("Skips the clusters of the schedule file that can't be found", func() {
+			file := filepath.Join(GinkgoT().TempDir(), "schedules.yaml")
+			Expect(os.WriteFile(file, []byte(`apiVersion: rosa.openshift.io/v1alpha1
+kind: HibernationSchedules
+schedules:
+- cluster: missing
+  hibernate: "* * * * *"
+  resume: "0 0 29 2 *"
+- cluster: ready
+  hibernate: "* * * * *"
+  resume: "0 0 29 2 *"
+`), 0600)).To(Succeed())
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{})))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList(clusters[:1])))
+
+			stdout, err := run(&options{scheduleFile: file, dryRun: true})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(2))
+			Expect(stdout).To(MatchRegexp(`missing +skip +There is no cluster with identifier or name 'missing'`))
+			Expect(stdout).To(MatchRegexp(`ready +ready +hibernating since \S+ +hibernate`))
+		})
+
+		It("Fails for the clusters of the schedule file that can't be retrieved", func() {
+			file := filepath.Join(GinkgoT().TempDir(), "schedules.yaml")
+			Expect(os.WriteFile(file, []byte(`apiVersion: rosa.openshift.io/v1alpha1
+kind: HibernationSchedules
+schedules:
+- cluster: forbidden
+  hibernate: "* * * * *"
+  resume: "0 0 29 2 *"
+`), 0600)).To(Succeed())
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusForbidden,
+				`{"kind": "Error", "reason": "access denied"}`))
+
+			stdout, err := run(&options{scheduleFile: file, dryRun: true})
+			Expect(err).To(MatchError("Failed to hibernate or resume 1 clusters"))
+			Expect(stdout).To(MatchRegexp(`forbidden +skip +.*access denied`))
+		})
+
+		It("Hibernates the clusters and reports the failures", func() {
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList(clusters)))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, `{"organization": {"id": "org-1"}}`))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, `{
+				"id": "org-1",
+				"capabilities": [{"name": "capability.organization.hibernate_cluster", "value": "true"}]
+			}`))
+			t.ApiServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters/ready/hibernate"),
+				RespondWithJSON(http.StatusBadRequest, `{"kind": "Error", "reason": "cluster is busy"}`),
+			))
+
+			stdout, err := run(&options{})
+			Expect(err).To(MatchError("Failed to hibernate or resume 1 clusters"))
+			Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(4))
+			Expect(stdout).To(MatchRegexp(`ready +ready +hibernating since \S+ +hibernate +failed to hibernate the ` +
+				`cluster: .*cluster is busy`))
+		})
+	})
+})
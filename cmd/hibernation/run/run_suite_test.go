@@ -0,0 +1,13 @@
+package run
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestHibernationRun(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Hibernation Run Suite")
+}
@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"os"
 	"strings"
-	"text/tabwriter"
 	"time"
 
 	v1 "github.com/openshift-online/ocm-sdk-go/accesstransparency/v1"
 	"github.com/spf13/cobra"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
 
+	"github.com/openshift/rosa/pkg/accessrequest"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -20,31 +22,101 @@ const (
 	use   = "access-request"
 	short = "List Access Requests"
 	long  = "List Access Requests in Pending or Approved status. " +
-		"If '--cluster' flag is used, list all Access Requests in any status for the specified cluster."
+		"If '--cluster' flag is used, list all Access Requests in any status for the specified cluster.\n\n" +
+		"With '--watch' the command keeps running and reports every new pending Access Request, " +
+		"optionally notifying a webhook or running a command for each of them. Webhooks receive " +
+		"the Access Request as a JSON POST request. Commands run in a shell, receive the Access " +
+		"Request as JSON in their standard input and its attributes in the ROSA_ACCESS_REQUEST_ID, " +
+		"ROSA_ACCESS_REQUEST_CLUSTER_ID, ROSA_ACCESS_REQUEST_SUBSCRIPTION_ID, " +
+		"ROSA_ACCESS_REQUEST_REQUESTED_BY, ROSA_ACCESS_REQUEST_JUSTIFICATION and " +
+		"ROSA_ACCESS_REQUEST_DEADLINE_AT environment variables."
 	example = `  # List all Access Requests for cluster 'foo'
   rosa list access-request --cluster foo
+
+  # List the denied and expired Access Requests of a requester
+  rosa list access-request --requester jdoe --state Denied,Expired
+
+  # Watch for new pending Access Requests and post them to a webhook
+  rosa list access-request --watch --on-pending-webhook https://hooks.example.com/rosa
+
+  # Watch for new pending Access Requests of cluster 'foo' and page the on-call engineer
+  rosa list access-request --watch --cluster foo --on-pending-command 'page-oncall "$ROSA_ACCESS_REQUEST_ID"'
   `
 )
 
-func NewListAccessRequestsCommand() *cobra.Command {
+type options struct {
+	requester string
+	states    []string
+	watch     bool
+	interval  time.Duration
+	webhooks  []string
+	commands  []string
+}
 
+func NewListAccessRequestsCommand() *cobra.Command {
+	options := &options{}
 	cmd := &cobra.Command{
 		Use:     use,
 		Aliases: []string{"accessrequest", "accessrequests", "access-requests"},
 		Short:   short,
 		Long:    long,
 		Example: example,
-		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), ListAccessRequestsRunner()),
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), ListAccessRequestsRunner(options)),
 		Args:    cobra.NoArgs,
 	}
 
-	output.AddFlag(cmd)
+	flags := cmd.Flags()
+	flags.StringVar(
+		&options.requester,
+		"requester",
+		"",
+		"List only the Access Requests requested by the given user.",
+	)
+	flags.StringSliceVar(
+		&options.states,
+		"state",
+		[]string{},
+		fmt.Sprintf("List only the Access Requests in the given states, valid values are '%s', '%s', '%s' "+
+			"and '%s'.", v1.AccessRequestStatePending, v1.AccessRequestStateApproved,
+			v1.AccessRequestStateDenied, v1.AccessRequestStateExpired),
+	)
+	flags.BoolVarP(
+		&options.watch,
+		"watch",
+		"w",
+		false,
+		"Keep running and report every new pending Access Request.",
+	)
+	flags.DurationVar(
+		&options.interval,
+		"interval",
+		accessrequest.DefaultInterval,
+		"Time between two consecutive checks for new pending Access Requests when watching.",
+	)
+	flags.StringArrayVar(
+		&options.webhooks,
+		"on-pending-webhook",
+		[]string{},
+		"URL that receives a POST request with every new pending Access Request when watching. "+
+			"Can be repeated.",
+	)
+	flags.StringArrayVar(
+		&options.commands,
+		"on-pending-command",
+		[]string{},
+		"Shell command that runs for every new pending Access Request when watching. Can be repeated.",
+	)
+	output.AddTableFlags(cmd)
 	ocm.AddOptionalClusterFlag(cmd)
 	return cmd
 }
 
-func ListAccessRequestsRunner() rosa.CommandRunner {
-	return func(_ context.Context, r *rosa.Runtime, cmd *cobra.Command, _ []string) error {
+func ListAccessRequestsRunner(options *options) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, cmd *cobra.Command, _ []string) error {
+		err := validateOptions(cmd, options)
+		if err != nil {
+			return err
+		}
 		clusterId := ""
 		if cmd.Flags().Changed("cluster") {
 			cluster, err := r.OCMClient.GetCluster(r.GetClusterKey(), r.Creator)
@@ -53,41 +125,132 @@ func ListAccessRequestsRunner() rosa.CommandRunner {
 			}
 			clusterId = cluster.ID()
 		}
-		accessRequests, err := r.OCMClient.ListAccessRequest(clusterId)
+		filter := ocm.AccessRequestFilter{
+			ClusterID:   clusterId,
+			RequestedBy: options.requester,
+			States:      options.states,
+		}
+		if options.watch {
+			return watch(ctx, r, filter, options)
+		}
+
+		accessRequests, err := r.OCMClient.ListAccessRequest(filter)
 		if err != nil {
 			return err
 		}
-		if output.HasFlag() {
-			output.Print(accessRequests)
-		} else {
-			if len(accessRequests) == 0 {
-				if clusterId == "" {
-					r.Reporter.Infof("There are no Access Requests in Pending or Approved status.")
-				} else {
-					r.Reporter.Infof("There are no Access Requests for cluster '%s'.", r.ClusterKey)
-				}
-				return nil
-			}
-			writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
-			output, hasPending, pendingId := getAccessRequestsOutput(clusterId, accessRequests)
-			fmt.Fprint(writer, output)
-			if err := writer.Flush(); err != nil {
-				return err
-			}
-			if hasPending {
-				r.Reporter.Infof("Run the following command to approve or deny the Access Request:\n\n"+
-					"   rosa create decision --access-request %s --decision Approved\n"+
-					"   rosa create decision --access-request %s --decision Denied --justification \"justification\"\n",
-					pendingId, pendingId)
+		if len(accessRequests) == 0 && output.IsTableOutput() {
+			if clusterId == "" && options.requester == "" && len(options.states) == 0 {
+				r.Reporter.Infof("There are no Access Requests in Pending or Approved status.")
+			} else if clusterId != "" && options.requester == "" && len(options.states) == 0 {
+				r.Reporter.Infof("There are no Access Requests for cluster '%s'.", r.ClusterKey)
+			} else {
+				r.Reporter.Infof("There are no Access Requests matching the filters.")
 			}
+			return nil
+		}
+		err = accessRequestsTable.Print(accessRequests)
+		if err != nil {
+			return err
+		}
+		if hasPending, pendingId := findPendingAccessRequest(clusterId, accessRequests); hasPending &&
+			output.IsTableOutput() {
+			r.Reporter.Infof("Run the following command to approve or deny the Access Request:\n\n"+
+				"   rosa create decision --access-request %s --decision Approved\n"+
+				"   rosa create decision --access-request %s --decision Denied --justification \"justification\"\n",
+				pendingId, pendingId)
 		}
 		return nil
 	}
 }
 
-func getAccessRequestsOutput(clusterId string, accessRequests []*v1.AccessRequest) (string, bool, string) {
-	var output strings.Builder
-	output.WriteString("STATE\tID\tCLUSTER ID\tUPDATED AT\n")
+func validateOptions(cmd *cobra.Command, options *options) error {
+	for i, state := range options.states {
+		state = cases.Title(language.English, cases.Compact).String(strings.TrimSpace(state))
+		switch v1.AccessRequestState(state) {
+		case v1.AccessRequestStatePending, v1.AccessRequestStateApproved,
+			v1.AccessRequestStateDenied, v1.AccessRequestStateExpired:
+			options.states[i] = state
+		default:
+			return fmt.Errorf("Invalid 'state' value: '%s', should be one of '%s', '%s', '%s', '%s'",
+				options.states[i], v1.AccessRequestStatePending, v1.AccessRequestStateApproved,
+				v1.AccessRequestStateDenied, v1.AccessRequestStateExpired)
+		}
+	}
+	if options.watch {
+		if len(options.states) > 0 {
+			return fmt.Errorf("The '--state' flag cannot be used with '--watch', which only reports " +
+				"pending Access Requests")
+		}
+		if options.interval <= 0 {
+			return fmt.Errorf("The '--interval' flag must be a positive duration")
+		}
+		return nil
+	}
+	for _, flag := range []string{"interval", "on-pending-webhook", "on-pending-command"} {
+		if cmd.Flags().Changed(flag) {
+			return fmt.Errorf("The '--%s' flag can only be used with '--watch'", flag)
+		}
+	}
+	return nil
+}
+
+func watch(ctx context.Context, r *rosa.Runtime, filter ocm.AccessRequestFilter, options *options) error {
+	filter.States = []string{string(v1.AccessRequestStatePending)}
+	hooks := []accessrequest.Hook{}
+	for _, url := range options.webhooks {
+		hooks = append(hooks, &accessrequest.WebhookHook{URL: url})
+	}
+	for _, command := range options.commands {
+		hooks = append(hooks, &accessrequest.CommandHook{Command: command, Stdout: os.Stdout, Stderr: os.Stderr})
+	}
+	watcher := &accessrequest.Watcher{
+		List: func() ([]*v1.AccessRequest, error) {
+			return r.OCMClient.ListAccessRequest(filter)
+		},
+		Interval: options.interval,
+		Hooks:    hooks,
+		OnNew: func(accessRequest *v1.AccessRequest) {
+			if output.HasFlag() {
+				err := output.Print(accessRequest)
+				if err != nil {
+					r.Reporter.Errorf("Failed to print Access Request '%s': %v", accessRequest.ID(), err)
+				}
+				return
+			}
+			r.Reporter.Infof("New pending Access Request '%s' for cluster '%s' requested by '%s', "+
+				"decide before %s:\n\n"+
+				"   rosa create decision --access-request %s --decision Approved\n",
+				accessRequest.ID(), accessRequest.ClusterId(), accessRequest.RequestedBy(),
+				accessRequest.DeadlineAt().Format(time.UnixDate), accessRequest.ID())
+		},
+		OnHookError: func(accessRequest *v1.AccessRequest, hook accessrequest.Hook, err error) {
+			r.Reporter.Warnf("Failed to notify %s about Access Request '%s': %v", hook, accessRequest.ID(), err)
+		},
+		OnListError: func(err error) {
+			r.Reporter.Warnf("Failed to list pending Access Requests: %v", err)
+		},
+	}
+	if output.IsTableOutput() {
+		r.Reporter.Infof("Watching for new pending Access Requests every %s, press Ctrl+C to stop", options.interval)
+	}
+	return watcher.Watch(ctx)
+}
+
+var accessRequestsTable = output.Table[*v1.AccessRequest]{
+	Columns: []output.Column[*v1.AccessRequest]{
+		{Header: "STATE", Value: func(a *v1.AccessRequest) string { return string(a.Status().State()) }},
+		{Header: "ID", Value: func(a *v1.AccessRequest) string { return a.ID() }},
+		{Header: "CLUSTER ID", Value: func(a *v1.AccessRequest) string { return a.ClusterId() }},
+		{Header: "UPDATED AT", Value: func(a *v1.AccessRequest) string { return a.UpdatedAt().Format(time.UnixDate) }},
+		{Header: "REQUESTED BY", Value: func(a *v1.AccessRequest) string { return a.RequestedBy() }, Wide: true},
+		{Header: "SUBSCRIPTION ID", Value: func(a *v1.AccessRequest) string { return a.SubscriptionId() }, Wide: true},
+	},
+	Name: func(a *v1.AccessRequest) string { return a.ID() },
+}
+
+// findPendingAccessRequest returns whether there is a pending Access Request and, when listing the
+// Access Requests of a cluster, the identifier of the last pending one
+func findPendingAccessRequest(clusterId string, accessRequests []*v1.AccessRequest) (bool, string) {
 	hasPending := false
 	id := "<ID>"
 	for _, accessRequest := range accessRequests {
@@ -97,12 +260,6 @@ func getAccessRequestsOutput(clusterId string, accessRequests []*v1.AccessReques
 				id = accessRequest.ID()
 			}
 		}
-		fmt.Fprintf(&output, "%s\t%s\t%s\t%s\n",
-			accessRequest.Status().State(),
-			accessRequest.ID(),
-			accessRequest.ClusterId(),
-			accessRequest.UpdatedAt().Format(time.UnixDate))
 	}
-
-	return output.String(), hasPending, id
+	return hasPending, id
 }
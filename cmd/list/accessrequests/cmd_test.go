@@ -8,6 +8,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
 	v1 "github.com/openshift-online/ocm-sdk-go/accesstransparency/v1"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	. "github.com/openshift-online/ocm-sdk-go/testing"
@@ -54,6 +55,9 @@ var _ = Describe("rosa attach policy", func() {
 
 			flag := cmd.Flags().Lookup("cluster")
 			Expect(flag).NotTo(BeNil())
+			for _, name := range []string{"requester", "state", "watch", "on-pending-webhook", "on-pending-command"} {
+				Expect(cmd.Flags().Lookup(name)).NotTo(BeNil())
+			}
 		})
 	})
 
@@ -77,7 +81,7 @@ var _ = Describe("rosa attach policy", func() {
 		It("Returns an error if OCM API fails to list Access Requests", func() {
 			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusInternalServerError, "{}"))
 
-			runner := ListAccessRequestsRunner()
+			runner := ListAccessRequestsRunner(&options{})
 			err := runner(nil, t.RosaRuntime, c, nil)
 
 			Expect(err).NotTo(BeNil())
@@ -86,7 +90,7 @@ var _ = Describe("rosa attach policy", func() {
 		It("Prints message if there are no Access Requests in Approved/Pending", func() {
 			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatAccessRequestList([]*v1.AccessRequest{})))
 
-			runner := ListAccessRequestsRunner()
+			runner := ListAccessRequestsRunner(&options{})
 
 			t.StdOutReader.Record()
 			err := runner(context.Background(), t.RosaRuntime, c, nil)
@@ -119,7 +123,7 @@ var _ = Describe("rosa attach policy", func() {
 					http.StatusOK, FormatAccessRequestList(
 						[]*v1.AccessRequest{accessRequest1, accessRequest2})))
 
-			runner := ListAccessRequestsRunner()
+			runner := ListAccessRequestsRunner(&options{})
 
 			t.StdOutReader.Record()
 			err = runner(context.Background(), t.RosaRuntime, c, nil)
@@ -159,7 +163,7 @@ var _ = Describe("rosa attach policy", func() {
 					http.StatusOK, FormatAccessRequestList(
 						[]*v1.AccessRequest{accessRequest1, accessRequest2})))
 
-			runner := ListAccessRequestsRunner()
+			runner := ListAccessRequestsRunner(&options{})
 
 			t.StdOutReader.Record()
 			c.Flags().Set("cluster", "mock-cluster-id")
@@ -170,5 +174,40 @@ var _ = Describe("rosa attach policy", func() {
 			Expect(stdOut).To(Equal(clusterAccessRequestsOutput))
 		})
 
+		It("Filters the Access Requests by requester and state", func() {
+			t.ApiServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyFormKV("search", "requested_by='jdoe' and status.state in ('Denied', 'Expired')"),
+				RespondWithJSON(http.StatusOK, FormatAccessRequestList([]*v1.AccessRequest{})),
+			))
+			Expect(c.Flags().Set("requester", "jdoe")).To(Succeed())
+			Expect(c.Flags().Set("state", "denied,Expired")).To(Succeed())
+
+			t.StdOutReader.Record()
+			err := ListAccessRequestsRunner(&options{requester: "jdoe", states: []string{"denied", "Expired"}})(
+				context.Background(), t.RosaRuntime, c, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(Equal("INFO: There are no Access Requests matching the filters.\n"))
+		})
+
+		It("Returns an error for an invalid state", func() {
+			err := ListAccessRequestsRunner(&options{states: []string{"Unknown"}})(
+				context.Background(), t.RosaRuntime, c, nil)
+			Expect(err).To(MatchError(ContainSubstring("Invalid 'state' value: 'Unknown'")))
+		})
+
+		It("Returns an error when using hooks without watching", func() {
+			Expect(c.Flags().Set("on-pending-webhook", "https://example.com")).To(Succeed())
+			err := ListAccessRequestsRunner(&options{webhooks: []string{"https://example.com"}})(
+				context.Background(), t.RosaRuntime, c, nil)
+			Expect(err).To(MatchError("The '--on-pending-webhook' flag can only be used with '--watch'"))
+		})
+
+		It("Returns an error when filtering the state while watching", func() {
+			err := ListAccessRequestsRunner(&options{watch: true, interval: time.Second, states: []string{"Denied"}})(
+				context.Background(), t.RosaRuntime, c, nil)
+			Expect(err).To(MatchError(ContainSubstring("The '--state' flag cannot be used with '--watch'")))
+		})
 	})
 })
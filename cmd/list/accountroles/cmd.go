@@ -17,14 +17,14 @@ limitations under the License.
 package accountroles
 
 import (
-	"fmt"
 	"os"
-	"text/tabwriter"
 	"time"
 
 	"github.com/briandowns/spinner"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -54,7 +54,7 @@ func init() {
 		"",
 		"List only account-roles that are associated with the given version.",
 	)
-	output.AddFlag(Cmd)
+	output.AddTableFlags(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -64,14 +64,14 @@ func run(_ *cobra.Command, _ []string) {
 	versionList, err := ocm.GetVersionMinorList(r.OCMClient)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	_, err = r.OCMClient.ValidateVersion(args.version, versionList,
 		r.Cluster.Version().ChannelGroup(), r.Cluster.AWS().STS().RoleARN() == "", r.Cluster.Hypershift().Enabled())
 	if err != nil {
 		r.Reporter.Errorf("Version '%s' is invalid", args.version)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	var spin *spinner.Spinner
@@ -91,40 +91,28 @@ func run(_ *cobra.Command, _ []string) {
 
 	if err != nil {
 		r.Reporter.Errorf("Failed to get account roles: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
-	if output.HasFlag() {
-		err = output.Print(accountRoles)
-		if err != nil {
-			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
-		}
-		os.Exit(0)
-	}
-
-	if len(accountRoles) == 0 {
+	if len(accountRoles) == 0 && output.IsTableOutput() {
 		r.Reporter.Infof("No account roles available")
 		os.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(writer, "ROLE NAME\tROLE TYPE\tROLE ARN\tOPENSHIFT VERSION\tAWS Managed\n")
-	for _, accountRole := range accountRoles {
-		awsManaged := "No"
-		if accountRole.ManagedPolicy {
-			awsManaged = "Yes"
-		}
-		fmt.Fprintf(
-			writer,
-			"%s\t%s\t%s\t%s\t%s\n",
-			accountRole.RoleName,
-			accountRole.RoleType,
-			accountRole.RoleARN,
-			accountRole.Version,
-			awsManaged,
-		)
+	err = accountRolesTable.Print(accountRoles)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
 	}
-	writer.Flush()
+}
+
+var accountRolesTable = output.Table[aws.Role]{
+	Columns: []output.Column[aws.Role]{
+		{Header: "ROLE NAME", Value: func(role aws.Role) string { return role.RoleName }},
+		{Header: "ROLE TYPE", Value: func(role aws.Role) string { return role.RoleType }},
+		{Header: "ROLE ARN", Value: func(role aws.Role) string { return role.RoleARN }},
+		{Header: "OPENSHIFT VERSION", Value: func(role aws.Role) string { return role.Version }},
+		{Header: "AWS Managed", Value: func(role aws.Role) string { return output.PrintBool(role.ManagedPolicy) }},
+	},
+	Name: func(role aws.Role) string { return role.RoleName },
 }
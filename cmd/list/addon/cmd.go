@@ -18,12 +18,14 @@ package addon
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"text/tabwriter"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -55,7 +57,49 @@ func init() {
 		"Name or ID of the cluster to list the add-ons of (required).",
 	)
 
-	output.AddFlag(Cmd)
+	output.AddTableFlags(Cmd)
+}
+
+var addOnsTable = output.Table[*ocm.AddOnResource]{
+	Columns: []output.Column[*ocm.AddOnResource]{
+		{Header: "ID", Value: func(a *ocm.AddOnResource) string { return a.AddOn.ID() }},
+		{Header: "NAME", Value: func(a *ocm.AddOnResource) string { return a.AddOn.Name() }},
+		{Header: "AVAILABILITY", Value: addOnAvailability},
+		{Header: "AZ TYPE", Value: func(a *ocm.AddOnResource) string { return a.AZType }, Wide: true},
+	},
+	Name: func(a *ocm.AddOnResource) string { return a.AddOn.ID() },
+	Default: func(w io.Writer, addOnResources []*ocm.AddOnResource) error {
+		writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(writer, "ID\t\tNAME\t\tAVAILABILITY\n")
+		for _, addOnResource := range addOnResources {
+			fmt.Fprintf(writer, "%s\t\t%s\t\t%s\n", addOnResource.AddOn.ID(), addOnResource.AddOn.Name(),
+				addOnAvailability(addOnResource))
+		}
+		return writer.Flush()
+	},
+}
+
+var clusterAddOnsTable = output.Table[*ocm.ClusterAddOn]{
+	Columns: []output.Column[*ocm.ClusterAddOn]{
+		{Header: "ID", Value: func(a *ocm.ClusterAddOn) string { return a.ID }},
+		{Header: "NAME", Value: func(a *ocm.ClusterAddOn) string { return a.Name }},
+		{Header: "STATE", Value: func(a *ocm.ClusterAddOn) string { return a.State }},
+	},
+	Default: func(w io.Writer, clusterAddOns []*ocm.ClusterAddOn) error {
+		writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(writer, "ID\t\tNAME\t\tSTATE\n")
+		for _, clusterAddOn := range clusterAddOns {
+			fmt.Fprintf(writer, "%s\t\t%s\t\t%s\n", clusterAddOn.ID, clusterAddOn.Name, clusterAddOn.State)
+		}
+		return writer.Flush()
+	},
+}
+
+func addOnAvailability(addOnResource *ocm.AddOnResource) string {
+	if addOnResource.Available {
+		return "available"
+	}
+	return "unavailable"
 }
 
 // When no specific cluster id is provided by the user, this function lists all available AddOns
@@ -64,35 +108,19 @@ func listAllAddOns(r *rosa.Runtime) {
 	addOnResources, err := r.OCMClient.GetAvailableAddOns()
 	if err != nil {
 		r.Reporter.Errorf("Failed to fetch add-ons: %v", err)
-		os.Exit(1)
-	}
-
-	if output.HasFlag() {
-		err = output.Print(addOnResources)
-		if err != nil {
-			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
-		}
-		os.Exit(0)
+		os.Exit(clierror.ExitCode(err))
 	}
 
-	if len(addOnResources) == 0 {
+	if len(addOnResources) == 0 && output.IsTableOutput() {
 		r.Reporter.Infof("There are no add-ons available")
 		os.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(writer, "ID\t\tNAME\t\tAVAILABILITY\n")
-	for _, addOnResource := range addOnResources {
-		availability := "unavailable"
-		if addOnResource.Available {
-			availability = "available"
-		}
-		fmt.Fprintf(writer, "%s\t\t%s\t\t%s\n", addOnResource.AddOn.ID(), addOnResource.AddOn.Name(), availability)
+	err = addOnsTable.Print(addOnResources)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
 	}
-	writer.Flush()
-
 	os.Exit(0)
 }
 
@@ -110,7 +138,7 @@ func listClusterAddOns(clusterKey string, r *rosa.Runtime) {
 				"must contain only letters, digits, dashes and underscores",
 			clusterKey,
 		)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	cluster := r.FetchCluster()
@@ -125,30 +153,19 @@ func listClusterAddOns(clusterKey string, r *rosa.Runtime) {
 	clusterAddOns, err := r.OCMClient.GetClusterAddOns(cluster)
 	if err != nil {
 		r.Reporter.Errorf("Failed to get add-ons for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
-	}
-
-	if output.HasFlag() {
-		err = output.Print(clusterAddOns)
-		if err != nil {
-			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
-		}
-		os.Exit(0)
+		os.Exit(clierror.ExitCode(err))
 	}
 
-	if len(clusterAddOns) == 0 {
+	if len(clusterAddOns) == 0 && output.IsTableOutput() {
 		r.Reporter.Infof("There are no add-ons installed on cluster '%s'", clusterKey)
 		os.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(writer, "ID\t\tNAME\t\tSTATE\n")
-	for _, clusterAddOn := range clusterAddOns {
-		fmt.Fprintf(writer, "%s\t\t%s\t\t%s\n", clusterAddOn.ID, clusterAddOn.Name, clusterAddOn.State)
+	err = clusterAddOnsTable.Print(clusterAddOns)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
 	}
-	writer.Flush()
 }
 
 func run(_ *cobra.Command, _ []string) {
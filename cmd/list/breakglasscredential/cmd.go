@@ -3,10 +3,12 @@ package breakglasscredential
 import (
 	"fmt"
 	"os"
-	"text/tabwriter"
+	"time"
 
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/externalauthprovider"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
@@ -26,7 +28,7 @@ var Cmd = &cobra.Command{
 
 func init() {
 	ocm.AddClusterFlag(Cmd)
-	output.AddFlag(Cmd)
+	output.AddTableFlags(Cmd)
 }
 
 func run(cmd *cobra.Command, _ []string) {
@@ -35,7 +37,7 @@ func run(cmd *cobra.Command, _ []string) {
 	err := runWithRuntime(r, cmd)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 }
 
@@ -56,31 +58,25 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		return fmt.Errorf("failed to get break glass credentials for cluster '%s': %v", clusterKey, err)
 	}
 
-	if output.HasFlag() {
-		err = output.Print(breakGlassCredentials)
-		if err != nil {
-			return fmt.Errorf("%s", err)
-		}
-		return nil
-	}
-
-	if len(breakGlassCredentials) == 0 {
+	if len(breakGlassCredentials) == 0 && output.IsTableOutput() {
 		r.Reporter.Infof("There are no break glass credentials for cluster '%s'", clusterKey)
 		return nil
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
-
-	fmt.Fprintf(writer, "ID\tUSERNAME\tSTATUS\n")
-	for _, credential := range breakGlassCredentials {
-		fmt.Fprintf(writer, "%s\t%s\t%s\n",
-			credential.ID(),
-			credential.Username(),
-			credential.Status(),
-		)
-	}
-	writer.Flush()
+	return breakGlassCredentialsTable.Print(breakGlassCredentials)
+}
 
-	return nil
+var breakGlassCredentialsTable = output.Table[*cmv1.BreakGlassCredential]{
+	Columns: []output.Column[*cmv1.BreakGlassCredential]{
+		{Header: "ID", Value: func(c *cmv1.BreakGlassCredential) string { return c.ID() }},
+		{Header: "USERNAME", Value: func(c *cmv1.BreakGlassCredential) string { return c.Username() }},
+		{Header: "STATUS", Value: func(c *cmv1.BreakGlassCredential) string { return string(c.Status()) }},
+		{
+			Header: "EXPIRATION TIMESTAMP",
+			Value: func(c *cmv1.BreakGlassCredential) string {
+				return c.ExpirationTimestamp().Format(time.RFC3339)
+			},
+			Wide: true,
+		},
+	},
 }
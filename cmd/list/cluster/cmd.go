@@ -19,12 +19,14 @@ package cluster
 import (
 	"fmt"
 	"os"
-	"text/tabwriter"
+	"strings"
+	"time"
 
 	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -34,29 +36,92 @@ var Cmd = &cobra.Command{
 	Use:     "clusters",
 	Aliases: []string{"cluster"},
 	Short:   "List clusters",
-	Long:    "List clusters.",
+	Long: `List clusters.
+
+The filter by AWS region is '--cluster-region' and not '--region', because '--region' is the
+AWS region used to run the command and is already inherited by all the commands.`,
 	Example: `  # List all clusters
-  rosa list clusters`,
+  rosa list clusters
+
+  # List the ready Hosted Control Plane clusters in 'us-east-1'
+  rosa list clusters --state ready --topology hcp --cluster-region us-east-1
+
+  # List the 10 most recently created clusters with the tag 'team=payments'
+  rosa list clusters --tag team=payments --sort-by -created --limit 10
+
+  # List the clusters that match a raw OCM search expression
+  rosa list clusters --search "name like 'prod-%' and multi_az = 'true'"`,
 	Args: cobra.NoArgs,
 	Run:  run,
 }
 
-const clusterCount = 1000
-
 var args struct {
 	listAll        bool
 	accountRoleArn string
+	states         []string
+	topology       string
+	clusterRegion  string
+	version        string
+	namePrefix     string
+	tags           map[string]string
+	search         string
+	sortBy         []string
+	limit          int
 }
 
 func init() {
 	flags := Cmd.Flags()
 	flags.SortFlags = false
 
-	output.AddFlag(Cmd)
+	output.AddTableFlags(Cmd)
 	flags.BoolVarP(&args.listAll, "all", "a", false, "List all clusters across different AWS "+
 		"accounts under the same Red Hat organization")
 	flags.StringVar(&args.accountRoleArn, "account-role-arn", "", "List all clusters "+
 		"using the account role identified by the ARN")
+	flags.StringSliceVar(&args.states, "state", nil, fmt.Sprintf("Only list clusters in the given "+
+		"states, for example 'ready,error'. Valid states are %s", strings.Join(ocm.ClusterStates, ", ")))
+	flags.StringVar(&args.topology, "topology", "", fmt.Sprintf("Only list clusters with the given "+
+		"topology. Valid topologies are %s", strings.Join(ocm.ClusterTopologies, ", ")))
+	flags.StringVar(&args.clusterRegion, "cluster-region", "", "Only list clusters in the given AWS region. "+
+		"Note that '--region' is the AWS region used to run the command, not a filter")
+	flags.StringVar(&args.version, "version", "", "Only list clusters running the given OpenShift "+
+		"version. A version like '4.14' selects all the patch releases")
+	flags.StringVar(&args.namePrefix, "name-prefix", "", "Only list clusters whose name starts "+
+		"with the given prefix")
+	flags.StringToStringVar(&args.tags, "tag", nil, "Only list clusters with the given AWS tag, "+
+		"for example 'team=payments'. The key can only contain letters, digits and '_'. "+
+		"Can be specified multiple times")
+	flags.StringVar(&args.search, "search", "", "Raw OCM search expression combined with the "+
+		"rest of the filters, for example \"multi_az = 'true'\"")
+	flags.StringSliceVar(&args.sortBy, "sort-by", nil, fmt.Sprintf("Comma-separated list of the "+
+		"fields used to sort the clusters. Prefix a field with '-' to sort in descending order. "+
+		"Valid fields are %s", strings.Join(ocm.ClusterSortFields(), ", ")))
+	flags.IntVar(&args.limit, "limit", 0, "Maximum number of clusters to list. By default all "+
+		"the clusters are listed")
+
+	Cmd.RegisterFlagCompletionFunc("state", completion(ocm.ClusterStates))
+	Cmd.RegisterFlagCompletionFunc("topology", completion(ocm.ClusterTopologies))
+	Cmd.RegisterFlagCompletionFunc("sort-by", completion(ocm.ClusterSortFields()))
+}
+
+func completion(values []string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return values, cobra.ShellCompDirectiveDefault
+	}
+}
+
+func listOptions() ocm.ClusterListOptions {
+	return ocm.ClusterListOptions{
+		States:     args.states,
+		Topology:   args.topology,
+		Region:     args.clusterRegion,
+		Version:    args.version,
+		NamePrefix: args.namePrefix,
+		Tags:       args.tags,
+		Search:     args.search,
+		SortBy:     args.sortBy,
+		Limit:      args.limit,
+	}
 }
 
 func listClustersUsingAccountRole(creator *aws.Creator, runtime *rosa.Runtime) ([]*v1.Cluster, error) {
@@ -65,13 +130,18 @@ func listClustersUsingAccountRole(creator *aws.Creator, runtime *rosa.Runtime) (
 		return []*v1.Cluster{}, err
 	}
 
-	return runtime.OCMClient.GetClustersUsingAccountRole(creator, role, clusterCount)
+	return runtime.OCMClient.ListClustersUsingAccountRole(creator, role, listOptions())
 }
 
 func run(_ *cobra.Command, _ []string) {
 	r := rosa.NewRuntime().WithAWSWarnInsteadOfExit().WithOCM()
 	defer r.Cleanup()
 
+	if args.limit < 0 {
+		r.Reporter.Errorf("Expected a non-negative limit, got %d", args.limit)
+		os.Exit(clierror.Validation.ExitCode())
+	}
+
 	// Retrieve the list of clusters:
 	var creator *aws.Creator
 	if args.listAll {
@@ -86,43 +156,41 @@ func run(_ *cobra.Command, _ []string) {
 	if args.accountRoleArn != "" {
 		clusters, err = listClustersUsingAccountRole(creator, r)
 	} else {
-		clusters, err = r.OCMClient.GetClusters(creator, clusterCount)
+		clusters, err = r.OCMClient.ListClusters(creator, listOptions())
 	}
 
 	if err != nil {
 		r.Reporter.Errorf("Failed to get clusters: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
-	if output.HasFlag() {
-		err = output.Print(clusters)
-		if err != nil {
-			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
-		}
-		os.Exit(0)
-	}
-
-	if len(clusters) == 0 {
+	if len(clusters) == 0 && output.IsTableOutput() {
 		r.Reporter.Infof("No clusters available")
 		os.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(writer, "ID\tNAME\tSTATE\tTOPOLOGY\n")
-	for _, cluster := range clusters {
-		typeOutput := clusterTopology(cluster)
-		fmt.Fprintf(
-			writer,
-			"%s\t%s\t%s\t%s\n",
-			cluster.ID(),
-			cluster.Name(),
-			cluster.State(),
-			typeOutput,
-		)
+	err = clustersTable.Print(clusters)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
 	}
-	writer.Flush()
+}
+
+var clustersTable = output.Table[*v1.Cluster]{
+	Columns: []output.Column[*v1.Cluster]{
+		{Header: "ID", Value: func(c *v1.Cluster) string { return c.ID() }},
+		{Header: "NAME", Value: func(c *v1.Cluster) string { return c.Name() }},
+		{Header: "STATE", Value: func(c *v1.Cluster) string { return string(c.State()) }},
+		{Header: "TOPOLOGY", Value: clusterTopology},
+		{Header: "VERSION", Value: func(c *v1.Cluster) string { return c.OpenshiftVersion() }, Wide: true},
+		{Header: "REGION", Value: func(c *v1.Cluster) string { return c.Region().ID() }, Wide: true},
+		{Header: "MULTI AZ", Value: func(c *v1.Cluster) string { return output.PrintBool(c.MultiAZ()) }, Wide: true},
+		{
+			Header: "CREATED",
+			Value:  func(c *v1.Cluster) string { return c.CreationTimestamp().Format(time.RFC3339) },
+			Wide:   true,
+		},
+	},
 }
 
 func clusterTopology(cluster *v1.Cluster) string {
@@ -87,7 +87,7 @@ var _ = Describe("List clusters", func() {
 			Expect(err.Error()).To(ContainSubstring("role not found"))
 		})
 
-		It("returns error when GetClustersUsingAccountRole fails", func() {
+		It("returns error when ListClustersUsingAccountRole fails", func() {
 			args.accountRoleArn = "arn:aws:iam::123456789012:role/ManagedOpenShift-Installer-Role"
 
 			mockAWS := t.RosaRuntime.AWSClient.(*awsClient.MockClient)
@@ -35,6 +35,7 @@ import (
 	"github.com/openshift/rosa/cmd/list/kubeletconfig"
 	"github.com/openshift/rosa/cmd/list/logforwarders"
 	"github.com/openshift/rosa/cmd/list/machinepool"
+	"github.com/openshift/rosa/cmd/list/network"
 	"github.com/openshift/rosa/cmd/list/ocmroles"
 	"github.com/openshift/rosa/cmd/list/oidcconfig"
 	"github.com/openshift/rosa/cmd/list/oidcprovider"
@@ -42,6 +43,7 @@ import (
 	"github.com/openshift/rosa/cmd/list/region"
 	"github.com/openshift/rosa/cmd/list/rhRegion"
 	"github.com/openshift/rosa/cmd/list/service"
+	"github.com/openshift/rosa/cmd/list/servicelogs"
 	"github.com/openshift/rosa/cmd/list/tuningconfigs"
 	"github.com/openshift/rosa/cmd/list/upgrade"
 	"github.com/openshift/rosa/cmd/list/user"
@@ -91,6 +93,9 @@ func init() {
 	Cmd.AddCommand(logforwardersCommand)
 	accessrequest := accessrequests.NewListAccessRequestsCommand()
 	Cmd.AddCommand(accessrequest)
+	serviceLogsCommand := servicelogs.NewListServiceLogsCommand()
+	Cmd.AddCommand(serviceLogsCommand)
+	Cmd.AddCommand(network.NewListNetworksCommand())
 	flags := Cmd.PersistentFlags()
 	arguments.AddProfileFlag(flags)
 	arguments.AddRegionFlag(flags)
@@ -105,6 +110,7 @@ func init() {
 		operatorroles.Cmd, region.Cmd, rhRegion.Cmd,
 		service.Cmd, tuningconfigs.Cmd, upgrade.Cmd,
 		user.Cmd, version.Cmd, kubeletconfig, logforwardersCommand, accessrequest,
+		serviceLogsCommand,
 	}
 	arguments.MarkRegionDeprecated(Cmd, globallyAvailableCommands)
 }
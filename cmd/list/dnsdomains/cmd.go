@@ -19,12 +19,12 @@ package dnsdomains
 import (
 	"fmt"
 	"os"
-	"text/tabwriter"
 	"time"
 
 	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -63,7 +63,7 @@ func init() {
 		"Filter to list only DNS Domains used for Hosted Control Plane clusters",
 	)
 
-	output.AddFlag(Cmd)
+	output.AddTableFlags(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -74,7 +74,7 @@ func run(_ *cobra.Command, _ []string) {
 	orgID, _, err := r.OCMClient.GetCurrentOrganization()
 	if err != nil {
 		_ = r.Reporter.Errorf("Failed to get current organization: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	search := fmt.Sprintf("user_defined='true' AND organization.id='%s'", orgID)
 	if args.all {
@@ -83,45 +83,36 @@ func run(_ *cobra.Command, _ []string) {
 	dnsDomains, err := r.OCMClient.ListDNSDomains(search)
 	if err != nil {
 		_ = r.Reporter.Errorf("Failed to list DNS Domains: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if args.hostedCp {
 		dnsDomains = filterByClusterArch(dnsDomains, v1.ClusterArchitectureHcp)
 	}
 
-	if output.HasFlag() {
-		err = output.Print(dnsDomains)
-		if err != nil {
-			_ = r.Reporter.Errorf("%s", err)
-			os.Exit(1)
-		}
-		os.Exit(0)
-	}
-
-	if len(dnsDomains) == 0 {
+	if len(dnsDomains) == 0 && output.IsTableOutput() {
 		r.Reporter.Infof("There are no DNS Domains for your organization")
 		os.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
-
-	fmt.Fprintf(writer, "ID\tCLUSTER ID\tRESERVED TIME\tUSER DEFINED\tARCHITECTURE\n")
-	for _, dnsdomain := range dnsDomains {
-		userDefined := "No"
-		if dnsdomain.UserDefined() {
-			userDefined = "Yes"
-		}
-		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n",
-			dnsdomain.ID(),
-			dnsdomain.Cluster().ID(),
-			dnsdomain.ReservedAtTimestamp().Format(time.RFC3339),
-			userDefined,
-			dnsdomain.ClusterArch(),
-		)
+	err = dnsDomainsTable.Print(dnsDomains)
+	if err != nil {
+		_ = r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
 	}
-	writer.Flush()
+}
+
+var dnsDomainsTable = output.Table[*v1.DNSDomain]{
+	Columns: []output.Column[*v1.DNSDomain]{
+		{Header: "ID", Value: func(d *v1.DNSDomain) string { return d.ID() }},
+		{Header: "CLUSTER ID", Value: func(d *v1.DNSDomain) string { return d.Cluster().ID() }},
+		{
+			Header: "RESERVED TIME",
+			Value:  func(d *v1.DNSDomain) string { return d.ReservedAtTimestamp().Format(time.RFC3339) },
+		},
+		{Header: "USER DEFINED", Value: func(d *v1.DNSDomain) string { return output.PrintBool(d.UserDefined()) }},
+		{Header: "ARCHITECTURE", Value: func(d *v1.DNSDomain) string { return string(d.ClusterArch()) }},
+	},
 }
 
 func filterByClusterArch(domains []*v1.DNSDomain, arch v1.ClusterArchitecture) []*v1.DNSDomain {
@@ -19,10 +19,11 @@ package externalauthprovider
 import (
 	"fmt"
 	"os"
-	"text/tabwriter"
 
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/externalauthprovider"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
@@ -42,7 +43,7 @@ var Cmd = &cobra.Command{
 
 func init() {
 	ocm.AddClusterFlag(Cmd)
-	output.AddFlag(Cmd)
+	output.AddTableFlags(Cmd)
 }
 
 func run(cmd *cobra.Command, _ []string) {
@@ -51,7 +52,7 @@ func run(cmd *cobra.Command, _ []string) {
 	err := runWithRuntime(r, cmd)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 }
 
@@ -72,30 +73,22 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		return fmt.Errorf("failed to get external authentication providers for cluster '%s': %v", clusterKey, err)
 	}
 
-	if output.HasFlag() {
-		err = output.Print(externalAuthProviders)
-		if err != nil {
-			return fmt.Errorf("%s", err)
-		}
-		return nil
-	}
-
-	if len(externalAuthProviders) == 0 {
+	if len(externalAuthProviders) == 0 && output.IsTableOutput() {
 		r.Reporter.Infof("There are no external authentication providers for cluster '%s'", clusterKey)
 		return nil
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
-
-	fmt.Fprintf(writer, "NAME\tISSUER URL\n")
-	for _, externalAuthProvider := range externalAuthProviders {
-		fmt.Fprintf(writer, "%s\t%s\n",
-			externalAuthProvider.ID(),
-			externalAuthProvider.Issuer().URL(),
-		)
-	}
-	writer.Flush()
+	return externalAuthProvidersTable.Print(externalAuthProviders)
+}
 
-	return nil
+var externalAuthProvidersTable = output.Table[*cmv1.ExternalAuth]{
+	Columns: []output.Column[*cmv1.ExternalAuth]{
+		{Header: "NAME", Value: func(e *cmv1.ExternalAuth) string { return e.ID() }},
+		{Header: "ISSUER URL", Value: func(e *cmv1.ExternalAuth) string { return e.Issuer().URL() }},
+		{
+			Header: "ISSUER AUDIENCES",
+			Value:  func(e *cmv1.ExternalAuth) string { return output.PrintStringSlice(e.Issuer().Audiences()) },
+			Wide:   true,
+		},
+	},
 }
@@ -18,7 +18,9 @@ package gates
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 
@@ -28,6 +30,7 @@ import (
 	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -86,7 +89,7 @@ func init() {
 
 	Cmd.MarkFlagRequired("version")
 
-	output.AddFlag(Cmd)
+	output.AddTableFlags(Cmd)
 }
 
 const (
@@ -127,7 +130,7 @@ func run(_ *cobra.Command, _ []string) {
 		upgradePolicy, err := upgradePolicyBuilder.Build()
 		if err != nil {
 			r.Reporter.Errorf("Failed to schedule upgrade for cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		// check if the cluster upgrade requires gate agreements
@@ -135,7 +138,7 @@ func run(_ *cobra.Command, _ []string) {
 		if err != nil {
 			r.Reporter.Errorf("Failed to check for missing gate agreements upgrade for "+
 				"cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	} else {
 		// Query OCM for available OCP gates
@@ -145,28 +148,28 @@ func run(_ *cobra.Command, _ []string) {
 			versionGates, err = r.OCMClient.ListStsGates(version)
 			if err != nil {
 				r.Reporter.Errorf("Failed to fetch available %s gates for OCP version %s: %v", args.gate, args.version, err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		case GateOCP:
 			versionGates, err = r.OCMClient.ListOcpGates(version)
 			if err != nil {
 				r.Reporter.Errorf("Failed to fetch available %s gates for OCP version %s: %v", args.gate, args.version, err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		case "":
 			versionGates, err = r.OCMClient.ListAllOcpGates(version)
 			if err != nil {
 				r.Reporter.Errorf("Failed to fetch available %s gates for OCP version %s: %v", args.gate, args.version, err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		default:
 			r.Reporter.Errorf("Invalid gate. Allowed values are %s and \"\" for all", strings.Join(Gates, ","))
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 
 		if err != nil {
 			r.Reporter.Errorf("Failed to fetch available OCP gates for OCP version %s: %v", err, args.version)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		if len(versionGates) == 0 {
@@ -175,45 +178,58 @@ func run(_ *cobra.Command, _ []string) {
 		}
 	}
 
-	if output.HasFlag() {
-		err = output.Print(versionGates)
-		if err != nil {
-			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
-		}
-		os.Exit(0)
-	}
-
-	// Create the writer that will be used to print the tabulated results:
+	// Wrap the descriptions to fit the terminal:
 	cols, _, err := term.GetSize(int(os.Stdout.Fd()))
 	if err != nil || cols <= 0 {
 		cols = 80
 	}
-	descriptionSize := float64(cols) * 0.30
-	writer := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
-	fmt.Fprintln(writer, "Gate Description\tSTS\tOCP Version\tDocumentation URL\t")
-
-	for _, gate := range versionGates {
-		wrappedDescription := wordWrap(strings.TrimSuffix(gate.Description(), "\n"), int(descriptionSize))
-
-		for i, line := range strings.Split(wrappedDescription, "\n") {
-			if i == 0 {
-				fmt.Fprintf(writer,
-					"%s\t%t\t%s\t%s\t\n",
-					line,
-					gate.STSOnly(),
-					gate.VersionRawIDPrefix(),
-					gate.DocumentationURL(),
-				)
-			} else {
-				fmt.Fprintf(writer,
-					"%s\t \t \t \t\n",
-					line,
-				)
+	descriptionSize := int(float64(cols) * 0.30)
+
+	table := output.Table[*v1.VersionGate]{
+		Columns: []output.Column[*v1.VersionGate]{
+			{
+				Header: "Gate Description",
+				Value: func(gate *v1.VersionGate) string {
+					return wordWrap(strings.TrimSuffix(gate.Description(), "\n"), descriptionSize)
+				},
+			},
+			{Header: "STS", Value: func(gate *v1.VersionGate) string { return strconv.FormatBool(gate.STSOnly()) }},
+			{Header: "OCP Version", Value: func(gate *v1.VersionGate) string { return gate.VersionRawIDPrefix() }},
+			{Header: "Documentation URL", Value: func(gate *v1.VersionGate) string { return gate.DocumentationURL() }},
+			{Header: "ID", Value: func(gate *v1.VersionGate) string { return gate.ID() }, Wide: true},
+			{Header: "Label", Value: func(gate *v1.VersionGate) string { return gate.Label() }, Wide: true},
+		},
+		Name: func(gate *v1.VersionGate) string { return gate.ID() },
+		Default: func(w io.Writer, gates []*v1.VersionGate) error {
+			writer := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+			fmt.Fprintln(writer, "Gate Description\tSTS\tOCP Version\tDocumentation URL\t")
+			for _, gate := range gates {
+				wrappedDescription := wordWrap(strings.TrimSuffix(gate.Description(), "\n"), descriptionSize)
+				for i, line := range strings.Split(wrappedDescription, "\n") {
+					if i == 0 {
+						fmt.Fprintf(writer,
+							"%s\t%t\t%s\t%s\t\n",
+							line,
+							gate.STSOnly(),
+							gate.VersionRawIDPrefix(),
+							gate.DocumentationURL(),
+						)
+					} else {
+						fmt.Fprintf(writer,
+							"%s\t \t \t \t\n",
+							line,
+						)
+					}
+				}
 			}
-		}
+			return writer.Flush()
+		},
+	}
+	err = table.Print(versionGates)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
 	}
-	writer.Flush()
 }
 
 func parseMajorMinor(version string) (string, error) {
@@ -17,8 +17,6 @@ package iamserviceaccounts
 import (
 	"context"
 	"fmt"
-	"os"
-	"text/tabwriter"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -62,7 +60,7 @@ func NewListIamServiceAccountsCommand() *cobra.Command {
 		"Namespace to filter service account roles by.",
 	)
 
-	output.AddFlag(cmd)
+	output.AddTableFlags(cmd)
 
 	return cmd
 }
@@ -115,42 +113,31 @@ func ListIamServiceAccountsRunner(userOptions *ListIamServiceAccountsUserOptions
 			serviceAccountRoles = append(serviceAccountRoles, serviceAccountRole)
 		}
 
-		// Table format
-		if len(serviceAccountRoles) == 0 {
+		if len(serviceAccountRoles) == 0 && output.IsTableOutput() {
 			r.Reporter.Infof("No IAM service account roles found")
 			return nil
 		}
 
-		// Output results
-		if output.HasFlag() {
-			err = output.Print(serviceAccountRoles)
-			if err != nil {
-				return fmt.Errorf("failed to print output: %s", err)
-			}
-			return nil
-		}
-
-		// Print table
-		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(writer, "NAME\tARN\tCLUSTER\tNAMESPACE\tSERVICE ACCOUNT\tCREATED")
+		return serviceAccountRolesTable.Print(serviceAccountRoles)
+	}
+}
 
-		for _, role := range serviceAccountRoles {
-			created := ""
-			if role.CreatedDate != nil {
-				created = role.CreatedDate.Format("2006-01-02 15:04:05")
+var serviceAccountRolesTable = output.Table[ServiceAccountRoleOutput]{
+	Columns: []output.Column[ServiceAccountRoleOutput]{
+		{Header: "NAME", Value: func(role ServiceAccountRoleOutput) string { return role.RoleName }},
+		{Header: "ARN", Value: func(role ServiceAccountRoleOutput) string { return role.ARN }},
+		{Header: "CLUSTER", Value: func(role ServiceAccountRoleOutput) string { return role.Cluster }},
+		{Header: "NAMESPACE", Value: func(role ServiceAccountRoleOutput) string { return role.Namespace }},
+		{Header: "SERVICE ACCOUNT", Value: func(role ServiceAccountRoleOutput) string { return role.ServiceAccount }},
+		{Header: "CREATED", Value: func(role ServiceAccountRoleOutput) string {
+			if role.CreatedDate == nil {
+				return ""
 			}
-			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n",
-				role.RoleName,
-				role.ARN,
-				role.Cluster,
-				role.Namespace,
-				role.ServiceAccount,
-				created,
-			)
-		}
-
-		return writer.Flush()
-	}
+			return role.CreatedDate.Format("2006-01-02 15:04:05")
+		}},
+		{Header: "PATH", Value: func(role ServiceAccountRoleOutput) string { return role.Path }, Wide: true},
+	},
+	Name: func(role ServiceAccountRoleOutput) string { return role.RoleName },
 }
 
 type ServiceAccountRoleOutput struct {
@@ -18,12 +18,14 @@ package idp
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"text/tabwriter"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -42,7 +44,7 @@ var Cmd = &cobra.Command{
 
 func init() {
 	ocm.AddClusterFlag(Cmd)
-	output.AddFlag(Cmd)
+	output.AddTableFlags(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -60,7 +62,7 @@ func run(_ *cobra.Command, _ []string) {
 
 	if cluster.ExternalAuthConfig().Enabled() {
 		r.Reporter.Errorf("Listing identity providers is not supported for clusters with external authentication configured.")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	// Load any existing IDPs for this cluster
@@ -68,36 +70,54 @@ func run(_ *cobra.Command, _ []string) {
 	idps, err := r.OCMClient.GetIdentityProviders(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get identity providers for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
-	}
-
-	if output.HasFlag() {
-		err = output.Print(idps)
-		if err != nil {
-			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
-		}
-		os.Exit(0)
+		os.Exit(clierror.ExitCode(err))
 	}
 
-	if len(idps) == 0 {
+	if len(idps) == 0 && output.IsTableOutput() {
 		r.Reporter.Infof("There are no identity providers configured for cluster '%s'", clusterKey)
 		os.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	if len(idps) == 1 && !ocm.HasAuthURLSupport(idps[0]) {
-		fmt.Fprintf(writer, "NAME\t\tTYPE\n")
-	} else {
-		fmt.Fprintf(writer, "NAME\t\tTYPE\t\tAUTH URL\n")
-	}
-	for _, idp := range idps {
+	authURL := func(idp *cmv1.IdentityProvider) string {
 		oauthURL, err := ocm.GetOAuthURL(cluster, idp)
 		if err != nil {
 			r.Reporter.Warnf("Error building OAuth URL for %s: %v", idp.Name(), err)
 		}
-		fmt.Fprintf(writer, "%s\t\t%s\t\t%s\n", idp.Name(), ocm.IdentityProviderType(idp), oauthURL)
+		return oauthURL
+	}
+	table := output.Table[*cmv1.IdentityProvider]{
+		Columns: []output.Column[*cmv1.IdentityProvider]{
+			{Header: "NAME", Value: func(idp *cmv1.IdentityProvider) string { return idp.Name() }},
+			{Header: "TYPE", Value: ocm.IdentityProviderType},
+		},
+		Name: func(idp *cmv1.IdentityProvider) string { return idp.Name() },
+		Default: func(w io.Writer, idps []*cmv1.IdentityProvider) error {
+			writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+			if len(idps) == 1 && !ocm.HasAuthURLSupport(idps[0]) {
+				fmt.Fprintf(writer, "NAME\t\tTYPE\n")
+			} else {
+				fmt.Fprintf(writer, "NAME\t\tTYPE\t\tAUTH URL\n")
+			}
+			for _, idp := range idps {
+				fmt.Fprintf(writer, "%s\t\t%s\t\t%s\n", idp.Name(), ocm.IdentityProviderType(idp), authURL(idp))
+			}
+			return writer.Flush()
+		},
+	}
+	if len(idps) != 1 || ocm.HasAuthURLSupport(idps[0]) {
+		table.Columns = append(table.Columns, output.Column[*cmv1.IdentityProvider]{
+			Header: "AUTH URL",
+			Value:  authURL,
+		})
+	}
+	table.Columns = append(table.Columns, output.Column[*cmv1.IdentityProvider]{
+		Header: "ID",
+		Value:  func(idp *cmv1.IdentityProvider) string { return idp.ID() },
+		Wide:   true,
+	})
+	err = table.Print(idps)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
 	}
-	writer.Flush()
 }
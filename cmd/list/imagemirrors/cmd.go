@@ -19,10 +19,9 @@ package imagemirrors
 import (
 	"context"
 	"fmt"
-	"os"
 	"strings"
-	"text/tabwriter"
 
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/arguments"
@@ -55,7 +54,7 @@ func NewListImageMirrorsCommand() *cobra.Command {
 		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), ListImageMirrorsRunner(options)),
 	}
 
-	output.AddFlag(cmd)
+	output.AddTableFlags(cmd)
 	ocm.AddClusterFlag(cmd)
 	arguments.AddProfileFlag(cmd.Flags())
 	arguments.AddRegionFlag(cmd.Flags())
@@ -75,39 +74,22 @@ func ListImageMirrorsRunner(options *ListImageMirrorsOptions) rosa.CommandRunner
 			return fmt.Errorf("failed to list image mirrors: %v", err)
 		}
 
-		if output.HasFlag() {
-			return output.Print(imageMirrors)
-		}
-
-		if len(imageMirrors) == 0 {
+		if len(imageMirrors) == 0 && output.IsTableOutput() {
 			runtime.Reporter.Infof("No image mirrors found for cluster '%s'", clusterKey)
 			return nil
 		}
 
-		// Create tabwriter for formatted output
-		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintf(writer, "ID\tTYPE\tSOURCE\tMIRRORS\n")
-
-		for _, mirror := range imageMirrors {
-			var mirrors strings.Builder
-			if len(mirror.Mirrors()) > 0 {
-				for i, m := range mirror.Mirrors() {
-					if i > 0 {
-						mirrors.WriteString(", ")
-					}
-					mirrors.WriteString(m)
-				}
-			}
-
-			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n",
-				mirror.ID(),
-				mirror.Type(),
-				mirror.Source(),
-				mirrors.String(),
-			)
-		}
-
-		writer.Flush()
-		return nil
+		return imageMirrorsTable.Print(imageMirrors)
 	}
 }
+
+var imageMirrorsTable = output.Table[*cmv1.ImageMirror]{
+	Columns: []output.Column[*cmv1.ImageMirror]{
+		{Header: "ID", Value: func(mirror *cmv1.ImageMirror) string { return mirror.ID() }},
+		{Header: "TYPE", Value: func(mirror *cmv1.ImageMirror) string { return mirror.Type() }},
+		{Header: "SOURCE", Value: func(mirror *cmv1.ImageMirror) string { return mirror.Source() }},
+		{Header: "MIRRORS", Value: func(mirror *cmv1.ImageMirror) string {
+			return strings.Join(mirror.Mirrors(), ", ")
+		}},
+	},
+}
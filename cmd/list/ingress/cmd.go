@@ -20,11 +20,11 @@ import (
 	"fmt"
 	"os"
 	"strings"
-	"text/tabwriter"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
@@ -44,7 +44,7 @@ var Cmd = &cobra.Command{
 
 func init() {
 	ocm.AddClusterFlag(Cmd)
-	output.AddFlag(Cmd)
+	output.AddTableFlags(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -65,42 +65,43 @@ func run(_ *cobra.Command, _ []string) {
 	ingresses, err := r.OCMClient.GetIngresses(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get ingresses for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
-	}
-
-	if output.HasFlag() {
-		err = output.Print(ingresses)
-		if err != nil {
-			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
-		}
-		os.Exit(0)
+		os.Exit(clierror.ExitCode(err))
 	}
 
-	if len(ingresses) == 0 {
+	if len(ingresses) == 0 && output.IsTableOutput() {
 		r.Reporter.Infof("There are no ingresses configured for cluster '%s'", clusterKey)
 		os.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 2, 4, 2, ' ', 0)
-
-	fmt.Fprintf(writer, "ID\tAPPLICATION ROUTER\tPRIVATE\tDEFAULT\tROUTE SELECTORS\tLB-TYPE"+
-		"\tEXCLUDED NAMESPACE\tWILDCARD POLICY\tNAMESPACE OWNERSHIP\n")
-	for _, ingress := range ingresses {
-		fmt.Fprintf(writer, "%s\thttps://%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
-			ingress.ID(),
-			ingress.DNSName(),
-			isPrivate(ingress.Listening()),
-			isDefault(ingress),
-			printRouteSelectors(ingress),
-			ingress.LoadBalancerType(),
-			helper.SliceToSortedString(ingress.ExcludedNamespaces()),
-			ingress.RouteWildcardPolicy(),
-			ingress.RouteNamespaceOwnershipPolicy(),
-		)
+	err = ingressesTable.Print(ingresses)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
 	}
-	writer.Flush()
+}
+
+var ingressesTable = output.Table[*cmv1.Ingress]{
+	Columns: []output.Column[*cmv1.Ingress]{
+		{Header: "ID", Value: func(ingress *cmv1.Ingress) string { return ingress.ID() }},
+		{Header: "APPLICATION ROUTER", Value: func(ingress *cmv1.Ingress) string {
+			return "https://" + ingress.DNSName()
+		}},
+		{Header: "PRIVATE", Value: func(ingress *cmv1.Ingress) string { return isPrivate(ingress.Listening()) }},
+		{Header: "DEFAULT", Value: isDefault},
+		{Header: "ROUTE SELECTORS", Value: printRouteSelectors},
+		{Header: "LB-TYPE", Value: func(ingress *cmv1.Ingress) string {
+			return string(ingress.LoadBalancerType())
+		}},
+		{Header: "EXCLUDED NAMESPACE", Value: func(ingress *cmv1.Ingress) string {
+			return helper.SliceToSortedString(ingress.ExcludedNamespaces())
+		}},
+		{Header: "WILDCARD POLICY", Value: func(ingress *cmv1.Ingress) string {
+			return string(ingress.RouteWildcardPolicy())
+		}},
+		{Header: "NAMESPACE OWNERSHIP", Value: func(ingress *cmv1.Ingress) string {
+			return string(ingress.RouteNamespaceOwnershipPolicy())
+		}},
+	},
 }
 
 func isPrivate(listeningMethod cmv1.ListeningMethod) string {
@@ -19,13 +19,14 @@ package instancetypes
 import (
 	"fmt"
 	"os"
-	"text/tabwriter"
+	"strconv"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
@@ -104,7 +105,7 @@ func initFlags(cmd *cobra.Command) {
 
 	arguments.AddRegionFlag(flags)
 	cmd.MarkFlagsMutuallyExclusive("with-feature", "region")
-	output.AddFlag(cmd)
+	output.AddTableFlags(cmd)
 	confirm.AddFlag(flags)
 }
 
@@ -114,7 +115,7 @@ func run(cmd *cobra.Command, _ []string) {
 	err := runWithRuntime(r, cmd)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 }
 
@@ -177,42 +178,40 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		machineTypes = availableMachineTypes
 	}
 
-	if output.HasFlag() {
-		var instanceTypes []*cmv1.MachineType
-		for _, machine := range machineTypes.Items {
+	// The table only shows the instance types that are available, the rest of the formats show all of them:
+	var instanceTypes []*cmv1.MachineType
+	for _, machine := range machineTypes.Items {
+		if machine.Available || !output.IsTableOutput() {
 			instanceTypes = append(instanceTypes, machine.MachineType)
 		}
-		err := output.Print(instanceTypes)
-		if err != nil {
-			return err
-		}
-
-		return nil
 	}
 
-	if len(machineTypes.Items) == 0 {
+	if len(machineTypes.Items) == 0 && output.IsTableOutput() {
 		return fmt.Errorf("there are no machine types supported for your account. Contact Red Hat support")
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(writer, "ID\tCATEGORY\tCPU_CORES\tMEMORY\n")
-
-	for _, machine := range machineTypes.Items {
-		if !machine.Available {
-			continue
-		}
-		availableMachine := machine.MachineType
-		fmt.Fprintf(writer,
-			"%s\t%s\t%d\t%s\n",
-			availableMachine.ID(), availableMachine.Category(), int(availableMachine.CPU().Value()),
-			ByteCountIEC(int(availableMachine.Memory().Value()),
-				availableMachine.Memory().Unit()),
-		)
-	}
-	writer.Flush()
+	return instanceTypesTable.Print(instanceTypes)
+}
 
-	return nil
+var instanceTypesTable = output.Table[*cmv1.MachineType]{
+	Columns: []output.Column[*cmv1.MachineType]{
+		{Header: "ID", Value: func(machineType *cmv1.MachineType) string { return machineType.ID() }},
+		{Header: "CATEGORY", Value: func(machineType *cmv1.MachineType) string {
+			return string(machineType.Category())
+		}},
+		{Header: "CPU_CORES", Value: func(machineType *cmv1.MachineType) string {
+			return strconv.Itoa(int(machineType.CPU().Value()))
+		}},
+		{Header: "MEMORY", Value: func(machineType *cmv1.MachineType) string {
+			return ByteCountIEC(int(machineType.Memory().Value()), machineType.Memory().Unit())
+		}},
+		{Header: "ARCHITECTURE", Value: func(machineType *cmv1.MachineType) string {
+			return string(machineType.Architecture())
+		}, Wide: true},
+		{Header: "SIZE", Value: func(machineType *cmv1.MachineType) string {
+			return string(machineType.Size())
+		}, Wide: true},
+	},
 }
 
 func ByteCountIEC(b int, uValue string) string {
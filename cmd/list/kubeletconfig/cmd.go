@@ -2,10 +2,9 @@ package kubeletconfig
 
 import (
 	"context"
-	"fmt"
-	"os"
-	"text/tabwriter"
+	"strconv"
 
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/kubeletconfig"
@@ -35,7 +34,7 @@ func NewListKubeletConfigsCommand() *cobra.Command {
 		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), ListKubeletConfigRunner()),
 	}
 
-	output.AddFlag(cmd)
+	output.AddTableFlags(cmd)
 	ocm.AddClusterFlag(cmd)
 	return cmd
 }
@@ -52,19 +51,21 @@ func ListKubeletConfigRunner() rosa.CommandRunner {
 			return err
 		}
 
-		if output.HasFlag() {
-			output.Print(kubeletConfigs)
-		} else {
-			if len(kubeletConfigs) == 0 {
-				runtime.Reporter.Infof("There are no KubeletConfigs for cluster '%s'.", runtime.ClusterKey)
-				return nil
-			}
-
-			writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
-			fmt.Fprint(writer, kubeletconfig.PrintKubeletConfigsForTabularOutput(kubeletConfigs))
-			return writer.Flush()
+		if len(kubeletConfigs) == 0 && output.IsTableOutput() {
+			runtime.Reporter.Infof("There are no KubeletConfigs for cluster '%s'.", runtime.ClusterKey)
+			return nil
 		}
 
-		return nil
+		return kubeletConfigsTable.Print(kubeletConfigs)
 	}
 }
+
+var kubeletConfigsTable = output.Table[*cmv1.KubeletConfig]{
+	Columns: []output.Column[*cmv1.KubeletConfig]{
+		{Header: "ID", Value: func(config *cmv1.KubeletConfig) string { return config.ID() }},
+		{Header: "NAME", Value: kubeletconfig.DisplayName},
+		{Header: "POD PIDS LIMIT", Value: func(config *cmv1.KubeletConfig) string {
+			return strconv.Itoa(config.PodPidsLimit())
+		}},
+	},
+}
@@ -19,8 +19,6 @@ package logforwarders
 import (
 	"context"
 	"fmt"
-	"os"
-	"text/tabwriter"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
@@ -53,7 +51,7 @@ func NewListLogForwardersCommand() *cobra.Command {
 	}
 
 	ocm.AddClusterFlag(cmd)
-	output.AddFlag(cmd)
+	output.AddTableFlags(cmd)
 	return cmd
 }
 
@@ -80,42 +78,37 @@ func ListLogForwardersRunner() rosa.CommandRunner {
 			return fmt.Errorf("failed to get log forwarders for cluster '%s': %v", clusterKey, err)
 		}
 
-		if output.HasFlag() {
-			err = output.Print(logForwarders)
-			if err != nil {
-				return fmt.Errorf("failed to output log forwarders: %v", err)
-			}
-			return nil
-		}
-
-		if len(logForwarders) == 0 {
+		if len(logForwarders) == 0 && output.IsTableOutput() {
 			runtime.Reporter.Infof("There are no log forwarders configured for cluster '%s'", clusterKey)
 			return nil
 		}
 
-		writer := tabwriter.NewWriter(os.Stdout, 2, 4, 2, ' ', 0)
-
-		fmt.Fprintf(writer, "ID\tTYPE\tSTATUS\n")
-		for _, logForwarder := range logForwarders {
-			logType := "Unknown"
-			if logForwarder.S3() != nil {
-				logType = "S3"
-			} else if logForwarder.Cloudwatch() != nil {
-				logType = "CloudWatch"
-			}
+		err = logForwardersTable.Print(logForwarders)
+		if err != nil {
+			return fmt.Errorf("failed to output log forwarders: %v", err)
+		}
+		return nil
+	}
+}
 
-			status := "N/A"
+var logForwardersTable = output.Table[*cmv1.LogForwarder]{
+	Columns: []output.Column[*cmv1.LogForwarder]{
+		{Header: "ID", Value: func(logForwarder *cmv1.LogForwarder) string { return logForwarder.ID() }},
+		{Header: "TYPE", Value: logForwarderType},
+		{Header: "STATUS", Value: func(logForwarder *cmv1.LogForwarder) string {
 			if logForwarder.Status() != nil && logForwarder.Status().State() != "" {
-				status = logForwarder.Status().State()
+				return logForwarder.Status().State()
 			}
+			return "N/A"
+		}},
+	},
+}
 
-			fmt.Fprintf(writer, "%s\t%s\t%s\n",
-				logForwarder.ID(),
-				logType,
-				status,
-			)
-		}
-		writer.Flush()
-		return nil
+func logForwarderType(logForwarder *cmv1.LogForwarder) string {
+	if logForwarder.S3() != nil {
+		return "S3"
+	} else if logForwarder.Cloudwatch() != nil {
+		return "CloudWatch"
 	}
+	return "Unknown"
 }
@@ -86,7 +86,7 @@ func NewListMachinePoolCommand() *cobra.Command {
 		"Show all additional information for each machine pool (equivalent to --az-type --dedicated-host --win-li)",
 	)
 
-	output.AddFlag(cmd)
+	output.AddTableFlags(cmd)
 	ocm.AddClusterFlag(cmd)
 	return cmd
 }
@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/network"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "networks"
+	short = "List network stacks"
+	long  = "List the CloudFormation stacks created with 'rosa create network' in the current region. " +
+		"The stacks are recognized by the 'rosa_network_template' tag, or by the description of the built-in " +
+		"template. Stacks created from a custom template by versions that didn't add the tag can be " +
+		"adopted by adding the tag to the stack, with the name of the template as value."
+	example = `  # List the network stacks
+  rosa list networks
+
+  # List the network stacks of a specific region
+  rosa list networks --region us-east-2`
+)
+
+var aliases = []string{"network"}
+
+func NewListNetworksCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Aliases: aliases,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithAWS(), ListNetworksRunner()),
+	}
+
+	output.AddTableFlags(cmd)
+	return cmd
+}
+
+func ListNetworksRunner() rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
+		stacks, err := network.ListStacks(ctx, r.AWSClient)
+		if err != nil {
+			return err
+		}
+
+		if len(stacks) == 0 && output.IsTableOutput() {
+			r.Reporter.Infof("There are no network stacks in region '%s'", r.AWSClient.GetRegion())
+			return nil
+		}
+
+		return networksTable.Print(stacks)
+	}
+}
+
+var networksTable = output.Table[*network.Stack]{
+	Columns: []output.Column[*network.Stack]{
+		{Header: "NAME", Value: func(stack *network.Stack) string { return stack.Name }},
+		{Header: "STATUS", Value: func(stack *network.Stack) string { return stack.Status }},
+		{Header: "VPC ID", Value: func(stack *network.Stack) string { return stack.VpcID }},
+		{Header: "PUBLIC SUBNETS", Value: func(stack *network.Stack) string {
+			return strings.Join(stack.PublicSubnets, "\n")
+		}},
+		{Header: "PRIVATE SUBNETS", Value: func(stack *network.Stack) string {
+			return strings.Join(stack.PrivateSubnets, "\n")
+		}},
+		{Header: "TEMPLATE", Value: func(stack *network.Stack) string { return stack.Template }, Wide: true},
+		{Header: "CREATED", Value: func(stack *network.Stack) string {
+			return stack.CreationTime.Format("2006-01-02 15:04:05 MST")
+		}, Wide: true},
+	},
+}
@@ -0,0 +1,89 @@
+package network
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cfTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("List networks", func() {
+	Context("Create Command", func() {
+		It("Creates the command correctly", func() {
+			cmd := NewListNetworksCommand()
+			Expect(cmd).NotTo(BeNil())
+
+			Expect(cmd.Use).To(Equal(use))
+			Expect(cmd.Short).To(Equal(short))
+			Expect(cmd.Long).To(Equal(long))
+			Expect(cmd.Aliases).To(ContainElements(aliases))
+			Expect(cmd.Args).NotTo(BeNil())
+			Expect(cmd.Run).NotTo(BeNil())
+			Expect(cmd.Flags().Lookup("output")).NotTo(BeNil())
+		})
+	})
+
+	Context("Command Runner", func() {
+		var (
+			t          *TestingRuntime
+			mockClient *aws.MockClient
+		)
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			mockClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+			t.RosaRuntime.AWSClient = mockClient
+			output.SetOutput("")
+		})
+
+		AfterEach(func() {
+			output.SetOutput("")
+		})
+
+		It("Prints a message if there are no network stacks", func() {
+			mockClient.EXPECT().ListCFStacks(gomock.Any()).Return([]cfTypes.Stack{{
+				StackName: awssdk.String("other"),
+			}}, nil)
+			mockClient.EXPECT().GetRegion().Return("us-east-1")
+
+			t.StdOutReader.Record()
+			err := ListNetworksRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(Equal("INFO: There are no network stacks in region 'us-east-1'\n"))
+		})
+
+		It("Prints the network stacks", func() {
+			mockClient.EXPECT().ListCFStacks(gomock.Any()).Return([]cfTypes.Stack{{
+				StackName:   awssdk.String("net"),
+				StackStatus: cfTypes.StackStatusCreateComplete,
+				Tags: []cfTypes.Tag{{
+					Key:   awssdk.String(tags.NetworkTemplate),
+					Value: awssdk.String("rosa-quickstart-default-vpc"),
+				}},
+				Outputs: []cfTypes.Output{
+					{OutputKey: awssdk.String("VPCId"), OutputValue: awssdk.String("vpc-1")},
+					{OutputKey: awssdk.String("PublicSubnets"), OutputValue: awssdk.String("subnet-a,subnet-b")},
+					{OutputKey: awssdk.String("PrivateSubnets"), OutputValue: awssdk.String("subnet-c")},
+				},
+			}}, nil)
+
+			t.StdOutReader.Record()
+			err := ListNetworksRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(Equal("" +
+				"NAME  STATUS           VPC ID  PUBLIC SUBNETS  PRIVATE SUBNETS\n" +
+				"net   CREATE_COMPLETE  vpc-1   subnet-a        subnet-c\n" +
+				"                               subnet-b        \n"))
+		})
+	})
+})
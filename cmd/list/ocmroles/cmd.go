@@ -18,15 +18,14 @@ package ocmroles
 
 import (
 	"fmt"
-	"io"
 	"os"
-	"text/tabwriter"
 	"time"
 
 	"github.com/briandowns/spinner"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -44,7 +43,7 @@ rosa list ocm-roles`,
 }
 
 func init() {
-	output.AddFlag(Cmd)
+	output.AddTableFlags(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -68,43 +67,40 @@ func run(_ *cobra.Command, _ []string) {
 
 	if err != nil {
 		r.Reporter.Errorf("Failed to get ocm roles: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
-	if output.HasFlag() {
-		err = output.Print(ocmRoles)
-		if err != nil {
-			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
-		}
-		os.Exit(0)
-	}
-
-	if len(ocmRoles) == 0 {
+	if len(ocmRoles) == 0 && output.IsTableOutput() {
 		r.Reporter.Infof("No ocm roles available")
 		os.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	printOCMRoles(writer, ocmRoles)
-	writer.Flush()
+	err = ocmRolesTable.Print(ocmRoles)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
+	}
 }
 
-func printOCMRoles(writer io.Writer, ocmRoles []aws.Role) {
-	fmt.Fprint(writer, "ROLE NAME\tROLE ARN\tLINKED\tADMIN\tAWS Managed\tCONSOLE ACCESS\n")
-	for _, ocmRole := range ocmRoles {
-		awsManaged := output.No
-		if ocmRole.ManagedPolicy {
-			awsManaged = output.Yes
-		}
-		consoleAccess := output.Yes
-		if ocmRole.NoConsole == output.Yes {
-			consoleAccess = output.No
-		}
-		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n", ocmRole.RoleName, ocmRole.RoleARN, ocmRole.Linked,
-			ocmRole.Admin, awsManaged, consoleAccess)
-	}
+var ocmRolesTable = output.Table[aws.Role]{
+	Columns: []output.Column[aws.Role]{
+		{Header: "ROLE NAME", Value: func(role aws.Role) string { return role.RoleName }},
+		{Header: "ROLE ARN", Value: func(role aws.Role) string { return role.RoleARN }},
+		{Header: "LINKED", Value: func(role aws.Role) string { return role.Linked }},
+		{Header: "ADMIN", Value: func(role aws.Role) string { return role.Admin }},
+		{Header: "AWS Managed", Value: func(role aws.Role) string {
+			if role.ManagedPolicy {
+				return output.Yes
+			}
+			return output.No
+		}},
+		{Header: "CONSOLE ACCESS", Value: func(role aws.Role) string {
+			if role.NoConsole == output.Yes {
+				return output.No
+			}
+			return output.Yes
+		}},
+	},
 }
 
 func listOCMRoles(r *rosa.Runtime) ([]aws.Role, error) {
@@ -18,6 +18,7 @@ package ocmroles
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 	"testing"
@@ -255,3 +256,20 @@ var _ = Describe("rosa list ocm-roles", func() {
 		})
 	})
 })
+
+// printOCMRoles writes the cells of the ocm roles table separated by tabs, so that the tests can
+// check the value of each column.
+func printOCMRoles(writer io.Writer, ocmRoles []aws.Role) {
+	headers := []string{}
+	for _, column := range ocmRolesTable.Columns {
+		headers = append(headers, column.Header)
+	}
+	fmt.Fprintln(writer, strings.Join(headers, "\t"))
+	for _, ocmRole := range ocmRoles {
+		cells := []string{}
+		for _, column := range ocmRolesTable.Columns {
+			cells = append(cells, column.Value(ocmRole))
+		}
+		fmt.Fprintln(writer, strings.Join(cells, "\t"))
+	}
+}
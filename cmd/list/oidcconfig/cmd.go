@@ -17,12 +17,13 @@ limitations under the License.
 package oidcconfig
 
 import (
-	"fmt"
 	"os"
-	"text/tabwriter"
+	"strconv"
 
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -39,7 +40,7 @@ var Cmd = &cobra.Command{
 }
 
 func init() {
-	output.AddFlag(Cmd)
+	output.AddTableFlags(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -51,34 +52,34 @@ func run(_ *cobra.Command, _ []string) {
 	oidcConfigs, err := r.OCMClient.ListOidcConfigs(r.Creator.AccountID)
 	if err != nil {
 		r.Reporter.Errorf("Failed to list OIDC Configurations: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
-	if output.HasFlag() {
-		err = output.Print(oidcConfigs)
-		if err != nil {
-			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
-		}
-		os.Exit(0)
-	}
-
-	if len(oidcConfigs) == 0 {
+	if len(oidcConfigs) == 0 && output.IsTableOutput() {
 		r.Reporter.Infof("There are no OIDC Configurations for your organization")
 		os.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
-
-	fmt.Fprintf(writer, "ID\tMANAGED\tISSUER URL\tSECRET ARN\n")
-	for _, oidcConfig := range oidcConfigs {
-		fmt.Fprintf(writer, "%s\t%v\t%s\t%s\n",
-			oidcConfig.ID(),
-			oidcConfig.Managed(),
-			oidcConfig.IssuerUrl(),
-			oidcConfig.SecretArn(),
-		)
+	err = oidcConfigsTable.Print(oidcConfigs)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
 	}
-	writer.Flush()
+}
+
+var oidcConfigsTable = output.Table[*v1.OidcConfig]{
+	Columns: []output.Column[*v1.OidcConfig]{
+		{Header: "ID", Value: func(oidcConfig *v1.OidcConfig) string { return oidcConfig.ID() }},
+		{Header: "MANAGED", Value: func(oidcConfig *v1.OidcConfig) string {
+			return strconv.FormatBool(oidcConfig.Managed())
+		}},
+		{Header: "ISSUER URL", Value: func(oidcConfig *v1.OidcConfig) string { return oidcConfig.IssuerUrl() }},
+		{Header: "SECRET ARN", Value: func(oidcConfig *v1.OidcConfig) string { return oidcConfig.SecretArn() }},
+		{Header: "REUSABLE", Value: func(oidcConfig *v1.OidcConfig) string {
+			return strconv.FormatBool(oidcConfig.Reusable())
+		}, Wide: true},
+		{Header: "INSTALLER ROLE ARN", Value: func(oidcConfig *v1.OidcConfig) string {
+			return oidcConfig.InstallerRoleArn()
+		}, Wide: true},
+	},
 }
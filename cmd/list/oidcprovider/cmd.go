@@ -19,7 +19,6 @@ package oidcprovider
 import (
 	"fmt"
 	"os"
-	"text/tabwriter"
 	"time"
 
 	"github.com/briandowns/spinner"
@@ -27,6 +26,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -50,7 +50,7 @@ var args struct {
 func init() {
 	flags := Cmd.Flags()
 	flags.SortFlags = false
-	output.AddFlag(Cmd)
+	output.AddTableFlags(Cmd)
 	ocm.AddOptionalClusterFlag(Cmd)
 
 	flags.StringVarP(
@@ -86,7 +86,7 @@ func run(cmd *cobra.Command, _ []string) {
 		config, err = r.OCMClient.GetOidcConfig(args.oidcConfigId)
 		if err != nil {
 			r.Reporter.Errorf("Failed to get OIDC config: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	providers, err := r.AWSClient.ListOidcProviders(clusterId, config)
@@ -96,7 +96,7 @@ func run(cmd *cobra.Command, _ []string) {
 	}
 	if err != nil {
 		r.Reporter.Errorf("Failed to get OIDC providers: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	providersInUse := map[string]bool{}
@@ -104,56 +104,54 @@ func run(cmd *cobra.Command, _ []string) {
 		resourceName, err := aws.GetResourceIdFromOidcProviderARN(provider.Arn)
 		if err != nil {
 			r.Reporter.Errorf("%v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		has, err := r.OCMClient.
 			HasAClusterUsingOidcProvider(
 				fmt.Sprintf("https://%s", resourceName), r.Creator.AccountID)
 		if err != nil {
 			r.Reporter.Errorf("%v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		providersInUse[provider.Arn] = has
 	}
 
-	if output.HasFlag() {
-		outList := []map[string]interface{}{}
-		for _, provider := range providers {
-			outList = append(outList, map[string]interface{}{
-				"arn": provider.Arn, "cluster_id": provider.ClusterId, "in_use": providersInUse[provider.Arn]})
-		}
-		err = output.Print(outList)
-		if err != nil {
-			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
-		}
-		os.Exit(0)
+	items := []oidcProviderOutput{}
+	for _, provider := range providers {
+		items = append(items, oidcProviderOutput{
+			Arn:       provider.Arn,
+			ClusterId: provider.ClusterId,
+			InUse:     providersInUse[provider.Arn],
+		})
 	}
 
-	if len(providers) == 0 {
+	if len(items) == 0 && output.IsTableOutput() {
 		r.Reporter.Infof("No OIDC providers available")
 		os.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(writer, "OIDC PROVIDER ARN\tCluster ID\tIn Use\n")
-	for _, provider := range providers {
-		printProvider(writer, providersInUse, provider)
+	err = oidcProvidersTable.Print(items)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
 	}
-	writer.Flush()
 }
 
-func printProvider(writer *tabwriter.Writer, providersInUse map[string]bool, provider aws.OidcProviderOutput) {
-	providerInUse := "No"
-	if ok := providersInUse[provider.Arn]; ok {
-		providerInUse = "Yes"
-	}
-	fmt.Fprintf(
-		writer,
-		"%s\t%s\t%v\n",
-		provider.Arn,
-		provider.ClusterId,
-		providerInUse,
-	)
+type oidcProviderOutput struct {
+	Arn       string `json:"arn"`
+	ClusterId string `json:"cluster_id"`
+	InUse     bool   `json:"in_use"`
+}
+
+var oidcProvidersTable = output.Table[oidcProviderOutput]{
+	Columns: []output.Column[oidcProviderOutput]{
+		{Header: "OIDC PROVIDER ARN", Value: func(provider oidcProviderOutput) string { return provider.Arn }},
+		{Header: "Cluster ID", Value: func(provider oidcProviderOutput) string { return provider.ClusterId }},
+		{Header: "In Use", Value: func(provider oidcProviderOutput) string {
+			if provider.InUse {
+				return output.Yes
+			}
+			return output.No
+		}},
+	},
 }
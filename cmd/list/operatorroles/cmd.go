@@ -19,12 +19,14 @@ package operatorroles
 import (
 	"fmt"
 	"os"
-	"text/tabwriter"
+	"strconv"
 	"time"
 
 	"github.com/briandowns/spinner"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
@@ -73,7 +75,7 @@ func init() {
 	)
 	interactive.AddFlag(flags)
 	ocm.AddOptionalClusterFlag(Cmd)
-	output.AddFlag(Cmd)
+	output.AddTableFlags(Cmd)
 }
 
 func run(cmd *cobra.Command, _ []string) {
@@ -83,14 +85,14 @@ func run(cmd *cobra.Command, _ []string) {
 	versionList, err := ocm.GetVersionMinorList(r.OCMClient)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	_, err = r.OCMClient.ValidateVersion(args.version, versionList,
 		r.Cluster.Version().ChannelGroup(), r.Cluster.AWS().STS().RoleARN() == "", r.Cluster.Hypershift().Enabled())
 	if err != nil {
 		r.Reporter.Errorf("Version '%s' is invalid", args.version)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	var spin *spinner.Spinner
@@ -109,7 +111,7 @@ func run(cmd *cobra.Command, _ []string) {
 		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
 		if err != nil {
 			r.Reporter.Errorf("Failed to get cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		clusterId = cluster.ID()
 		args.prefix = cluster.AWS().STS().OperatorRolePrefix()
@@ -125,7 +127,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if err != nil {
 		r.Reporter.Errorf("Failed to get operator roles: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if len(operatorsMap) == 0 {
@@ -142,21 +144,6 @@ func run(cmd *cobra.Command, _ []string) {
 		r.Reporter.Infof(noOperatorRolesOutput)
 		os.Exit(0)
 	}
-	if output.HasFlag() {
-		var resource interface{} = operatorsMap
-		if args.prefix != "" {
-			resource = operatorsMap[args.prefix]
-		}
-		err = output.Print(resource)
-		if err != nil {
-			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
-		}
-		os.Exit(0)
-	}
-
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	if clusterId != "" {
 		for key, value := range operatorsMap {
 			if value[0].ClusterID == clusterId {
@@ -165,17 +152,29 @@ func run(cmd *cobra.Command, _ []string) {
 		}
 	}
 	if args.prefix == "" {
-		fmt.Fprintf(writer, "ROLE PREFIX\tAMOUNT IN BUNDLE\n")
-		for _, key := range prefixes {
-			fmt.Fprintf(
-				writer,
-				"%s\t%d\n",
-				key,
-				len(operatorsMap[key]),
-			)
+		// The complete set of bundles is only available as a structured document:
+		if output.IsStructuredOutput() {
+			err = output.Print(operatorsMap)
+			if err != nil {
+				r.Reporter.Errorf("%s", err)
+				os.Exit(clierror.ExitCode(err))
+			}
+			os.Exit(0)
+		}
+		prefixesTable := output.Table[string]{
+			Columns: []output.Column[string]{
+				{Header: "ROLE PREFIX", Value: func(prefix string) string { return prefix }},
+				{Header: "AMOUNT IN BUNDLE", Value: func(prefix string) string {
+					return strconv.Itoa(len(operatorsMap[prefix]))
+				}},
+			},
+		}
+		err = prefixesTable.Print(prefixes)
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(clierror.ExitCode(err))
 		}
-		writer.Flush()
-		if !interactive.Enabled() {
+		if !interactive.Enabled() || !output.IsTableOutput() {
 			os.Exit(0)
 		}
 		if !confirm.Prompt(true, "Would you like to detail a specific prefix") {
@@ -190,51 +189,55 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid OIDC Config ID: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
-	if args.prefix != "" {
-		if _, ok := operatorsMap[args.prefix]; !ok {
-			noOperatorRolesPrefixOutput := fmt.Sprintf("No operator roles available for prefix '%s'", args.prefix)
-			if args.version != "" {
-				noOperatorRolesPrefixOutput =
-					fmt.Sprintf("%s in version '%s'", noOperatorRolesPrefixOutput, args.version)
-			}
-			r.Reporter.Infof(noOperatorRolesPrefixOutput)
-			os.Exit(0)
-		}
-		hasClusterUsingOperatorRolesPrefix, err := r.OCMClient.HasAClusterUsingOperatorRolesPrefix(args.prefix)
-		if err != nil {
-			r.Reporter.Errorf("There was a problem checking if any clusters"+
-				" are using Operator Roles Prefix '%s' : %v", args.prefix, err)
-			os.Exit(1)
+	if _, ok := operatorsMap[args.prefix]; !ok {
+		noOperatorRolesPrefixOutput := fmt.Sprintf("No operator roles available for prefix '%s'", args.prefix)
+		if args.version != "" {
+			noOperatorRolesPrefixOutput =
+				fmt.Sprintf("%s in version '%s'", noOperatorRolesPrefixOutput, args.version)
 		}
+		r.Reporter.Infof(noOperatorRolesPrefixOutput)
+		os.Exit(0)
+	}
+	hasClusterUsingOperatorRolesPrefix, err := r.OCMClient.HasAClusterUsingOperatorRolesPrefix(args.prefix)
+	if err != nil {
+		r.Reporter.Errorf("There was a problem checking if any clusters"+
+			" are using Operator Roles Prefix '%s' : %v", args.prefix, err)
+		os.Exit(clierror.ExitCode(err))
+	}
 
-		fmt.Fprintf(writer, "OPERATOR NAME\tOPERATOR NAMESPACE\tROLE NAME\t"+
-			"ROLE ARN\tCLUSTER ID\tVERSION\tPOLICIES\tAWS Managed\tIN USE\n")
-		for _, operatorRole := range operatorsMap[args.prefix] {
-			awsManaged := "No"
-			inUse := "No"
-			if operatorRole.ManagedPolicy {
-				awsManaged = "Yes"
-			}
-			if hasClusterUsingOperatorRolesPrefix {
-				inUse = "Yes"
-			}
-			fmt.Fprintf(
-				writer,
-				"%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
-				operatorRole.OperatorName,
-				operatorRole.OperatorNamespace,
-				operatorRole.RoleName,
-				operatorRole.RoleARN,
-				operatorRole.ClusterID,
-				operatorRole.Version,
-				operatorRole.AttachedPolicies,
-				awsManaged,
-				inUse,
-			)
-		}
-		writer.Flush()
+	err = operatorRolesTable(hasClusterUsingOperatorRolesPrefix).Print(operatorsMap[args.prefix])
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
+	}
+}
+
+func operatorRolesTable(inUse bool) output.Table[aws.OperatorRoleDetail] {
+	return output.Table[aws.OperatorRoleDetail]{
+		Columns: []output.Column[aws.OperatorRoleDetail]{
+			{Header: "OPERATOR NAME", Value: func(role aws.OperatorRoleDetail) string { return role.OperatorName }},
+			{Header: "OPERATOR NAMESPACE", Value: func(role aws.OperatorRoleDetail) string { return role.OperatorNamespace }},
+			{Header: "ROLE NAME", Value: func(role aws.OperatorRoleDetail) string { return role.RoleName }},
+			{Header: "ROLE ARN", Value: func(role aws.OperatorRoleDetail) string { return role.RoleARN }},
+			{Header: "CLUSTER ID", Value: func(role aws.OperatorRoleDetail) string { return role.ClusterID }},
+			{Header: "VERSION", Value: func(role aws.OperatorRoleDetail) string { return role.Version }},
+			{Header: "POLICIES", Value: func(role aws.OperatorRoleDetail) string { return fmt.Sprint(role.AttachedPolicies) }},
+			{Header: "AWS Managed", Value: func(role aws.OperatorRoleDetail) string {
+				if role.ManagedPolicy {
+					return output.Yes
+				}
+				return output.No
+			}},
+			{Header: "IN USE", Value: func(_ aws.OperatorRoleDetail) string {
+				if inUse {
+					return output.Yes
+				}
+				return output.No
+			}},
+		},
+		Name: func(role aws.OperatorRoleDetail) string { return role.RoleName },
 	}
 }
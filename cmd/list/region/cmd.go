@@ -18,13 +18,16 @@ package region
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"strconv"
 	"text/tabwriter"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -79,7 +82,7 @@ func init() {
 		"List only regions with support for Hosted Control Planes",
 	)
 
-	output.AddFlag(Cmd)
+	output.AddTableFlags(Cmd)
 }
 
 func run(cmd *cobra.Command, _ []string) {
@@ -88,19 +91,19 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if err := validateChangedSTSExternalIDFlag(cmd, args.externalID); err != nil {
 		r.Reporter.Errorf("Expected a valid STS external ID: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	callerIdentity, err := r.AWSClient.GetCallerIdentity()
 	if err != nil {
 		r.Reporter.Errorf("Failed to get caller identity: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	isUsingAssumedRole, err := aws.IsArnAssumedRole(*callerIdentity.Arn)
 	if err != nil {
 		r.Reporter.Errorf("Failed to check if role is an assumed role: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if isUsingAssumedRole && r.Creator.IsSTS && !cmd.Flag(roleArnFlag).Changed {
@@ -113,7 +116,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid role arn value: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -122,7 +125,7 @@ func run(cmd *cobra.Command, _ []string) {
 	regions, err := r.OCMClient.GetRegions(args.roleARN, args.externalID)
 	if err != nil {
 		r.Reporter.Errorf("Failed to fetch regions: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	// Filter out unwanted regions
@@ -144,35 +147,51 @@ func run(cmd *cobra.Command, _ []string) {
 		availableRegions = append(availableRegions, region)
 	}
 
-	if output.HasFlag() {
-		err = output.Print(availableRegions)
-		if err != nil {
-			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
-		}
-		os.Exit(0)
-	}
-
-	if len(availableRegions) == 0 {
+	if len(availableRegions) == 0 && output.IsTableOutput() {
 		r.Reporter.Warnf("There are no regions available for this AWS account")
 		os.Exit(1)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	headerFormat := "ID\t\tNAME\t\tMULTI-AZ SUPPORT\t\tHOSTED-CP SUPPORT\n"
-	fmt.Fprint(writer, headerFormat)
-
-	for _, region := range availableRegions {
-		fmt.Fprintf(writer,
-			"%s\t\t%s\t\t%t\t\t%t\n",
-			region.ID(),
-			region.DisplayName(),
-			region.SupportsMultiAZ(),
-			region.SupportsHypershift(),
-		)
+	err = regionsTable.Print(availableRegions)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
 	}
-	writer.Flush()
+}
+
+var regionsTable = output.Table[*cmv1.CloudRegion]{
+	Columns: []output.Column[*cmv1.CloudRegion]{
+		{Header: "ID", Value: func(region *cmv1.CloudRegion) string { return region.ID() }},
+		{Header: "NAME", Value: func(region *cmv1.CloudRegion) string { return region.DisplayName() }},
+		{Header: "MULTI-AZ SUPPORT", Value: func(region *cmv1.CloudRegion) string {
+			return strconv.FormatBool(region.SupportsMultiAZ())
+		}},
+		{Header: "HOSTED-CP SUPPORT", Value: func(region *cmv1.CloudRegion) string {
+			return strconv.FormatBool(region.SupportsHypershift())
+		}},
+		{Header: "CCS ONLY", Value: func(region *cmv1.CloudRegion) string {
+			return strconv.FormatBool(region.CCSOnly())
+		}, Wide: true},
+		{Header: "GOVCLOUD", Value: func(region *cmv1.CloudRegion) string {
+			return strconv.FormatBool(region.GovCloud())
+		}, Wide: true},
+	},
+	Default: func(w io.Writer, regions []*cmv1.CloudRegion) error {
+		writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		headerFormat := "ID\t\tNAME\t\tMULTI-AZ SUPPORT\t\tHOSTED-CP SUPPORT\n"
+		fmt.Fprint(writer, headerFormat)
+
+		for _, region := range regions {
+			fmt.Fprintf(writer,
+				"%s\t\t%s\t\t%t\t\t%t\n",
+				region.ID(),
+				region.DisplayName(),
+				region.SupportsMultiAZ(),
+				region.SupportsHypershift(),
+			)
+		}
+		return writer.Flush()
+	},
 }
 
 func validateChangedSTSExternalIDFlag(cmd *cobra.Command, externalID string) error {
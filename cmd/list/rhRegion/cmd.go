@@ -2,20 +2,25 @@ package rhRegion
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"sort"
 	"strings"
 	"text/tabwriter"
 
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/config"
+	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
 var (
-	writer = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.TabIndent)
+	writer io.Writer = os.Stdout
 	args   struct {
 		discoveryURL string
 	}
@@ -44,6 +49,7 @@ ocm list rh-regions`,
 			"file or "+sdk.DefaultURL+" as a last resort. The value should be a complete URL "+
 			"or a valid URL alias: "+strings.Join(ocm.ValidOCMUrlAliases(), ", "),
 	)
+	output.AddTableFlags(Cmd)
 	return Cmd
 }
 
@@ -53,7 +59,7 @@ func run(cmd *cobra.Command, argv []string) {
 	err := ListRhRegions(args.discoveryURL, r)
 	if err != nil {
 		r.Reporter.Errorf("Failed to determine gateway URL: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 }
 
@@ -68,21 +74,45 @@ func ListRhRegions(discoveryURL string, r *rosa.Runtime) error {
 		return fmt.Errorf("Failed to determine gateway URL: %v", err)
 	}
 
-	fmt.Fprintf(writer, "Discovery URL: %s\n\n", gatewayURL)
+	if output.IsTableOutput() {
+		fmt.Fprintf(writer, "Discovery URL: %s\n\n", gatewayURL)
+	}
 	regions, err := sdk.GetRhRegions(gatewayURL)
 	if err != nil {
 		return fmt.Errorf("Failed to get OCM regions: %v", err)
 	}
 
 	// If there are no regions, print a warning message and return early
-	if len(regions) == 0 {
+	if len(regions) == 0 && output.IsTableOutput() {
 		r.Reporter.Warnf("No regions found")
 		return nil
 	}
-	fmt.Fprintf(writer, "RH Region\t\tGateway URL\n")
-	for regionName, region := range regions {
-		fmt.Fprintf(writer, "%s\t\t%v\n", regionName, region.URL)
+	items := []rhRegionOutput{}
+	for _, name := range helper.MapKeys(regions) {
+		items = append(items, rhRegionOutput{Name: name, URL: regions[name].URL})
 	}
-	writer.Flush()
-	return nil
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+	return rhRegionsTable.Write(writer, items)
+}
+
+type rhRegionOutput struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+var rhRegionsTable = output.Table[rhRegionOutput]{
+	Columns: []output.Column[rhRegionOutput]{
+		{Header: "RH Region", Value: func(region rhRegionOutput) string { return region.Name }},
+		{Header: "Gateway URL", Value: func(region rhRegionOutput) string { return region.URL }},
+	},
+	Default: func(w io.Writer, regions []rhRegionOutput) error {
+		writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.TabIndent)
+		fmt.Fprintf(writer, "RH Region\t\tGateway URL\n")
+		for _, region := range regions {
+			fmt.Fprintf(writer, "%s\t\t%v\n", region.Name, region.URL)
+		}
+		return writer.Flush()
+	},
 }
@@ -17,13 +17,12 @@ limitations under the License.
 package service
 
 import (
-	"fmt"
 	"os"
-	"text/tabwriter"
 
 	msv1 "github.com/openshift-online/ocm-sdk-go/servicemgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -46,7 +45,7 @@ func init() {
 	flags := Cmd.Flags()
 	flags.SortFlags = false
 
-	output.AddFlag(Cmd)
+	output.AddTableFlags(Cmd)
 }
 
 func run(cmd *cobra.Command, argv []string) {
@@ -60,29 +59,24 @@ func run(cmd *cobra.Command, argv []string) {
 	servicesList, err := r.OCMClient.ListManagedServices(1000)
 	if err != nil {
 		r.Reporter.Errorf("Failed to retrieve list of managed services: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
-	if output.HasFlag() {
-		outList := []*msv1.ManagedService{}
-		servicesList.Each(func(srv *msv1.ManagedService) bool {
-			outList = append(outList, srv)
-			return true
-		})
-		output.Print(outList)
-		if err != nil {
-			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
-		}
-		os.Exit(0)
+	err = servicesTable.Print(servicesList.Slice())
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
 	}
+}
 
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(writer, "SERVICE_ID\tSERVICE\tSERVICE_STATE\tCLUSTER_NAME\n")
-	servicesList.Each(func(srv *msv1.ManagedService) bool {
-		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n",
-			srv.ID(), srv.Service(), srv.ServiceState(), srv.Cluster().Name())
-		return true
-	})
-	writer.Flush()
+var servicesTable = output.Table[*msv1.ManagedService]{
+	Columns: []output.Column[*msv1.ManagedService]{
+		{Header: "SERVICE_ID", Value: func(srv *msv1.ManagedService) string { return srv.ID() }},
+		{Header: "SERVICE", Value: func(srv *msv1.ManagedService) string { return srv.Service() }},
+		{Header: "SERVICE_STATE", Value: func(srv *msv1.ManagedService) string { return srv.ServiceState() }},
+		{Header: "CLUSTER_NAME", Value: func(srv *msv1.ManagedService) string { return srv.Cluster().Name() }},
+		{Header: "CLUSTER_ID", Value: func(srv *msv1.ManagedService) string {
+			return srv.Cluster().Id()
+		}, Wide: true},
+	},
 }
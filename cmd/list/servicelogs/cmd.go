@@ -0,0 +1,318 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicelogs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "service-logs"
+	short = "List service log entries"
+	long  = "List the service log entries sent by Red Hat to a cluster, such as limited support " +
+		"notices and upgrade notes, or to all the clusters of the organization when no cluster is " +
+		"given. Entries are printed from the oldest to the most recent one. With '--follow' the " +
+		"command keeps running and prints the new entries as they arrive."
+	example = `  # List the service log entries of cluster 'mycluster'
+  rosa list service-logs --cluster mycluster
+
+  # List the warnings and errors of the last week across all the clusters
+  rosa list service-logs --severity Warning,Error --since 7d
+
+  # Follow the service log of a cluster
+  rosa list service-logs --cluster mycluster --follow`
+
+	// DefaultLimit is the default number of entries listed
+	DefaultLimit = 100
+	// DefaultInterval is the default time between two checks for new entries when following
+	DefaultInterval = 30 * time.Second
+)
+
+type options struct {
+	severities   []string
+	serviceNames []string
+	since        string
+	until        string
+	limit        int
+	follow       bool
+	interval     time.Duration
+}
+
+var logEntriesTable = output.Table[*slv1.LogEntry]{
+	Columns: []output.Column[*slv1.LogEntry]{
+		{Header: "TIMESTAMP", Value: func(e *slv1.LogEntry) string { return e.Timestamp().Format(time.RFC3339) }},
+		{Header: "SEVERITY", Value: func(e *slv1.LogEntry) string { return string(e.Severity()) }},
+		{Header: "SERVICE", Value: func(e *slv1.LogEntry) string { return e.ServiceName() }},
+		{Header: "CLUSTER ID", Value: func(e *slv1.LogEntry) string { return e.ClusterID() }},
+		{Header: "SUMMARY", Value: func(e *slv1.LogEntry) string { return e.Summary() }},
+		{Header: "ID", Value: func(e *slv1.LogEntry) string { return e.ID() }, Wide: true},
+		{Header: "TYPE", Value: func(e *slv1.LogEntry) string { return string(e.LogType()) }, Wide: true},
+		{Header: "CREATED BY", Value: func(e *slv1.LogEntry) string { return e.CreatedBy() }, Wide: true},
+	},
+	Name: func(e *slv1.LogEntry) string { return e.ID() },
+}
+
+func NewListServiceLogsCommand() *cobra.Command {
+	options := &options{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: []string{"service-log", "servicelogs", "servicelog"},
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), ListServiceLogsRunner(options)),
+		Args:    cobra.NoArgs,
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+	ocm.AddOptionalClusterFlag(cmd)
+	flags.StringSliceVar(
+		&options.severities,
+		"severity",
+		nil,
+		fmt.Sprintf("Only list the entries with the given severities. Valid severities are %s.",
+			strings.Join(ocm.ServiceLogSeverities, ", ")),
+	)
+	flags.StringSliceVar(
+		&options.serviceNames,
+		"service",
+		nil,
+		"Only list the entries sent by the given services, for example 'SREManualAction'.",
+	)
+	flags.StringVar(
+		&options.since,
+		"since",
+		"",
+		"Only list the entries created after the given time. Accepts an RFC 3339 timestamp or a "+
+			"duration relative to now, for example '2h' or '7d'.",
+	)
+	flags.StringVar(
+		&options.until,
+		"until",
+		"",
+		"Only list the entries created before the given time. Accepts the same values as '--since'.",
+	)
+	flags.IntVar(
+		&options.limit,
+		"limit",
+		DefaultLimit,
+		"Maximum number of entries to list, the most recent ones are listed. Zero lists all the entries.",
+	)
+	flags.BoolVarP(
+		&options.follow,
+		"follow",
+		"f",
+		false,
+		"Keep running and print the new entries as they arrive.",
+	)
+	flags.DurationVar(
+		&options.interval,
+		"interval",
+		DefaultInterval,
+		"Time between two checks for new entries when following.",
+	)
+	output.AddTableFlags(cmd)
+	cmd.RegisterFlagCompletionFunc("severity", func(*cobra.Command, []string, string) ([]string,
+		cobra.ShellCompDirective) {
+		return ocm.ServiceLogSeverities, cobra.ShellCompDirectiveNoFileComp
+	})
+	return cmd
+}
+
+func ListServiceLogsRunner(options *options) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, cmd *cobra.Command, _ []string) error {
+		start := time.Now()
+		listOptions, err := buildListOptions(options, start)
+		if err != nil {
+			return err
+		}
+		if options.follow && !listOptions.Until.IsZero() {
+			return fmt.Errorf("The '--until' flag cannot be used with '--follow'")
+		}
+		if options.follow && options.interval <= 0 {
+			return fmt.Errorf("The '--interval' flag must be a positive duration")
+		}
+		if cmd.Flags().Changed("cluster") {
+			listOptions.ClusterID = r.FetchCluster().ID()
+		}
+
+		entries, err := r.OCMClient.ListServiceLogs(listOptions)
+		if err != nil {
+			return fmt.Errorf("Failed to list service log entries: %v", err)
+		}
+		if !options.follow {
+			if len(entries) == 0 && output.IsTableOutput() {
+				r.Reporter.Infof("There are no service log entries matching the filters")
+				return nil
+			}
+			return logEntriesTable.Print(entries)
+		}
+
+		follower := &follower{options: listOptions}
+		if follower.options.Since.IsZero() {
+			follower.options.Since = start
+		}
+		err = follower.print(entries)
+		if err != nil {
+			return err
+		}
+		ticker := time.NewTicker(options.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+			entries, err = follower.poll(r.OCMClient)
+			if err != nil {
+				r.Reporter.Warnf("Failed to list new service log entries: %v", err)
+				continue
+			}
+			err = follower.print(entries)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func buildListOptions(options *options, now time.Time) (ocm.ServiceLogListOptions, error) {
+	listOptions := ocm.ServiceLogListOptions{
+		ServiceNames: options.serviceNames,
+		Limit:        options.limit,
+	}
+	if options.limit < 0 {
+		return listOptions, fmt.Errorf("The '--limit' flag must not be negative")
+	}
+	for _, severity := range options.severities {
+		normalized, err := ocm.NormalizeServiceLogSeverity(severity)
+		if err != nil {
+			return listOptions, err
+		}
+		listOptions.Severities = append(listOptions.Severities, normalized)
+	}
+	var err error
+	listOptions.Since, err = parseTime("since", options.since, now)
+	if err != nil {
+		return listOptions, err
+	}
+	listOptions.Until, err = parseTime("until", options.until, now)
+	if err != nil {
+		return listOptions, err
+	}
+	if !listOptions.Since.IsZero() && !listOptions.Until.IsZero() && listOptions.Until.Before(listOptions.Since) {
+		return listOptions, fmt.Errorf("The '--until' time must be after the '--since' time")
+	}
+	return listOptions, nil
+}
+
+// parseTime accepts an RFC 3339 timestamp or a duration before the given time. Besides the units
+// supported by time.ParseDuration, durations can use 'd' for days.
+func parseTime(flag string, value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if timestamp, err := time.Parse(time.RFC3339, value); err == nil {
+		return timestamp, nil
+	}
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		if count, err := strconv.Atoi(days); err == nil && count >= 0 {
+			return now.AddDate(0, 0, -count), nil
+		}
+	}
+	if duration, err := time.ParseDuration(value); err == nil && duration >= 0 {
+		return now.Add(-duration), nil
+	}
+	return time.Time{}, fmt.Errorf("Invalid '--%s' value '%s': expected an RFC 3339 timestamp or a "+
+		"duration like '2h' or '7d'", flag, value)
+}
+
+// follower remembers the last entries printed so that every poll only prints the entries that
+// arrived after them
+type follower struct {
+	options ocm.ServiceLogListOptions
+	seen    map[string]bool
+	printed bool
+}
+
+// poll lists the entries with a timestamp equal or after the second of the last one printed,
+// skipping the ones already printed, as several entries can share the same second
+func (f *follower) poll(client *ocm.Client) ([]*slv1.LogEntry, error) {
+	options := f.options
+	options.Limit = 0
+	entries, err := client.ListServiceLogs(options)
+	if err != nil {
+		return nil, err
+	}
+	result := []*slv1.LogEntry{}
+	for _, entry := range entries {
+		if !f.seen[entry.ID()] {
+			result = append(result, entry)
+		}
+	}
+	return result, nil
+}
+
+func (f *follower) print(entries []*slv1.LogEntry) error {
+	if len(entries) > 0 {
+		// The search only has a precision of seconds, so the next poll lists again all the entries
+		// of the second of the last one:
+		second := entries[len(entries)-1].Timestamp().Truncate(time.Second)
+		if second.After(f.options.Since) || f.seen == nil {
+			f.seen = map[string]bool{}
+		}
+		f.options.Since = second
+		for _, entry := range entries {
+			if !entry.Timestamp().Before(second) {
+				f.seen[entry.ID()] = true
+			}
+		}
+	}
+	// Structured output prints every entry as its own document, so that they can be consumed as
+	// a stream:
+	if output.IsStructuredOutput() {
+		for _, entry := range entries {
+			err := output.Print(entry)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	if f.printed {
+		output.SetNoHeaders(true)
+	}
+	f.printed = true
+	return logEntriesTable.Print(entries)
+}
@@ -0,0 +1,203 @@
+package servicelogs
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+func mockLogEntry(id string, timestamp time.Time, severity slv1.Severity, summary string) *slv1.LogEntry {
+	logEntry, err := slv1.NewLogEntry().
+		ID(id).
+		ClusterID("mock-cluster-id").
+		ServiceName("SREManualAction").
+		Severity(severity).
+		Summary(summary).
+		Timestamp(timestamp).
+		Build()
+	Expect(err).NotTo(HaveOccurred())
+	return logEntry
+}
+
+var _ = Describe("rosa list service-logs", func() {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	Context("Create Command", func() {
+		It("Returns Command", func() {
+			cmd := NewListServiceLogsCommand()
+			Expect(cmd).NotTo(BeNil())
+
+			Expect(cmd.Use).To(Equal(use))
+			Expect(cmd.Example).To(Equal(example))
+			Expect(cmd.Short).To(Equal(short))
+			Expect(cmd.Long).To(Equal(long))
+			Expect(cmd.Args).NotTo(BeNil())
+			Expect(cmd.Run).NotTo(BeNil())
+
+			for _, name := range []string{"cluster", "severity", "service", "since", "until", "limit", "follow",
+				"interval", "output"} {
+				Expect(cmd.Flags().Lookup(name)).NotTo(BeNil())
+			}
+		})
+	})
+
+	Context("Command Runner", func() {
+		var (
+			t *TestingRuntime
+			c *cobra.Command
+		)
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			c = NewListServiceLogsCommand()
+			output.SetOutput("")
+		})
+
+		AfterEach(func() {
+			output.SetOutput("")
+			output.SetNoHeaders(false)
+		})
+
+		It("Returns an error if OCM API fails to list the service logs", func() {
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusInternalServerError, "{}"))
+
+			err := ListServiceLogsRunner(&options{limit: DefaultLimit})(context.Background(), t.RosaRuntime, c, nil)
+			Expect(err).To(MatchError(ContainSubstring("Failed to list service log entries")))
+		})
+
+		It("Prints a message if there are no service logs", func() {
+			t.ApiServer.AppendHandlers(ghttp.CombineHandlers(
+				RespondWithJSON(http.StatusOK, FormatLogEntryList([]*slv1.LogEntry{})),
+			))
+
+			t.StdOutReader.Record()
+			err := ListServiceLogsRunner(&options{limit: DefaultLimit})(context.Background(), t.RosaRuntime, c, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(Equal("INFO: There are no service log entries matching the filters\n"))
+		})
+
+		It("Prints the service logs of a cluster from the oldest to the most recent one", func() {
+			cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.ID("mock-cluster-id")
+			})
+			t.SetCluster(cluster.Name(), cluster)
+			t.ApiServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyFormKV("search", "cluster_id='mock-cluster-id' and severity in ('Warning', 'Error')"),
+				ghttp.VerifyFormKV("order", "timestamp desc"),
+				ghttp.VerifyFormKV("size", "2"),
+				RespondWithJSON(http.StatusOK, FormatLogEntryList([]*slv1.LogEntry{
+					mockLogEntry("mock-id-2", now, slv1.SeverityError, "Cluster in limited support"),
+					mockLogEntry("mock-id-1", now.Add(-time.Hour), slv1.SeverityWarning, "Upgrade delayed"),
+				})),
+			))
+			Expect(c.Flags().Set("cluster", "mock-cluster-id")).To(Succeed())
+
+			t.StdOutReader.Record()
+			err := ListServiceLogsRunner(&options{severities: []string{"warning", "error"}, limit: 2})(
+				context.Background(), t.RosaRuntime, c, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(Equal(
+				"TIMESTAMP             SEVERITY  SERVICE          CLUSTER ID       SUMMARY\n" +
+					"2026-10-16T11:00:00Z  Warning   SREManualAction  mock-cluster-id  Upgrade delayed\n" +
+					"2026-10-16T12:00:00Z  Error     SREManualAction  mock-cluster-id  Cluster in limited support\n"))
+		})
+
+		It("Returns an error when following with an end time", func() {
+			err := ListServiceLogsRunner(&options{until: "1h", follow: true, interval: DefaultInterval})(
+				context.Background(), t.RosaRuntime, c, nil)
+			Expect(err).To(MatchError("The '--until' flag cannot be used with '--follow'"))
+		})
+
+		It("Only prints the new entries when following", func() {
+			f := &follower{options: ocm.ServiceLogListOptions{Since: now.Add(-time.Hour)}}
+			first := mockLogEntry("mock-id-1", now, slv1.SeverityInfo, "First")
+			second := mockLogEntry("mock-id-2", now, slv1.SeverityInfo, "Second")
+			third := mockLogEntry("mock-id-3", now.Add(time.Minute), slv1.SeverityInfo, "Third")
+
+			t.StdOutReader.Record()
+			Expect(f.print([]*slv1.LogEntry{first, second})).To(Succeed())
+			t.ApiServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyFormKV("search", "timestamp >= '2026-10-16T12:00:00Z'"),
+				RespondWithJSON(http.StatusOK, FormatLogEntryList([]*slv1.LogEntry{third, second, first})),
+			))
+			entries, err := f.poll(t.RosaRuntime.OCMClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].ID()).To(Equal("mock-id-3"))
+			Expect(f.print(entries)).To(Succeed())
+
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(Equal(
+				"TIMESTAMP             SEVERITY  SERVICE          CLUSTER ID       SUMMARY\n" +
+					"2026-10-16T12:00:00Z  Info      SREManualAction  mock-cluster-id  First\n" +
+					"2026-10-16T12:00:00Z  Info      SREManualAction  mock-cluster-id  Second\n" +
+					"2026-10-16T12:01:00Z  Info  SREManualAction  mock-cluster-id  Third\n"))
+		})
+
+		It("Doesn't print again the entries of the same second when following", func() {
+			f := &follower{options: ocm.ServiceLogListOptions{Since: now.Add(-time.Hour)}}
+			first := mockLogEntry("mock-id-1", now.Add(300*time.Millisecond), slv1.SeverityInfo, "First")
+			second := mockLogEntry("mock-id-2", now.Add(700*time.Millisecond), slv1.SeverityInfo, "Second")
+			third := mockLogEntry("mock-id-3", now.Add(900*time.Millisecond), slv1.SeverityInfo, "Third")
+
+			t.StdOutReader.Record()
+			Expect(f.print([]*slv1.LogEntry{first, second})).To(Succeed())
+			t.ApiServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyFormKV("search", "timestamp >= '2026-10-16T12:00:00Z'"),
+				RespondWithJSON(http.StatusOK, FormatLogEntryList([]*slv1.LogEntry{third, second, first})),
+			))
+			entries, err := f.poll(t.RosaRuntime.OCMClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].ID()).To(Equal("mock-id-3"))
+			Expect(f.print(entries)).To(Succeed())
+
+			t.ApiServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyFormKV("search", "timestamp >= '2026-10-16T12:00:00Z'"),
+				RespondWithJSON(http.StatusOK, FormatLogEntryList([]*slv1.LogEntry{third, second, first})),
+			))
+			entries, err = f.poll(t.RosaRuntime.OCMClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(ContainSubstring("Third"))
+		})
+	})
+
+	DescribeTable("parses the time filters",
+		func(value string, expected time.Time) {
+			parsed, err := parseTime("since", value, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed).To(Equal(expected))
+		},
+		Entry("empty", "", time.Time{}),
+		Entry("timestamp", "2026-10-01T08:00:00Z", time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)),
+		Entry("duration", "90m", now.Add(-90*time.Minute)),
+		Entry("days", "7d", now.AddDate(0, 0, -7)),
+	)
+
+	It("rejects invalid time filters", func() {
+		_, err := parseTime("since", "yesterday", now)
+		Expect(err).To(MatchError("Invalid '--since' value 'yesterday': expected an RFC 3339 timestamp or a " +
+			"duration like '2h' or '7d'"))
+		_, err = buildListOptions(&options{since: "1h", until: "2h"}, now)
+		Expect(err).To(MatchError("The '--until' time must be after the '--since' time"))
+	})
+})
@@ -0,0 +1,13 @@
+package servicelogs
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestListServiceLogs(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "List Service Logs Suite")
+}
@@ -17,12 +17,12 @@ limitations under the License.
 package tuningconfigs
 
 import (
-	"fmt"
 	"os"
-	"text/tabwriter"
 
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/input"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
@@ -42,7 +42,7 @@ var Cmd = &cobra.Command{
 
 func init() {
 	ocm.AddClusterFlag(Cmd)
-	output.AddFlag(Cmd)
+	output.AddTableFlags(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -59,32 +59,25 @@ func run(_ *cobra.Command, _ []string) {
 	tuningConfigs, err := r.OCMClient.GetTuningConfigs(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get tuning configs for cluster '%s': %v", cluster.ID(), err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
-	if output.HasFlag() {
-		err = output.Print(tuningConfigs)
-		if err != nil {
-			r.Reporter.Errorf("%v", err)
-			os.Exit(1)
-		}
-		os.Exit(0)
-	}
-
-	if len(tuningConfigs) == 0 {
+	if len(tuningConfigs) == 0 && output.IsTableOutput() {
 		r.Reporter.Infof("There are no tuning configs for this cluster.")
 		os.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
-
-	fmt.Fprintf(writer, "ID\tNAME\n")
-	for _, tuningConfig := range tuningConfigs {
-		fmt.Fprintf(writer, "%s\t%s\n",
-			tuningConfig.ID(),
-			tuningConfig.Name(),
-		)
+	err = tuningConfigsTable.Print(tuningConfigs)
+	if err != nil {
+		r.Reporter.Errorf("%v", err)
+		os.Exit(clierror.ExitCode(err))
 	}
-	writer.Flush()
+}
+
+var tuningConfigsTable = output.Table[*cmv1.TuningConfig]{
+	Columns: []output.Column[*cmv1.TuningConfig]{
+		{Header: "ID", Value: func(tuningConfig *cmv1.TuningConfig) string { return tuningConfig.ID() }},
+		{Header: "NAME", Value: func(tuningConfig *cmv1.TuningConfig) string { return tuningConfig.Name() }},
+	},
+	Name: func(tuningConfig *cmv1.TuningConfig) string { return tuningConfig.Name() },
 }
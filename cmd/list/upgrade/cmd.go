@@ -21,11 +21,11 @@ import (
 	"os"
 	"strconv"
 	"strings"
-	"text/tabwriter"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/machinepool"
 	"github.com/openshift/rosa/pkg/ocm"
@@ -60,7 +60,7 @@ func init() {
 	)
 
 	confirm.AddFlag(flags)
-	output.AddFlag(Cmd)
+	output.AddTableFlags(Cmd)
 }
 
 func run(cmd *cobra.Command, _ []string) {
@@ -69,7 +69,7 @@ func run(cmd *cobra.Command, _ []string) {
 	err := runWithRuntime(r, cmd)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 }
 
@@ -121,16 +121,6 @@ func runWithRuntime(r *rosa.Runtime, _ *cobra.Command) error {
 		}
 	}
 
-	if output.HasFlag() {
-		err := output.Print(availableUpgrades)
-		if err != nil {
-			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
-		}
-
-		os.Exit(0)
-	}
-
 	latestRev := latestInCurrentMinor(ocm.GetVersionID(cluster), availableUpgrades)
 
 	r.Reporter.Debugf("Loading scheduled upgrades for cluster '%s'", clusterKey)
@@ -148,36 +138,30 @@ func runWithRuntime(r *rosa.Runtime, _ *cobra.Command) error {
 		}
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(writer, "VERSION\tNOTES\n")
-	for i, availableUpgrade := range availableUpgrades {
-		notes := make([]string, 0)
-		if i == 0 || availableUpgrade == latestRev {
-			notes = append(notes, "recommended")
-		}
-		if !isHypershift {
-			upgradeNotes := formatScheduledUpgrade(availableUpgrade, scheduledUpgrade, upgradeState)
-			if len(upgradeNotes) != 0 {
-				notes = append(notes, upgradeNotes)
-			}
-		} else {
-			if isNodePool {
-				upgradeNotes := formatScheduledUpgradeHypershift(availableUpgrade, nodePoolScheduledUpgrade)
-				if len(upgradeNotes) != 0 {
-					notes = append(notes, upgradeNotes)
+	upgradesTable := output.Table[string]{
+		Columns: []output.Column[string]{
+			{Header: "VERSION", Value: func(availableUpgrade string) string { return availableUpgrade }},
+			{Header: "NOTES", Value: func(availableUpgrade string) string {
+				notes := make([]string, 0)
+				if availableUpgrade == availableUpgrades[0] || availableUpgrade == latestRev {
+					notes = append(notes, "recommended")
+				}
+				var upgradeNotes string
+				if !isHypershift {
+					upgradeNotes = formatScheduledUpgrade(availableUpgrade, scheduledUpgrade, upgradeState)
+				} else if isNodePool {
+					upgradeNotes = formatScheduledUpgradeHypershift(availableUpgrade, nodePoolScheduledUpgrade)
+				} else {
+					upgradeNotes = formatScheduledUpgradeHypershift(availableUpgrade, controlPlaneScheduledUpgrade)
 				}
-			} else {
-				upgradeNotes := formatScheduledUpgradeHypershift(availableUpgrade, controlPlaneScheduledUpgrade)
 				if len(upgradeNotes) != 0 {
 					notes = append(notes, upgradeNotes)
 				}
-			}
-		}
-		fmt.Fprintf(writer, "%s\t%s\n", availableUpgrade, strings.Join(notes, " - "))
+				return strings.Join(notes, " - ")
+			}},
+		},
 	}
-	writer.Flush()
-	return nil
+	return upgradesTable.Print(availableUpgrades)
 }
 
 func formatScheduledUpgrade(availableUpgrade string,
@@ -18,8 +18,9 @@ package user
 
 import (
 	"fmt"
-	"math"
+	"io"
 	"os"
+	"sort"
 	"strings"
 	"text/tabwriter"
 
@@ -27,6 +28,8 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/cmd/create/admin"
+	"github.com/openshift/rosa/pkg/clierror"
+	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -45,7 +48,7 @@ var Cmd = &cobra.Command{
 
 func init() {
 	ocm.AddClusterFlag(Cmd)
-	output.AddFlag(Cmd)
+	output.AddTableFlags(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -63,7 +66,7 @@ func run(_ *cobra.Command, _ []string) {
 
 	if cluster.ExternalAuthConfig().Enabled() {
 		r.Reporter.Errorf("Listing cluster users is not supported for clusters with external authentication configured.")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	var clusterAdmins []*cmv1.User
@@ -73,55 +76,74 @@ func run(_ *cobra.Command, _ []string) {
 	clusterAdmins, err = r.OCMClient.GetUsers(cluster.ID(), admin.ClusterAdminGroupname)
 	if err != nil {
 		r.Reporter.Errorf("Failed to get cluster-admins for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	// Load dedicated-admins for this cluster
 	dedicatedAdmins, err := r.OCMClient.GetUsers(cluster.ID(), "dedicated-admins")
 	if err != nil {
 		r.Reporter.Errorf("Failed to get dedicated-admins for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
-	if output.HasFlag() {
+	if output.IsStructuredOutput() {
 		// Join the two lists of users and print the raw data. This may result in duplicate entries
 		// in the lists where a user has both roles
 		userList := append(clusterAdmins, dedicatedAdmins...)
 		err := output.Print(userList)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		os.Exit(0)
 	}
 
-	if len(clusterAdmins) == 0 && len(dedicatedAdmins) == 0 {
+	if len(clusterAdmins) == 0 && len(dedicatedAdmins) == 0 && output.IsTableOutput() {
 		r.Reporter.Infof("There are no users configured for cluster '%s'", clusterKey)
 		os.Exit(0)
 	}
 
-	longestUserId := 0.0
 	groups := make(map[string][]string)
 	for _, user := range clusterAdmins {
-		longestUserId = math.Max(longestUserId, float64(len(user.ID())))
 		groups[user.ID()] = []string{admin.ClusterAdminGroupname}
 	}
 	for _, user := range dedicatedAdmins {
-		longestUserId = math.Max(longestUserId, float64(len(user.ID())))
 		if _, ok := groups[user.ID()]; ok {
 			groups[user.ID()] = []string{admin.ClusterAdminGroupname, admin.DedicatedAdminGroupname}
 		} else {
 			groups[user.ID()] = []string{admin.DedicatedAdminGroupname}
 		}
 	}
-
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, int(longestUserId)+2, 4, 2, ' ', 0)
-	fmt.Fprintf(writer, "ID\tGROUPS\t\n")
-
-	for u, r := range groups {
-		fmt.Fprintf(writer, "%s\t%s\t\n", u, strings.Join(r, ", "))
-		writer.Flush()
+	users := helper.MapKeys(groups)
+	sort.Strings(users)
+
+	usersTable := output.Table[string]{
+		Columns: []output.Column[string]{
+			{Header: "ID", Value: func(user string) string { return user }},
+			{Header: "GROUPS", Value: func(user string) string { return strings.Join(groups[user], ", ") }},
+		},
+		Default: func(w io.Writer, users []string) error {
+			longestUserId := 0
+			for _, user := range users {
+				longestUserId = max(longestUserId, len(user))
+			}
+
+			writer := tabwriter.NewWriter(w, longestUserId+2, 4, 2, ' ', 0)
+			fmt.Fprintf(writer, "ID\tGROUPS\t\n")
+			for _, user := range users {
+				fmt.Fprintf(writer, "%s\t%s\t\n", user, strings.Join(groups[user], ", "))
+				err := writer.Flush()
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	err = usersTable.Print(users)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
 	}
 }
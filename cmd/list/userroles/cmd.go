@@ -16,13 +16,13 @@ package userroles
 import (
 	"fmt"
 	"os"
-	"text/tabwriter"
 	"time"
 
 	"github.com/briandowns/spinner"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -40,7 +40,7 @@ rosa list user-roles`,
 }
 
 func init() {
-	output.AddFlag(Cmd)
+	output.AddTableFlags(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -64,30 +64,27 @@ func run(_ *cobra.Command, _ []string) {
 
 	if err != nil {
 		r.Reporter.Errorf("Failed to get user roles: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
-	if output.HasFlag() {
-		err = output.Print(userRoles)
-		if err != nil {
-			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
-		}
-		os.Exit(0)
-	}
-
-	if len(userRoles) == 0 {
+	if len(userRoles) == 0 && output.IsTableOutput() {
 		r.Reporter.Infof("No user roles available")
 		os.Exit(0)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprint(writer, "ROLE NAME\tROLE ARN\tLINKED\n")
-	for _, userRole := range userRoles {
-		fmt.Fprintf(writer, "%s\t%s\t%s\n", userRole.RoleName, userRole.RoleARN, userRole.Linked)
+	err = userRolesTable.Print(userRoles)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
 	}
-	writer.Flush()
+}
+
+var userRolesTable = output.Table[aws.Role]{
+	Columns: []output.Column[aws.Role]{
+		{Header: "ROLE NAME", Value: func(role aws.Role) string { return role.RoleName }},
+		{Header: "ROLE ARN", Value: func(role aws.Role) string { return role.RoleARN }},
+		{Header: "LINKED", Value: func(role aws.Role) string { return role.Linked }},
+	},
 }
 
 func listUserRoles(r *rosa.Runtime) ([]aws.Role, error) {
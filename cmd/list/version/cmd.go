@@ -18,6 +18,7 @@ package version
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"text/tabwriter"
@@ -26,6 +27,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/cmd/list/upgrade"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -61,7 +63,7 @@ func init() {
 		"hosted-cp",
 		false,
 		"Lists only versions that are hosted-cp enabled")
-	output.AddFlag(Cmd)
+	output.AddTableFlags(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -78,7 +80,7 @@ func run(_ *cobra.Command, _ []string) {
 	versions, err := r.OCMClient.GetVersionsWithProduct(product, args.channelGroup, false)
 	if err != nil {
 		r.Reporter.Errorf("Failed to fetch versions: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	var availableVersions []*cmv1.Version
@@ -91,52 +93,84 @@ func run(_ *cobra.Command, _ []string) {
 		availableVersions = append(availableVersions, version)
 	}
 
-	if output.HasFlag() {
-		err = output.Print(availableVersions)
-		if err != nil {
-			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
-		}
-		os.Exit(0)
-	}
-
-	if len(availableVersions) == 0 {
+	if len(availableVersions) == 0 && output.IsTableOutput() {
 		r.Reporter.Warnf("There are no OpenShift versions available")
 		os.Exit(1)
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	if isHostedCp {
-		r.Reporter.Infof("Hosted cluster upgrades are cluster-based. To list available upgrades for a cluster, "+
-			"please use '%s'", upgrade.Cmd.CommandPath())
-		fmt.Fprintf(writer, "VERSION\t\tDEFAULT\n")
-	} else {
-		fmt.Fprintf(writer, "VERSION\t\tDEFAULT\t\tAVAILABLE UPGRADES\n")
-	}
+	versionsTable := output.Table[*cmv1.Version]{
+		Columns: []output.Column[*cmv1.Version]{
+			{Header: "VERSION", Value: func(version *cmv1.Version) string { return version.RawID() }},
+			{Header: "DEFAULT", Value: func(version *cmv1.Version) string {
+				if (isHostedCp && version.HostedControlPlaneDefault()) || (!isHostedCp && version.Default()) {
+					return "yes"
+				}
+				return "no"
+			}},
+		},
+		Default: func(w io.Writer, versions []*cmv1.Version) error {
+			writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+			if isHostedCp {
+				fmt.Fprintf(writer, "VERSION\t\tDEFAULT\n")
+			} else {
+				fmt.Fprintf(writer, "VERSION\t\tDEFAULT\t\tAVAILABLE UPGRADES\n")
+			}
 
-	for _, version := range availableVersions {
-		isDefault := "no"
-		availableUpgrades := ""
-		if isHostedCp {
-			if version.HostedControlPlaneDefault() {
-				isDefault = "yes"
+			for _, version := range versions {
+				isDefault := "no"
+				availableUpgrades := ""
+				if isHostedCp {
+					if version.HostedControlPlaneDefault() {
+						isDefault = "yes"
+					}
+				} else {
+					// classic clusters
+					if version.Default() {
+						isDefault = "yes"
+					}
+					availableUpgrades = strings.Join(version.AvailableUpgrades(), ", ")
+				}
+				fmt.Fprintf(writer,
+					"%s\t\t%s\t\t%s\n",
+					version.RawID(),
+					isDefault,
+					availableUpgrades,
+				)
 			}
-		} else {
-			// classic clusters
-			if version.Default() {
-				isDefault = "yes"
+			return writer.Flush()
+		},
+	}
+	if !isHostedCp {
+		versionsTable.Columns = append(versionsTable.Columns, output.Column[*cmv1.Version]{
+			Header: "AVAILABLE UPGRADES",
+			Value: func(version *cmv1.Version) string {
+				return strings.Join(version.AvailableUpgrades(), ", ")
+			},
+		})
+	}
+	versionsTable.Columns = append(versionsTable.Columns, output.Column[*cmv1.Version]{
+		Header: "END OF LIFE",
+		Value: func(version *cmv1.Version) string {
+			if version.EndOfLifeTimestamp().IsZero() {
+				return ""
 			}
-			availableUpgrades = strings.Join(version.AvailableUpgrades(), ", ")
-		}
-		fmt.Fprintf(writer,
-			"%s\t\t%s\t\t%s\n",
-			version.RawID(),
-			isDefault,
-			availableUpgrades,
-		)
+			return version.EndOfLifeTimestamp().Format("2006-01-02")
+		},
+		Wide: true,
+	})
+
+	if isHostedCp && output.IsTableOutput() {
+		r.Reporter.Infof("Hosted cluster upgrades are cluster-based. To list available upgrades for a cluster, "+
+			"please use '%s'", upgrade.Cmd.CommandPath())
+	}
+	err = versionsTable.Print(availableVersions)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(clierror.ExitCode(err))
+	}
+	if !output.IsTableOutput() {
+		os.Exit(0)
 	}
-	writer.Flush()
 
 	r.Reporter.Warnf("DEPRECATED: Available upgrades in 'rosa list versions' are deprecated. " +
 		"To list available upgrades for a specific cluster, please use 'rosa list upgrades --cluster <cluster_id>'")
@@ -32,6 +32,7 @@ import (
 
 	"github.com/openshift/rosa/cmd/logout"
 	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/config"
 	"github.com/openshift/rosa/pkg/constants"
 	"github.com/openshift/rosa/pkg/fedramp"
@@ -79,7 +80,10 @@ var Cmd = &cobra.Command{
 		"\t5. Configuration file\n"+
 		"\t6. Command-line prompt\n", uiTokenPage),
 	Example: fmt.Sprintf(`  # Login to the OpenShift API with an existing token generated from %s
-  rosa login --token=$OFFLINE_ACCESS_TOKEN`, uiTokenPage),
+  rosa login --token=$OFFLINE_ACCESS_TOKEN
+
+  # Login to the staging environment and store the session in the 'staging' context
+  rosa login --context staging --env staging --use-auth-code`, uiTokenPage),
 	Run:  run,
 	Args: cobra.NoArgs,
 }
@@ -174,7 +178,7 @@ func run(cmd *cobra.Command, argv []string) {
 	err := runWithRuntime(r, cmd, argv)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 }
 
@@ -199,13 +203,13 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
 	// Confirm that token is not passed with auth code flags
 	if (args.useAuthCode || args.useDeviceCode) && args.token != "" {
 		r.Reporter.Errorf("Token cannot be passed with '--use-auth-code' or '--use-device-code' commands")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	// FedRAMP does not support oauth code flow login yet
 	if fedramp.HasFlag(cmd) && (args.useAuthCode || args.useDeviceCode) {
 		r.Reporter.Errorf("This login method is currently not supported with FedRAMP")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	if args.useAuthCode {
@@ -262,7 +266,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
 	err = CheckAndLogIntoFedramp(fedramp.HasFlag(cmd), fedramp.HasAdminFlag(cmd), cfg, token, r)
 	if err != nil {
 		r.Reporter.Errorf("%s", err.Error())
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	haveReqs := token != "" || (args.clientID != "" && args.clientSecret != "")
@@ -311,7 +315,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
 	gatewayURL, err := ocm.ResolveGatewayUrl(env, cfg)
 	if err != nil {
 		r.Reporter.Errorf("Failed to resolve gateway URL: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	var ok bool
@@ -339,7 +343,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
 			if !fedramp.IsValidEnv(env) {
 				_ = r.Reporter.Errorf("%s is an invalid environment name, please use one of: ",
 					strings.Join(ocm.ValidOCMUrlAliases(), ", "))
-				os.Exit(1)
+				os.Exit(clierror.Validation.ExitCode())
 			}
 			gatewayURL, ok = fedramp.AdminURLAliases[env]
 			if !ok {
@@ -380,7 +384,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
 		regValue, err := sdk.GetRhRegion(gatewayURL, args.rhRegion)
 		if err != nil {
 			r.Reporter.Errorf("Can't find region: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		gatewayURL = fmt.Sprintf("https://%s", regValue.URL)
 	}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/config"
 	rprtr "github.com/openshift/rosa/pkg/reporter"
 )
@@ -38,7 +39,7 @@ func run(_ *cobra.Command, _ []string) {
 	err := runLogout()
 	if err != nil {
 		reporter.Errorf("Failed to remove config file: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 }
 
@@ -27,6 +27,7 @@ import (
 	"github.com/spf13/cobra"
 	errors "github.com/zgalor/weberr"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -123,7 +124,7 @@ func run(cmd *cobra.Command, argv []string) {
 			r.Reporter.Infof(pendingMessage)
 		} else {
 			r.Reporter.Errorf("Failed to get logs for cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	printLog(logs, nil)
@@ -158,7 +159,7 @@ func run(cmd *cobra.Command, argv []string) {
 			err = r.OCMClient.KeepTokensAlive()
 			if err != nil {
 				r.Reporter.Errorf(fmt.Sprintf("Failed to keep tokens alive for polling: %v", err))
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 
 			return false
@@ -27,6 +27,7 @@ import (
 	"github.com/spf13/cobra"
 	errors "github.com/zgalor/weberr"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -109,7 +110,7 @@ func run(cmd *cobra.Command, argv []string) {
 			r.Reporter.Warnf("Logs for cluster '%s' are not available", clusterKey)
 		} else {
 			r.Reporter.Errorf("Failed to get logs for cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	printLog(logs, nil)
@@ -135,7 +136,7 @@ func run(cmd *cobra.Command, argv []string) {
 			err = r.OCMClient.KeepTokensAlive()
 			if err != nil {
 				r.Reporter.Errorf(fmt.Sprintf("Failed to keep tokens alive for polling: %v", err))
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 
 			return false
@@ -0,0 +1,187 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/interactive/securitygroups"
+	"github.com/openshift/rosa/pkg/machinepool"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/wait"
+)
+
+const (
+	use   = "machinepool ID"
+	short = "Replace a machine pool with a new one"
+	long  = "Replace a machine pool of a Hosted Control Plane cluster with a new machine pool that has " +
+		"the same attributes, except for the instance type, root disk size, subnet, security groups or image " +
+		"type given. The new machine pool is created with the current replicas of the old one, and once they " +
+		"are ready the autoscaling bounds are moved to it and the old machine pool is deleted. If the new " +
+		"machine pool doesn't become ready it is deleted and the old one is left untouched."
+	example = `  # Replace machine pool "workers" of cluster "mycluster" with one that uses a larger instance type
+  rosa replace machinepool --cluster=mycluster workers --instance-type=m6i.2xlarge
+
+  # Move machine pool "workers" to another subnet, naming the new machine pool "workers-b"
+  rosa replace machinepool --cluster=mycluster workers --name=workers-b --subnet=subnet-0b761d44d3d9a4663`
+)
+
+var aliases = []string{"machine-pool"}
+
+type replaceOptions struct {
+	machinepool      string
+	name             string
+	instanceType     string
+	rootDiskSize     string
+	subnet           string
+	securityGroupIDs []string
+	imageType        string
+	interval         time.Duration
+	timeout          time.Duration
+	noRollback       bool
+}
+
+func NewReplaceMachinePoolCommand() *cobra.Command {
+	options := &replaceOptions{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.MaximumNArgs(1),
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), ReplaceMachinePoolRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+	flags.StringVar(
+		&options.machinepool,
+		"machinepool",
+		"",
+		"Machine pool of the cluster to replace",
+	)
+	flags.StringVar(
+		&options.name,
+		"name",
+		"",
+		"Name of the new machine pool. Defaults to the name of the replaced machine pool with an increasing "+
+			"numeric suffix.",
+	)
+	flags.StringVar(
+		&options.instanceType,
+		"instance-type",
+		"",
+		"Instance type of the new machine pool.",
+	)
+	flags.StringVar(
+		&options.rootDiskSize,
+		"disk-size",
+		"",
+		"Root disk size of the new machine pool, with a suffix like GiB or TiB.",
+	)
+	flags.StringVar(
+		&options.subnet,
+		"subnet",
+		"",
+		"Subnet of the new machine pool.",
+	)
+	flags.StringSliceVar(
+		&options.securityGroupIDs,
+		securitygroups.MachinePoolSecurityGroupFlag,
+		nil,
+		"The additional Security Group IDs of the new machine pool. Format should be a comma-separated list.",
+	)
+	flags.StringVar(
+		&options.imageType,
+		"type",
+		"",
+		"Image type of the new machine pool.",
+	)
+	flags.DurationVar(
+		&options.interval,
+		"interval",
+		wait.DefaultInterval,
+		"Time between two checks of the replicas of the new machine pool.",
+	)
+	flags.DurationVar(
+		&options.timeout,
+		"timeout",
+		wait.DefaultTimeout,
+		"Maximum time to wait for the replicas of the new machine pool to be ready.",
+	)
+	flags.BoolVar(
+		&options.noRollback,
+		"no-rollback",
+		false,
+		"Keep the new machine pool when it doesn't become ready, instead of deleting it.",
+	)
+	ocm.AddClusterFlag(cmd)
+	return cmd
+}
+
+func ReplaceMachinePoolRunner(options *replaceOptions) rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
+		machinePoolID := options.machinepool
+		if len(argv) == 1 && !cmd.Flag("machinepool").Changed {
+			machinePoolID = argv[0]
+		}
+		if machinePoolID == "" {
+			return fmt.Errorf("Machine pool is required. Specify it as an argument or use the --machinepool flag")
+		}
+		if options.interval <= 0 || options.timeout < options.interval {
+			return fmt.Errorf("Timeout must be greater than the interval, and the interval greater than zero")
+		}
+
+		replaceOptions := &machinepool.ReplaceNodePoolOptions{
+			ID: options.name,
+			Overrides: machinepool.NodePoolOverrides{
+				InstanceType: options.instanceType,
+				Subnet:       options.subnet,
+				ImageType:    options.imageType,
+			},
+			Interval:   options.interval,
+			Timeout:    options.timeout,
+			NoRollback: options.noRollback,
+		}
+		if cmd.Flag(securitygroups.MachinePoolSecurityGroupFlag).Changed {
+			replaceOptions.Overrides.SecurityGroupIDs = options.securityGroupIDs
+		}
+		if options.rootDiskSize != "" {
+			size, err := ocm.ParseDiskSizeToGigibyte(options.rootDiskSize)
+			if err != nil {
+				return fmt.Errorf("Expected a valid machine pool root disk size value '%s': %v",
+					options.rootDiskSize, err)
+			}
+			replaceOptions.Overrides.RootDiskSize = size
+		}
+
+		clusterKey := r.GetClusterKey()
+		cluster := r.FetchCluster()
+		service := machinepool.NewMachinePoolService()
+		err := service.ReplaceNodePool(r, machinePoolID, clusterKey, cluster, replaceOptions)
+		if err != nil {
+			return fmt.Errorf("Error replacing machine pool: %v", err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,59 @@
+package machinepool
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/test"
+	"github.com/openshift/rosa/pkg/wait"
+)
+
+var _ = Describe("Replace machine pool", func() {
+	var t *test.TestingRuntime
+	var options *replaceOptions
+
+	BeforeEach(func() {
+		t = test.NewTestRuntime()
+		options = &replaceOptions{interval: wait.DefaultInterval, timeout: wait.DefaultTimeout}
+	})
+
+	It("Creates the command correctly", func() {
+		cmd := NewReplaceMachinePoolCommand()
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		for _, flag := range []string{"cluster", "machinepool", "name", "instance-type", "disk-size", "subnet",
+			"additional-security-group-ids", "type", "interval", "timeout", "no-rollback"} {
+			Expect(cmd.Flags().Lookup(flag)).NotTo(BeNil(), flag)
+		}
+	})
+
+	It("Fails without machine pool", func() {
+		runner := ReplaceMachinePoolRunner(options)
+		err := runner(context.Background(), t.RosaRuntime, NewReplaceMachinePoolCommand(), []string{})
+		Expect(err).To(MatchError(ContainSubstring("Machine pool is required")))
+	})
+
+	It("Fails with an invalid disk size", func() {
+		options.rootDiskSize = "large"
+		runner := ReplaceMachinePoolRunner(options)
+		err := runner(context.Background(), t.RosaRuntime, NewReplaceMachinePoolCommand(), []string{"workers"})
+		Expect(err).To(MatchError(ContainSubstring("Expected a valid machine pool root disk size value 'large'")))
+	})
+
+	It("Fails for classic clusters", func() {
+		cluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.State(cmv1.ClusterStateReady)
+		})
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, test.FormatClusterList([]*cmv1.Cluster{cluster})))
+		runner := ReplaceMachinePoolRunner(options)
+		cmd := NewReplaceMachinePoolCommand()
+		Expect(cmd.Flag("cluster").Value.Set(test.MockClusterID)).To(Succeed())
+		err := runner(context.Background(), t.RosaRuntime, cmd, []string{"workers"})
+		Expect(err).To(MatchError(ContainSubstring("only supported for Hosted Control Plane clusters")))
+	})
+})
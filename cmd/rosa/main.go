@@ -24,6 +24,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/color"
 	"github.com/openshift/rosa/pkg/commands"
 	"github.com/openshift/rosa/pkg/info"
@@ -46,6 +47,7 @@ func init() {
 	fs := root.PersistentFlags()
 	color.AddFlag(root)
 	arguments.AddDebugFlag(fs)
+	arguments.AddContextFlag(fs)
 
 	// Register the subcommands:
 	commands.RegisterCommands(root)
@@ -59,7 +61,13 @@ func main() {
 		if !strings.Contains(err.Error(), "Did you mean this?") {
 			fmt.Fprintf(os.Stderr, "Failed to execute root command: %s\n", err)
 		}
-		os.Exit(1)
+		// The commands report their own errors and exit, so the errors returned here come from
+		// parsing the command line unless they have a class of their own
+		class := clierror.Classify(err)
+		if class == clierror.Generic {
+			class = clierror.Validation
+		}
+		os.Exit(class.ExitCode())
 	}
 }
 
@@ -0,0 +1,287 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package idpusers
+
+import (
+	"context"
+	"fmt"
+
+	idputils "github.com/openshift-online/ocm-common/pkg/idp/utils"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/cmd/create/admin"
+	"github.com/openshift/rosa/cmd/create/idp"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "idp-users"
+	short = "Synchronize the users of an HTPasswd identity provider with a file"
+	long  = "Add, rotate the passwords of and optionally delete the users of an HTPasswd identity " +
+		"provider so that they match the content of a file. Files with the '.csv' extension contain " +
+		"'username,password' rows with plain text passwords, any other file is read as an htpasswd file. " +
+		"Plain text passwords can't be compared with the current ones, so the passwords of existing " +
+		"users are only rotated with the '--rotate-passwords' flag."
+	example = `  # Show the changes needed to make the users of IDP 'htpasswd-1' match an htpasswd file
+  rosa sync idp-users --cluster=mycluster --idp=htpasswd-1 --from-file=users.htpasswd --dry-run
+
+  # Synchronize the users with a CSV file, deleting the users that aren't in it
+  rosa sync idp-users --cluster=mycluster --idp=htpasswd-1 --from-file=users.csv --prune
+
+  # Also set the passwords of the existing users to the ones of a CSV file
+  rosa sync idp-users --cluster=mycluster --idp=htpasswd-1 --from-file=users.csv --rotate-passwords`
+)
+
+type options struct {
+	idp    string
+	file   string
+	dryRun bool
+	prune  bool
+
+	rotatePasswords bool
+}
+
+var planTable = output.Table[userChange]{
+	Columns: []output.Column[userChange]{
+		{Header: "USERNAME", Value: func(c userChange) string { return c.username }},
+		{Header: "ACTION", Value: func(c userChange) string { return string(c.action) }},
+	},
+}
+
+func NewSyncIdpUsersCommand() *cobra.Command {
+	options := &options{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: []string{"htpasswd-users"},
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), SyncIdpUsersRunner(options)),
+		Args:    cobra.NoArgs,
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+	ocm.AddClusterFlag(cmd)
+	flags.StringVar(
+		&options.idp,
+		"idp",
+		"",
+		"Name of the HTPasswd identity provider. Not required when the cluster has a single one.",
+	)
+	flags.StringVar(
+		&options.file,
+		"from-file",
+		"",
+		"Path to the htpasswd or CSV file with the users of the identity provider.",
+	)
+	flags.BoolVar(
+		&options.dryRun,
+		"dry-run",
+		false,
+		"Show the changes without applying them.",
+	)
+	flags.BoolVar(
+		&options.prune,
+		"prune",
+		false,
+		"Delete the users of the identity provider that aren't in the file.",
+	)
+	flags.BoolVar(
+		&options.rotatePasswords,
+		"rotate-passwords",
+		false,
+		"Rotate the passwords that can't be compared with the ones of the file, because they are in "+
+			"plain text or the identity provider doesn't return their hash.",
+	)
+	return cmd
+}
+
+func SyncIdpUsersRunner(options *options) rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		if options.file == "" {
+			return fmt.Errorf("The '--from-file' flag is required")
+		}
+		users, hashed, err := idp.ReadUsersFile(options.file)
+		if err != nil {
+			return fmt.Errorf("Failed to load users file '%s': %v", options.file, err)
+		}
+		if len(users) == 0 {
+			return fmt.Errorf("Users file '%s' doesn't contain any users", options.file)
+		}
+
+		clusterKey := r.GetClusterKey()
+		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+		if err != nil {
+			return err
+		}
+		if cluster.State() != cmv1.ClusterStateReady {
+			return fmt.Errorf("Cluster '%s' is not yet ready", clusterKey)
+		}
+		if cluster.ExternalAuthConfig().Enabled() {
+			return fmt.Errorf("Synchronizing identity provider users is not supported for clusters " +
+				"with external authentication configured.")
+		}
+
+		htpasswdIDP, err := findHTPasswdIDP(r, cluster.ID(), options.idp)
+		if err != nil {
+			return err
+		}
+
+		r.Reporter.Debugf("Loading users of identity provider '%s'", htpasswdIDP.Name())
+		current, err := r.OCMClient.GetHTPasswdUserList(cluster.ID(), htpasswdIDP.ID())
+		if err != nil {
+			return fmt.Errorf("Failed to get users of identity provider '%s': %v", htpasswdIDP.Name(), err)
+		}
+
+		plan := buildPlan(current, users, hashed, options.prune, options.rotatePasswords)
+		counts := countChanges(plan)
+		if len(counts) == 0 {
+			r.Reporter.Infof("The users of identity provider '%s' already match file '%s'",
+				htpasswdIDP.Name(), options.file)
+			return nil
+		}
+
+		r.Reporter.Infof("The following changes are needed to make the users of identity provider '%s' "+
+			"match file '%s':", htpasswdIDP.Name(), options.file)
+		fmt.Println()
+		err = planTable.Print(plan)
+		if err != nil {
+			return err
+		}
+		fmt.Println()
+		if options.dryRun {
+			r.Reporter.Infof("Dry run: %s. No changes have been applied", summary(counts))
+			return nil
+		}
+		if !confirm.Confirm("synchronize the users of identity provider '%s' on cluster '%s'",
+			htpasswdIDP.Name(), clusterKey) {
+			return nil
+		}
+
+		err = applyPlan(r, cluster.ID(), htpasswdIDP.ID(), plan, hashed)
+		if err != nil {
+			return fmt.Errorf("Failed to synchronize users of identity provider '%s': %v", htpasswdIDP.Name(), err)
+		}
+		r.Reporter.Infof("Synchronized the users of identity provider '%s': %s", htpasswdIDP.Name(), summary(counts))
+		return nil
+	}
+}
+
+// findHTPasswdIDP returns the HTPasswd identity provider with the given name, or the only one of
+// the cluster when no name is given. The identity provider of the cluster-admin user is managed
+// with 'rosa create admin' and 'rosa delete admin' and can't be synchronized.
+func findHTPasswdIDP(r *rosa.Runtime, clusterID string, name string) (*cmv1.IdentityProvider, error) {
+	idps, err := r.OCMClient.GetIdentityProviders(clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get identity providers for cluster '%s': %v", r.ClusterKey, err)
+	}
+	candidates := []*cmv1.IdentityProvider{}
+	for _, item := range idps {
+		if item.Type() != cmv1.IdentityProviderTypeHtpasswd {
+			continue
+		}
+		if name != "" && item.Name() == name {
+			if name == admin.ClusterAdminIDPname {
+				return nil, fmt.Errorf("The users of identity provider '%s' can't be synchronized, "+
+					"use 'rosa create admin' and 'rosa delete admin' instead", name)
+			}
+			return item, nil
+		}
+		if item.Name() != admin.ClusterAdminIDPname {
+			candidates = append(candidates, item)
+		}
+	}
+	switch {
+	case name != "":
+		return nil, fmt.Errorf("There is no HTPasswd identity provider named '%s' on cluster '%s'",
+			name, r.ClusterKey)
+	case len(candidates) == 0:
+		return nil, fmt.Errorf("There is no HTPasswd identity provider on cluster '%s'", r.ClusterKey)
+	case len(candidates) > 1:
+		return nil, fmt.Errorf("Cluster '%s' has more than one HTPasswd identity provider, "+
+			"select one with the '--idp' flag", r.ClusterKey)
+	}
+	return candidates[0], nil
+}
+
+// applyPlan adds the new users first, then rotates the passwords and deletes the users last, so
+// that a failure never leaves the identity provider with fewer users than before.
+func applyPlan(r *rosa.Runtime, clusterID string, idpID string, plan []userChange, hashed bool) error {
+	additions := []*cmv1.HTPasswdUserBuilder{}
+	rotations := []*cmv1.HTPasswdUserBuilder{}
+	deletions := []userChange{}
+	for _, change := range plan {
+		hash := change.password
+		if change.password != "" && !hashed {
+			var err error
+			hash, err = idputils.GenerateHTPasswdCompatibleHash(change.password)
+			if err != nil {
+				return fmt.Errorf("failed to hash the password of user '%s': %v", change.username, err)
+			}
+		}
+		switch change.action {
+		case actionAdd:
+			additions = append(additions, cmv1.NewHTPasswdUser().Username(change.username).HashedPassword(hash))
+		case actionRotate:
+			rotations = append(rotations, cmv1.NewHTPasswdUser().ID(change.userID).Username(change.username).
+				HashedPassword(hash))
+		case actionDelete:
+			deletions = append(deletions, change)
+		}
+	}
+
+	if len(additions) > 0 {
+		r.Reporter.Debugf("Adding %d users", len(additions))
+		userList, err := cmv1.NewHTPasswdUserList().Items(additions...).Build()
+		if err != nil {
+			return err
+		}
+		err = r.OCMClient.AddHTPasswdUsers(userList, clusterID, idpID)
+		if err != nil {
+			return fmt.Errorf("failed to add %d users: %v", len(additions), err)
+		}
+	}
+	for _, rotation := range rotations {
+		user, err := rotation.Build()
+		if err != nil {
+			return err
+		}
+		r.Reporter.Debugf("Rotating the password of user '%s'", user.Username())
+		err = r.OCMClient.UpdateHTPasswdUser(clusterID, idpID, user)
+		if err != nil {
+			return fmt.Errorf("failed to rotate the password of user '%s': %v", user.Username(), err)
+		}
+	}
+	for _, deletion := range deletions {
+		r.Reporter.Debugf("Deleting user '%s'", deletion.username)
+		err := r.OCMClient.DeleteHTPasswdUserByID(clusterID, idpID, deletion.userID)
+		if err != nil {
+			return fmt.Errorf("failed to delete user '%s': %v", deletion.username, err)
+		}
+	}
+	return nil
+}
+
+func summary(counts map[action]int) string {
+	return fmt.Sprintf("%d added, %d rotated, %d deleted",
+		counts[actionAdd], counts[actionRotate], counts[actionDelete])
+}
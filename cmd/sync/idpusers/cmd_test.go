@@ -0,0 +1,192 @@
+package idpusers
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var idpsPath = "/api/clusters_mgmt/v1/clusters/" + MockClusterID + "/identity_providers"
+
+var _ = Describe("sync idp-users", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewSyncIdpUsersCommand()
+		Expect(cmd).NotTo(BeNil())
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Run).NotTo(BeNil())
+		for _, flag := range []string{"cluster", "idp", "from-file", "dry-run", "prune", "rotate-passwords"} {
+			Expect(cmd.Flags().Lookup(flag)).NotTo(BeNil())
+		}
+	})
+
+	Context("Sync Idp Users Runner", func() {
+		var t *TestingRuntime
+		var cluster *cmv1.Cluster
+		var idps string
+		var users string
+		var file string
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			output.SetOutput("")
+			cluster = MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+			})
+			t.SetCluster("cluster", cluster)
+
+			admin, err := cmv1.NewIdentityProvider().ID("idp-0").Name("cluster-admin").
+				Type(cmv1.IdentityProviderTypeHtpasswd).Build()
+			Expect(err).ToNot(HaveOccurred())
+			htpasswd, err := cmv1.NewIdentityProvider().ID("idp-1").Name("htpasswd-1").
+				Type(cmv1.IdentityProviderTypeHtpasswd).Build()
+			Expect(err).ToNot(HaveOccurred())
+			idps = FormatIDPList([]*cmv1.IdentityProvider{admin, htpasswd})
+
+			alice, err := cmv1.NewHTPasswdUser().ID("user-1").Username("alice").Build()
+			Expect(err).ToNot(HaveOccurred())
+			bob, err := cmv1.NewHTPasswdUser().ID("user-2").Username("bob").Build()
+			Expect(err).ToNot(HaveOccurred())
+			users = FormatHtpasswdUserList([]*cmv1.HTPasswdUser{alice, bob})
+
+			file = filepath.Join(GinkgoT().TempDir(), "users.htpasswd")
+			Expect(os.WriteFile(file, []byte("alice:$apr1$hRY7OJWH$km1EYH.UIRjp6CzfZQz/g1\n"+
+				"carol:$apr1$Q58SO804$B/fECNWfn5xkJXJLvu0mF/\n"), 0600)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			output.SetOutput("")
+		})
+
+		run := func(options *options) (string, error) {
+			stdout, _, err := RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+				return SyncIdpUsersRunner(options)(context.Background(), r, cmd, nil)
+			}, t.RosaRuntime, nil)
+			return stdout, err
+		}
+
+		It("Returns an error if the file is missing", func() {
+			_, err := run(&options{file: filepath.Join(GinkgoT().TempDir(), "missing")})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("Failed to load users file"))
+		})
+
+		It("Refuses to synchronize the cluster-admin identity provider", func() {
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, idps))
+
+			_, err := run(&options{file: file, idp: "cluster-admin"})
+			Expect(err).To(MatchError("The users of identity provider 'cluster-admin' can't be synchronized, " +
+				"use 'rosa create admin' and 'rosa delete admin' instead"))
+		})
+
+		It("Shows the plan without applying it in dry run mode", func() {
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, idps))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, users))
+
+			stdout, err := run(&options{file: file, dryRun: true})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stdout).To(ContainSubstring("USERNAME  ACTION\n" +
+				"alice     keep password (can't be compared, use --rotate-passwords to rotate)\n" +
+				"bob       keep (not in file, use --prune to delete)\n" +
+				"carol     add\n"))
+			Expect(stdout).To(ContainSubstring("Dry run: 1 added, 0 rotated, 0 deleted. No changes have been applied"))
+		})
+
+		It("Plans no changes when running again with the same file", func() {
+			for _, name := range []string{"users.htpasswd", "users.csv"} {
+				synced := filepath.Join(GinkgoT().TempDir(), name)
+				content := "alice:$apr1$hRY7OJWH$km1EYH.UIRjp6CzfZQz/g1\n"
+				if filepath.Ext(name) == ".csv" {
+					content = "alice,Passw0rd-Passw0rd\n"
+				}
+				Expect(os.WriteFile(synced, []byte(content), 0600)).To(Succeed())
+				alice, err := cmv1.NewHTPasswdUser().ID("user-1").Username("alice").
+					HashedPassword("$apr1$hRY7OJWH$km1EYH.UIRjp6CzfZQz/g1").Build()
+				Expect(err).ToNot(HaveOccurred())
+				t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+				t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, idps))
+				t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+					FormatHtpasswdUserList([]*cmv1.HTPasswdUser{alice})))
+
+				stdout, err := run(&options{file: synced})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stdout).To(ContainSubstring(
+					"The users of identity provider 'htpasswd-1' already match file '" + synced + "'"))
+			}
+		})
+
+		It("Applies the plan", func() {
+			yesFlags := pflag.NewFlagSet("confirm", pflag.ContinueOnError)
+			confirm.AddFlag(yesFlags)
+			Expect(yesFlags.Set("yes", "true")).To(Succeed())
+			defer func() { _ = yesFlags.Set("yes", "false") }()
+
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, idps))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, users))
+			t.ApiServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, idpsPath+"/idp-1/htpasswd_users/import"),
+				RespondWithJSON(http.StatusOK, "{}"),
+			))
+			t.ApiServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPatch, idpsPath+"/idp-1/htpasswd_users/user-1"),
+				ghttp.VerifyJSON(`{
+					"id": "user-1",
+					"username": "alice",
+					"hashed_password": "$apr1$hRY7OJWH$km1EYH.UIRjp6CzfZQz/g1"
+				}`),
+				RespondWithJSON(http.StatusOK, "{}"),
+			))
+			t.ApiServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodDelete, idpsPath+"/idp-1/htpasswd_users/user-2"),
+				RespondWithJSON(http.StatusNoContent, ""),
+			))
+
+			stdout, err := run(&options{file: file, prune: true, rotatePasswords: true})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stdout).To(ContainSubstring(
+				"Synchronized the users of identity provider 'htpasswd-1': 1 added, 1 rotated, 1 deleted"))
+		})
+
+		It("Adds the users before deleting any", func() {
+			yesFlags := pflag.NewFlagSet("confirm", pflag.ContinueOnError)
+			confirm.AddFlag(yesFlags)
+			Expect(yesFlags.Set("yes", "true")).To(Succeed())
+			defer func() { _ = yesFlags.Set("yes", "false") }()
+
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, idps))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, users))
+			t.ApiServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, idpsPath+"/idp-1/htpasswd_users/import"),
+				RespondWithJSON(http.StatusOK, "{}"),
+			))
+			t.ApiServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodDelete, idpsPath+"/idp-1/htpasswd_users/user-2"),
+				RespondWithJSON(http.StatusInternalServerError, "{}"),
+			))
+
+			_, err := run(&options{file: file, prune: true})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(
+				"Failed to synchronize users of identity provider 'htpasswd-1': failed to delete user 'bob'"))
+			Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(5))
+		})
+	})
+})
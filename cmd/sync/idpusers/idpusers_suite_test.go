@@ -0,0 +1,13 @@
+package idpusers
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSyncIdpUsers(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Sync Idp Users Suite")
+}
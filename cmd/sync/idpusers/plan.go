@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package idpusers
+
+import (
+	"sort"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+type action string
+
+const (
+	actionAdd    action = "add"
+	actionRotate action = "rotate password"
+	actionDelete action = "delete"
+	actionKeep   action = "keep (not in file, use --prune to delete)"
+
+	actionKeepPassword action = "keep password (can't be compared, use --rotate-passwords to rotate)"
+)
+
+// userChange is a change needed to make the users of the IDP match the file. The password is the
+// one read from the file, hashed or in plain text.
+type userChange struct {
+	username string
+	action   action
+	userID   string
+	password string
+}
+
+// buildPlan compares the current users of the IDP with the ones of the file. Passwords of existing
+// users are rotated when the API returns their hash and it differs from the one of the file. Plain
+// text passwords and users without a hash can't be compared, so their passwords are only rotated
+// when requested, otherwise every run would rotate them again.
+func buildPlan(current *cmv1.HTPasswdUserList, desired map[string]string, hashed bool,
+	prune bool, rotate bool) []userChange {
+	plan := []userChange{}
+	existing := map[string]bool{}
+	current.Each(func(user *cmv1.HTPasswdUser) bool {
+		existing[user.Username()] = true
+		password, found := desired[user.Username()]
+		switch {
+		case !found && prune:
+			plan = append(plan, userChange{username: user.Username(), action: actionDelete, userID: user.ID()})
+		case !found:
+			plan = append(plan, userChange{username: user.Username(), action: actionKeep, userID: user.ID()})
+		case (!hashed || user.HashedPassword() == "") && !rotate:
+			plan = append(plan, userChange{username: user.Username(), action: actionKeepPassword, userID: user.ID()})
+		case !hashed || user.HashedPassword() != password:
+			plan = append(plan, userChange{
+				username: user.Username(),
+				action:   actionRotate,
+				userID:   user.ID(),
+				password: password,
+			})
+		}
+		return true
+	})
+	for username, password := range desired {
+		if !existing[username] {
+			plan = append(plan, userChange{username: username, action: actionAdd, password: password})
+		}
+	}
+	sort.Slice(plan, func(i, j int) bool {
+		return plan[i].username < plan[j].username
+	})
+	return plan
+}
+
+// countChanges returns the number of changes that modify the IDP, excluding the users that are kept.
+func countChanges(plan []userChange) map[action]int {
+	result := map[action]int{}
+	for _, change := range plan {
+		if change.action != actionKeep && change.action != actionKeepPassword {
+			result[change.action]++
+		}
+	}
+	return result
+}
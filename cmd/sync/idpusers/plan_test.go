@@ -0,0 +1,68 @@
+package idpusers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("buildPlan", func() {
+	var current *cmv1.HTPasswdUserList
+
+	BeforeEach(func() {
+		var err error
+		current, err = cmv1.NewHTPasswdUserList().Items(
+			cmv1.NewHTPasswdUser().ID("1").Username("alice").HashedPassword("hash-a"),
+			cmv1.NewHTPasswdUser().ID("2").Username("bob"),
+			cmv1.NewHTPasswdUser().ID("3").Username("carol").HashedPassword("hash-c"),
+		).Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("rotates the passwords whose hash differs", func() {
+		plan := buildPlan(current, map[string]string{
+			"alice": "hash-a",
+			"bob":   "hash-b",
+			"carol": "hash-c2",
+			"dave":  "hash-d",
+		}, true, false, false)
+		Expect(plan).To(Equal([]userChange{
+			{username: "bob", action: actionKeepPassword, userID: "2"},
+			{username: "carol", action: actionRotate, userID: "3", password: "hash-c2"},
+			{username: "dave", action: actionAdd, password: "hash-d"},
+		}))
+		Expect(countChanges(plan)).To(Equal(map[action]int{actionRotate: 1, actionAdd: 1}))
+	})
+
+	It("only rotates the passwords that can't be compared when requested", func() {
+		desired := map[string]string{"alice": "hash-a", "bob": "hash-b", "carol": "hash-c"}
+		plan := buildPlan(current, desired, true, false, true)
+		Expect(plan).To(Equal([]userChange{
+			{username: "bob", action: actionRotate, userID: "2", password: "hash-b"},
+		}))
+
+		desired = map[string]string{"alice": "a", "bob": "b", "carol": "c"}
+		plan = buildPlan(current, desired, false, false, false)
+		Expect(plan).To(Equal([]userChange{
+			{username: "alice", action: actionKeepPassword, userID: "1"},
+			{username: "bob", action: actionKeepPassword, userID: "2"},
+			{username: "carol", action: actionKeepPassword, userID: "3"},
+		}))
+		Expect(countChanges(plan)).To(BeEmpty())
+
+		plan = buildPlan(current, desired, false, false, true)
+		Expect(plan).To(ContainElement(userChange{username: "alice", action: actionRotate, userID: "1",
+			password: "a"}))
+		Expect(countChanges(plan)).To(Equal(map[action]int{actionRotate: 3}))
+	})
+
+	It("only deletes the users that aren't in the file when pruning", func() {
+		desired := map[string]string{"alice": "hash-a", "carol": "hash-c"}
+		plan := buildPlan(current, desired, true, false, false)
+		Expect(plan).To(Equal([]userChange{{username: "bob", action: actionKeep, userID: "2"}}))
+		Expect(countChanges(plan)).To(BeEmpty())
+
+		plan = buildPlan(current, desired, true, true, false)
+		Expect(plan).To(Equal([]userChange{{username: "bob", action: actionDelete, userID: "2"}}))
+	})
+})
@@ -23,6 +23,7 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -78,7 +79,7 @@ func run(_ *cobra.Command, argv []string) {
 	err := r.OCMClient.UninstallAddOn(cluster.ID(), addOnID)
 	if err != nil {
 		r.Reporter.Errorf("Failed to remove add-on installation '%s' from cluster '%s': %s", addOnID, clusterKey, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	r.Reporter.Infof("Add-on '%s' is now uninstalling. To check the status run 'rosa list addons -c %s'",
 		addOnID, clusterKey)
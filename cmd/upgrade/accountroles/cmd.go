@@ -31,6 +31,7 @@ import (
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
 	awscbRoles "github.com/openshift/rosa/pkg/aws/commandbuilder/helper/roles"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/helper/roles"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
@@ -44,6 +45,7 @@ var args struct {
 	version      string
 	channelGroup string
 	hostedCP     bool
+	showDiff     bool
 }
 
 var Cmd = &cobra.Command{
@@ -52,7 +54,10 @@ var Cmd = &cobra.Command{
 	Short:   "Upgrade account-wide IAM roles to the latest version.",
 	Long:    "Upgrade account-wide IAM roles to the latest version before upgrading your cluster.",
 	Example: `  # Upgrade account roles for ROSA STS clusters
-  rosa upgrade account-roles`,
+  rosa upgrade account-roles
+
+  # Show the changes to the role policies before upgrading them
+  rosa upgrade account-roles --prefix=ManagedOpenShift --show-diff`,
 	Args: cobra.NoArgs,
 	Run:  run,
 }
@@ -93,6 +98,14 @@ func init() {
 		"Enable the use of Hosted Control Planes",
 	)
 
+	flags.BoolVar(
+		&args.showDiff,
+		"show-diff",
+		false,
+		"Print the actions and resources that the upgrade adds to and removes from each role policy "+
+			"before applying it.",
+	)
+
 	confirm.AddFlag(flags)
 	interactive.AddFlag(flags)
 }
@@ -108,7 +121,7 @@ func run(cmd *cobra.Command, _ []string) {
 	mode, err := interactive.GetMode()
 	if err != nil {
 		reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	prefix := args.prefix
 
@@ -118,13 +131,13 @@ func run(cmd *cobra.Command, _ []string) {
 	policyVersion, err := ocmClient.GetPolicyVersion(version, channelGroup)
 	if err != nil {
 		reporter.Errorf("Error getting version: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	env, err := ocm.GetEnv()
 	if err != nil {
 		reporter.Errorf("Failed to determine OCM environment: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	var role aws.AccountRole
@@ -149,14 +162,14 @@ func run(cmd *cobra.Command, _ []string) {
 	managedPolicies, err := awsClient.HasManagedPolicies(roleARN)
 	if err != nil {
 		r.Reporter.Errorf("Failed to determine if the role has managed policies: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if managedPolicies {
 		hostedCPPolicies, err := awsClient.HasHostedCPPolicies(roleARN)
 		if err != nil {
 			r.Reporter.Errorf("Failed to determine if the role has hosted CP managed policies: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		if hostedCPPolicies && !args.hostedCP {
@@ -168,7 +181,7 @@ func run(cmd *cobra.Command, _ []string) {
 		err = roles.ValidateAccountRolesManagedPolicies(r, prefix, hostedCPPolicies)
 		if err != nil {
 			r.Reporter.Errorf("Failed while validating managed policies: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		r.Reporter.Infof("Account roles with the prefix '%s' have attached managed policies. "+
@@ -179,7 +192,7 @@ func run(cmd *cobra.Command, _ []string) {
 	creator, err := awsClient.GetCreator()
 	if err != nil {
 		reporter.Errorf("Failed to get IAM credentials: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	var spin *spinner.Spinner
@@ -195,7 +208,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if err != nil {
 		reporter.Errorf("%s", err)
 		LogError(roles.RosaUpgradeAccRolesModeAuto, ocmClient, policyVersion, err, reporter)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if spin != nil {
@@ -210,7 +223,22 @@ func run(cmd *cobra.Command, _ []string) {
 	policyPath, err := getAccountPolicyPath(awsClient, prefix)
 	if err != nil {
 		reporter.Errorf("Error trying to determine the path for the account policies. Error: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
+	}
+
+	policies, err := ocmClient.GetPolicies("")
+	if err != nil {
+		reporter.Errorf("Expected a valid role creation mode: %s", err)
+		os.Exit(clierror.ExitCode(err))
+	}
+
+	if args.showDiff {
+		err = roles.ShowPolicyDiff(r, accountRolePolicyUpgrades(prefix, creator.Partition, creator.AccountID,
+			policies, policyPath))
+		if err != nil {
+			reporter.Errorf("%s", err)
+			os.Exit(clierror.ExitCode(err))
+		}
 	}
 
 	// Determine if interactive mode is needed
@@ -222,15 +250,10 @@ func run(cmd *cobra.Command, _ []string) {
 		mode, err = interactive.GetOptionMode(cmd, mode, "Account role upgrade mode")
 		if err != nil {
 			reporter.Errorf("Expected a valid Account role upgrade mode: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		interactive.SetModeKey(mode)
 	}
-	policies, err := ocmClient.GetPolicies("")
-	if err != nil {
-		reporter.Errorf("Expected a valid role creation mode: %s", err)
-		os.Exit(1)
-	}
 
 	switch mode {
 	case interactive.ModeAuto:
@@ -241,7 +264,7 @@ func run(cmd *cobra.Command, _ []string) {
 			if err != nil {
 				LogError(roles.RosaUpgradeAccRolesModeAuto, ocmClient, policyVersion, err, reporter)
 				reporter.Errorf("Error upgrading the role polices: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		}
 	case interactive.ModeManual:
@@ -249,7 +272,7 @@ func run(cmd *cobra.Command, _ []string) {
 			err = aws.GenerateAccountRolePolicyFiles(reporter, env, policies, false, aws.AccountRoles, creator.Partition, "")
 			if err != nil {
 				reporter.Errorf("There was an error generating the policy files: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		}
 		if reporter.IsTerminal() {
@@ -263,7 +286,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	default:
 		reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 }
 
@@ -327,6 +350,22 @@ func upgradeAccountRolePolicies(reporter reporter.Logger, awsClient aws.Client,
 	return nil
 }
 
+// accountRolePolicyUpgrades returns the policies of the account roles together with the documents
+// they are upgraded to
+func accountRolePolicyUpgrades(prefix string, partition string, accountID string,
+	policies map[string]*cmv1.AWSSTSPolicy, policyPath string) []roles.PolicyUpgrade {
+	var upgrades []roles.PolicyUpgrade
+	for file, role := range aws.AccountRoles {
+		roleName := common.GetRoleName(prefix, role.Name)
+		upgrades = append(upgrades, roles.PolicyUpgrade{
+			RoleName:  roleName,
+			PolicyARN: aws.GetPolicyArnWithSuffix(partition, accountID, roleName, policyPath),
+			Document:  aws.GetPolicyDetails(policies, fmt.Sprintf("sts_%s_permission_policy", file)),
+		})
+	}
+	return upgrades
+}
+
 func buildCommands(prefix string, partition string, accountID string, isUpgradeNeedForAccountRolePolicies bool,
 	awsClient aws.Client, defaultPolicyVersion string, policyPath string) string {
 	commands := []string{}
@@ -0,0 +1,13 @@
+package clusters
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestUpgradeClusters(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Upgrade clusters Suite")
+}
@@ -0,0 +1,309 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rollout"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/wait"
+)
+
+const (
+	use   = "clusters"
+	short = "Upgrade a fleet of clusters in waves"
+	long  = "Upgrade the clusters selected by name, tag or version in waves. The upgrades of a canary " +
+		"wave are scheduled and completed first, followed by waves of '--batch-size' clusters. A wave " +
+		"only starts once all the clusters of the previous one run the new version and none of them is " +
+		"in limited support, otherwise the rollout is halted.\n\n" +
+		"The progress is saved in a state file, so the rollout can be paused, resumed or aborted, " +
+		"also from another terminal. Account and operator role policies of STS clusters must already be " +
+		"compatible with the new version, which is verified using the AWS credentials, so clusters of " +
+		"other AWS accounts that don't use managed policies fail to upgrade. Version gates that need to " +
+		"be read must be acknowledged beforehand with 'rosa upgrade cluster --dry-run'."
+	example = `  # Show the waves of the upgrade of the clusters of team "payments" to 4.16.2
+  rosa upgrade clusters --selector tag.team=payments --version 4.16.2 --dry-run
+
+  # Upgrade the "prod-" clusters running 4.15, two canaries first and then 10 at a time
+  rosa upgrade clusters --selector "name=prod-*,version=4.15" --version 4.16.2 --canary 2 --batch-size 10
+
+  # Pause the rollout, from any terminal
+  rosa upgrade clusters --pause
+
+  # Resume a paused or halted rollout
+  rosa upgrade clusters --resume`
+)
+
+type upgradeOptions struct {
+	selector  string
+	version   string
+	canary    int
+	batchSize int
+	stateFile string
+	interval  time.Duration
+	dryRun    bool
+	pause     bool
+	resume    bool
+	abort     bool
+}
+
+func NewUpgradeClustersCommand() *cobra.Command {
+	options := &upgradeOptions{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), UpgradeClustersRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+	flags.StringVar(
+		&options.selector,
+		"selector",
+		"",
+		"Comma-separated list of 'key=value' terms that the clusters to upgrade must all match. "+
+			"Valid keys are 'name', which accepts '*' wildcards, 'version', 'region', 'topology' and "+
+			"'tag.<key>'.",
+	)
+	flags.StringVar(
+		&options.version,
+		"version",
+		"",
+		"Version of OpenShift that the clusters will be upgraded to.",
+	)
+	flags.IntVar(
+		&options.canary,
+		"canary",
+		1,
+		"Number of clusters upgraded in the canary wave, before any other.",
+	)
+	flags.IntVar(
+		&options.batchSize,
+		"batch-size",
+		5,
+		"Number of clusters upgraded in each wave after the canary one.",
+	)
+	flags.StringVar(
+		&options.stateFile,
+		"state-file",
+		rollout.DefaultStateFile,
+		"File where the progress of the rollout is saved.",
+	)
+	flags.DurationVar(
+		&options.interval,
+		"interval",
+		wait.DefaultInterval,
+		"Time between two checks of the upgrades of a wave.",
+	)
+	flags.BoolVar(
+		&options.dryRun,
+		"dry-run",
+		false,
+		"Show the waves of the rollout without scheduling any upgrade.",
+	)
+	flags.BoolVar(
+		&options.pause,
+		"pause",
+		false,
+		"Pause the rollout saved in the state file. Upgrades that are already scheduled will continue.",
+	)
+	flags.BoolVar(
+		&options.resume,
+		"resume",
+		false,
+		"Resume the paused or halted rollout saved in the state file, retrying the clusters that failed.",
+	)
+	flags.BoolVar(
+		&options.abort,
+		"abort",
+		false,
+		"Abort the rollout saved in the state file, cancelling the upgrades that haven't started yet.",
+	)
+	cmd.MarkFlagsMutuallyExclusive("pause", "resume", "abort")
+	cmd.MarkFlagsMutuallyExclusive("selector", "pause")
+	cmd.MarkFlagsMutuallyExclusive("selector", "resume")
+	cmd.MarkFlagsMutuallyExclusive("selector", "abort")
+	confirm.AddFlag(flags)
+	return cmd
+}
+
+func UpgradeClustersRunner(options *upgradeOptions) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		if options.interval <= 0 {
+			return fmt.Errorf("Interval must be greater than zero")
+		}
+		runner := &rollout.Runner{
+			Upgrader: rollout.NewUpgrader(r.OCMClient, r.AWSClient, r.Creator),
+			Reporter: r.Reporter,
+			Path:     options.stateFile,
+			Interval: options.interval,
+		}
+		// The AWS client is only needed to verify the roles of the STS clusters before scheduling
+		// their upgrades:
+		run := func(plan *rollout.Rollout) error {
+			r.WithAWS()
+			runner.Upgrader = rollout.NewUpgrader(r.OCMClient, r.AWSClient, r.Creator)
+			return runner.Run(ctx, plan)
+		}
+
+		switch {
+		case options.pause:
+			plan, err := rollout.Load(options.stateFile)
+			if err != nil {
+				return err
+			}
+			err = plan.Pause()
+			if err != nil {
+				return err
+			}
+			err = plan.Save(options.stateFile)
+			if err != nil {
+				return err
+			}
+			r.Reporter.Infof("Paused the rollout of version '%s'. Upgrades that are already scheduled "+
+				"will continue, run with '--resume' to continue the rollout", plan.Version)
+			return nil
+		case options.abort:
+			plan, err := rollout.Load(options.stateFile)
+			if err != nil {
+				return err
+			}
+			if !confirm.Confirm("abort the rollout of version '%s'", plan.Version) {
+				return nil
+			}
+			err = runner.Abort(plan)
+			if err != nil {
+				return err
+			}
+			r.Reporter.Infof("Aborted the rollout of version '%s'", plan.Version)
+			return nil
+		case options.resume:
+			plan, err := rollout.Load(options.stateFile)
+			if err != nil {
+				return err
+			}
+			err = plan.Resume()
+			if err != nil {
+				return err
+			}
+			err = plan.Save(options.stateFile)
+			if err != nil {
+				return err
+			}
+			err = printPlan(plan)
+			if err != nil {
+				return err
+			}
+			r.Reporter.Infof("Resuming the rollout of version '%s'", plan.Version)
+			return run(plan)
+		}
+
+		if options.selector == "" || options.version == "" {
+			return fmt.Errorf("The '--selector' and '--version' flags are required to start a rollout")
+		}
+		existing, err := rollout.Load(options.stateFile)
+		if err == nil && !existing.Finished() {
+			return fmt.Errorf("There is already a %s rollout of version '%s' in state file '%s'. Use "+
+				"'--resume', '--pause' or '--abort', or choose another '--state-file'",
+				existing.Status, existing.Version, options.stateFile)
+		}
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+
+		selector, err := rollout.ParseSelector(options.selector)
+		if err != nil {
+			return err
+		}
+		clusters, err := selector.Select(r.OCMClient)
+		if err != nil {
+			return fmt.Errorf("Failed to get clusters: %v", err)
+		}
+		if len(clusters) == 0 {
+			r.Reporter.Infof("There are no clusters matching selector '%s'", options.selector)
+			return nil
+		}
+		plan, err := rollout.New(options.selector, options.version, clusters, options.canary, options.batchSize)
+		if err != nil {
+			return err
+		}
+		err = printPlan(plan)
+		if err != nil {
+			return err
+		}
+		if len(plan.Waves) == 0 {
+			r.Reporter.Infof("None of the %d selected clusters can be upgraded to version '%s'",
+				len(clusters), options.version)
+			return nil
+		}
+		if options.dryRun {
+			r.Reporter.Infof("Running in dry-run mode, no upgrade was scheduled")
+			return nil
+		}
+		if !confirm.Confirm("upgrade %d clusters to version '%s' in %d waves",
+			len(clusters)-len(plan.Skipped), options.version, len(plan.Waves)) {
+			return nil
+		}
+		err = plan.Save(options.stateFile)
+		if err != nil {
+			return err
+		}
+		r.Reporter.Infof("Saved the state of the rollout to '%s'", options.stateFile)
+		return run(plan)
+	}
+}
+
+type planRow struct {
+	wave   string
+	target *rollout.Target
+}
+
+var planTable = output.Table[planRow]{
+	Columns: []output.Column[planRow]{
+		{Header: "WAVE", Value: func(p planRow) string { return p.wave }},
+		{Header: "CLUSTER", Value: func(p planRow) string { return p.target.Name }},
+		{Header: "ID", Value: func(p planRow) string { return p.target.ID }},
+		{Header: "VERSION", Value: func(p planRow) string { return p.target.FromVersion }},
+		{Header: "STATUS", Value: func(p planRow) string { return string(p.target.Status) }},
+		{Header: "MESSAGE", Value: func(p planRow) string { return p.target.Message }},
+	},
+}
+
+func printPlan(plan *rollout.Rollout) error {
+	rows := []planRow{}
+	for _, wave := range plan.Waves {
+		for _, target := range wave.Targets {
+			rows = append(rows, planRow{wave: wave.Name, target: target})
+		}
+	}
+	for _, target := range plan.Skipped {
+		rows = append(rows, planRow{wave: "-", target: target})
+	}
+	return planTable.Print(rows)
+}
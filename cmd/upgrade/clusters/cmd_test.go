@@ -0,0 +1,129 @@
+package clusters
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rollout"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Upgrade clusters", func() {
+	It("Returns Command", func() {
+		cmd := NewUpgradeClustersCommand()
+		Expect(cmd).NotTo(BeNil())
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Long).To(Equal(long))
+		Expect(cmd.Example).To(Equal(example))
+		Expect(cmd.Run).NotTo(BeNil())
+		for _, flag := range []string{"selector", "version", "canary", "batch-size", "state-file", "interval",
+			"dry-run", "pause", "resume", "abort", "yes"} {
+			Expect(cmd.Flags().Lookup(flag)).NotTo(BeNil())
+		}
+	})
+
+	Context("UpgradeClustersRunner", func() {
+		var t *test.TestingRuntime
+		var options *upgradeOptions
+
+		mockCluster := func(name string, version string) *cmv1.Cluster {
+			return test.MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.ID(name + "-id").Name(name).State(cmv1.ClusterStateReady).
+					Version(cmv1.NewVersion().RawID(version).AvailableUpgrades("4.16.2"))
+			})
+		}
+
+		run := func() (string, error) {
+			stdout, _, err := test.RunWithOutputCapture(func(r *rosa.Runtime, _ *cobra.Command) error {
+				return UpgradeClustersRunner(options)(context.Background(), r, nil, nil)
+			}, t.RosaRuntime, nil)
+			return stdout, err
+		}
+
+		saveRollout := func(status rollout.Status) {
+			plan := &rollout.Rollout{
+				APIVersion: rollout.APIVersion,
+				Kind:       rollout.Kind,
+				Version:    "4.16.2",
+				Status:     status,
+			}
+			Expect(plan.Save(options.stateFile)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			t = test.NewTestRuntime()
+			options = &upgradeOptions{
+				canary:    1,
+				batchSize: 2,
+				interval:  time.Second,
+				stateFile: filepath.Join(GinkgoT().TempDir(), rollout.DefaultStateFile),
+			}
+			output.SetOutput("")
+		})
+
+		It("Requires a selector and a version to start a rollout", func() {
+			options.selector = "name=prod-*"
+			_, err := run()
+			Expect(err).To(MatchError(ContainSubstring("The '--selector' and '--version' flags are required")))
+		})
+
+		It("Shows the waves in dry-run mode without saving them", func() {
+			options.selector = "name=prod-*"
+			options.version = "4.16.2"
+			options.dryRun = true
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, test.FormatClusterList([]*cmv1.Cluster{
+				mockCluster("prod-c", "4.15.9"),
+				mockCluster("prod-a", "4.15.9"),
+				mockCluster("prod-b", "4.15.9"),
+				mockCluster("prod-d", "4.16.2"),
+				mockCluster("staging-prod-e", "4.15.9"),
+			})))
+			stdout, err := run()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(MatchRegexp(`canary\s+prod-a\s+prod-a-id\s+4.15.9\s+pending`))
+			Expect(stdout).To(MatchRegexp(`wave-1\s+prod-b\s+prod-b-id\s+4.15.9\s+pending`))
+			Expect(stdout).To(MatchRegexp(`wave-1\s+prod-c\s+prod-c-id\s+4.15.9\s+pending`))
+			Expect(stdout).To(MatchRegexp(`-\s+prod-d\s+prod-d-id\s+4.16.2\s+skipped\s+cluster already runs`))
+			Expect(stdout).NotTo(ContainSubstring("staging-prod-e"))
+			Expect(stdout).To(ContainSubstring("Running in dry-run mode, no upgrade was scheduled"))
+			Expect(options.stateFile).NotTo(BeAnExistingFile())
+		})
+
+		It("Refuses to start a rollout while another one isn't finished", func() {
+			options.selector = "name=prod-*"
+			options.version = "4.16.2"
+			saveRollout(rollout.StatusPaused)
+			_, err := run()
+			Expect(err).To(MatchError(ContainSubstring("There is already a paused rollout of version '4.16.2'")))
+		})
+
+		It("Pauses a running rollout", func() {
+			options.pause = true
+			saveRollout(rollout.StatusRunning)
+			stdout, err := run()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(ContainSubstring("Paused the rollout of version '4.16.2'"))
+			plan, err := rollout.Load(options.stateFile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Status).To(Equal(rollout.StatusPaused))
+		})
+
+		It("Fails to resume a completed rollout", func() {
+			options.resume = true
+			saveRollout(rollout.StatusCompleted)
+			_, err := run()
+			Expect(err).To(MatchError("only a paused or halted rollout can be resumed, this one is completed"))
+		})
+	})
+})
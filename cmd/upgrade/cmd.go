@@ -21,6 +21,7 @@ import (
 
 	"github.com/openshift/rosa/cmd/upgrade/accountroles"
 	"github.com/openshift/rosa/cmd/upgrade/cluster"
+	"github.com/openshift/rosa/cmd/upgrade/clusters"
 	"github.com/openshift/rosa/cmd/upgrade/machinepool"
 	"github.com/openshift/rosa/cmd/upgrade/operatorroles"
 	"github.com/openshift/rosa/cmd/upgrade/roles"
@@ -41,6 +42,8 @@ func init() {
 	Cmd.AddCommand(accountroles.Cmd)
 	Cmd.AddCommand(operatorroles.Cmd)
 	Cmd.AddCommand(roles.Cmd)
+	clustersCmd := clusters.NewUpgradeClustersCommand()
+	Cmd.AddCommand(clustersCmd)
 
 	flags := Cmd.PersistentFlags()
 	arguments.AddProfileFlag(flags)
@@ -49,7 +52,7 @@ func init() {
 
 	globallyAvailableCommands := []*cobra.Command{
 		accountroles.Cmd, operatorroles.Cmd,
-		roles.Cmd, machinepool.Cmd, cluster.Cmd,
+		roles.Cmd, machinepool.Cmd, cluster.Cmd, clustersCmd,
 	}
 	arguments.MarkRegionDeprecated(Cmd, globallyAvailableCommands)
 }
@@ -26,6 +26,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/aws"
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/helper/roles"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
@@ -35,6 +36,7 @@ import (
 
 var args struct {
 	upgradeVersion string
+	showDiff       bool
 }
 
 var Cmd = &cobra.Command{
@@ -43,7 +45,10 @@ var Cmd = &cobra.Command{
 	Short:   "Upgrade operator IAM roles for a cluster.",
 	Long:    "Upgrade cluster-specific operator IAM roles to latest version.",
 	Example: `  # Upgrade cluster-specific operator IAM roles
-  rosa upgrade operators-roles`,
+  rosa upgrade operators-roles
+
+  # Show the changes to the operator role policies before upgrading them
+  rosa upgrade operator-roles --cluster=mycluster --show-diff`,
 	Args: cobra.NoArgs,
 	Run:  run,
 }
@@ -61,6 +66,14 @@ func init() {
 		"Version of OpenShift that the cluster will be upgraded to",
 	)
 
+	flags.BoolVar(
+		&args.showDiff,
+		"show-diff",
+		false,
+		"Print the actions and resources that the upgrade adds to and removes from each operator role "+
+			"policy before applying it.",
+	)
+
 	confirm.AddFlag(flags)
 	interactive.AddFlag(flags)
 }
@@ -72,7 +85,7 @@ func run(cmd *cobra.Command, _ []string) {
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	clusterKey := r.GetClusterKey()
@@ -81,7 +94,7 @@ func run(cmd *cobra.Command, _ []string) {
 	latestPolicyVersion, err := r.OCMClient.GetLatestVersion(cluster.Version().ChannelGroup())
 	if err != nil {
 		r.Reporter.Errorf("Error getting latest version: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	/**
@@ -104,7 +117,7 @@ func run(cmd *cobra.Command, _ []string) {
 		}
 		if !validVersion {
 			r.Reporter.Errorf("Expected a valid version to upgrade the cluster")
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 	}
 
@@ -119,18 +132,18 @@ func run(cmd *cobra.Command, _ []string) {
 	if err != nil {
 		r.Reporter.Errorf("Error getting account role prefix for the cluster '%s'",
 			clusterKey)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	unifiedPath, err := aws.GetPathFromAccountRole(cluster, aws.AccountRoles[aws.InstallerAccountRole].Name)
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid path for '%s': %v", cluster.AWS().STS().RoleARN(), err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	env, err := ocm.GetEnv()
 	if err != nil {
 		r.Reporter.Errorf("Failed to determine OCM environment: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	managedPolicies := cluster.AWS().STS().ManagedPolicies()
@@ -138,20 +151,20 @@ func run(cmd *cobra.Command, _ []string) {
 	credRequests, err := r.OCMClient.GetCredRequests(cluster.Hypershift().Enabled())
 	if err != nil {
 		r.Reporter.Errorf("Error getting operator credential request from OCM %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	policies, err := r.OCMClient.GetPolicies("OperatorRole")
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if managedPolicies {
 		mode, err = handleModeFlag(cmd, mode)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		hostedCPPolicies := aws.IsHostedCPManagedPolicies(cluster)
@@ -160,7 +173,7 @@ func run(cmd *cobra.Command, _ []string) {
 			args.upgradeVersion, hostedCPPolicies)
 		if err != nil {
 			r.Reporter.Errorf("Failed while validating managed policies: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		r.Reporter.Infof("Cluster '%s' operator roles have attached managed policies. "+
@@ -176,7 +189,7 @@ func run(cmd *cobra.Command, _ []string) {
 		prefix, latestPolicyVersion)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if isAccountRoleUpgradeNeed {
 		r.Reporter.Infof("Account roles with prefix '%s' need to be upgraded before operator roles. "+
@@ -189,7 +202,7 @@ func run(cmd *cobra.Command, _ []string) {
 		r.Creator.Partition, r.Creator.AccountID, latestPolicyVersion, credRequests, unifiedPath)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	version := args.upgradeVersion
@@ -201,7 +214,7 @@ func run(cmd *cobra.Command, _ []string) {
 	missingRolesInCS, err := r.OCMClient.FindMissingOperatorRolesForUpgrade(cluster, version, credRequests)
 	if err != nil {
 		r.Reporter.Errorf("Error finding operator roles for upgrade '%s'", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if len(missingRolesInCS) <= 0 && !isOperatorPolicyUpgradeNeeded {
@@ -209,6 +222,15 @@ func run(cmd *cobra.Command, _ []string) {
 		os.Exit(0)
 	}
 
+	if args.showDiff && isOperatorPolicyUpgradeNeeded {
+		err = roles.ShowPolicyDiff(r, operatorRolePolicyUpgrades(r, prefix, policies, credRequests, cluster,
+			unifiedPath))
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(clierror.ExitCode(err))
+		}
+	}
+
 	if len(missingRolesInCS) > 0 || isOperatorPolicyUpgradeNeeded {
 		r.Reporter.Infof("Starting to upgrade the operator IAM roles and policies")
 	}
@@ -216,7 +238,7 @@ func run(cmd *cobra.Command, _ []string) {
 	mode, err = handleModeFlag(cmd, mode)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if isOperatorPolicyUpgradeNeeded {
@@ -224,7 +246,7 @@ func run(cmd *cobra.Command, _ []string) {
 			policies, env, latestPolicyVersion, credRequests, cluster, unifiedPath)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -232,7 +254,7 @@ func run(cmd *cobra.Command, _ []string) {
 		err = roles.CreateMissingRoles(r, missingRolesInCS, cluster, mode, prefix, policies, unifiedPath, false)
 		if err != nil {
 			r.Reporter.Errorf("Error creating operator roles: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 }
@@ -264,7 +286,7 @@ func upgradeOperatorPolicies(mode string, r *rosa.Runtime,
 			cluster.AWS().PrivateHostedZoneRoleARN(), r.Creator.Partition)
 		if err != nil {
 			r.Reporter.Errorf("There was an error generating the policy files: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		if r.Reporter.IsTerminal() {
@@ -284,6 +306,32 @@ func upgradeOperatorPolicies(mode string, r *rosa.Runtime,
 	return nil
 }
 
+// operatorRolePolicyUpgrades returns the policies of the operator roles of the cluster together with
+// the documents they are upgraded to
+func operatorRolePolicyUpgrades(r *rosa.Runtime, prefix string, policies map[string]*cmv1.AWSSTSPolicy,
+	credRequests map[string]*cmv1.STSOperator, cluster *cmv1.Cluster, policyPath string) []roles.PolicyUpgrade {
+	var upgrades []roles.PolicyUpgrade
+	for credRequest, operator := range credRequests {
+		roleName := fmt.Sprintf("%s/%s", operator.Namespace(), operator.Name())
+		for _, operatorRole := range cluster.AWS().STS().OperatorIAMRoles() {
+			if operatorRole.Namespace() == operator.Namespace() && operatorRole.Name() == operator.Name() {
+				name, err := aws.GetResourceIdFromARN(operatorRole.RoleARN())
+				if err == nil {
+					roleName = name
+				}
+				break
+			}
+		}
+		upgrades = append(upgrades, roles.PolicyUpgrade{
+			RoleName: roleName,
+			PolicyARN: aws.GetOperatorPolicyARN(r.Creator.Partition, r.Creator.AccountID, prefix,
+				operator.Namespace(), operator.Name(), policyPath),
+			Document: aws.GetOperatorPolicyDetails(policies, credRequest, r.Creator.Partition, cluster),
+		})
+	}
+	return upgrades
+}
+
 func handleModeFlag(cmd *cobra.Command, mode string) (string, error) {
 	// Determine if interactive mode is needed
 	if !interactive.Enabled() && !cmd.Flags().Changed("mode") {
@@ -33,6 +33,7 @@ import (
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
 	awscbRoles "github.com/openshift/rosa/pkg/aws/commandbuilder/helper/roles"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/helper/rolepolicybindings"
 	"github.com/openshift/rosa/pkg/helper/roles"
@@ -131,7 +132,7 @@ func run(cmd *cobra.Command, argv []string) {
 	mode, err := interactive.GetMode()
 	if err != nil {
 		reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	clusterUpgradeVersion := args.clusterUpgradeVersion
@@ -144,13 +145,13 @@ func run(cmd *cobra.Command, argv []string) {
 	err = ocmClient.CheckUpgradeClusterVersion(availableUpgrades, clusterUpgradeVersion, cluster)
 	if err != nil {
 		reporter.Errorf("%v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	env, err := ocm.GetEnv()
 	if err != nil {
 		reporter.Errorf("Failed to determine OCM environment: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	managedPolicies := cluster.AWS().STS().ManagedPolicies()
@@ -158,13 +159,13 @@ func run(cmd *cobra.Command, argv []string) {
 	unifiedPath, err := aws.GetPathFromAccountRole(cluster, aws.AccountRoles[aws.InstallerAccountRole].Name)
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid path for '%s': %v", cluster.AWS().STS().RoleARN(), err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	credRequests, err := ocmClient.GetCredRequests(cluster.Hypershift().Enabled())
 	if err != nil {
 		r.Reporter.Errorf("Error getting operator credential request from OCM %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	// Determine if interactive mode is needed
@@ -177,7 +178,7 @@ func run(cmd *cobra.Command, argv []string) {
 		mode, err = interactive.GetOptionMode(cmd, mode, "Roles upgrade mode")
 		if err != nil {
 			r.Reporter.Errorf("expected a valid Account role upgrade mode: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -186,7 +187,7 @@ func run(cmd *cobra.Command, argv []string) {
 			r, cluster, credRequests, unifiedPath, mode, clusterUpgradeVersion)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		r.Reporter.Infof("Cluster '%s' operator roles have attached managed policies. "+
 			"An upgrade isn't needed", cluster.Name())
@@ -198,24 +199,24 @@ func run(cmd *cobra.Command, argv []string) {
 		args.policyUpgradeversion, clusterUpgradeVersion)
 	if err != nil {
 		reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	policyVersion, err = ocmClient.GetPolicyVersion(policyVersion, channelGroup)
 	if err != nil {
 		reporter.Errorf("Error getting version: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	err = checkPolicyAndClusterVersionCompatibility(policyVersion, clusterUpgradeVersion)
 	if err != nil {
 		reporter.Errorf("%v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	creator, err := awsClient.GetCreator()
 	if err != nil {
 		reporter.Errorf("Failed to get IAM credentials: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	var spin *spinner.Spinner
@@ -239,7 +240,7 @@ func run(cmd *cobra.Command, argv []string) {
 	if err != nil {
 		reporter.Errorf("%s", err)
 		LogError(roles.RosaUpgradeAccRolesModeAuto, ocmClient, policyVersion, err, reporter)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if spin != nil {
@@ -252,13 +253,13 @@ func run(cmd *cobra.Command, argv []string) {
 			reporter.Debugf(err.Error())
 		} else {
 			reporter.Errorf("Failed to get rolePolicyBinding: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	} else {
 		err = rolepolicybindings.CheckRolePolicyBindingStatus(rolePolicyBindings)
 		if err != nil {
 			reporter.Errorf("Error in rolePolicyBinding: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 
@@ -272,7 +273,7 @@ func run(cmd *cobra.Command, argv []string) {
 			err = syncAccountRoleVersionTagsForCluster(awsClient, cluster, policyVersion)
 			if err != nil {
 				reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		}
 		reporter.Infof("Account roles/policies for cluster '%s' are already up-to-date.", r.ClusterKey)
@@ -280,7 +281,7 @@ func run(cmd *cobra.Command, argv []string) {
 		accountRolePolicies, err := ocmClient.GetPolicies("")
 		if err != nil {
 			reporter.Errorf("Expected a valid role creation mode: %s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 
 		switch mode {
@@ -309,7 +310,7 @@ func run(cmd *cobra.Command, argv []string) {
 						os.Exit(1)
 					}
 					reporter.Errorf("Error upgrading the account role policies: %s", err)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 			}
 		case interactive.ModeManual:
@@ -318,7 +319,7 @@ func run(cmd *cobra.Command, argv []string) {
 					aws.AccountRoles, creator.Partition, "")
 				if err != nil {
 					reporter.Errorf("There was an error generating the policy files: %s", err)
-					os.Exit(1)
+					os.Exit(clierror.ExitCode(err))
 				}
 			}
 			if reporter.IsTerminal() {
@@ -338,13 +339,13 @@ func run(cmd *cobra.Command, argv []string) {
 			)
 			if err != nil {
 				reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 
 			fmt.Println(commands)
 		default:
 			reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 	}
 
@@ -367,7 +368,7 @@ func run(cmd *cobra.Command, argv []string) {
 	operatorRolePolicyPrefix, err := aws.GetOperatorRolePolicyPrefixFromCluster(cluster, r.AWSClient)
 	if err != nil {
 		r.Reporter.Errorf("Error getting operator role policy prefix: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	isOperatorPolicyUpgradeNeeded := false
@@ -381,13 +382,13 @@ func run(cmd *cobra.Command, argv []string) {
 	)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	missingRolesInCS, err := ocmClient.FindMissingOperatorRolesForUpgrade(cluster, clusterUpgradeVersion, credRequests)
 	if err != nil {
 		r.Reporter.Errorf("Error finding operator roles for upgrade '%s'", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if spin != nil {
@@ -408,7 +409,7 @@ func run(cmd *cobra.Command, argv []string) {
 	operatorRolePolicies, err := ocmClient.GetPolicies("OperatorRole")
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	if isOperatorPolicyUpgradeNeeded {
@@ -440,7 +441,7 @@ func run(cmd *cobra.Command, argv []string) {
 			exists, _, err := r.AWSClient.CheckRoleExists(roleName)
 			if err != nil {
 				r.Reporter.Errorf("Error when detecting checking missing operator IAM roles %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			if !exists {
 				err = createOperatorRole(
@@ -1057,7 +1058,7 @@ func createOperatorRole(
 		fmt.Println(commands)
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 	return nil
 }
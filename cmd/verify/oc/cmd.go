@@ -27,10 +27,21 @@ import (
 	rprtr "github.com/openshift/rosa/pkg/reporter"
 )
 
-var ocVersionOutput = func(ctx context.Context) ([]byte, error) {
+// VersionOutput returns the output of 'oc version --client'
+var VersionOutput = func(ctx context.Context) ([]byte, error) {
 	return exec.CommandContext(ctx, "oc", "version", "--client").Output()
 }
 
+// supportedVersionRE matches the output of 'oc version --client' for the supported versions
+var supportedVersionRE = regexp.MustCompile(`\W4.\d*`)
+
+// ParseVersion returns the first line of the output of 'oc version --client', and whether it is a
+// supported version
+func ParseVersion(output []byte) (string, bool) {
+	version := strings.Replace(strings.Split(string(output), "\n")[0], "\n", "", 1)
+	return version, supportedVersionRE.Match(output)
+}
+
 var Cmd = &cobra.Command{
 	Use:     "openshift-client",
 	Aliases: []string{"oc", "openshift"},
@@ -48,7 +59,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if cmd != nil {
 		ctx = cmd.Context()
 	}
-	runVerifyOC(ctx, reporter, ocVersionOutput)
+	runVerifyOC(ctx, reporter, VersionOutput)
 }
 
 func runVerifyOC(ctx context.Context, reporter rprtr.Logger, getVersion func(context.Context) ([]byte, error)) {
@@ -67,13 +78,7 @@ func runVerifyOC(ctx context.Context, reporter rprtr.Logger, getVersion func(con
 		return
 	}
 
-	version := strings.Replace(strings.Split(string(output), "\n")[0], "\n", "", 1)
-	isCorrectVersion, err := regexp.Match(`\W4.\d*`, output)
-	if err != nil {
-		reporter.Errorf("Failed to parse OpenShift Client version: %v", err)
-		return
-	}
-
+	version, isCorrectVersion := ParseVersion(output)
 	if !isCorrectVersion {
 		reporter.Warnf("Current OpenShift %s", version)
 		reporter.Warnf("Your version of the OpenShift command-line tool is not supported.\n" +
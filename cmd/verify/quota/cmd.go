@@ -21,22 +21,41 @@ import (
 	"os"
 	"strings"
 
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clierror"
+	"github.com/openshift/rosa/pkg/helper"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
+var args struct {
+	hostedCP        bool
+	clusterSize     string
+	requestIncrease bool
+}
+
 var Cmd = &cobra.Command{
 	Use:   "quota",
 	Short: "Verify AWS quota is ok for cluster install",
-	Long:  "Verify AWS quota needed to create a cluster is configured as expected",
+	Long: "Verify AWS quota needed to create a cluster is configured as expected. The values needed " +
+		"depend on the topology and the size of the clusters, and the quota of multiple regions can be " +
+		"verified at once.",
 	Example: `  # Verify AWS quotas are configured correctly
   rosa verify quota
 
   # Verify AWS quotas in a different region
-  rosa verify quota --region=us-west-2`,
+  rosa verify quota --region=us-west-2
+
+  # Verify AWS quotas for large hosted control plane clusters in two regions, as JSON
+  rosa verify quota --region=us-east-1,us-west-2 --hosted-cp --cluster-size=large -o json
+
+  # Request the increase of the insufficient AWS quotas
+  rosa verify quota --request-increase`,
 	Args: cobra.NoArgs,
 	Run:  run,
 }
@@ -46,6 +65,37 @@ func init() {
 
 	arguments.AddRegionFlag(flags)
 	arguments.AddProfileFlag(flags)
+
+	flags.BoolVar(
+		&args.hostedCP,
+		"hosted-cp",
+		false,
+		"Verify the quota needed by hosted control plane clusters instead of classic ones.",
+	)
+	flags.StringVar(
+		&args.clusterSize,
+		"cluster-size",
+		aws.DefaultClusterSize,
+		fmt.Sprintf("Size of the clusters to verify the quota for. Allowed values are %s.",
+			helper.MapKeysToString(aws.ClusterSizes)),
+	)
+	flags.BoolVar(
+		&args.requestIncrease,
+		"request-increase",
+		false,
+		"Request the increase of the insufficient quotas to the values needed, asking for "+
+			"confirmation of each request.",
+	)
+	confirm.AddFlag(flags)
+	output.AddTableFlags(Cmd)
+}
+
+// newAWSClient builds the AWS client used to verify the quota of a region.
+var newAWSClient = func(logger *logrus.Logger, region string) (aws.Client, error) {
+	return aws.NewClient().
+		Logger(logger).
+		Region(region).
+		Build()
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -53,51 +103,150 @@ func run(_ *cobra.Command, _ []string) {
 	err := runWithRuntime(r)
 	r.Cleanup()
 	if err != nil {
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 }
 
 func runWithRuntime(r *rosa.Runtime) error {
-	region, err := aws.GetRegion(arguments.GetRegion())
-	if err != nil {
-		r.Reporter.Errorf("Error getting region: %v", err)
-		return fmt.Errorf("getting AWS region for quota verification: %w", err)
+	size, ok := aws.ClusterSizes[args.clusterSize]
+	if !ok {
+		r.Reporter.Errorf("Invalid cluster size '%s', allowed values are %s", args.clusterSize,
+			helper.MapKeysToString(aws.ClusterSizes))
+		return clierror.New(clierror.Validation, fmt.Errorf("invalid cluster size '%s'", args.clusterSize))
+	}
+	topology := aws.ClassicTopology
+	if args.hostedCP {
+		topology = aws.HostedCPTopology
 	}
 
-	if r.AWSClient == nil {
-		r.AWSClient, err = aws.NewClient().
-			Logger(r.Logger).
-			Region(region).
-			Build()
+	regions := []string{}
+	for _, region := range strings.Split(arguments.GetRegion(), ",") {
+		region, err := aws.GetRegion(strings.TrimSpace(region))
 		if err != nil {
-			// FIXME Hack to capture errors due to using STS accounts
-			if strings.Contains(fmt.Sprintf("%s", err), "STS") {
-				r.OCMClient.LogEvent("ROSAInitCredentialsSTS", nil)
+			r.Reporter.Errorf("Error getting region: %v", err)
+			return fmt.Errorf("getting AWS region for quota verification: %w", err)
+		}
+		regions = append(regions, region)
+	}
+
+	if r.Reporter.IsTerminal() && !output.HasFlag() {
+		r.Reporter.Infof("Validating AWS quota for %s %s clusters in %s...", args.clusterSize, topology,
+			strings.Join(regions, ", "))
+	}
+	reports := []*aws.QuotaReport{}
+	clients := map[string]aws.Client{}
+	for _, region := range regions {
+		awsClient := r.AWSClient
+		if awsClient == nil || len(regions) > 1 {
+			var err error
+			awsClient, err = newAWSClient(r.Logger, region)
+			if err != nil {
+				// FIXME Hack to capture errors due to using STS accounts
+				if strings.Contains(fmt.Sprintf("%s", err), "STS") {
+					r.OCMClient.LogEvent("ROSAInitCredentialsSTS", nil)
+				}
+				r.Reporter.Errorf("Error creating AWS client: %v", err)
+				return fmt.Errorf("building AWS client for quota verification: %w", err)
 			}
-			r.Reporter.Errorf("Error creating AWS client: %v", err)
-			return fmt.Errorf("building AWS client for quota verification: %w", err)
 		}
+		clients[region] = awsClient
+		reports = append(reports, awsClient.VerifyQuotas(aws.QuotaRequirements, topology, size)...)
 	}
 
-	if r.Reporter.IsTerminal() {
-		r.Reporter.Infof("Validating AWS quota...")
+	failed := []*aws.QuotaReport{}
+	for _, report := range reports {
+		if report.Status != aws.QuotaStatusOK {
+			failed = append(failed, report)
+		}
 	}
-	ok, err := r.AWSClient.ValidateQuota()
-	if err != nil {
-		r.OCMClient.LogEvent("ROSAVerifyQuotaInsufficient", nil)
-		r.Reporter.Errorf("Insufficient AWS quotas")
-		r.Reporter.Errorf("%v", err)
-		return fmt.Errorf("validating AWS quotas: %w", err)
+	if output.HasFlag() || r.Reporter.IsTerminal() {
+		err := printReports(reports)
+		if err != nil {
+			r.Reporter.Errorf("Failed to print the quota report: %v", err)
+			return err
+		}
 	}
-	if !ok {
-		r.OCMClient.LogEvent("ROSAVerifyQuotaInsufficient", nil)
-		r.Reporter.Errorf("Insufficient AWS quotas")
-		return fmt.Errorf("validating AWS quotas: insufficient AWS quotas")
+
+	if len(failed) == 0 {
+		if r.Reporter.IsTerminal() && !output.HasFlag() {
+			r.Reporter.Infof("AWS quota ok. " +
+				"If cluster installation fails, validate actual AWS resource usage against " +
+				"https://docs.openshift.com/rosa/rosa_getting_started/rosa-required-aws-service-quotas.html")
+		}
+		return nil
+	}
+
+	r.OCMClient.LogEvent("ROSAVerifyQuotaInsufficient", nil)
+	r.Reporter.Errorf("Insufficient AWS quotas")
+	adjustable := false
+	for _, report := range failed {
+		if report.Status == aws.QuotaStatusError {
+			r.Reporter.Errorf("- Region %s service %s quota code %s %s: %s", report.Region,
+				report.ServiceCode, report.QuotaCode, report.QuotaName, report.Message)
+			continue
+		}
+		r.Reporter.Errorf("- Region %s service %s quota code %s %s not valid, "+
+			"expected quota of at least %d, but got %d", report.Region, report.ServiceCode,
+			report.QuotaCode, report.QuotaName, int(report.Required), int(report.Value))
+		adjustable = adjustable || report.Adjustable
+	}
+
+	if adjustable && !args.requestIncrease && r.Reporter.IsTerminal() {
+		r.Reporter.Infof("To request the increase of the insufficient quotas run " +
+			"'rosa verify quota --request-increase' with the same regions and cluster options")
+	}
+	if args.requestIncrease {
+		requestIncreases(r, clients, failed)
 	}
-	if r.Reporter.IsTerminal() {
-		r.Reporter.Infof("AWS quota ok. " +
-			"If cluster installation fails, validate actual AWS resource usage against " +
-			"https://docs.openshift.com/rosa/rosa_getting_started/rosa-required-aws-service-quotas.html")
+	return clierror.New(clierror.QuotaExceeded, fmt.Errorf("validating AWS quotas: insufficient AWS quotas"))
+}
+
+func printReports(reports []*aws.QuotaReport) error {
+	return output.Table[*aws.QuotaReport]{
+		Columns: []output.Column[*aws.QuotaReport]{
+			{Header: "REGION", Value: func(q *aws.QuotaReport) string { return q.Region }},
+			{Header: "SERVICE", Value: func(q *aws.QuotaReport) string { return q.ServiceCode }},
+			{Header: "CODE", Value: func(q *aws.QuotaReport) string { return q.QuotaCode }},
+			{Header: "NAME", Value: func(q *aws.QuotaReport) string { return q.QuotaName }},
+			{Header: "VALUE", Value: func(q *aws.QuotaReport) string {
+				if q.Status == aws.QuotaStatusError {
+					return "-"
+				}
+				return fmt.Sprint(q.Value)
+			}},
+			{Header: "REQUIRED", Value: func(q *aws.QuotaReport) string { return fmt.Sprint(q.Required) }},
+			{Header: "STATUS", Value: func(q *aws.QuotaReport) string { return q.Status }},
+			{Header: "ADJUSTABLE", Value: func(q *aws.QuotaReport) string { return fmt.Sprint(q.Adjustable) },
+				Wide: true},
+			{Header: "MESSAGE", Value: func(q *aws.QuotaReport) string { return q.Message }, Wide: true},
+		},
+	}.Print(reports)
+}
+
+// requestIncreases files Service Quotas increase requests for the insufficient adjustable quotas,
+// after confirming each of them.
+func requestIncreases(r *rosa.Runtime, clients map[string]aws.Client, failed []*aws.QuotaReport) {
+	for _, report := range failed {
+		if report.Status != aws.QuotaStatusInsufficient {
+			continue
+		}
+		if !report.Adjustable {
+			r.Reporter.Warnf("Quota '%s' in region '%s' can't be adjusted, contact AWS support to increase it",
+				report.QuotaName, report.Region)
+			continue
+		}
+		if !confirm.Confirm("request the increase of quota '%s' in region '%s' from %d to %d",
+			report.QuotaName, report.Region, int(report.Value), int(report.Required)) {
+			continue
+		}
+		id, err := clients[report.Region].RequestQuotaIncrease(report.ServiceCode, report.QuotaCode,
+			report.Required)
+		if err != nil {
+			r.Reporter.Errorf("Failed to request the increase of quota '%s' in region '%s': %v",
+				report.QuotaName, report.Region, err)
+			continue
+		}
+		r.Reporter.Infof("Requested the increase of quota '%s' in region '%s', request ID '%s'",
+			report.QuotaName, report.Region, id)
 	}
-	return nil
 }
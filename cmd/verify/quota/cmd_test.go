@@ -1,14 +1,19 @@
 package quota
 
 import (
-	"fmt"
+	"encoding/json"
 	"os"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"go.uber.org/mock/gomock"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clierror"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
 	"github.com/openshift/rosa/pkg/test"
 )
@@ -22,9 +27,21 @@ var _ = Describe("verify quota", func() {
 		DeferCleanup(os.Unsetenv, "AWS_REGION")
 	})
 
+	okReport := &aws.QuotaReport{
+		Region:      "us-east-1",
+		ServiceCode: "ec2",
+		QuotaCode:   "L-1216C47A",
+		QuotaName:   "Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances",
+		Value:       200,
+		Required:    100,
+		Status:      aws.QuotaStatusOK,
+		Adjustable:  true,
+	}
+
 	It("Succeeds when quota validation passes", func() {
 		mockClient := t.RosaRuntime.AWSClient.(*aws.MockClient)
-		mockClient.EXPECT().ValidateQuota().Return(true, nil)
+		mockClient.EXPECT().VerifyQuotas(aws.QuotaRequirements, aws.ClassicTopology, 1.0).
+			Return([]*aws.QuotaReport{okReport})
 
 		err := runWithRuntime(t.RosaRuntime)
 		Expect(err).NotTo(HaveOccurred())
@@ -32,7 +49,8 @@ var _ = Describe("verify quota", func() {
 
 	It("Prints no info messages when not running in a terminal", func() {
 		mockClient := t.RosaRuntime.AWSClient.(*aws.MockClient)
-		mockClient.EXPECT().ValidateQuota().Return(true, nil)
+		mockClient.EXPECT().VerifyQuotas(aws.QuotaRequirements, aws.ClassicTopology, 1.0).
+			Return([]*aws.QuotaReport{okReport})
 
 		stdout, stderr, err := test.RunWithOutputCapture(
 			func(r *rosa.Runtime, _ *cobra.Command) error {
@@ -43,31 +61,123 @@ var _ = Describe("verify quota", func() {
 		Expect(stdout).To(BeEmpty())
 	})
 
-	It("Returns error and prints failure details when ValidateQuota fails with error", func() {
+	It("Returns error and prints failure details of every quota that couldn't be verified", func() {
 		mockClient := t.RosaRuntime.AWSClient.(*aws.MockClient)
-		mockClient.EXPECT().ValidateQuota().Return(false, fmt.Errorf("not enough vCPU"))
+		mockClient.EXPECT().VerifyQuotas(aws.QuotaRequirements, aws.ClassicTopology, 1.0).Return(
+			[]*aws.QuotaReport{
+				{
+					Region:      "us-east-1",
+					ServiceCode: "ec2",
+					QuotaCode:   "L-0263D0A3",
+					Status:      aws.QuotaStatusError,
+					Message:     "Error getting AWS service quota: access denied",
+				},
+				{
+					Region:      "us-east-1",
+					ServiceCode: "ec2",
+					QuotaCode:   "L-1216C47A",
+					QuotaName:   "Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances",
+					Value:       32,
+					Required:    100,
+					Status:      aws.QuotaStatusInsufficient,
+				},
+			})
 
 		stdout, stderr, err := test.RunWithOutputCapture(
 			func(r *rosa.Runtime, _ *cobra.Command) error {
 				return runWithRuntime(r)
 			}, t.RosaRuntime, Cmd)
 		Expect(err).To(HaveOccurred())
+		Expect(clierror.ExitCode(err)).To(Equal(clierror.QuotaExceeded.ExitCode()))
 		Expect(stderr).To(ContainSubstring("Insufficient AWS quotas"))
-		Expect(stderr).To(ContainSubstring("not enough vCPU"))
+		Expect(stderr).To(ContainSubstring("access denied"))
+		Expect(stderr).To(ContainSubstring("expected quota of at least 100, but got 32"))
 		Expect(stdout).To(BeEmpty())
 	})
 
-	It("Returns error when ValidateQuota returns false without error", func() {
+	It("Reports the quota of multiple regions for hosted control plane clusters as JSON", func() {
+		Expect(os.Setenv("AWS_REGION", "us-east-1,us-west-2")).To(Succeed())
+		args.hostedCP = true
+		args.clusterSize = "large"
+		output.SetOutput("json")
+		DeferCleanup(func() {
+			args.hostedCP = false
+			args.clusterSize = aws.DefaultClusterSize
+			output.SetOutput("")
+		})
+		clients := map[string]*aws.MockClient{}
+		DeferCleanup(func(original func(*logrus.Logger, string) (aws.Client, error)) {
+			newAWSClient = original
+		}, newAWSClient)
+		newAWSClient = func(_ *logrus.Logger, region string) (aws.Client, error) {
+			clients[region] = aws.NewMockClient(gomock.NewController(GinkgoT()))
+			report := *okReport
+			report.Region = region
+			clients[region].EXPECT().VerifyQuotas(aws.QuotaRequirements, aws.HostedCPTopology, 4.0).
+				Return([]*aws.QuotaReport{&report})
+			return clients[region], nil
+		}
+
+		stdout, _, err := test.RunWithOutputCapture(
+			func(r *rosa.Runtime, _ *cobra.Command) error {
+				return runWithRuntime(r)
+			}, t.RosaRuntime, Cmd)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clients).To(HaveLen(2))
+		reports := []*aws.QuotaReport{}
+		Expect(json.Unmarshal([]byte(stdout), &reports)).To(Succeed())
+		Expect(reports).To(HaveLen(2))
+		Expect(reports[0].Region).To(Equal("us-east-1"))
+		Expect(reports[1].Region).To(Equal("us-west-2"))
+		Expect(reports[1].Status).To(Equal(aws.QuotaStatusOK))
+	})
+
+	It("Requests the increase of insufficient adjustable quotas", func() {
+		args.requestIncrease = true
+		DeferCleanup(func() { args.requestIncrease = false })
+		Expect(confirm.Yes()).To(BeFalse())
+		Expect(Cmd.Flags().Set("yes", "true")).To(Succeed())
+		DeferCleanup(Cmd.Flags().Set, "yes", "false")
+
 		mockClient := t.RosaRuntime.AWSClient.(*aws.MockClient)
-		mockClient.EXPECT().ValidateQuota().Return(false, nil)
+		mockClient.EXPECT().VerifyQuotas(aws.QuotaRequirements, aws.ClassicTopology, 1.0).Return(
+			[]*aws.QuotaReport{
+				{
+					Region:      "us-east-1",
+					ServiceCode: "ec2",
+					QuotaCode:   "L-1216C47A",
+					QuotaName:   "Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances",
+					Value:       32,
+					Required:    100,
+					Status:      aws.QuotaStatusInsufficient,
+					Adjustable:  true,
+				},
+				{
+					Region:      "us-east-1",
+					ServiceCode: "vpc",
+					QuotaCode:   "L-F678F1CE",
+					QuotaName:   "VPCs per Region",
+					Value:       1,
+					Required:    5,
+					Status:      aws.QuotaStatusInsufficient,
+				},
+			})
+		mockClient.EXPECT().RequestQuotaIncrease("ec2", "L-1216C47A", 100.0).Return("request-1", nil)
 
-		stdout, stderr, err := test.RunWithOutputCapture(
+		_, stderr, err := test.RunWithOutputCapture(
 			func(r *rosa.Runtime, _ *cobra.Command) error {
 				return runWithRuntime(r)
 			}, t.RosaRuntime, Cmd)
 		Expect(err).To(HaveOccurred())
-		Expect(stderr).To(ContainSubstring("Insufficient AWS quotas"))
-		Expect(stdout).To(BeEmpty())
+		Expect(stderr).To(ContainSubstring("'VPCs per Region' in region 'us-east-1' can't be adjusted"))
+	})
+
+	It("Rejects invalid cluster sizes", func() {
+		args.clusterSize = "huge"
+		DeferCleanup(func() { args.clusterSize = aws.DefaultClusterSize })
+
+		err := runWithRuntime(t.RosaRuntime)
+		Expect(err).To(MatchError("invalid cluster size 'huge'"))
 	})
 
 	It("Rejects extra arguments via cobra.NoArgs", func() {
@@ -0,0 +1,136 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/wait"
+)
+
+const (
+	use     = "cluster"
+	short   = "Wait for a cluster to reach a condition"
+	long    = "Wait until a cluster is ready, has finished hibernating or has been deleted."
+	example = `  # Wait up to 40 minutes for a cluster named "mycluster" to be ready
+  rosa wait cluster --cluster=mycluster --for=ready --timeout=40m
+
+  # Wait for the cluster to finish hibernating, checking every minute
+  rosa wait cluster --cluster=mycluster --for=hibernating --interval=1m
+
+  # Wait for the cluster to be deleted
+  rosa wait cluster --cluster=mycluster --for=deleted`
+)
+
+const (
+	conditionReady       = "ready"
+	conditionHibernating = "hibernating"
+	conditionDeleted     = "deleted"
+)
+
+var conditions = []string{conditionReady, conditionHibernating, conditionDeleted}
+
+func NewWaitClusterCommand() *cobra.Command {
+	options := &wait.Options{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), WaitClusterRunner(options)),
+	}
+
+	ocm.AddClusterFlag(cmd)
+	wait.AddFlags(cmd, options, conditions)
+	return cmd
+}
+
+func WaitClusterRunner(options *wait.Options) rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		err := options.Validate(conditions)
+		if err != nil {
+			return err
+		}
+
+		clusterKey := r.GetClusterKey()
+		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+		if err != nil {
+			if errors.GetType(err) == errors.NotFound && options.For == conditionDeleted {
+				r.Reporter.Infof("Cluster '%s' doesn't exist", clusterKey)
+				return nil
+			}
+			return fmt.Errorf("Failed to get cluster '%s': %v", clusterKey, err)
+		}
+
+		waiter := wait.NewWaiter(
+			r.Reporter,
+			options,
+			fmt.Sprintf("cluster '%s' to be %s", clusterKey, options.For),
+			condition(options.For),
+			func(cluster *cmv1.Cluster) string {
+				if cluster == nil {
+					return fmt.Sprintf("Cluster '%s' doesn't exist", clusterKey)
+				}
+				return fmt.Sprintf("Cluster '%s' is in '%s' state", clusterKey, cluster.State())
+			},
+		)
+		_, err = r.OCMClient.PollCluster(cluster.ID(), options.Interval, options.Timeout, waiter.Done)
+		err = waiter.Result(err)
+		if err != nil {
+			return err
+		}
+		r.Reporter.Infof("Cluster '%s' is %s", clusterKey, options.For)
+		return nil
+	}
+}
+
+func condition(name string) wait.Condition[*cmv1.Cluster] {
+	return func(cluster *cmv1.Cluster) (bool, error) {
+		if cluster == nil {
+			if name == conditionDeleted {
+				return true, nil
+			}
+			return false, fmt.Errorf("the cluster has been deleted")
+		}
+		switch cluster.State() {
+		case cmv1.ClusterStateError:
+			if description := cluster.Status().Description(); description != "" {
+				return false, fmt.Errorf("the cluster is in 'error' state: %s", description)
+			}
+			return false, fmt.Errorf("the cluster is in 'error' state")
+		case cmv1.ClusterStateUninstalling:
+			if name != conditionDeleted {
+				return false, fmt.Errorf("the cluster is being uninstalled")
+			}
+		}
+		switch name {
+		case conditionReady:
+			return cluster.State() == cmv1.ClusterStateReady, nil
+		case conditionHibernating:
+			return cluster.State() == cmv1.ClusterStateHibernating, nil
+		}
+		return false, nil
+	}
+}
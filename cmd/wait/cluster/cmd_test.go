@@ -0,0 +1,90 @@
+package cluster
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/clierror"
+	"github.com/openshift/rosa/pkg/test"
+	"github.com/openshift/rosa/pkg/wait"
+)
+
+var _ = Describe("Wait cluster", func() {
+	var t *test.TestingRuntime
+	var options *wait.Options
+
+	mockCluster := func(state cmv1.ClusterState) *cmv1.Cluster {
+		return test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.State(state)
+		})
+	}
+
+	BeforeEach(func() {
+		t = test.NewTestRuntime()
+		options = &wait.Options{For: conditionReady, Interval: 10 * time.Millisecond, Timeout: time.Minute}
+	})
+
+	It("Creates the command correctly", func() {
+		cmd := NewWaitClusterCommand()
+		Expect(cmd.Use).To(Equal(use))
+		for _, flag := range []string{"cluster", "for", "interval", "timeout"} {
+			Expect(cmd.Flags().Lookup(flag)).NotTo(BeNil())
+		}
+	})
+
+	It("Waits until the cluster is ready", func() {
+		t.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, test.FormatClusterList([]*cmv1.Cluster{mockCluster(cmv1.ClusterStateInstalling)})),
+			RespondWithJSON(http.StatusOK, test.FormatResource(mockCluster(cmv1.ClusterStateInstalling))),
+			RespondWithJSON(http.StatusOK, test.FormatResource(mockCluster(cmv1.ClusterStateReady))),
+		)
+		err := WaitClusterRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(3))
+	})
+
+	It("Exits with the failed code when the cluster is in error state", func() {
+		t.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, test.FormatClusterList([]*cmv1.Cluster{mockCluster(cmv1.ClusterStateInstalling)})),
+			RespondWithJSON(http.StatusOK, test.FormatResource(mockCluster(cmv1.ClusterStateError))),
+		)
+		err := WaitClusterRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).To(MatchError(ContainSubstring("the cluster is in 'error' state")))
+		Expect(clierror.Classify(err)).To(Equal(clierror.FailedState))
+	})
+
+	It("Succeeds when waiting for a cluster that doesn't exist to be deleted", func() {
+		options.For = conditionDeleted
+		t.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, test.FormatClusterList([]*cmv1.Cluster{})),
+		)
+		err := WaitClusterRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	DescribeTable("Evaluates the conditions",
+		func(name string, cluster *cmv1.Cluster, met bool, failure string) {
+			ok, err := condition(name)(cluster)
+			Expect(ok).To(Equal(met))
+			if failure == "" {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(failure))
+			}
+		},
+		Entry("hibernating", conditionHibernating, mockCluster(cmv1.ClusterStateHibernating), true, ""),
+		Entry("powering down", conditionHibernating, mockCluster(cmv1.ClusterStatePoweringDown), false, ""),
+		Entry("uninstalling while waiting to be deleted", conditionDeleted,
+			mockCluster(cmv1.ClusterStateUninstalling), false, ""),
+		Entry("uninstalling while waiting to be ready", conditionReady,
+			mockCluster(cmv1.ClusterStateUninstalling), false, "the cluster is being uninstalled"),
+		Entry("deleted", conditionDeleted, nil, true, ""),
+		Entry("deleted while waiting to be ready", conditionReady, nil, false, "the cluster has been deleted"),
+	)
+})
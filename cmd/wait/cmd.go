@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wait
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/cmd/wait/cluster"
+	"github.com/openshift/rosa/cmd/wait/machinepool"
+	"github.com/openshift/rosa/cmd/wait/upgrade"
+	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/clierror"
+)
+
+func NewRosaWaitCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wait",
+		Short: "Wait for a resource to reach a condition",
+		Long: fmt.Sprintf("Wait until a cluster, machine pool or upgrade reaches a condition.\n\n"+
+			"The command exits with code 0 when the condition is met, with code %d when the timeout "+
+			"expires and with code %d when the resource reaches a state from which the condition "+
+			"can't be met anymore.", clierror.Timeout.ExitCode(), clierror.FailedState.ExitCode()),
+		Args: cobra.NoArgs,
+	}
+	cmd.AddCommand(cluster.NewWaitClusterCommand())
+	cmd.AddCommand(machinepool.NewWaitMachinePoolCommand())
+	cmd.AddCommand(upgrade.NewWaitUpgradeCommand())
+	flags := cmd.PersistentFlags()
+	arguments.AddProfileFlag(flags)
+	arguments.AddRegionFlag(flags)
+	return cmd
+}
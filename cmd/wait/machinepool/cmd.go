@@ -0,0 +1,200 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"context"
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/wait"
+)
+
+const (
+	use   = "machinepool"
+	alias = "machine-pool"
+	short = "Wait for a machine pool to reach a condition"
+	long  = "Wait until a machine pool has the desired number of replicas or has been deleted. " +
+		"Waiting for the replicas is only supported for machine pools of Hosted Control Plane clusters."
+	example = `  # Wait for machine pool "mymachinepool" of cluster "mycluster" to have all its replicas
+  rosa wait machinepool --cluster=mycluster mymachinepool --for=ready
+
+  # Wait up to 10 minutes for the machine pool to be deleted
+  rosa wait machinepool --cluster=mycluster --machinepool=mymachinepool --for=deleted --timeout=10m`
+)
+
+const (
+	conditionReady   = "ready"
+	conditionDeleted = "deleted"
+)
+
+var conditions = []string{conditionReady, conditionDeleted}
+
+type waitOptions struct {
+	wait.Options
+	machinepool string
+}
+
+func NewWaitMachinePoolCommand() *cobra.Command {
+	options := &waitOptions{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Aliases: []string{alias},
+		Example: example,
+		Args:    cobra.MaximumNArgs(1),
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), WaitMachinePoolRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(
+		&options.machinepool,
+		"machinepool",
+		"",
+		"Machine pool of the cluster to target",
+	)
+	ocm.AddClusterFlag(cmd)
+	wait.AddFlags(cmd, &options.Options, conditions)
+	return cmd
+}
+
+func WaitMachinePoolRunner(options *waitOptions) rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
+		machinePoolID := options.machinepool
+		if len(argv) == 1 && !cmd.Flag("machinepool").Changed {
+			machinePoolID = argv[0]
+		}
+		if machinePoolID == "" {
+			return fmt.Errorf("Machine pool is required. Specify it as an argument or use the --machinepool flag")
+		}
+		err := options.Validate(conditions)
+		if err != nil {
+			return err
+		}
+
+		clusterKey := r.GetClusterKey()
+		cluster := r.FetchCluster()
+		target := fmt.Sprintf("machine pool '%s' of cluster '%s' to be %s", machinePoolID, clusterKey, options.For)
+
+		if ocm.IsHyperShiftCluster(cluster) {
+			_, exists, err := r.OCMClient.GetNodePool(cluster.ID(), machinePoolID)
+			if err != nil {
+				return fmt.Errorf("Failed to get machine pool '%s' of cluster '%s': %v", machinePoolID, clusterKey, err)
+			}
+			if !exists {
+				return notFound(r, options, machinePoolID, clusterKey)
+			}
+			waiter := wait.NewWaiter(r.Reporter, &options.Options, target,
+				nodePoolCondition(options.For), describeNodePool(machinePoolID))
+			_, err = r.OCMClient.PollNodePool(cluster.ID(), machinePoolID, options.Interval, options.Timeout,
+				waiter.Done)
+			err = waiter.Result(err)
+			if err != nil {
+				return err
+			}
+		} else {
+			if options.For == conditionReady {
+				return fmt.Errorf("Waiting for machine pool '%s' to be ready is only supported for "+
+					"Hosted Control Plane clusters", machinePoolID)
+			}
+			_, exists, err := r.OCMClient.GetMachinePool(cluster.ID(), machinePoolID)
+			if err != nil {
+				return fmt.Errorf("Failed to get machine pool '%s' of cluster '%s': %v", machinePoolID, clusterKey, err)
+			}
+			if !exists {
+				return notFound(r, options, machinePoolID, clusterKey)
+			}
+			waiter := wait.NewWaiter(r.Reporter, &options.Options, target,
+				func(machinePool *cmv1.MachinePool) (bool, error) {
+					return machinePool == nil, nil
+				},
+				func(machinePool *cmv1.MachinePool) string {
+					if machinePool == nil {
+						return fmt.Sprintf("Machine pool '%s' doesn't exist", machinePoolID)
+					}
+					return fmt.Sprintf("Machine pool '%s' has %d replicas", machinePoolID, machinePool.Replicas())
+				})
+			_, err = r.OCMClient.PollMachinePool(cluster.ID(), machinePoolID, options.Interval, options.Timeout,
+				waiter.Done)
+			err = waiter.Result(err)
+			if err != nil {
+				return err
+			}
+		}
+
+		r.Reporter.Infof("Machine pool '%s' of cluster '%s' is %s", machinePoolID, clusterKey, options.For)
+		return nil
+	}
+}
+
+func notFound(r *rosa.Runtime, options *waitOptions, machinePoolID string, clusterKey string) error {
+	if options.For == conditionDeleted {
+		r.Reporter.Infof("Machine pool '%s' doesn't exist for cluster '%s'", machinePoolID, clusterKey)
+		return nil
+	}
+	return fmt.Errorf("Machine pool '%s' does not exist for cluster '%s'", machinePoolID, clusterKey)
+}
+
+func nodePoolCondition(name string) wait.Condition[*cmv1.NodePool] {
+	return func(nodePool *cmv1.NodePool) (bool, error) {
+		if nodePool == nil {
+			if name == conditionDeleted {
+				return true, nil
+			}
+			return false, fmt.Errorf("the machine pool has been deleted")
+		}
+		if name == conditionReady {
+			return hasDesiredReplicas(nodePool), nil
+		}
+		return false, nil
+	}
+}
+
+// hasDesiredReplicas checks if the current replicas of the node pool are the requested ones, or
+// are within the limits when autoscaling is enabled.
+func hasDesiredReplicas(nodePool *cmv1.NodePool) bool {
+	current := nodePool.Status().CurrentReplicas()
+	if autoscaling, ok := nodePool.GetAutoscaling(); ok {
+		return current >= autoscaling.MinReplica() && current <= autoscaling.MaxReplica()
+	}
+	return current == nodePool.Replicas()
+}
+
+func describeNodePool(machinePoolID string) func(*cmv1.NodePool) string {
+	return func(nodePool *cmv1.NodePool) string {
+		if nodePool == nil {
+			return fmt.Sprintf("Machine pool '%s' doesn't exist", machinePoolID)
+		}
+		var status string
+		if autoscaling, ok := nodePool.GetAutoscaling(); ok {
+			status = fmt.Sprintf("Machine pool '%s' has %d replicas, autoscaling between %d and %d",
+				machinePoolID, nodePool.Status().CurrentReplicas(), autoscaling.MinReplica(), autoscaling.MaxReplica())
+		} else {
+			status = fmt.Sprintf("Machine pool '%s' has %d of %d replicas",
+				machinePoolID, nodePool.Status().CurrentReplicas(), nodePool.Replicas())
+		}
+		if message := nodePool.Status().Message(); message != "" {
+			status = fmt.Sprintf("%s: %s", status, message)
+		}
+		return status
+	}
+}
@@ -0,0 +1,61 @@
+package machinepool
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Wait machine pool", func() {
+	nodePool := func(replicas int, current int, autoscaling *cmv1.NodePoolAutoscalingBuilder) *cmv1.NodePool {
+		return test.MockNodePool(func(n *cmv1.NodePoolBuilder) {
+			n.ID("workers").Status(cmv1.NewNodePoolStatus().CurrentReplicas(current))
+			if autoscaling != nil {
+				n.Autoscaling(autoscaling)
+			} else {
+				n.Replicas(replicas)
+			}
+		})
+	}
+
+	It("Creates the command correctly", func() {
+		cmd := NewWaitMachinePoolCommand()
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Aliases).To(ContainElement(alias))
+		for _, flag := range []string{"cluster", "machinepool", "for", "interval", "timeout"} {
+			Expect(cmd.Flags().Lookup(flag)).NotTo(BeNil())
+		}
+	})
+
+	DescribeTable("Checks if the node pool has the desired replicas",
+		func(pool *cmv1.NodePool, expected bool) {
+			Expect(hasDesiredReplicas(pool)).To(Equal(expected))
+		},
+		Entry("scaling up", nodePool(3, 1, nil), false),
+		Entry("scaled", nodePool(3, 3, nil), true),
+		Entry("below the autoscaling limits", nodePool(0, 1, cmv1.NewNodePoolAutoscaling().MinReplica(2).MaxReplica(4)),
+			false),
+		Entry("within the autoscaling limits", nodePool(0, 3, cmv1.NewNodePoolAutoscaling().MinReplica(2).MaxReplica(4)),
+			true),
+	)
+
+	It("Fails waiting for a deleted node pool to be ready", func() {
+		met, err := nodePoolCondition(conditionReady)(nil)
+		Expect(met).To(BeFalse())
+		Expect(err).To(MatchError("the machine pool has been deleted"))
+
+		met, err = nodePoolCondition(conditionDeleted)(nil)
+		Expect(met).To(BeTrue())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Describes the replicas of the node pool", func() {
+		describe := describeNodePool("workers")
+		Expect(describe(nodePool(3, 1, nil))).To(Equal("Machine pool 'workers' has 1 of 3 replicas"))
+		Expect(describe(nodePool(0, 3, cmv1.NewNodePoolAutoscaling().MinReplica(2).MaxReplica(4)))).To(
+			Equal("Machine pool 'workers' has 3 replicas, autoscaling between 2 and 4"))
+		Expect(describe(nil)).To(Equal("Machine pool 'workers' doesn't exist"))
+	})
+})
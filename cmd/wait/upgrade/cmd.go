@@ -0,0 +1,219 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrade
+
+import (
+	"context"
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/wait"
+)
+
+const (
+	use   = "upgrade"
+	short = "Wait for a scheduled upgrade to reach a condition"
+	long  = "Wait until the scheduled upgrade of a cluster, or of a machine pool of a Hosted Control Plane " +
+		"cluster, has started or completed."
+	example = `  # Wait for the scheduled upgrade of a cluster named "mycluster" to complete
+  rosa wait upgrade --cluster=mycluster --for=completed --timeout=2h
+
+  # Wait for the scheduled upgrade of machine pool "mymachinepool" to start
+  rosa wait upgrade --cluster=mycluster --machinepool=mymachinepool --for=started`
+)
+
+const (
+	conditionStarted   = "started"
+	conditionCompleted = "completed"
+)
+
+var conditions = []string{conditionStarted, conditionCompleted}
+
+type waitOptions struct {
+	wait.Options
+	machinepool string
+}
+
+func NewWaitUpgradeCommand() *cobra.Command {
+	options := &waitOptions{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), WaitUpgradeRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(
+		&options.machinepool,
+		"machinepool",
+		"",
+		"Machine pool of a Hosted Control Plane cluster whose upgrade to wait for. "+
+			"When not given the upgrade of the cluster is used.",
+	)
+	ocm.AddClusterFlag(cmd)
+	wait.AddFlags(cmd, &options.Options, conditions)
+	return cmd
+}
+
+func WaitUpgradeRunner(options *waitOptions) rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		err := options.Validate(conditions)
+		if err != nil {
+			return err
+		}
+
+		clusterKey := r.GetClusterKey()
+		cluster := r.FetchCluster()
+		isHypershift := ocm.IsHyperShiftCluster(cluster)
+		if options.machinepool != "" && !isHypershift {
+			return fmt.Errorf("The '--machinepool' flag is only supported for Hosted Control Plane clusters")
+		}
+
+		// The upgrade policy is removed once the upgrade ends, so in that case the version of the
+		// cluster or machine pool tells if it was done or cancelled.
+		var version string
+		clusterUpgraded := func() (bool, error) {
+			current, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+			if err != nil {
+				return false, err
+			}
+			return current.Version().RawID() == version, nil
+		}
+
+		switch {
+		case options.machinepool != "":
+			_, policy, err := r.OCMClient.GetHypershiftNodePoolUpgrade(cluster.ID(), clusterKey, options.machinepool)
+			if err != nil {
+				return err
+			}
+			if policy == nil {
+				return fmt.Errorf("There is no scheduled upgrade for machine pool '%s' of cluster '%s'",
+					options.machinepool, clusterKey)
+			}
+			version = policy.Version()
+			waiter := newWaiter(r, options,
+				fmt.Sprintf("the upgrade of machine pool '%s' to version '%s' to be %s",
+					options.machinepool, version, options.For),
+				func() (bool, error) {
+					nodePool, exists, err := r.OCMClient.GetNodePool(cluster.ID(), options.machinepool)
+					if err != nil || !exists {
+						return false, err
+					}
+					return nodePool.Version().RawID() == version, nil
+				})
+			_, err = r.OCMClient.PollNodePoolUpgradePolicy(cluster.ID(), options.machinepool, policy.ID(),
+				options.Interval, options.Timeout, func(policy *cmv1.NodePoolUpgradePolicy) bool {
+					return waiter.Done(policy.State())
+				})
+			err = waiter.Result(err)
+			if err != nil {
+				return err
+			}
+		case isHypershift:
+			policy, err := r.OCMClient.GetControlPlaneScheduledUpgrade(cluster.ID())
+			if err != nil {
+				return fmt.Errorf("Failed to get scheduled upgrade for cluster '%s': %v", clusterKey, err)
+			}
+			if policy == nil {
+				return fmt.Errorf("There is no scheduled upgrade for cluster '%s'", clusterKey)
+			}
+			version = policy.Version()
+			waiter := newWaiter(r, options,
+				fmt.Sprintf("the upgrade of cluster '%s' to version '%s' to be %s", clusterKey, version, options.For),
+				clusterUpgraded)
+			_, err = r.OCMClient.PollControlPlaneUpgradePolicy(cluster.ID(), policy.ID(),
+				options.Interval, options.Timeout, func(policy *cmv1.ControlPlaneUpgradePolicy) bool {
+					return waiter.Done(policy.State())
+				})
+			err = waiter.Result(err)
+			if err != nil {
+				return err
+			}
+		default:
+			policy, _, err := r.OCMClient.GetScheduledUpgrade(cluster.ID())
+			if err != nil {
+				return fmt.Errorf("Failed to get scheduled upgrade for cluster '%s': %v", clusterKey, err)
+			}
+			if policy == nil {
+				return fmt.Errorf("There is no scheduled upgrade for cluster '%s'", clusterKey)
+			}
+			version = policy.Version()
+			waiter := newWaiter(r, options,
+				fmt.Sprintf("the upgrade of cluster '%s' to version '%s' to be %s", clusterKey, version, options.For),
+				clusterUpgraded)
+			_, err = r.OCMClient.PollUpgradePolicyState(cluster.ID(), policy.ID(),
+				options.Interval, options.Timeout, waiter.Done)
+			err = waiter.Result(err)
+			if err != nil {
+				return err
+			}
+		}
+
+		r.Reporter.Infof("Upgrade to version '%s' is %s", version, options.For)
+		return nil
+	}
+}
+
+func newWaiter(r *rosa.Runtime, options *waitOptions, target string,
+	upgraded func() (bool, error)) *wait.Waiter[*cmv1.UpgradePolicyState] {
+	return wait.NewWaiter(r.Reporter, &options.Options, target,
+		func(state *cmv1.UpgradePolicyState) (bool, error) {
+			if state == nil {
+				done, err := upgraded()
+				if err != nil {
+					// Keep polling, the error may be transient
+					r.Reporter.Debugf("Failed to check if the upgrade is done: %v", err)
+					return false, nil
+				}
+				if !done {
+					return false, fmt.Errorf("the upgrade was cancelled")
+				}
+				return true, nil
+			}
+			return condition(options.For, state)
+		},
+		func(state *cmv1.UpgradePolicyState) string {
+			if state == nil {
+				return "Upgrade is no longer scheduled"
+			}
+			if description := state.Description(); description != "" {
+				return fmt.Sprintf("Upgrade is %s: %s", state.Value(), description)
+			}
+			return fmt.Sprintf("Upgrade is %s", state.Value())
+		})
+}
+
+func condition(name string, state *cmv1.UpgradePolicyState) (bool, error) {
+	switch state.Value() {
+	case cmv1.UpgradePolicyStateValueFailed:
+		return false, fmt.Errorf("the upgrade failed")
+	case cmv1.UpgradePolicyStateValueCancelled:
+		return false, fmt.Errorf("the upgrade was cancelled")
+	case cmv1.UpgradePolicyStateValueCompleted:
+		return true, nil
+	case cmv1.UpgradePolicyStateValueStarted:
+		return name == conditionStarted, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,81 @@
+package upgrade
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/wait"
+)
+
+var _ = Describe("Wait upgrade", func() {
+	state := func(value cmv1.UpgradePolicyStateValue) *cmv1.UpgradePolicyState {
+		result, err := cmv1.NewUpgradePolicyState().Value(value).Build()
+		Expect(err).NotTo(HaveOccurred())
+		return result
+	}
+
+	It("Creates the command correctly", func() {
+		cmd := NewWaitUpgradeCommand()
+		Expect(cmd.Use).To(Equal(use))
+		for _, flag := range []string{"cluster", "machinepool", "for", "interval", "timeout"} {
+			Expect(cmd.Flags().Lookup(flag)).NotTo(BeNil())
+		}
+	})
+
+	DescribeTable("Evaluates the state of the upgrade",
+		func(name string, value cmv1.UpgradePolicyStateValue, met bool, failure string) {
+			ok, err := condition(name, state(value))
+			Expect(ok).To(Equal(met))
+			if failure == "" {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(failure))
+			}
+		},
+		Entry("scheduled", conditionStarted, cmv1.UpgradePolicyStateValueScheduled, false, ""),
+		Entry("started", conditionStarted, cmv1.UpgradePolicyStateValueStarted, true, ""),
+		Entry("started but not completed", conditionCompleted, cmv1.UpgradePolicyStateValueStarted, false, ""),
+		Entry("completed", conditionCompleted, cmv1.UpgradePolicyStateValueCompleted, true, ""),
+		Entry("failed", conditionCompleted, cmv1.UpgradePolicyStateValueFailed, false, "the upgrade failed"),
+		Entry("cancelled", conditionStarted, cmv1.UpgradePolicyStateValueCancelled, false,
+			"the upgrade was cancelled"),
+	)
+
+	Context("When the upgrade policy is removed", func() {
+		var options *waitOptions
+
+		BeforeEach(func() {
+			options = &waitOptions{
+				Options: wait.Options{For: conditionCompleted, Interval: time.Second, Timeout: time.Minute},
+			}
+		})
+
+		It("Succeeds if the version was upgraded", func() {
+			waiter := newWaiter(rosa.NewRuntime(), options, "the upgrade", func() (bool, error) {
+				return true, nil
+			})
+			Expect(waiter.Done(nil)).To(BeTrue())
+			Expect(waiter.Result(nil)).To(Succeed())
+		})
+
+		It("Fails if the version wasn't upgraded", func() {
+			waiter := newWaiter(rosa.NewRuntime(), options, "the upgrade", func() (bool, error) {
+				return false, nil
+			})
+			Expect(waiter.Done(nil)).To(BeTrue())
+			Expect(waiter.Result(nil)).To(MatchError("Stopped waiting for the upgrade: the upgrade was cancelled"))
+		})
+
+		It("Keeps waiting if the version can't be checked", func() {
+			waiter := newWaiter(rosa.NewRuntime(), options, "the upgrade", func() (bool, error) {
+				return false, errors.New("connection refused")
+			})
+			Expect(waiter.Done(nil)).To(BeFalse())
+		})
+	})
+})
@@ -127,6 +127,7 @@ func runWithRuntime(r *rosa.Runtime) error {
 		"AWS Default Region":    awsRegion,
 		"AWS ARN":               r.Creator.ARN,
 		"OCM API":               cfg.URL,
+		"OCM Context":           activeContext(cfg),
 		"OCM Account ID":        account.ID(),
 		"OCM Account Name":      fmt.Sprintf("%s %s", account.FirstName(), account.LastName()),
 		"OCM Account Username":  account.Username(),
@@ -158,6 +159,14 @@ func runWithRuntime(r *rosa.Runtime) error {
 	return nil
 }
 
+// activeContext returns the name of the login context the command is running with
+func activeContext(cfg *config.Config) string {
+	if name := config.SelectedContext(); name != "" {
+		return name
+	}
+	return cfg.ActiveContext()
+}
+
 func getAccountDataFromToken(cfg *config.Config) (*amsv1.Account, error) {
 	firstName, err := cfg.GetData("first_name")
 	if err != nil {
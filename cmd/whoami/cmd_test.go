@@ -87,6 +87,8 @@ var _ = Describe("whoami command", func() {
 		Expect(stdout).To(ContainSubstring("AWS Account ID:"))
 		Expect(stdout).To(ContainSubstring("OCM Account Username:"))
 		Expect(stdout).To(ContainSubstring("testuser"))
+		Expect(stdout).To(ContainSubstring("OCM Context:"))
+		Expect(stdout).To(ContainSubstring(config.DefaultContext))
 	})
 
 	It("Displays account information in JSON mode", func() {
@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package access checks the OCM permissions of the current user with self access reviews, so that
+// the commands fail before doing anything instead of halfway through.
+package access
+
+import (
+	"fmt"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/clierror"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+// Verbs are the actions that can be checked
+var Verbs = []string{
+	ocm.ActionGet,
+	ocm.ActionList,
+	ocm.ActionCreate,
+	ocm.ActionUpdate,
+	ocm.ActionDelete,
+}
+
+// Resources are the names of the resources that can be checked
+var Resources = []string{"clusters", "machinepools", "idps", "addons"}
+
+// resourceTypes are the OCM types of the resources that can be checked, by name and alias
+var resourceTypes = map[string]string{
+	"clusters":      ocm.ClusterResourceType,
+	"cluster":       ocm.ClusterResourceType,
+	"machinepools":  ocm.MachinePoolResourceType,
+	"machinepool":   ocm.MachinePoolResourceType,
+	"machine-pools": ocm.MachinePoolResourceType,
+	"machine-pool":  ocm.MachinePoolResourceType,
+	"idps":          ocm.IdpResourceType,
+	"idp":           ocm.IdpResourceType,
+	"addons":        ocm.AddOnInstallationResourceType,
+	"addon":         ocm.AddOnInstallationResourceType,
+}
+
+// descriptions are the names of the resource types used in the messages
+var descriptions = map[string]string{
+	ocm.ClusterResourceType:           "clusters",
+	ocm.MachinePoolResourceType:       "machine pools",
+	ocm.IdpResourceType:               "identity providers",
+	ocm.AddOnInstallationResourceType: "add-ons",
+}
+
+// ValidateVerb checks that the verb is one of the actions that can be checked
+func ValidateVerb(verb string) error {
+	for _, valid := range Verbs {
+		if verb == valid {
+			return nil
+		}
+	}
+	return fmt.Errorf("Invalid verb '%s', should be one of '%s'", verb, strings.Join(Verbs, "', '"))
+}
+
+// ResourceType returns the OCM type of the resource with the given name or alias
+func ResourceType(name string) (string, error) {
+	resourceType, ok := resourceTypes[strings.ToLower(name)]
+	if !ok {
+		return "", fmt.Errorf("Invalid resource '%s', should be one of '%s'", name,
+			strings.Join(Resources, "', '"))
+	}
+	return resourceType, nil
+}
+
+// Result is the outcome of the review of an action
+type Result struct {
+	Allowed bool
+	Reason  string
+
+	// Description is the action on the resources of the review, for example "create machine pools
+	// in cluster 'mycluster'"
+	Description string
+}
+
+// Err returns the error that explains that the action isn't allowed
+func (r *Result) Err() error {
+	reason := ""
+	if r.Reason != "" {
+		reason = fmt.Sprintf(": %s", r.Reason)
+	}
+	return clierror.New(clierror.Unauthorized, fmt.Errorf(
+		"You are not allowed to %s%s. Ask an administrator of your organization for an OCM role "+
+			"that allows it", r.Description, reason))
+}
+
+// Review checks whether the current user is allowed to perform the action on the resources of the
+// cluster, or of the current organization when there is no cluster.
+func Review(client *ocm.Client, action string, resourceType string, cluster *cmv1.Cluster) (*Result, error) {
+	review := ocm.AccessReview{
+		Action:       action,
+		ResourceType: resourceType,
+		Cluster:      cluster,
+	}
+	var description string
+	switch {
+	case cluster != nil && resourceType == ocm.ClusterResourceType:
+		description = fmt.Sprintf("%s cluster '%s'", action, cluster.Name())
+	case cluster != nil:
+		description = fmt.Sprintf("%s %s in cluster '%s'", action, descriptions[resourceType], cluster.Name())
+	default:
+		account, err := client.GetCurrentAccount()
+		if err != nil {
+			return nil, err
+		}
+		review.OrganizationID = account.Organization().ID()
+		description = fmt.Sprintf("%s %s in organization '%s'", action, descriptions[resourceType],
+			account.Organization().Name())
+	}
+	allowed, reason, err := client.SelfAccessReview(review)
+	if err != nil {
+		return nil, err
+	}
+	return &Result{
+		Allowed:     allowed,
+		Reason:      reason,
+		Description: description,
+	}, nil
+}
+
+// Preflight fails when the current user isn't allowed to perform the action. A review that can't
+// be done doesn't fail, the command then finds out about the missing permissions the usual way.
+func Preflight(r *rosa.Runtime, action string, resourceType string, cluster *cmv1.Cluster) error {
+	result, err := Review(r.OCMClient, action, resourceType, cluster)
+	if err != nil {
+		r.Reporter.Debugf("Failed to check the permission to %s %s: %v", action, descriptions[resourceType], err)
+		return nil
+	}
+	if !result.Allowed {
+		return result.Err()
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package access
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Access", func() {
+	DescribeTable("ResourceType returns the OCM type of the resources and their aliases",
+		func(name string, expected string) {
+			resourceType, err := ResourceType(name)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resourceType).To(Equal(expected))
+		},
+		Entry("clusters", "clusters", ocm.ClusterResourceType),
+		Entry("machine pool alias", "machine-pool", ocm.MachinePoolResourceType),
+		Entry("upper case", "IDPs", ocm.IdpResourceType),
+		Entry("add-ons", "addon", ocm.AddOnInstallationResourceType),
+	)
+
+	Context("Preflight", func() {
+		var (
+			t       *TestingRuntime
+			cluster *cmv1.Cluster
+		)
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			cluster = MockCluster(nil)
+		})
+
+		It("passes when the action is allowed", func() {
+			Expect(Preflight(t.RosaRuntime, ocm.ActionUpdate, ocm.ClusterResourceType, cluster)).To(Succeed())
+		})
+
+		It("fails when the action isn't allowed", func() {
+			t.ApiServer.RouteToHandler(http.MethodPost, SelfAccessReviewPath,
+				RespondWithJSON(http.StatusOK, `{"allowed": false, "reason": "missing role ClusterEditor"}`))
+
+			err := Preflight(t.RosaRuntime, ocm.ActionUpdate, ocm.ClusterResourceType, cluster)
+			Expect(err).To(MatchError("You are not allowed to update cluster 'cluster': " +
+				"missing role ClusterEditor. Ask an administrator of your organization for an OCM role " +
+				"that allows it"))
+		})
+
+		It("passes when the review can't be done", func() {
+			t.ApiServer.RouteToHandler(http.MethodPost, SelfAccessReviewPath,
+				RespondWithJSON(http.StatusServiceUnavailable, `{"kind": "Error", "reason": "unavailable"}`))
+
+			Expect(Preflight(t.RosaRuntime, ocm.ActionUpdate, ocm.ClusterResourceType, cluster)).To(Succeed())
+		})
+	})
+})
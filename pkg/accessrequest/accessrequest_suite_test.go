@@ -0,0 +1,13 @@
+package accessrequest
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAccessRequest(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Access Request Suite")
+}
@@ -0,0 +1,131 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accessrequest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/accesstransparency/v1"
+)
+
+// HookTimeout bounds the time a single hook can take, so that a slow receiver doesn't delay the
+// notification of the following Access Requests
+const HookTimeout = 30 * time.Second
+
+// Hook is notified of every new pending Access Request.
+type Hook interface {
+	Fire(ctx context.Context, accessRequest *v1.AccessRequest) error
+	String() string
+}
+
+// WebhookHook posts the Access Request, in the same JSON format used by the OCM API, to a URL.
+type WebhookHook struct {
+	URL    string
+	Client *http.Client
+}
+
+func (h *WebhookHook) Fire(ctx context.Context, accessRequest *v1.AccessRequest) error {
+	body, err := marshal(accessRequest)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, HookTimeout)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	client := h.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, response.Body)
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("webhook '%s' responded with status %d", h.URL, response.StatusCode)
+	}
+	return nil
+}
+
+func (h *WebhookHook) String() string {
+	return fmt.Sprintf("webhook '%s'", h.URL)
+}
+
+// CommandHook runs a shell command. The Access Request is written to the standard input of the
+// command in JSON format and its main attributes are available in environment variables.
+type CommandHook struct {
+	Command string
+	Stdout  io.Writer
+	Stderr  io.Writer
+}
+
+func (h *CommandHook) Fire(ctx context.Context, accessRequest *v1.AccessRequest) error {
+	body, err := marshal(accessRequest)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, HookTimeout)
+	defer cancel()
+	command := exec.CommandContext(ctx, "sh", "-c", h.Command)
+	command.Env = append(os.Environ(), Environment(accessRequest)...)
+	command.Stdin = bytes.NewReader(body)
+	command.Stdout = h.Stdout
+	command.Stderr = h.Stderr
+	err = command.Run()
+	if err != nil {
+		return fmt.Errorf("command '%s' failed: %v", h.Command, err)
+	}
+	return nil
+}
+
+func (h *CommandHook) String() string {
+	return fmt.Sprintf("command '%s'", h.Command)
+}
+
+// Environment returns the environment variables that describe the Access Request to a command hook
+func Environment(accessRequest *v1.AccessRequest) []string {
+	return []string{
+		"ROSA_ACCESS_REQUEST_ID=" + accessRequest.ID(),
+		"ROSA_ACCESS_REQUEST_CLUSTER_ID=" + accessRequest.ClusterId(),
+		"ROSA_ACCESS_REQUEST_SUBSCRIPTION_ID=" + accessRequest.SubscriptionId(),
+		"ROSA_ACCESS_REQUEST_REQUESTED_BY=" + accessRequest.RequestedBy(),
+		"ROSA_ACCESS_REQUEST_JUSTIFICATION=" + accessRequest.Justification(),
+		"ROSA_ACCESS_REQUEST_DEADLINE_AT=" + accessRequest.DeadlineAt().Format(time.RFC3339),
+	}
+}
+
+func marshal(accessRequest *v1.AccessRequest) ([]byte, error) {
+	var body strings.Builder
+	err := v1.MarshalAccessRequest(accessRequest, &body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Access Request '%s': %v", accessRequest.ID(), err)
+	}
+	return []byte(body.String()), nil
+}
@@ -0,0 +1,72 @@
+package accessrequest
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	v1 "github.com/openshift-online/ocm-sdk-go/accesstransparency/v1"
+)
+
+var _ = Describe("Hooks", func() {
+	var accessRequest *v1.AccessRequest
+
+	BeforeEach(func() {
+		var err error
+		accessRequest, err = v1.NewAccessRequest().
+			ID("request-id").
+			ClusterId("cluster-id").
+			RequestedBy("jdoe").
+			Status(v1.NewAccessRequestStatus().State(v1.AccessRequestStatePending)).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("WebhookHook", func() {
+		var server *ghttp.Server
+
+		BeforeEach(func() {
+			server = ghttp.NewServer()
+			DeferCleanup(server.Close)
+		})
+
+		It("posts the Access Request", func() {
+			server.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/hook"),
+				ghttp.VerifyContentType("application/json"),
+				ghttp.VerifyJSON(`{"kind": "AccessRequest", "id": "request-id", "cluster_id": "cluster-id",
+					"requested_by": "jdoe", "status": {"state": "Pending"}}`),
+				ghttp.RespondWith(http.StatusNoContent, nil),
+			))
+			hook := &WebhookHook{URL: server.URL() + "/hook"}
+			Expect(hook.Fire(context.Background(), accessRequest)).To(Succeed())
+		})
+
+		It("fails when the webhook doesn't accept the request", func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusBadGateway, nil))
+			hook := &WebhookHook{URL: server.URL()}
+			Expect(hook.Fire(context.Background(), accessRequest)).To(MatchError(ContainSubstring("status 502")))
+		})
+	})
+
+	Context("CommandHook", func() {
+		It("passes the Access Request in the environment and the standard input", func() {
+			stdout := &bytes.Buffer{}
+			hook := &CommandHook{
+				Command: `echo "$ROSA_ACCESS_REQUEST_ID $ROSA_ACCESS_REQUEST_REQUESTED_BY"; cat`,
+				Stdout:  stdout,
+			}
+			Expect(hook.Fire(context.Background(), accessRequest)).To(Succeed())
+			Expect(stdout.String()).To(HavePrefix("request-id jdoe\n"))
+			Expect(stdout.String()).To(ContainSubstring(`"cluster_id": "cluster-id"`))
+		})
+
+		It("fails when the command fails", func() {
+			hook := &CommandHook{Command: "exit 3"}
+			Expect(hook.Fire(context.Background(), accessRequest)).To(MatchError(ContainSubstring("exit status 3")))
+		})
+	})
+})
@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package accessrequest contains the watcher used by 'rosa list access-request --watch' to
+// notify about new pending Access Requests before they expire.
+package accessrequest
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/accesstransparency/v1"
+)
+
+// DefaultInterval is the time between two consecutive listings of the pending Access Requests
+const DefaultInterval = 30 * time.Second
+
+// Watcher repeatedly lists the pending Access Requests and notifies about the ones that it didn't
+// see before. Access Requests that are already pending when the watcher starts are notified in the
+// first poll, as they can also expire.
+type Watcher struct {
+	// List returns the pending Access Requests
+	List     func() ([]*v1.AccessRequest, error)
+	Interval time.Duration
+	Hooks    []Hook
+	// OnNew is called for every new pending Access Request before its hooks fire
+	OnNew func(accessRequest *v1.AccessRequest)
+	// OnHookError is called for every hook that fails, failed hooks are not retried
+	OnHookError func(accessRequest *v1.AccessRequest, hook Hook, err error)
+	// OnListError is called when listing the Access Requests fails, the watcher keeps polling
+	OnListError func(err error)
+
+	seen map[string]bool
+}
+
+// Watch polls until the context is done
+func (w *Watcher) Watch(ctx context.Context) error {
+	interval := w.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		_, err := w.Poll(ctx)
+		if err != nil && w.OnListError != nil {
+			w.OnListError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll lists the pending Access Requests once, notifies about the new ones and returns them
+func (w *Watcher) Poll(ctx context.Context) ([]*v1.AccessRequest, error) {
+	if w.seen == nil {
+		w.seen = map[string]bool{}
+	}
+	accessRequests, err := w.List()
+	if err != nil {
+		return nil, err
+	}
+	pending := map[string]bool{}
+	added := []*v1.AccessRequest{}
+	for _, accessRequest := range accessRequests {
+		if accessRequest.Status().State() != v1.AccessRequestStatePending {
+			continue
+		}
+		pending[accessRequest.ID()] = true
+		if w.seen[accessRequest.ID()] {
+			continue
+		}
+		added = append(added, accessRequest)
+		if w.OnNew != nil {
+			w.OnNew(accessRequest)
+		}
+		for _, hook := range w.Hooks {
+			err := hook.Fire(ctx, accessRequest)
+			if err != nil && w.OnHookError != nil {
+				w.OnHookError(accessRequest, hook, err)
+			}
+		}
+	}
+	// Only the Access Requests that are still pending are remembered, so that the set doesn't grow
+	// without bounds in long running watches
+	w.seen = pending
+	return added, nil
+}
@@ -0,0 +1,95 @@
+package accessrequest
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/openshift-online/ocm-sdk-go/accesstransparency/v1"
+)
+
+type recordingHook struct {
+	fired []string
+	err   error
+}
+
+func (h *recordingHook) Fire(_ context.Context, accessRequest *v1.AccessRequest) error {
+	h.fired = append(h.fired, accessRequest.ID())
+	return h.err
+}
+
+func (h *recordingHook) String() string {
+	return "recording hook"
+}
+
+func mockAccessRequest(id string, state v1.AccessRequestState) *v1.AccessRequest {
+	accessRequest, err := v1.NewAccessRequest().ID(id).Status(v1.NewAccessRequestStatus().State(state)).Build()
+	Expect(err).NotTo(HaveOccurred())
+	return accessRequest
+}
+
+var _ = Describe("Watcher", func() {
+	var listed [][]*v1.AccessRequest
+	var hook *recordingHook
+	var watcher *Watcher
+
+	BeforeEach(func() {
+		listed = nil
+		hook = &recordingHook{}
+		watcher = &Watcher{
+			List: func() ([]*v1.AccessRequest, error) {
+				if len(listed) == 0 {
+					return nil, fmt.Errorf("nothing to list")
+				}
+				result := listed[0]
+				listed = listed[1:]
+				return result, nil
+			},
+			Hooks: []Hook{hook},
+		}
+	})
+
+	It("notifies every pending Access Request once", func() {
+		listed = [][]*v1.AccessRequest{
+			{mockAccessRequest("a", v1.AccessRequestStatePending), mockAccessRequest("b", v1.AccessRequestStateApproved)},
+			{mockAccessRequest("a", v1.AccessRequestStatePending), mockAccessRequest("c", v1.AccessRequestStatePending)},
+		}
+		added, err := watcher.Poll(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(added).To(HaveLen(1))
+		added, err = watcher.Poll(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(added).To(HaveLen(1))
+		Expect(added[0].ID()).To(Equal("c"))
+		Expect(hook.fired).To(Equal([]string{"a", "c"}))
+	})
+
+	It("reports the hooks that fail and keeps notifying", func() {
+		hook.err = fmt.Errorf("unreachable")
+		failed := []string{}
+		watcher.OnHookError = func(accessRequest *v1.AccessRequest, hook Hook, err error) {
+			failed = append(failed, fmt.Sprintf("%s: %s: %v", accessRequest.ID(), hook, err))
+		}
+		listed = [][]*v1.AccessRequest{
+			{mockAccessRequest("a", v1.AccessRequestStatePending), mockAccessRequest("b", v1.AccessRequestStatePending)},
+		}
+		_, err := watcher.Poll(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(failed).To(Equal([]string{"a: recording hook: unreachable", "b: recording hook: unreachable"}))
+	})
+
+	It("keeps polling after a failure to list until the context is done", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		watcher.Interval = 1
+		failures := 0
+		watcher.OnListError = func(err error) {
+			failures++
+			if failures == 3 {
+				cancel()
+			}
+		}
+		Expect(watcher.Watch(ctx)).To(MatchError(context.Canceled))
+		Expect(failures).To(BeNumerically(">=", 3))
+	})
+})
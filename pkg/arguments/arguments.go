@@ -29,6 +29,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/aws/profile"
 	"github.com/openshift/rosa/pkg/aws/region"
+	"github.com/openshift/rosa/pkg/config"
 	"github.com/openshift/rosa/pkg/debug"
 )
 
@@ -298,6 +299,31 @@ func AddDebugFlag(fs *pflag.FlagSet) {
 	debug.AddFlag(fs)
 }
 
+// AddContextFlag adds the '--context' flag to the given set of command line flags.
+func AddContextFlag(fs *pflag.FlagSet) {
+	fs.Var(
+		&contextValue{},
+		"context",
+		"Use a specific OCM login context instead of the active one.",
+	)
+}
+
+// contextValue forwards the value of the '--context' flag to the configuration package.
+type contextValue struct{}
+
+func (v *contextValue) String() string {
+	return config.SelectedContext()
+}
+
+func (v *contextValue) Set(value string) error {
+	config.SetSelectedContext(strings.TrimSpace(value))
+	return nil
+}
+
+func (v *contextValue) Type() string {
+	return "string"
+}
+
 // AddProfileFlag adds the '--profile' flag to the given set of command line flags.
 func AddProfileFlag(fs *pflag.FlagSet) {
 	profile.AddFlag(fs)
@@ -43,6 +43,13 @@ type Ec2ApiClient interface {
 	DescribeInstances(ctx context.Context,
 		params *ec2.DescribeInstancesInput, optFns ...func(*ec2.Options),
 	) (*ec2.DescribeInstancesOutput, error)
+
+	DescribeVolumes(ctx context.Context, params *ec2.DescribeVolumesInput, optFns ...func(*ec2.Options),
+	) (*ec2.DescribeVolumesOutput, error)
+
+	DescribeNetworkInterfaces(ctx context.Context,
+		params *ec2.DescribeNetworkInterfacesInput, optFns ...func(*ec2.Options),
+	) (*ec2.DescribeNetworkInterfacesOutput, error)
 }
 
 // interface guard to ensure that all methods defined in the Ec2ApiClient
@@ -32,6 +32,10 @@ type SecretsManagerApiClient interface {
 		params *secretsmanager.DeleteSecretInput, optFns ...func(*secretsmanager.Options),
 	) (*secretsmanager.DeleteSecretOutput, error)
 
+	ListSecrets(ctx context.Context,
+		params *secretsmanager.ListSecretsInput, optFns ...func(*secretsmanager.Options),
+	) (*secretsmanager.ListSecretsOutput, error)
+
 	CreateSecret(ctx context.Context,
 		params *secretsmanager.CreateSecretInput, optFns ...func(*secretsmanager.Options),
 	) (*secretsmanager.CreateSecretOutput, error)
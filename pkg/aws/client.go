@@ -45,6 +45,7 @@ import (
 	secretsmanagertypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
 	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go"
 	"github.com/aws/smithy-go/middleware"
 	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/go-logr/logr"
@@ -57,6 +58,7 @@ import (
 	"github.com/openshift/rosa/pkg/aws/profile"
 	regionflag "github.com/openshift/rosa/pkg/aws/region"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/fedramp"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/iamserviceaccount"
@@ -120,7 +122,10 @@ type Client interface {
 	GetVPCSubnets(subnetID string) ([]ec2types.Subnet, error)
 	GetVPCPrivateSubnets(subnetID string) ([]ec2types.Subnet, error)
 	FilterVPCsPrivateSubnets(subnets []ec2types.Subnet) ([]ec2types.Subnet, error)
-	ValidateQuota() (bool, error)
+	VerifyQuotas(requirements []*QuotaRequirement, topology string, size float64) []*QuotaReport
+	RequestQuotaIncrease(serviceCode string, quotaCode string, value float64) (string, error)
+	GetServiceQuotaValue(serviceCode string, quotaCode string) (float64, error)
+	GetQuotaUsage() (*QuotaUsage, error)
 	TagUserRegion(username string, region string) error
 	GetClusterRegionTagForUser(username string) (string, error)
 	EnsureRole(reporter reporter.Logger, name string, policy string, permissionsBoundary string,
@@ -134,6 +139,7 @@ type Client interface {
 	AttachRolePolicy(reporter reporter.Logger, roleName string, policyARN string) error
 	CreateOpenIDConnectProvider(issuerURL string, thumbprint string, clusterID string) (string, error)
 	DeleteOpenIDConnectProvider(providerURL string) error
+	GetOpenIDConnectProviderCreateDate(oidcProviderARN string) (time.Time, error)
 	HasOpenIDConnectProvider(issuerURL string, partition string, accountID string) (bool, error)
 	FindRoleARNs(roleType string, version string) ([]string, error)
 	FindRoleARNsClassic(roleType string, version string) ([]string, error)
@@ -213,6 +219,8 @@ type Client interface {
 	PutPublicReadObjectInS3Bucket(bucketName string, body io.ReadSeeker, key string) error
 	CreateSecretInSecretsManager(name string, secret string) (string, error)
 	DeleteSecretInSecretsManager(secretArn string) error
+	ListRedHatManagedS3Buckets() ([]ManagedResource, error)
+	ListRedHatManagedSecrets() ([]ManagedResource, error)
 	ValidateAccountRoleVersionCompatibility(roleName string, roleType string, minVersion string) (bool, error)
 	GetDefaultPolicyDocument(policyArn string) (string, error)
 	GetAccountRoleByArn(roleArn string) (Role, error)
@@ -227,6 +235,7 @@ type Client interface {
 	CreateStackWithParamsTags(ctx context.Context, cfTemplateBody, stackName string,
 		stackParams, stackTags map[string]string) (*string, error)
 	GetCFStack(ctx context.Context, stackName string) (*cftypes.Stack, error)
+	ListCFStacks(ctx context.Context) ([]cftypes.Stack, error)
 	DescribeCFStackResources(ctx context.Context, stackName string) (*[]cftypes.StackResource, error)
 	DeleteCFStack(ctx context.Context, stackName string) error
 	// Service account role filtering (only add the filtering functionality we need)
@@ -272,7 +281,7 @@ func CreateNewClientOrExit(logger *logrus.Logger, reporter reporter.Logger) Clie
 		Build()
 	if err != nil {
 		reporter.Errorf("Failed to create AWS client: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	return awsClient
@@ -1378,6 +1387,85 @@ func (c *awsClient) DeleteSecretInSecretsManager(secretArn string) error {
 	return nil
 }
 
+// ManagedResource is a resource tagged as managed by Red Hat, identified by its name or ARN.
+type ManagedResource struct {
+	ID           string
+	CreationDate time.Time
+}
+
+// ListRedHatManagedS3Buckets returns the S3 buckets of the region of the client that are tagged as
+// managed by Red Hat, like the ones created for unmanaged OIDC configurations.
+func (c *awsClient) ListRedHatManagedS3Buckets() ([]ManagedResource, error) {
+	buckets := []ManagedResource{}
+	var continuationToken *string
+	for {
+		output, err := c.s3Client.ListBuckets(context.Background(),
+			&s3.ListBucketsInput{
+				BucketRegion:      aws.String(c.GetRegion()),
+				ContinuationToken: continuationToken,
+			})
+		if err != nil {
+			return nil, err
+		}
+		for _, bucket := range output.Buckets {
+			tagging, err := c.s3Client.GetBucketTagging(context.Background(),
+				&s3.GetBucketTaggingInput{
+					Bucket: bucket.Name,
+				})
+			if err != nil {
+				var apiErr smithy.APIError
+				if errors.As(err, &apiErr) && apiErr.ErrorCode() == "NoSuchTagSet" {
+					continue
+				}
+				return nil, err
+			}
+			for _, tag := range tagging.TagSet {
+				if aws.ToString(tag.Key) == tags.RedHatManaged && aws.ToString(tag.Value) == tags.True {
+					buckets = append(buckets, ManagedResource{
+						ID:           aws.ToString(bucket.Name),
+						CreationDate: aws.ToTime(bucket.CreationDate),
+					})
+					break
+				}
+			}
+		}
+		if aws.ToString(output.ContinuationToken) == "" {
+			return buckets, nil
+		}
+		continuationToken = output.ContinuationToken
+	}
+}
+
+// ListRedHatManagedSecrets returns the Secrets Manager secrets of the region of the client that are
+// tagged as managed by Red Hat, like the OIDC private key secrets, identified by their ARN.
+func (c *awsClient) ListRedHatManagedSecrets() ([]ManagedResource, error) {
+	secrets := []ManagedResource{}
+	var nextToken *string
+	for {
+		output, err := c.smClient.ListSecrets(context.Background(),
+			&secretsmanager.ListSecretsInput{
+				Filters: []secretsmanagertypes.Filter{{
+					Key:    secretsmanagertypes.FilterNameStringTypeTagKey,
+					Values: []string{tags.RedHatManaged},
+				}},
+				NextToken: nextToken,
+			})
+		if err != nil {
+			return nil, err
+		}
+		for _, secret := range output.SecretList {
+			secrets = append(secrets, ManagedResource{
+				ID:           aws.ToString(secret.ARN),
+				CreationDate: aws.ToTime(secret.CreatedDate),
+			})
+		}
+		if aws.ToString(output.NextToken) == "" {
+			return secrets, nil
+		}
+		nextToken = output.NextToken
+	}
+}
+
 func (c *awsClient) GetSecurityGroupIds(vpcId string) ([]ec2types.SecurityGroup, error) {
 	describeSecurityGroupsInput := &ec2.DescribeSecurityGroupsInput{
 		Filters: []ec2types.Filter{
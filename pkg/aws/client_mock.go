@@ -13,6 +13,7 @@ import (
 	context "context"
 	io "io"
 	reflect "reflect"
+	time "time"
 
 	aws "github.com/aws/aws-sdk-go-v2/aws"
 	types "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
@@ -905,6 +906,21 @@ func (mr *MockClientMockRecorder) GetOpenIDConnectProviderByOidcEndpointUrl(oidc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenIDConnectProviderByOidcEndpointUrl", reflect.TypeOf((*MockClient)(nil).GetOpenIDConnectProviderByOidcEndpointUrl), oidcEndpointUrl)
 }
 
+// GetOpenIDConnectProviderCreateDate mocks base method.
+func (m *MockClient) GetOpenIDConnectProviderCreateDate(oidcProviderARN string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenIDConnectProviderCreateDate", oidcProviderARN)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenIDConnectProviderCreateDate indicates an expected call of GetOpenIDConnectProviderCreateDate.
+func (mr *MockClientMockRecorder) GetOpenIDConnectProviderCreateDate(oidcProviderARN any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenIDConnectProviderCreateDate", reflect.TypeOf((*MockClient)(nil).GetOpenIDConnectProviderCreateDate), oidcProviderARN)
+}
+
 // GetOperatorRoleDefaultPolicy mocks base method.
 func (m *MockClient) GetOperatorRoleDefaultPolicy(roleName string) (string, error) {
 	m.ctrl.T.Helper()
@@ -981,6 +997,21 @@ func (mr *MockClientMockRecorder) GetPolicyDetailsFromRole(role any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyDetailsFromRole", reflect.TypeOf((*MockClient)(nil).GetPolicyDetailsFromRole), role)
 }
 
+// GetQuotaUsage mocks base method.
+func (m *MockClient) GetQuotaUsage() (*QuotaUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuotaUsage")
+	ret0, _ := ret[0].(*QuotaUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuotaUsage indicates an expected call of GetQuotaUsage.
+func (mr *MockClientMockRecorder) GetQuotaUsage() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuotaUsage", reflect.TypeOf((*MockClient)(nil).GetQuotaUsage))
+}
+
 // GetRegion mocks base method.
 func (m *MockClient) GetRegion() string {
 	m.ctrl.T.Helper()
@@ -1072,6 +1103,21 @@ func (mr *MockClientMockRecorder) GetServiceAccountRoleDetails(roleName any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceAccountRoleDetails", reflect.TypeOf((*MockClient)(nil).GetServiceAccountRoleDetails), roleName)
 }
 
+// GetServiceQuotaValue mocks base method.
+func (m *MockClient) GetServiceQuotaValue(serviceCode, quotaCode string) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceQuotaValue", serviceCode, quotaCode)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceQuotaValue indicates an expected call of GetServiceQuotaValue.
+func (mr *MockClientMockRecorder) GetServiceQuotaValue(serviceCode, quotaCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceQuotaValue", reflect.TypeOf((*MockClient)(nil).GetServiceQuotaValue), serviceCode, quotaCode)
+}
+
 // GetSubnetAvailabilityZone mocks base method.
 func (m *MockClient) GetSubnetAvailabilityZone(subnetID string) (string, error) {
 	m.ctrl.T.Helper()
@@ -1372,6 +1418,21 @@ func (mr *MockClientMockRecorder) ListAttachedRolePolicies(roleName any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttachedRolePolicies", reflect.TypeOf((*MockClient)(nil).ListAttachedRolePolicies), roleName)
 }
 
+// ListCFStacks mocks base method.
+func (m *MockClient) ListCFStacks(ctx context.Context) ([]types.Stack, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCFStacks", ctx)
+	ret0, _ := ret[0].([]types.Stack)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCFStacks indicates an expected call of ListCFStacks.
+func (mr *MockClientMockRecorder) ListCFStacks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCFStacks", reflect.TypeOf((*MockClient)(nil).ListCFStacks), ctx)
+}
+
 // ListOCMRoles mocks base method.
 func (m *MockClient) ListOCMRoles() ([]Role, error) {
 	m.ctrl.T.Helper()
@@ -1432,6 +1493,36 @@ func (mr *MockClientMockRecorder) ListPolicyVersions(policyArn any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicyVersions", reflect.TypeOf((*MockClient)(nil).ListPolicyVersions), policyArn)
 }
 
+// ListRedHatManagedS3Buckets mocks base method.
+func (m *MockClient) ListRedHatManagedS3Buckets() ([]ManagedResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRedHatManagedS3Buckets")
+	ret0, _ := ret[0].([]ManagedResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRedHatManagedS3Buckets indicates an expected call of ListRedHatManagedS3Buckets.
+func (mr *MockClientMockRecorder) ListRedHatManagedS3Buckets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRedHatManagedS3Buckets", reflect.TypeOf((*MockClient)(nil).ListRedHatManagedS3Buckets))
+}
+
+// ListRedHatManagedSecrets mocks base method.
+func (m *MockClient) ListRedHatManagedSecrets() ([]ManagedResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRedHatManagedSecrets")
+	ret0, _ := ret[0].([]ManagedResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRedHatManagedSecrets indicates an expected call of ListRedHatManagedSecrets.
+func (mr *MockClientMockRecorder) ListRedHatManagedSecrets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRedHatManagedSecrets", reflect.TypeOf((*MockClient)(nil).ListRedHatManagedSecrets))
+}
+
 // ListServiceAccountRoles mocks base method.
 func (m *MockClient) ListServiceAccountRoles(clusterName string) ([]types1.Role, error) {
 	m.ctrl.T.Helper()
@@ -1509,6 +1600,21 @@ func (mr *MockClientMockRecorder) PutRolePolicy(roleName, policyName, policy any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRolePolicy", reflect.TypeOf((*MockClient)(nil).PutRolePolicy), roleName, policyName, policy)
 }
 
+// RequestQuotaIncrease mocks base method.
+func (m *MockClient) RequestQuotaIncrease(serviceCode, quotaCode string, value float64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestQuotaIncrease", serviceCode, quotaCode, value)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestQuotaIncrease indicates an expected call of RequestQuotaIncrease.
+func (mr *MockClientMockRecorder) RequestQuotaIncrease(serviceCode, quotaCode, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestQuotaIncrease", reflect.TypeOf((*MockClient)(nil).RequestQuotaIncrease), serviceCode, quotaCode, value)
+}
+
 // TagUserRegion mocks base method.
 func (m *MockClient) TagUserRegion(username, region string) error {
 	m.ctrl.T.Helper()
@@ -1609,21 +1715,6 @@ func (mr *MockClientMockRecorder) ValidateOperatorRolesManagedPolicies(cluster,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateOperatorRolesManagedPolicies", reflect.TypeOf((*MockClient)(nil).ValidateOperatorRolesManagedPolicies), cluster, operatorRoles, policies, hostedCPPolicies)
 }
 
-// ValidateQuota mocks base method.
-func (m *MockClient) ValidateQuota() (bool, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateQuota")
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// ValidateQuota indicates an expected call of ValidateQuota.
-func (mr *MockClientMockRecorder) ValidateQuota() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateQuota", reflect.TypeOf((*MockClient)(nil).ValidateQuota))
-}
-
 // ValidateRoleARNAccountIDMatchCallerAccountID mocks base method.
 func (m *MockClient) ValidateRoleARNAccountIDMatchCallerAccountID(roleARN string) error {
 	m.ctrl.T.Helper()
@@ -1667,6 +1758,20 @@ func (mr *MockClientMockRecorder) ValidateSCP(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateSCP", reflect.TypeOf((*MockClient)(nil).ValidateSCP), arg0, arg1)
 }
 
+// VerifyQuotas mocks base method.
+func (m *MockClient) VerifyQuotas(requirements []*QuotaRequirement, topology string, size float64) []*QuotaReport {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyQuotas", requirements, topology, size)
+	ret0, _ := ret[0].([]*QuotaReport)
+	return ret0
+}
+
+// VerifyQuotas indicates an expected call of VerifyQuotas.
+func (mr *MockClientMockRecorder) VerifyQuotas(requirements, topology, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyQuotas", reflect.TypeOf((*MockClient)(nil).VerifyQuotas), requirements, topology, size)
+}
+
 // MockAccessKeyGetter is a mock of AccessKeyGetter interface.
 type MockAccessKeyGetter struct {
 	ctrl     *gomock.Controller
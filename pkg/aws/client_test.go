@@ -3,6 +3,7 @@ package aws
 import (
 	"context"
 	"fmt"
+	"time"
 
 	gomock "go.uber.org/mock/gomock"
 
@@ -670,6 +671,64 @@ var _ = Describe("Client", func() {
 		})
 	})
 
+	Describe("Red Hat managed resources", func() {
+		It("lists the tagged S3 buckets of all the pages", func() {
+			created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+			mockS3API.EXPECT().ListBuckets(gomock.Any(), &s3.ListBucketsInput{BucketRegion: awsSdk.String("")}).
+				Return(&s3.ListBucketsOutput{
+					Buckets: []s3types.Bucket{{
+						Name:         awsSdk.String("mine-oidc-ab1c"),
+						CreationDate: awsSdk.Time(created),
+					}},
+					ContinuationToken: awsSdk.String("next"),
+				}, nil)
+			mockS3API.EXPECT().ListBuckets(gomock.Any(), &s3.ListBucketsInput{
+				BucketRegion:      awsSdk.String(""),
+				ContinuationToken: awsSdk.String("next"),
+			}).Return(&s3.ListBucketsOutput{
+				Buckets: []s3types.Bucket{{Name: awsSdk.String("untagged")}, {Name: awsSdk.String("other")}},
+			}, nil)
+			mockS3API.EXPECT().GetBucketTagging(gomock.Any(), &s3.GetBucketTaggingInput{
+				Bucket: awsSdk.String("mine-oidc-ab1c"),
+			}).Return(&s3.GetBucketTaggingOutput{TagSet: []s3types.Tag{{
+				Key:   awsSdk.String(rosaTags.RedHatManaged),
+				Value: awsSdk.String(rosaTags.True),
+			}}}, nil)
+			mockS3API.EXPECT().GetBucketTagging(gomock.Any(), &s3.GetBucketTaggingInput{
+				Bucket: awsSdk.String("untagged"),
+			}).Return(nil, &smithy.GenericAPIError{Code: "NoSuchTagSet"})
+			mockS3API.EXPECT().GetBucketTagging(gomock.Any(), &s3.GetBucketTaggingInput{
+				Bucket: awsSdk.String("other"),
+			}).Return(&s3.GetBucketTaggingOutput{TagSet: []s3types.Tag{{
+				Key:   awsSdk.String("team"),
+				Value: awsSdk.String("mine"),
+			}}}, nil)
+
+			buckets, err := client.ListRedHatManagedS3Buckets()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(buckets).To(Equal([]ManagedResource{{ID: "mine-oidc-ab1c", CreationDate: created}}))
+		})
+
+		It("lists the secrets with the Red Hat managed tag", func() {
+			created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+			mockSecretsManagerAPI.EXPECT().ListSecrets(gomock.Any(), &secretsmanager.ListSecretsInput{
+				Filters: []secretsmanagertypes.Filter{{
+					Key:    secretsmanagertypes.FilterNameStringTypeTagKey,
+					Values: []string{rosaTags.RedHatManaged},
+				}},
+			}).Return(&secretsmanager.ListSecretsOutput{
+				SecretList: []secretsmanagertypes.SecretListEntry{{
+					ARN:         awsSdk.String("arn:secret"),
+					CreatedDate: awsSdk.Time(created),
+				}},
+			}, nil)
+
+			secrets, err := client.ListRedHatManagedSecrets()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secrets).To(Equal([]ManagedResource{{ID: "arn:secret", CreationDate: created}}))
+		})
+	})
+
 	Context("AvailabilityZoneType", func() {
 
 		zoneName := "us-east-1a"
@@ -171,6 +171,23 @@ func (c *awsClient) GetCFStack(ctx context.Context, stackName string) (*cloudfor
 	return &output.Stacks[0], nil
 }
 
+// ListCFStacks returns all the CloudFormation stacks of the region that haven't been deleted.
+func (c *awsClient) ListCFStacks(ctx context.Context) ([]cloudformationtypes.Stack, error) {
+	stacks := []cloudformationtypes.Stack{}
+	input := &cloudformation.DescribeStacksInput{}
+	for {
+		output, err := c.cfClient.DescribeStacks(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		stacks = append(stacks, output.Stacks...)
+		if output.NextToken == nil {
+			return stacks, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
 func (c *awsClient) DescribeCFStackResources(ctx context.Context, stackName string) (*[]cloudformationtypes.StackResource, error) {
 	output, err := c.cfClient.DescribeStackResources(ctx, &cloudformation.DescribeStackResourcesInput{
 		StackName: aws.String(stackName),
@@ -349,6 +349,45 @@ var _ = Describe("CloudFormation", func() {
 		})
 	})
 
+	Context("ListCFStacks", func() {
+		It("Follows the next token", func() {
+			gomock.InOrder(
+				mockCfAPI.EXPECT().DescribeStacks(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *cloudformation.DescribeStacksInput,
+						_ ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error) {
+						Expect(input.StackName).To(BeNil())
+						Expect(input.NextToken).To(BeNil())
+						return &cloudformation.DescribeStacksOutput{
+							Stacks:    []cloudformationtypes.Stack{{StackName: awsSdk.String("first")}},
+							NextToken: awsSdk.String("token"),
+						}, nil
+					}),
+				mockCfAPI.EXPECT().DescribeStacks(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *cloudformation.DescribeStacksInput,
+						_ ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error) {
+						Expect(*input.NextToken).To(Equal("token"))
+						return &cloudformation.DescribeStacksOutput{
+							Stacks: []cloudformationtypes.Stack{{StackName: awsSdk.String("second")}},
+						}, nil
+					}),
+			)
+
+			result, err := client.ListCFStacks(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(HaveLen(2))
+			Expect(*result[1].StackName).To(Equal("second"))
+		})
+
+		It("Propagates DescribeStacks API error", func() {
+			mockCfAPI.EXPECT().DescribeStacks(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, fmt.Errorf("throttling exception"))
+
+			result, err := client.ListCFStacks(context.Background())
+			Expect(err).To(MatchError("throttling exception"))
+			Expect(result).To(BeNil())
+		})
+	})
+
 	Context("DescribeCFStackResources", func() {
 		stackName := "my-stack"
 
@@ -2,6 +2,7 @@ package commandbuilder
 
 import (
 	"fmt"
+	"net/url"
 	"sort"
 	"strings"
 )
@@ -212,6 +213,46 @@ func createTags(m map[string]string) string {
 	return strings.Join(keys, " ")
 }
 
+// BucketTagging returns the value of the '--tagging' parameter of the 'put-bucket-tagging' command
+// that sets the given tags.
+func BucketTagging(tags map[string]string) string {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	tagSet := make([]string, 0, len(keys))
+	for _, k := range keys {
+		tagSet = append(tagSet, fmt.Sprintf("{Key=%s,Value=%s}", k, tags[k]))
+	}
+	return fmt.Sprintf("'TagSet=[%s]'", strings.Join(tagSet, ","))
+}
+
+// ObjectTagging returns the value of the '--tagging' parameter of the 'put-object' command that
+// sets the given tags, which are encoded as URL query parameters.
+func ObjectTagging(tags map[string]string) string {
+	values := url.Values{}
+	for k, v := range tags {
+		values.Set(k, v)
+	}
+	return fmt.Sprintf("'%s'", values.Encode())
+}
+
+// PublicAccessBlock returns the value of the '--public-access-block-configuration'
+// parameter of the 'put-public-access-block' command that applies the given settings.
+func PublicAccessBlock(settings map[string]bool) string {
+	keys := make([]string, 0, len(settings))
+	for k := range settings {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	values := make([]string, 0, len(keys))
+	for _, k := range keys {
+		values = append(values, fmt.Sprintf("%s=%t", k, settings[k]))
+	}
+	return strings.Join(values, ",")
+}
+
 func JoinCommands(commands []string) string {
 	return strings.Join(commands, "\n\n")
 }
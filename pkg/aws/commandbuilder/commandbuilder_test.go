@@ -91,5 +91,24 @@ var _ = Describe("Commandbuilder", func() {
 				).To(Equal(command))
 			})
 		})
+
+		var _ = Context("when formatting the settings of S3 resources", func() {
+			tags := map[string]string{"team": "a b&c", "red-hat-managed": "true"}
+
+			It("formats the bucket tagging", func() {
+				Expect(BucketTagging(tags)).To(Equal("'TagSet=[{Key=red-hat-managed,Value=true},{Key=team,Value=a b&c}]'"))
+			})
+
+			It("formats the object tagging", func() {
+				Expect(ObjectTagging(tags)).To(Equal("'red-hat-managed=true&team=a+b%26c'"))
+			})
+
+			It("formats the public access block configuration", func() {
+				Expect(PublicAccessBlock(map[string]bool{
+					"IgnorePublicAcls":  true,
+					"BlockPublicPolicy": false,
+				})).To(Equal("BlockPublicPolicy=false,IgnorePublicAcls=true"))
+			})
+		})
 	})
 })
@@ -29,6 +29,7 @@ import (
 	client "github.com/openshift/rosa/pkg/aws/api_interface"
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/constants"
 	"github.com/openshift/rosa/pkg/fedramp"
 	"github.com/openshift/rosa/pkg/helper"
@@ -189,16 +190,16 @@ func GetAWSClientForUserRegion(reporter rprtr.Logger, logger *logrus.Logger,
 	awsRegionInUserConfig, err := GetRegion(arguments.GetRegion())
 	if err != nil {
 		reporter.Errorf("Error getting region: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if awsRegionInUserConfig == "" {
 		reporter.Errorf("AWS Region not set")
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 	if !helper.Contains(supportedRegions, awsRegionInUserConfig) {
 		reporter.Errorf("Unsupported region '%s', available regions: %s",
 			awsRegionInUserConfig, helper.SliceToSortedString(supportedRegions))
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 
 	// Create the AWS client:
@@ -209,7 +210,7 @@ func GetAWSClientForUserRegion(reporter rprtr.Logger, logger *logrus.Logger,
 		Build()
 	if err != nil {
 		reporter.Errorf("Error creating aws client for stack validation: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	regionUsedForInit, err := client.GetClusterRegionTagForUser(AdminUserName)
 	if err != nil || regionUsedForInit == "" {
@@ -220,7 +221,7 @@ func GetAWSClientForUserRegion(reporter rprtr.Logger, logger *logrus.Logger,
 		if !helper.Contains(supportedRegions, regionUsedForInit) {
 			reporter.Errorf("Unsupported region '%s', available regions: %s",
 				regionUsedForInit, helper.SliceToSortedString(supportedRegions))
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		// Create the AWS client with the region used in the init
 		// So we can check for the stack in that region
@@ -231,7 +232,7 @@ func GetAWSClientForUserRegion(reporter rprtr.Logger, logger *logrus.Logger,
 			Build()
 		if err != nil {
 			reporter.Errorf("Error creating aws client for stack validation: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		return awsClient
 	}
@@ -797,16 +798,9 @@ func UpgradeOperatorRolePolicies(
 	path string,
 	cluster *cmv1.Cluster,
 ) error {
-	isSharedVpc := cluster.AWS().PrivateHostedZoneRoleARN() != ""
 	for credrequest, operator := range credRequests {
 		policyARN := GetOperatorPolicyARN(partition, accountID, prefix, operator.Namespace(), operator.Name(), path)
-		filename := GetOperatorPolicyKey(credrequest, cluster.Hypershift().Enabled(), isSharedVpc)
-		policyDetails := GetPolicyDetails(policies, filename)
-		if isSharedVpc {
-			policyDetails = InterpolatePolicyDocument(partition, policyDetails, map[string]string{
-				"shared_vpc_role_arn": cluster.AWS().PrivateHostedZoneRoleARN(),
-			})
-		}
+		policyDetails := GetOperatorPolicyDetails(policies, credrequest, partition, cluster)
 		policyARN, err := awsClient.EnsurePolicy(policyARN, policyDetails,
 			defaultPolicyVersion, map[string]string{
 				awsCommonValidations.OpenShiftVersion: defaultPolicyVersion,
@@ -822,6 +816,21 @@ func UpgradeOperatorRolePolicies(
 	return nil
 }
 
+// GetOperatorPolicyDetails returns the policy document of the operator role of the given credential
+// request, with the shared VPC role filled in when the cluster uses one
+func GetOperatorPolicyDetails(policies map[string]*cmv1.AWSSTSPolicy, credRequest string, partition string,
+	cluster *cmv1.Cluster) string {
+	isSharedVpc := cluster.AWS().PrivateHostedZoneRoleARN() != ""
+	filename := GetOperatorPolicyKey(credRequest, cluster.Hypershift().Enabled(), isSharedVpc)
+	policyDetails := GetPolicyDetails(policies, filename)
+	if isSharedVpc {
+		policyDetails = InterpolatePolicyDocument(partition, policyDetails, map[string]string{
+			"shared_vpc_role_arn": cluster.AWS().PrivateHostedZoneRoleARN(),
+		})
+	}
+	return policyDetails
+}
+
 type Subnet struct {
 	AvailabilityZone string
 	OwnerID          string
@@ -917,6 +926,24 @@ func GetResourceIdFromSecretArn(secretArn string) (string, error) {
 	return parsedARN.Resource[index+1:], nil
 }
 
+// GetBucketNameFromSecretArn returns the name of the S3 bucket of an unmanaged OIDC config, which
+// is derived from the name of the secret that contains its private key.
+func GetBucketNameFromSecretArn(secretArn string) (string, error) {
+	secretResourceName, err := GetResourceIdFromSecretArn(secretArn)
+	if err != nil {
+		return "", err
+	}
+	// The secret when creating from ROSA options has the following format
+	// rosa-private-key-<prefix>-oidc-<random-hash-length-4>-<random-aws-created-hash>
+	// The bucket is expected to be <prefix>-oidc-<random-hash-length-4>
+	bucketName := strings.TrimPrefix(secretResourceName, "rosa-private-key-")
+	index := strings.LastIndex(bucketName, "-")
+	if index != -1 {
+		bucketName = bucketName[:index]
+	}
+	return bucketName, nil
+}
+
 func FindOperatorRoleNameBySTSOperator(cluster *cmv1.Cluster, operator *cmv1.STSOperator) (string, bool) {
 	for _, role := range cluster.AWS().STS().OperatorIAMRoles() {
 		if role.Namespace() == operator.Namespace() && role.Name() == operator.Name() {
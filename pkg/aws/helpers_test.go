@@ -1122,3 +1122,17 @@ var _ = Describe("ComputeOperatorRoleArn", func() {
 		})
 	})
 })
+
+var _ = Describe("GetBucketNameFromSecretArn", func() {
+	It("Should derive the bucket name from the private key secret", func() {
+		bucketName, err := GetBucketNameFromSecretArn(
+			"arn:aws:secretsmanager:us-east-1:123456789012:secret:rosa-private-key-my-prefix-oidc-ab1c-XyZ123")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bucketName).To(Equal("my-prefix-oidc-ab1c"))
+	})
+
+	It("Should fail for an invalid ARN", func() {
+		_, err := GetBucketNameFromSecretArn("my-secret")
+		Expect(err).To(HaveOccurred())
+	})
+})
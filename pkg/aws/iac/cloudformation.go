@@ -0,0 +1,303 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iac
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
+)
+
+// ReadFileFunc reads the documents referenced by the commands.
+type ReadFileFunc func(name string) ([]byte, error)
+
+type cfnTemplate struct {
+	AWSTemplateFormatVersion string                    `json:"AWSTemplateFormatVersion"`
+	Description              string                    `json:"Description"`
+	Parameters               map[string]map[string]any `json:"Parameters,omitempty"`
+	Resources                map[string]*cfnResource   `json:"Resources"`
+	Outputs                  map[string]map[string]any `json:"Outputs,omitempty"`
+}
+
+type cfnResource struct {
+	Type       string         `json:"Type"`
+	Properties map[string]any `json:"Properties"`
+}
+
+type cfnTag struct {
+	Key   string `json:"Key"`
+	Value string `json:"Value"`
+}
+
+var cfnInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// logicalID returns a unique logical ID for a resource, ending with its kind so that a role and a
+// policy with the same name don't collide.
+func logicalID(used map[string]bool, name string, kind string) string {
+	id := ""
+	for _, part := range cfnInvalidChars.Split(name, -1) {
+		if part != "" {
+			id += strings.ToUpper(part[:1]) + part[1:]
+		}
+	}
+	if !strings.HasSuffix(id, kind) {
+		id += kind
+	}
+	if id[0] >= '0' && id[0] <= '9' {
+		id = kind + id
+	}
+	unique := id
+	for i := 2; used[unique]; i++ {
+		unique = fmt.Sprintf("%s%d", id, i)
+	}
+	used[unique] = true
+	return unique
+}
+
+func cfnTags(tags map[string]string) []cfnTag {
+	result := []cfnTag{}
+	for _, key := range sortedKeys(tags) {
+		result = append(result, cfnTag{Key: key, Value: tags[key]})
+	}
+	return result
+}
+
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func readDocument(readFile ReadFileFunc, name string) (json.RawMessage, error) {
+	content, err := readFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read document '%s': %v", name, err)
+	}
+	if !json.Valid(content) {
+		return nil, fmt.Errorf("document '%s' doesn't contain valid JSON", name)
+	}
+	return content, nil
+}
+
+func policyDocument(readFile ReadFileFunc, policy *Policy) (json.RawMessage, error) {
+	if policy.Content == "" {
+		return readDocument(readFile, policy.Document)
+	}
+	if !json.Valid([]byte(policy.Content)) {
+		return nil, fmt.Errorf("document of policy '%s' doesn't contain valid JSON", policy.Name)
+	}
+	return json.RawMessage(policy.Content), nil
+}
+
+// CloudFormation renders the template as a CloudFormation template. Policy documents are read
+// with the given function and inlined, while the value of the secrets is a parameter of the
+// template, so that it isn't stored in it.
+func (t *Template) CloudFormation(readFile ReadFileFunc) (string, error) {
+	template := &cfnTemplate{
+		AWSTemplateFormatVersion: "2010-09-09",
+		Description:              "Resources created by the ROSA CLI",
+		Parameters:               map[string]map[string]any{},
+		Resources:                map[string]*cfnResource{},
+		Outputs:                  map[string]map[string]any{},
+	}
+	used := map[string]bool{}
+
+	roles := map[string]string{}
+	for _, role := range t.Roles {
+		id := logicalID(used, role.Name, "Role")
+		roles[role.Name] = id
+		document, err := readDocument(readFile, role.AssumeRolePolicy)
+		if err != nil {
+			return "", err
+		}
+		properties := map[string]any{
+			"RoleName":                 role.Name,
+			"AssumeRolePolicyDocument": document,
+		}
+		if role.Path != "" {
+			properties["Path"] = role.Path
+		}
+		if role.PermissionsBoundary != "" {
+			properties["PermissionsBoundary"] = role.PermissionsBoundary
+		}
+		if len(role.Tags) > 0 {
+			properties["Tags"] = cfnTags(role.Tags)
+		}
+		template.Resources[id] = &cfnResource{Type: "AWS::IAM::Role", Properties: properties}
+		template.Outputs[id+"Arn"] = map[string]any{"Value": map[string]any{"Fn::GetAtt": []string{id, "Arn"}}}
+	}
+
+	policies := map[*Policy]string{}
+	for _, policy := range t.Policies {
+		id := logicalID(used, policy.Name, "Policy")
+		policies[policy] = id
+		document, err := policyDocument(readFile, policy)
+		if err != nil {
+			return "", err
+		}
+		properties := map[string]any{
+			"ManagedPolicyName": policy.Name,
+			"PolicyDocument":    document,
+		}
+		if policy.Path != "" {
+			properties["Path"] = policy.Path
+		}
+		template.Resources[id] = &cfnResource{Type: "AWS::IAM::ManagedPolicy", Properties: properties}
+	}
+
+	for _, attachment := range t.Attachments {
+		policy := t.policy(attachment.PolicyARN)
+		roleID, roleInTemplate := roles[attachment.Role]
+		switch {
+		case roleInTemplate:
+			var arn any = attachment.PolicyARN
+			if policy != nil {
+				// The reference to a managed policy returns its ARN:
+				arn = map[string]string{"Ref": policies[policy]}
+			}
+			properties := template.Resources[roleID].Properties
+			arns, _ := properties["ManagedPolicyArns"].([]any)
+			properties["ManagedPolicyArns"] = append(arns, arn)
+		case policy != nil:
+			properties := template.Resources[policies[policy]].Properties
+			names, _ := properties["Roles"].([]any)
+			properties["Roles"] = append(names, attachment.Role)
+		}
+	}
+
+	for _, provider := range t.OIDCProviders {
+		id := logicalID(used, strings.TrimPrefix(provider.URL, "https://"), "OIDCProvider")
+		properties := map[string]any{
+			"Url":            provider.URL,
+			"ClientIdList":   provider.ClientIDs,
+			"ThumbprintList": provider.Thumbprints,
+		}
+		if len(provider.Tags) > 0 {
+			properties["Tags"] = cfnTags(provider.Tags)
+		}
+		template.Resources[id] = &cfnResource{Type: "AWS::IAM::OIDCProvider", Properties: properties}
+		template.Outputs[id+"Arn"] = map[string]any{"Value": map[string]any{"Fn::GetAtt": []string{id, "Arn"}}}
+	}
+
+	for _, bucket := range t.Buckets {
+		id := logicalID(used, bucket.Name, "Bucket")
+		properties := map[string]any{"BucketName": bucket.Name}
+		if bucket.PublicAccessBlock != nil {
+			properties["PublicAccessBlockConfiguration"] = bucket.PublicAccessBlock
+		}
+		if len(bucket.Tags) > 0 {
+			properties["Tags"] = cfnTags(bucket.Tags)
+		}
+		template.Resources[id] = &cfnResource{Type: "AWS::S3::Bucket", Properties: properties}
+		if bucket.Policy != "" {
+			document, err := readDocument(readFile, bucket.Policy)
+			if err != nil {
+				return "", err
+			}
+			template.Resources[logicalID(used, bucket.Name, "BucketPolicy")] = &cfnResource{
+				Type: "AWS::S3::BucketPolicy",
+				Properties: map[string]any{
+					"Bucket":         map[string]string{"Ref": id},
+					"PolicyDocument": document,
+				},
+			}
+		}
+	}
+
+	for _, secret := range t.Secrets {
+		id := logicalID(used, secret.Name, "Secret")
+		parameter := id + "Value"
+		template.Parameters[parameter] = map[string]any{
+			"Type":        "String",
+			"NoEcho":      true,
+			"Description": fmt.Sprintf("Value of secret '%s', the content of file '%s'", secret.Name, secret.Source),
+		}
+		properties := map[string]any{
+			"Name":         secret.Name,
+			"SecretString": map[string]string{"Ref": parameter},
+		}
+		if secret.Description != "" {
+			properties["Description"] = secret.Description
+		}
+		if len(secret.Tags) > 0 {
+			properties["Tags"] = cfnTags(secret.Tags)
+		}
+		template.Resources[id] = &cfnResource{Type: "AWS::SecretsManager::Secret", Properties: properties}
+	}
+
+	result, err := json.MarshalIndent(template, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(result), nil
+}
+
+// cloudFormationUnsupported returns the commands needed to complete the resources that
+// CloudFormation can't describe: the tags of managed policies, the objects of the buckets and the
+// attachments between roles and policies that aren't part of the template.
+func (t *Template) cloudFormationUnsupported() []string {
+	commands := []string{}
+	for _, policy := range t.Policies {
+		if len(policy.Tags) == 0 {
+			continue
+		}
+		for _, attachment := range t.Attachments {
+			if t.policy(attachment.PolicyARN) == policy {
+				commands = append(commands, awscb.NewIAMCommandBuilder().
+					SetCommand(awscb.TagPolicy).
+					AddParam(awscb.PolicyArn, attachment.PolicyARN).
+					AddTags(policy.Tags).
+					Build())
+				break
+			}
+		}
+	}
+	for _, attachment := range t.Attachments {
+		if t.role(attachment.Role) == nil && t.policy(attachment.PolicyARN) == nil {
+			commands = append(commands, awscb.NewIAMCommandBuilder().
+				SetCommand(awscb.AttachRolePolicy).
+				AddParam(awscb.RoleName, attachment.Role).
+				AddParam(awscb.PolicyArn, attachment.PolicyARN).
+				Build())
+		}
+	}
+	for _, bucket := range t.Buckets {
+		for _, object := range bucket.Objects {
+			builder := awscb.NewS3ApiCommandBuilder().
+				SetCommand(awscb.PutObject).
+				AddParam(awscb.Body, "./"+object.Source).
+				AddParam(awscb.Bucket, bucket.Name).
+				AddParam(awscb.Key, object.Key)
+			if len(object.Tags) > 0 {
+				tagging := []string{}
+				for _, key := range sortedKeys(object.Tags) {
+					tagging = append(tagging, fmt.Sprintf("%s=%s", key, object.Tags[key]))
+				}
+				builder.AddParam(awscb.Tagging, fmt.Sprintf("'%s'", strings.Join(tagging, "&")))
+			}
+			commands = append(commands, builder.Build())
+		}
+	}
+	return commands
+}
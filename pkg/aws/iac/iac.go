@@ -0,0 +1,362 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package iac renders the resources created by the AWS CLI commands printed in manual mode as
+// infrastructure as code: a Terraform module or a CloudFormation template that creates the same
+// resources.
+package iac
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
+	"github.com/openshift/rosa/pkg/interactive"
+	"github.com/openshift/rosa/pkg/reporter"
+)
+
+const OutputFormatFlag = "output-format"
+
+// Format is the format used to print the resources created in manual mode.
+type Format string
+
+const (
+	FormatShell          Format = "shell"
+	FormatTerraform      Format = "terraform"
+	FormatCloudFormation Format = "cloudformation"
+)
+
+// Formats are the values accepted by the '--output-format' flag.
+var Formats = []string{string(FormatShell), string(FormatTerraform), string(FormatCloudFormation)}
+
+// AddOutputFormatFlag adds the '--output-format' flag used by the commands that support manual mode.
+func AddOutputFormatFlag(flags *pflag.FlagSet, value *string) {
+	flags.StringVar(
+		value,
+		OutputFormatFlag,
+		string(FormatShell),
+		fmt.Sprintf("Format of the resources printed in manual mode. Valid values are %s. The "+
+			"'terraform' and 'cloudformation' formats print a Terraform module or a CloudFormation "+
+			"template instead of AWS CLI commands.", strings.Join(Formats, ", ")),
+	)
+}
+
+// ParseFormat validates the value of the '--output-format' flag.
+func ParseFormat(value string) (Format, error) {
+	if value == "" {
+		return FormatShell, nil
+	}
+	if !slices.Contains(Formats, value) {
+		return "", fmt.Errorf("invalid output format '%s'. Valid values are %s", value, strings.Join(Formats, ", "))
+	}
+	return Format(value), nil
+}
+
+// GetFormat validates the '--output-format' flag of the command. The infrastructure as code formats
+// are only available in manual mode, that they select when the '--mode' flag isn't given.
+func GetFormat(cmd *cobra.Command, value string) (Format, error) {
+	format, err := ParseFormat(value)
+	if err != nil || format == FormatShell {
+		return format, err
+	}
+	mode := cmd.Flags().Lookup(interactive.Mode)
+	if !mode.Changed {
+		return format, cmd.Flags().Set(interactive.Mode, interactive.ModeManual)
+	}
+	if mode.Value.String() != interactive.ModeManual {
+		return "", fmt.Errorf("the '--%s' flag can only be used in '%s' mode", OutputFormatFlag, interactive.ModeManual)
+	}
+	return format, nil
+}
+
+// Instructions returns the message printed before the resources, for example 'Run the following
+// commands to create the operator roles'.
+func (f Format) Instructions(purpose string) string {
+	switch f {
+	case FormatTerraform:
+		return "Apply the following Terraform module " + purpose
+	case FormatCloudFormation:
+		return "Deploy the following CloudFormation template " + purpose
+	}
+	return "Run the following commands " + purpose
+}
+
+// Role is an IAM role. The documents of the roles, policies and buckets are the names of the files
+// that contain them, as saved to the current directory in manual mode.
+type Role struct {
+	Name                string
+	Path                string
+	AssumeRolePolicy    string
+	PermissionsBoundary string
+	Tags                map[string]string
+}
+
+// Policy is a customer managed IAM policy. Content is the document when the command gives it
+// inline instead of in a file.
+type Policy struct {
+	Name     string
+	Path     string
+	Document string
+	Content  string
+	Tags     map[string]string
+}
+
+// Attachment attaches a managed policy to a role.
+type Attachment struct {
+	Role      string
+	PolicyARN string
+}
+
+// CreateRoleCommand returns the command that creates the role with the trust policy saved in the
+// given file, and the role that it creates.
+func CreateRoleCommand(name string, assumeRolePolicy string, permissionsBoundary string,
+	tags map[string]string, path string) (string, *Role) {
+	command := awscb.NewIAMCommandBuilder().
+		SetCommand(awscb.CreateRole).
+		AddParam(awscb.RoleName, name).
+		AddParam(awscb.AssumeRolePolicyDocument, fmt.Sprintf("file://%s", assumeRolePolicy)).
+		AddParam(awscb.PermissionsBoundary, permissionsBoundary).
+		AddTags(tags).
+		AddParam(awscb.Path, path).
+		Build()
+	return command, &Role{
+		Name:                name,
+		Path:                path,
+		AssumeRolePolicy:    assumeRolePolicy,
+		PermissionsBoundary: permissionsBoundary,
+		Tags:                tags,
+	}
+}
+
+// CreatePolicyCommand returns the command that creates the policy with the document saved in the
+// given file, and the policy that it creates.
+func CreatePolicyCommand(name string, document string, tags map[string]string, path string) (string, *Policy) {
+	command := awscb.NewIAMCommandBuilder().
+		SetCommand(awscb.CreatePolicy).
+		AddParam(awscb.PolicyName, name).
+		AddParam(awscb.PolicyDocument, fmt.Sprintf("file://%s", document)).
+		AddTags(tags).
+		AddParam(awscb.Path, path).
+		Build()
+	return command, &Policy{
+		Name:     name,
+		Path:     path,
+		Document: document,
+		Tags:     tags,
+	}
+}
+
+// AttachRolePolicyCommand returns the command that attaches the policy to the role.
+func AttachRolePolicyCommand(role string, policyARN string) (string, *Attachment) {
+	command := awscb.NewIAMCommandBuilder().
+		SetCommand(awscb.AttachRolePolicy).
+		AddParam(awscb.RoleName, role).
+		AddParam(awscb.PolicyArn, policyARN).
+		Build()
+	return command, &Attachment{
+		Role:      role,
+		PolicyARN: policyARN,
+	}
+}
+
+// OIDCProvider is an IAM OpenID Connect identity provider.
+type OIDCProvider struct {
+	URL         string
+	ClientIDs   []string
+	Thumbprints []string
+	Tags        map[string]string
+}
+
+// Bucket is an S3 bucket with its policy and objects.
+type Bucket struct {
+	Name              string
+	Region            string
+	Tags              map[string]string
+	PublicAccessBlock map[string]bool
+	Policy            string
+	Objects           []*Object
+}
+
+// Object is an object uploaded to a bucket, Source is the file with its content.
+type Object struct {
+	Key    string
+	Source string
+	Tags   map[string]string
+}
+
+// Secret is a Secrets Manager secret whose value is read from the Source file.
+type Secret struct {
+	Name        string
+	Description string
+	Region      string
+	Source      string
+	Tags        map[string]string
+}
+
+// Template contains the resources created by the AWS CLI commands printed in manual mode. The
+// commands are added together with the resources that they create, which are described with the
+// same values used to build the commands.
+type Template struct {
+	Roles         []*Role
+	Policies      []*Policy
+	Attachments   []*Attachment
+	OIDCProviders []*OIDCProvider
+	Buckets       []*Bucket
+	Secrets       []*Secret
+
+	// Unsupported contains the commands that don't create a resource, for example the ones that
+	// modify existing resources, and that need to be run separately.
+	Unsupported []string
+
+	commands []string
+}
+
+// Add adds a command whose resources are already described by the template, like the ones that
+// configure a bucket, or that the templates don't need, like the ones that remove the files with
+// the documents.
+func (t *Template) Add(command string) {
+	t.commands = append(t.commands, command)
+}
+
+// AddUnsupported adds a command that doesn't create a resource and that is reported to be run
+// separately from the infrastructure as code formats.
+func (t *Template) AddUnsupported(command string) {
+	t.Add(command)
+	t.Unsupported = append(t.Unsupported, command)
+}
+
+// AddRole adds the command that creates the given role.
+func (t *Template) AddRole(command string, role *Role) {
+	t.Add(command)
+	t.Roles = append(t.Roles, role)
+}
+
+// AddPolicy adds the command that creates the given policy.
+func (t *Template) AddPolicy(command string, policy *Policy) {
+	t.Add(command)
+	t.Policies = append(t.Policies, policy)
+}
+
+// AddAttachment adds the command that attaches a policy to a role.
+func (t *Template) AddAttachment(command string, attachment *Attachment) {
+	t.Add(command)
+	t.Attachments = append(t.Attachments, attachment)
+}
+
+// AddOIDCProvider adds the command that creates the given OIDC provider.
+func (t *Template) AddOIDCProvider(command string, provider *OIDCProvider) {
+	t.Add(command)
+	t.OIDCProviders = append(t.OIDCProviders, provider)
+}
+
+// AddBucket adds the command that creates the given bucket. The commands that configure it and
+// upload its objects are added with Add.
+func (t *Template) AddBucket(command string, bucket *Bucket) {
+	t.Add(command)
+	t.Buckets = append(t.Buckets, bucket)
+}
+
+// AddSecret adds the command that creates the given secret.
+func (t *Template) AddSecret(command string, secret *Secret) {
+	t.Add(command)
+	t.Secrets = append(t.Secrets, secret)
+}
+
+// Commands returns the commands added to the template, joined by JoinCommands.
+func (t *Template) Commands() string {
+	return awscb.JoinCommands(t.commands)
+}
+
+// role returns the role of the template with the given name, or nil if the template doesn't
+// create it.
+func (t *Template) role(name string) *Role {
+	for _, role := range t.Roles {
+		if role.Name == name {
+			return role
+		}
+	}
+	return nil
+}
+
+// policy returns the policy of the template with the given ARN, or nil if the template doesn't
+// create it.
+func (t *Template) policy(arn string) *Policy {
+	for _, policy := range t.Policies {
+		path := policy.Path
+		if path == "" {
+			path = "/"
+		}
+		if strings.HasSuffix(arn, ":policy"+path+policy.Name) {
+			return policy
+		}
+	}
+	return nil
+}
+
+// region returns the region of the regional resources of the template, if any.
+func (t *Template) region() string {
+	for _, bucket := range t.Buckets {
+		if bucket.Region != "" {
+			return bucket.Region
+		}
+	}
+	for _, secret := range t.Secrets {
+		if secret.Region != "" {
+			return secret.Region
+		}
+	}
+	return ""
+}
+
+// Render renders the template in the given format. The shell format returns its commands.
+func Render(format Format, template *Template) (string, error) {
+	switch format {
+	case FormatShell, "":
+		return template.Commands(), nil
+	case FormatTerraform:
+		return template.Terraform(), nil
+	case FormatCloudFormation:
+		return template.CloudFormation(os.ReadFile)
+	}
+	return "", fmt.Errorf("invalid output format '%s'", format)
+}
+
+// Print prints the template in the given format. For the infrastructure as code formats it also
+// reports the commands that the template doesn't cover.
+func Print(r reporter.Logger, format Format, template *Template) error {
+	result, err := Render(format, template)
+	if err != nil {
+		return err
+	}
+	fmt.Println(result)
+	if format == FormatShell || format == "" {
+		return nil
+	}
+	unsupported := template.Unsupported
+	if format == FormatCloudFormation {
+		unsupported = append(slices.Clone(unsupported), template.cloudFormationUnsupported()...)
+	}
+	if len(unsupported) > 0 {
+		r.Warnf("The following commands can't be expressed in the %s format, run them after applying it:\n\n%s\n",
+			format, awscb.JoinCommands(unsupported))
+	}
+	return nil
+}
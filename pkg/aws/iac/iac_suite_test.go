@@ -0,0 +1,13 @@
+package iac
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestIac(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Iac Suite")
+}
@@ -0,0 +1,413 @@
+package iac
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
+	"github.com/openshift/rosa/pkg/interactive"
+)
+
+const (
+	policyARN  = "arn:aws:iam::123456789012:policy/prefix/ManagedOpenShift-Installer-Role-Policy"
+	awsPolicy  = "arn:aws:iam::aws:policy/service-role/ROSAInstallerPolicy"
+	boundary   = "arn:aws:iam::123456789012:policy/boundary"
+	bucketName = "oidc-bucket"
+)
+
+func inlinePolicyTemplate() *Template {
+	template := &Template{}
+	template.AddPolicy(awscb.NewIAMCommandBuilder().
+		SetCommand(awscb.CreatePolicy).
+		AddParam(awscb.PolicyName, "inline-policy").
+		AddParam(awscb.PolicyDocument, `'{"Version":"2012-10-17","Statement":[]}'`).
+		Build(), &Policy{
+		Name:    "inline-policy",
+		Content: `{"Version":"2012-10-17","Statement":[]}`,
+	})
+	return template
+}
+
+func installerRole() *Role {
+	return &Role{
+		Name:                "ManagedOpenShift-Installer-Role",
+		Path:                "/prefix/",
+		AssumeRolePolicy:    "sts_installer_trust_policy.json",
+		PermissionsBoundary: boundary,
+		Tags:                map[string]string{"red-hat-managed": "true", "rosa_role_type": "installer"},
+	}
+}
+
+func installerPolicy() *Policy {
+	return &Policy{
+		Name:     "ManagedOpenShift-Installer-Role-Policy",
+		Path:     "/prefix/",
+		Document: "sts_installer_permission_policy.json",
+		Tags:     map[string]string{"red-hat-managed": "true"},
+	}
+}
+
+func attachCommand(attachment *Attachment) string {
+	return awscb.NewIAMCommandBuilder().
+		SetCommand(awscb.AttachRolePolicy).
+		AddParam(awscb.RoleName, attachment.Role).
+		AddParam(awscb.PolicyArn, attachment.PolicyARN).
+		Build()
+}
+
+func roleTemplate() *Template {
+	template := &Template{}
+	role := installerRole()
+	template.AddRole(awscb.NewIAMCommandBuilder().
+		SetCommand(awscb.CreateRole).
+		AddParam(awscb.RoleName, role.Name).
+		AddParam(awscb.AssumeRolePolicyDocument, "file://"+role.AssumeRolePolicy).
+		AddParam(awscb.PermissionsBoundary, role.PermissionsBoundary).
+		AddParam(awscb.Path, role.Path).
+		AddTags(role.Tags).
+		Build(), role)
+	policy := installerPolicy()
+	template.AddPolicy(awscb.NewIAMCommandBuilder().
+		SetCommand(awscb.CreatePolicy).
+		AddParam(awscb.PolicyName, policy.Name).
+		AddParam(awscb.PolicyDocument, "file://"+policy.Document).
+		AddParam(awscb.Path, policy.Path).
+		AddTags(policy.Tags).
+		Build(), policy)
+	for _, policyARN := range []string{policyARN, awsPolicy} {
+		attachment := &Attachment{Role: role.Name, PolicyARN: policyARN}
+		template.AddAttachment(attachCommand(attachment), attachment)
+	}
+	return template
+}
+
+func oidcTemplate() *Template {
+	template := &Template{}
+	bucket := &Bucket{
+		Name:   bucketName,
+		Region: "us-east-2",
+		Tags:   map[string]string{"red-hat-managed": "true"},
+		PublicAccessBlock: map[string]bool{
+			"BlockPublicAcls":       true,
+			"IgnorePublicAcls":      true,
+			"BlockPublicPolicy":     false,
+			"RestrictPublicBuckets": false,
+		},
+		Policy: "readOnlyPolicy-oidc-bucket.json",
+		Objects: []*Object{{
+			Key:    "keys.json",
+			Source: "jwks-oidc-bucket.json",
+			Tags:   map[string]string{"red-hat-managed": "true"},
+		}},
+	}
+	template.AddBucket(awscb.NewS3ApiCommandBuilder().
+		SetCommand(awscb.CreateBucket).
+		AddParam(awscb.Bucket, bucketName).
+		AddParam(awscb.CreateBucketConfiguration, "LocationConstraint=us-east-2").
+		AddParam(awscb.Region, bucket.Region).
+		Build(), bucket)
+	template.Add("rm readOnlyPolicy-oidc-bucket.json")
+	template.AddSecret(awscb.NewSecretsManagerCommandBuilder().
+		SetCommand(awscb.CreateSecret).
+		AddParam(awscb.Name, "oidc-bucket-key").
+		Build(), &Secret{
+		Name:        "oidc-bucket-key",
+		Description: "Secret for oidc-bucket",
+		Region:      "us-east-2",
+		Source:      "private-key.key",
+		Tags:        map[string]string{"red-hat-managed": "true"},
+	})
+	template.AddOIDCProvider(awscb.NewIAMCommandBuilder().
+		SetCommand(awscb.CreateOpenIdConnectProvider).
+		AddParam(awscb.Url, "https://oidc-bucket.s3.us-east-2.amazonaws.com").
+		Build(), &OIDCProvider{
+		URL:         "https://oidc-bucket.s3.us-east-2.amazonaws.com",
+		ClientIDs:   []string{"openshift", "sts.amazonaws.com"},
+		Thumbprints: []string{"a9d53002e97e00e043244f3d170d6f4c414104fd"},
+		Tags:        map[string]string{"red-hat-managed": "true"},
+	})
+	return template
+}
+
+func readFake(name string) ([]byte, error) {
+	if name == "missing.json" {
+		return nil, fmt.Errorf("no such file")
+	}
+	return []byte(fmt.Sprintf(`{"Version": "2012-10-17", "Id": %q}`, name)), nil
+}
+
+var _ = Describe("Template", func() {
+	It("Keeps the commands in the order in which they are added", func() {
+		policyVersion := awscb.NewIAMCommandBuilder().
+			SetCommand(awscb.CreatePolicyVersion).
+			AddParam(awscb.PolicyArn, awsPolicy).
+			AddParam(awscb.PolicyDocument, "file://policy.json").
+			AddParamNoValue(awscb.SetAsDefault).
+			Build()
+		template := roleTemplate()
+		template.AddUnsupported(policyVersion)
+		template.Add("rm policy.json")
+
+		commands := template.Commands()
+		Expect(commands).To(HavePrefix("aws iam create-role"))
+		Expect(commands).To(HaveSuffix(policyVersion + "\n\nrm policy.json"))
+		Expect(strings.Count(commands, "aws iam attach-role-policy")).To(Equal(2))
+		Expect(template.Unsupported).To(Equal([]string{policyVersion}))
+		Expect(template.Roles).To(Equal([]*Role{installerRole()}))
+		Expect(template.Policies).To(Equal([]*Policy{installerPolicy()}))
+		Expect(template.Attachments).To(HaveLen(2))
+	})
+
+	It("Builds the commands together with the resources that they create", func() {
+		role, policy := installerRole(), installerPolicy()
+		template := &Template{}
+		template.AddRole(CreateRoleCommand(role.Name, role.AssumeRolePolicy, role.PermissionsBoundary,
+			role.Tags, role.Path))
+		template.AddPolicy(CreatePolicyCommand(policy.Name, policy.Document, policy.Tags, policy.Path))
+		for _, policyARN := range []string{policyARN, awsPolicy} {
+			template.AddAttachment(AttachRolePolicyCommand(role.Name, policyARN))
+		}
+
+		expected := roleTemplate()
+		Expect(template.Commands()).To(Equal(expected.Commands()))
+		Expect(template.Roles).To(Equal(expected.Roles))
+		Expect(template.Policies).To(Equal(expected.Policies))
+		Expect(template.Attachments).To(Equal(expected.Attachments))
+	})
+
+	It("Finds the policies that it creates", func() {
+		template := roleTemplate()
+		Expect(template.policy(policyARN)).To(Equal(template.Policies[0]))
+		Expect(template.policy(awsPolicy)).To(BeNil())
+		Expect(template.role("ManagedOpenShift-Installer-Role")).To(Equal(template.Roles[0]))
+	})
+
+	It("Renders the commands in the shell format", func() {
+		template := roleTemplate()
+		result, err := Render(FormatShell, template)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(template.Commands()))
+	})
+})
+
+var _ = Describe("Terraform", func() {
+	It("Renders the roles, policies and attachments", func() {
+		module := roleTemplate().Terraform()
+		Expect(module).To(Equal(`terraform {
+  required_providers {
+    aws = {
+      source = "hashicorp/aws"
+    }
+  }
+}
+
+resource "aws_iam_role" "managedopenshift_installer_role" {
+  name                 = "ManagedOpenShift-Installer-Role"
+  path                 = "/prefix/"
+  assume_role_policy   = file("${path.module}/sts_installer_trust_policy.json")
+  permissions_boundary = "arn:aws:iam::123456789012:policy/boundary"
+  tags = {
+    "red-hat-managed" = "true"
+    "rosa_role_type"  = "installer"
+  }
+}
+
+resource "aws_iam_policy" "managedopenshift_installer_role_policy" {
+  name   = "ManagedOpenShift-Installer-Role-Policy"
+  path   = "/prefix/"
+  policy = file("${path.module}/sts_installer_permission_policy.json")
+  tags = {
+    "red-hat-managed" = "true"
+  }
+}
+
+resource "aws_iam_role_policy_attachment" "managedopenshift_installer_role_managedopenshift_installer_role_policy" {
+  role       = aws_iam_role.managedopenshift_installer_role.name
+  policy_arn = aws_iam_policy.managedopenshift_installer_role_policy.arn
+}
+
+resource "aws_iam_role_policy_attachment" "managedopenshift_installer_role_rosainstallerpolicy" {
+  role       = aws_iam_role.managedopenshift_installer_role.name
+  policy_arn = "arn:aws:iam::aws:policy/service-role/ROSAInstallerPolicy"
+}
+
+output "managedopenshift_installer_role_arn" {
+  value = aws_iam_role.managedopenshift_installer_role.arn
+}`))
+	})
+
+	It("Renders the OIDC configuration and provider", func() {
+		module := oidcTemplate().Terraform()
+		Expect(module).To(ContainSubstring(`provider "aws" {
+  region = "us-east-2"
+}`))
+		Expect(module).To(ContainSubstring(`resource "aws_s3_bucket_public_access_block" "oidc_bucket" {
+  bucket                  = aws_s3_bucket.oidc_bucket.id
+  block_public_acls       = true
+  ignore_public_acls      = true
+  block_public_policy     = false
+  restrict_public_buckets = false
+}`))
+		Expect(module).To(ContainSubstring(`resource "aws_s3_bucket_policy" "oidc_bucket" {
+  bucket     = aws_s3_bucket.oidc_bucket.id
+  policy     = file("${path.module}/readOnlyPolicy-oidc-bucket.json")
+  depends_on = [aws_s3_bucket_public_access_block.oidc_bucket]
+}`))
+		Expect(module).To(ContainSubstring(`resource "aws_s3_object" "oidc_bucket_keys_json" {
+  bucket = aws_s3_bucket.oidc_bucket.id
+  key    = "keys.json"
+  source = "${path.module}/jwks-oidc-bucket.json"`))
+		Expect(module).To(ContainSubstring(`resource "aws_secretsmanager_secret_version" "oidc_bucket_key" {
+  secret_id     = aws_secretsmanager_secret.oidc_bucket_key.id
+  secret_string = file("${path.module}/private-key.key")
+}`))
+		Expect(module).To(ContainSubstring(
+			`resource "aws_iam_openid_connect_provider" "oidc_bucket_s3_us_east_2_amazonaws_com" {
+  url             = "https://oidc-bucket.s3.us-east-2.amazonaws.com"
+  client_id_list  = ["openshift", "sts.amazonaws.com"]
+  thumbprint_list = ["a9d53002e97e00e043244f3d170d6f4c414104fd"]`))
+	})
+
+	It("Renders the inline policy documents", func() {
+		module := inlinePolicyTemplate().Terraform()
+		Expect(module).To(ContainSubstring(`resource "aws_iam_policy" "inline_policy" {
+  name   = "inline-policy"
+  policy = "{\"Version\":\"2012-10-17\",\"Statement\":[]}"
+}`))
+	})
+
+	It("Escapes the interpolation sequences of strings", func() {
+		Expect(hclString(`a "${b}" %{c}`)).To(Equal(`"a \"$${b}\" %%{c}"`))
+	})
+})
+
+var _ = Describe("CloudFormation", func() {
+	render := func(template *Template) map[string]any {
+		text, err := template.CloudFormation(readFake)
+		Expect(err).NotTo(HaveOccurred())
+		result := map[string]any{}
+		Expect(json.Unmarshal([]byte(text), &result)).To(Succeed())
+		return result
+	}
+
+	It("Renders the roles with their managed policies", func() {
+		template := render(roleTemplate())
+		resources := template["Resources"].(map[string]any)
+		Expect(resources).To(HaveLen(2))
+
+		role := resources["ManagedOpenShiftInstallerRole"].(map[string]any)
+		Expect(role["Type"]).To(Equal("AWS::IAM::Role"))
+		properties := role["Properties"].(map[string]any)
+		Expect(properties["RoleName"]).To(Equal("ManagedOpenShift-Installer-Role"))
+		Expect(properties["Path"]).To(Equal("/prefix/"))
+		Expect(properties["PermissionsBoundary"]).To(Equal(boundary))
+		Expect(properties["AssumeRolePolicyDocument"]).To(HaveKeyWithValue("Id", "sts_installer_trust_policy.json"))
+		Expect(properties["ManagedPolicyArns"]).To(Equal([]any{
+			map[string]any{"Ref": "ManagedOpenShiftInstallerRolePolicy"},
+			awsPolicy,
+		}))
+		Expect(properties["Tags"]).To(Equal([]any{
+			map[string]any{"Key": "red-hat-managed", "Value": "true"},
+			map[string]any{"Key": "rosa_role_type", "Value": "installer"},
+		}))
+
+		policy := resources["ManagedOpenShiftInstallerRolePolicy"].(map[string]any)
+		Expect(policy["Type"]).To(Equal("AWS::IAM::ManagedPolicy"))
+		Expect(template["Outputs"]).To(HaveKey("ManagedOpenShiftInstallerRoleArn"))
+	})
+
+	It("Attaches policies of the template to roles created elsewhere", func() {
+		policy := installerPolicy()
+		attachment := &Attachment{Role: "ManagedOpenShift-Installer-Role", PolicyARN: policyARN}
+		source := &Template{}
+		source.AddPolicy("aws iam create-policy", policy)
+		source.AddAttachment(attachCommand(attachment), attachment)
+		template := render(source)
+		resources := template["Resources"].(map[string]any)
+		properties := resources["ManagedOpenShiftInstallerRolePolicy"].(map[string]any)["Properties"].(map[string]any)
+		Expect(properties["Roles"]).To(Equal([]any{"ManagedOpenShift-Installer-Role"}))
+	})
+
+	It("Renders the OIDC configuration with the secret value as a parameter", func() {
+		template := render(oidcTemplate())
+		resources := template["Resources"].(map[string]any)
+		Expect(resources).To(HaveKey("OidcBucket"))
+		Expect(resources).To(HaveKey("OidcBucketBucketPolicy"))
+		Expect(resources).To(HaveKey("OidcBucketS3UsEast2AmazonawsComOIDCProvider"))
+		secret := resources["OidcBucketKeySecret"].(map[string]any)["Properties"].(map[string]any)
+		Expect(secret["SecretString"]).To(Equal(map[string]any{"Ref": "OidcBucketKeySecretValue"}))
+		Expect(template["Parameters"]).To(HaveKeyWithValue("OidcBucketKeySecretValue",
+			HaveKeyWithValue("NoEcho", true)))
+	})
+
+	It("Reports the commands that CloudFormation can't express", func() {
+		template := roleTemplate()
+		oidc := oidcTemplate()
+		template.Buckets = oidc.Buckets
+		unsupported := template.cloudFormationUnsupported()
+		Expect(unsupported).To(HaveLen(2))
+		Expect(unsupported[0]).To(HavePrefix("aws iam tag-policy"))
+		Expect(unsupported[0]).To(ContainSubstring(policyARN))
+		Expect(unsupported[1]).To(HavePrefix("aws s3api put-object"))
+		Expect(unsupported[1]).To(ContainSubstring("--tagging 'red-hat-managed=true'"))
+	})
+
+	It("Renders the inline policy documents", func() {
+		template := render(inlinePolicyTemplate())
+		resources := template["Resources"].(map[string]any)
+		properties := resources["InlinePolicy"].(map[string]any)["Properties"].(map[string]any)
+		Expect(properties["PolicyDocument"]).To(Equal(map[string]any{
+			"Version":   "2012-10-17",
+			"Statement": []any{},
+		}))
+	})
+
+	It("Fails when a document can't be read", func() {
+		template := &Template{}
+		template.AddPolicy("aws iam create-policy", &Policy{Name: "policy", Document: "missing.json"})
+		_, err := template.CloudFormation(readFake)
+		Expect(err).To(MatchError("failed to read document 'missing.json': no such file"))
+	})
+})
+
+var _ = Describe("GetFormat", func() {
+	var cmd *cobra.Command
+	var value string
+
+	BeforeEach(func() {
+		interactive.SetModeKey("")
+		cmd = &cobra.Command{}
+		interactive.AddModeFlag(cmd)
+		AddOutputFormatFlag(cmd.Flags(), &value)
+	})
+
+	It("Keeps the mode for the shell format", func() {
+		format, err := GetFormat(cmd, string(FormatShell))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(format).To(Equal(FormatShell))
+		Expect(cmd.Flags().Changed(interactive.Mode)).To(BeFalse())
+	})
+
+	It("Selects manual mode for the infrastructure as code formats", func() {
+		format, err := GetFormat(cmd, "terraform")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(format).To(Equal(FormatTerraform))
+		Expect(interactive.GetMode()).To(Equal(interactive.ModeManual))
+	})
+
+	It("Rejects auto mode", func() {
+		Expect(cmd.Flags().Set(interactive.Mode, interactive.ModeAuto)).To(Succeed())
+		_, err := GetFormat(cmd, "cloudformation")
+		Expect(err).To(MatchError("the '--output-format' flag can only be used in 'manual' mode"))
+	})
+
+	It("Rejects unknown formats", func() {
+		_, err := GetFormat(cmd, "pulumi")
+		Expect(err).To(MatchError(ContainSubstring("invalid output format 'pulumi'")))
+	})
+})
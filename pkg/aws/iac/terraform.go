@@ -0,0 +1,269 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iac
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// publicAccessBlockSettings maps the settings of the 'put-public-access-block' command to the
+// arguments of the 'aws_s3_bucket_public_access_block' resource, in the order they are printed.
+var publicAccessBlockSettings = [][2]string{
+	{"BlockPublicAcls", "block_public_acls"},
+	{"IgnorePublicAcls", "ignore_public_acls"},
+	{"BlockPublicPolicy", "block_public_policy"},
+	{"RestrictPublicBuckets", "restrict_public_buckets"},
+}
+
+const requiredProviders = `terraform {
+  required_providers {
+    aws = {
+      source = "hashicorp/aws"
+    }
+  }
+}
+`
+
+type hclAttribute struct {
+	name  string
+	value string
+}
+
+// hclWriter writes blocks formatted as 'terraform fmt' does, aligning the equal signs of
+// consecutive single line attributes.
+type hclWriter struct {
+	builder strings.Builder
+	names   map[string]bool
+}
+
+func (w *hclWriter) block(header string, attributes ...hclAttribute) {
+	if w.builder.Len() > 0 {
+		w.builder.WriteString("\n")
+	}
+	w.builder.WriteString(header + " {\n")
+	width := 0
+	for i, attribute := range attributes {
+		if strings.Contains(attribute.value, "\n") {
+			width = 0
+			continue
+		}
+		if width == 0 {
+			// Find the width of the names of this group of single line attributes:
+			for _, next := range attributes[i:] {
+				if strings.Contains(next.value, "\n") {
+					break
+				}
+				width = max(width, len(next.name))
+			}
+		}
+		attributes[i].name = fmt.Sprintf("%-*s", width, attribute.name)
+	}
+	for _, attribute := range attributes {
+		w.builder.WriteString(fmt.Sprintf("  %s = %s\n", attribute.name, attribute.value))
+	}
+	w.builder.WriteString("}\n")
+}
+
+// name returns a unique Terraform name for a resource of the given type.
+func (w *hclWriter) name(resourceType string, text string) string {
+	name := strings.Trim(hclInvalidChars.ReplaceAllString(strings.ToLower(text), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "r_" + name
+	}
+	unique := name
+	for i := 2; w.names[resourceType+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	w.names[resourceType+"."+unique] = true
+	return unique
+}
+
+var hclInvalidChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+func hclString(value string) string {
+	value = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "${", "$${", "%{", "%%{").Replace(value)
+	return `"` + value + `"`
+}
+
+func hclList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = hclString(value)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+func hclMap(values map[string]string) string {
+	keys := sortedKeys(values)
+	width := 0
+	for _, key := range keys {
+		width = max(width, len(hclString(key)))
+	}
+	lines := []string{"{"}
+	for _, key := range keys {
+		lines = append(lines, fmt.Sprintf("    %-*s = %s", width, hclString(key), hclString(values[key])))
+	}
+	lines = append(lines, "  }")
+	return strings.Join(lines, "\n")
+}
+
+func hclFile(name string) string {
+	return fmt.Sprintf(`file("${path.module}/%s")`, name)
+}
+
+func withTags(attributes []hclAttribute, tags map[string]string) []hclAttribute {
+	if len(tags) == 0 {
+		return attributes
+	}
+	return append(attributes, hclAttribute{"tags", hclMap(tags)})
+}
+
+// Terraform renders the template as a Terraform module. The documents are read from the files
+// saved to the current directory, that must be kept next to the module.
+func (t *Template) Terraform() string {
+	w := &hclWriter{names: map[string]bool{}}
+	w.builder.WriteString(requiredProviders)
+	if region := t.region(); region != "" {
+		w.block(`provider "aws"`, hclAttribute{"region", hclString(region)})
+	}
+
+	roles := map[string]string{}
+	for _, role := range t.Roles {
+		name := w.name("aws_iam_role", role.Name)
+		roles[role.Name] = name
+		attributes := []hclAttribute{{"name", hclString(role.Name)}}
+		if role.Path != "" {
+			attributes = append(attributes, hclAttribute{"path", hclString(role.Path)})
+		}
+		attributes = append(attributes, hclAttribute{"assume_role_policy", hclFile(role.AssumeRolePolicy)})
+		if role.PermissionsBoundary != "" {
+			attributes = append(attributes, hclAttribute{"permissions_boundary", hclString(role.PermissionsBoundary)})
+		}
+		w.block(fmt.Sprintf(`resource "aws_iam_role" "%s"`, name), withTags(attributes, role.Tags)...)
+	}
+
+	policies := map[*Policy]string{}
+	for _, policy := range t.Policies {
+		name := w.name("aws_iam_policy", policy.Name)
+		policies[policy] = name
+		attributes := []hclAttribute{{"name", hclString(policy.Name)}}
+		if policy.Path != "" {
+			attributes = append(attributes, hclAttribute{"path", hclString(policy.Path)})
+		}
+		document := hclFile(policy.Document)
+		if policy.Content != "" {
+			document = hclString(policy.Content)
+		}
+		attributes = append(attributes, hclAttribute{"policy", document})
+		w.block(fmt.Sprintf(`resource "aws_iam_policy" "%s"`, name), withTags(attributes, policy.Tags)...)
+	}
+
+	for _, attachment := range t.Attachments {
+		role := hclString(attachment.Role)
+		if name, ok := roles[attachment.Role]; ok {
+			role = fmt.Sprintf("aws_iam_role.%s.name", name)
+		}
+		policyARN := hclString(attachment.PolicyARN)
+		policyName := attachment.PolicyARN[strings.LastIndex(attachment.PolicyARN, "/")+1:]
+		if policy := t.policy(attachment.PolicyARN); policy != nil {
+			policyARN = fmt.Sprintf("aws_iam_policy.%s.arn", policies[policy])
+			policyName = policy.Name
+		}
+		name := w.name("aws_iam_role_policy_attachment", attachment.Role+"_"+policyName)
+		w.block(fmt.Sprintf(`resource "aws_iam_role_policy_attachment" "%s"`, name),
+			hclAttribute{"role", role},
+			hclAttribute{"policy_arn", policyARN},
+		)
+	}
+
+	providers := map[*OIDCProvider]string{}
+	for _, provider := range t.OIDCProviders {
+		name := w.name("aws_iam_openid_connect_provider", strings.TrimPrefix(provider.URL, "https://"))
+		providers[provider] = name
+		attributes := []hclAttribute{
+			{"url", hclString(provider.URL)},
+			{"client_id_list", hclList(provider.ClientIDs)},
+			{"thumbprint_list", hclList(provider.Thumbprints)},
+		}
+		w.block(fmt.Sprintf(`resource "aws_iam_openid_connect_provider" "%s"`, name),
+			withTags(attributes, provider.Tags)...)
+	}
+
+	for _, bucket := range t.Buckets {
+		name := w.name("aws_s3_bucket", bucket.Name)
+		reference := fmt.Sprintf("aws_s3_bucket.%s.id", name)
+		w.block(fmt.Sprintf(`resource "aws_s3_bucket" "%s"`, name),
+			withTags([]hclAttribute{{"bucket", hclString(bucket.Name)}}, bucket.Tags)...)
+		accessBlock := ""
+		if bucket.PublicAccessBlock != nil {
+			accessBlock = w.name("aws_s3_bucket_public_access_block", bucket.Name)
+			attributes := []hclAttribute{{"bucket", reference}}
+			for _, setting := range publicAccessBlockSettings {
+				attributes = append(attributes,
+					hclAttribute{setting[1], fmt.Sprint(bucket.PublicAccessBlock[setting[0]])})
+			}
+			w.block(fmt.Sprintf(`resource "aws_s3_bucket_public_access_block" "%s"`, accessBlock),
+				attributes...)
+		}
+		if bucket.Policy != "" {
+			attributes := []hclAttribute{{"bucket", reference}, {"policy", hclFile(bucket.Policy)}}
+			if accessBlock != "" {
+				// The policy is rejected while the public access block still blocks public policies:
+				attributes = append(attributes, hclAttribute{
+					"depends_on", fmt.Sprintf("[aws_s3_bucket_public_access_block.%s]", accessBlock),
+				})
+			}
+			w.block(fmt.Sprintf(`resource "aws_s3_bucket_policy" "%s"`, w.name("aws_s3_bucket_policy", bucket.Name)),
+				attributes...)
+		}
+		for _, object := range bucket.Objects {
+			attributes := []hclAttribute{
+				{"bucket", reference},
+				{"key", hclString(object.Key)},
+				{"source", fmt.Sprintf(`"${path.module}/%s"`, object.Source)},
+			}
+			w.block(fmt.Sprintf(`resource "aws_s3_object" "%s"`, w.name("aws_s3_object", bucket.Name+"_"+object.Key)),
+				withTags(attributes, object.Tags)...)
+		}
+	}
+
+	for _, secret := range t.Secrets {
+		name := w.name("aws_secretsmanager_secret", secret.Name)
+		attributes := []hclAttribute{{"name", hclString(secret.Name)}}
+		if secret.Description != "" {
+			attributes = append(attributes, hclAttribute{"description", hclString(secret.Description)})
+		}
+		w.block(fmt.Sprintf(`resource "aws_secretsmanager_secret" "%s"`, name), withTags(attributes, secret.Tags)...)
+		w.block(fmt.Sprintf(`resource "aws_secretsmanager_secret_version" "%s"`, name),
+			hclAttribute{"secret_id", fmt.Sprintf("aws_secretsmanager_secret.%s.id", name)},
+			hclAttribute{"secret_string", hclFile(secret.Source)},
+		)
+	}
+
+	for _, role := range t.Roles {
+		name := roles[role.Name]
+		w.block(fmt.Sprintf(`output "%s_arn"`, name), hclAttribute{"value", fmt.Sprintf("aws_iam_role.%s.arn", name)})
+	}
+	for _, provider := range t.OIDCProviders {
+		name := providers[provider]
+		w.block(fmt.Sprintf(`output "%s_arn"`, name),
+			hclAttribute{"value", fmt.Sprintf("aws_iam_openid_connect_provider.%s.arn", name)})
+	}
+	return strings.TrimSuffix(w.builder.String(), "\n")
+}
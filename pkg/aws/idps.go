@@ -19,6 +19,7 @@ package aws
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
@@ -87,6 +88,17 @@ func (c *awsClient) HasOpenIDConnectProvider(issuerURL string, partition string,
 	return true, nil
 }
 
+// GetOpenIDConnectProviderCreateDate returns the date when the OIDC provider was created
+func (c *awsClient) GetOpenIDConnectProviderCreateDate(oidcProviderARN string) (time.Time, error) {
+	output, err := c.iamClient.GetOpenIDConnectProvider(context.Background(), &iam.GetOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: aws.String(oidcProviderARN),
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	return aws.ToTime(output.CreateDate), nil
+}
+
 func (c *awsClient) DeleteOpenIDConnectProvider(oidcProviderARN string) error {
 	_, err := c.iamClient.DeleteOpenIDConnectProvider(context.TODO(), &iam.DeleteOpenIDConnectProviderInput{
 		OpenIDConnectProviderArn: aws.String(oidcProviderARN),
@@ -22,6 +22,7 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
@@ -89,6 +90,9 @@ type OperatorRoleDetail struct {
 	ClusterID         string   `json:"ClusterID,omitempty"`
 	AttachedPolicies  []string `json:"Policy,omitempty"`
 	ManagedPolicy     bool     `json:"ManagedPolicy,omitempty"`
+
+	// CreateDate is the date when the role was created
+	CreateDate time.Time `json:"-"`
 }
 
 type PolicyDetail struct {
@@ -996,6 +1000,7 @@ func (c *awsClient) ListOperatorRoles(targetVersion string,
 			continue
 		}
 
+		operatorRole.CreateDate = aws.ToTime(role.CreateDate)
 		if operatorRole.ManagedPolicy || len(attachedPoliciesOutput.AttachedPolicies) == 0 {
 			operatorRole.RoleName = aws.ToString(role.RoleName)
 			operatorRole.RoleARN = aws.ToString(role.Arn)
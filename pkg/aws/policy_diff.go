@@ -0,0 +1,154 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// PolicyStatementDiff contains the actions and resources that are added to and removed from a
+// statement of a policy document
+type PolicyStatementDiff struct {
+	// Name is the Sid of the statement, or its effect and position when it doesn't have one
+	Name             string
+	AddedActions     []string
+	RemovedActions   []string
+	AddedResources   []string
+	RemovedResources []string
+}
+
+// IsEmpty returns true when the statement doesn't change
+func (d *PolicyStatementDiff) IsEmpty() bool {
+	return len(d.AddedActions) == 0 && len(d.RemovedActions) == 0 &&
+		len(d.AddedResources) == 0 && len(d.RemovedResources) == 0
+}
+
+// Lines returns the changes of the statement, one per line, prefixed with '+' or '-'
+func (d *PolicyStatementDiff) Lines() []string {
+	var lines []string
+	for _, action := range d.AddedActions {
+		lines = append(lines, fmt.Sprintf("+ action: %s", action))
+	}
+	for _, action := range d.RemovedActions {
+		lines = append(lines, fmt.Sprintf("- action: %s", action))
+	}
+	for _, resource := range d.AddedResources {
+		lines = append(lines, fmt.Sprintf("+ resource: %s", resource))
+	}
+	for _, resource := range d.RemovedResources {
+		lines = append(lines, fmt.Sprintf("- resource: %s", resource))
+	}
+	return lines
+}
+
+// DiffPolicyDocuments compares two policy documents statement by statement. Statements are matched
+// by their Sid, and the ones without a Sid by their effect and their position among the statements
+// without a Sid. Only the statements that change are returned.
+func DiffPolicyDocuments(current *PolicyDocument, target *PolicyDocument) []PolicyStatementDiff {
+	currentStatements := statementsByName(current)
+	targetStatements := statementsByName(target)
+
+	names := map[string]bool{}
+	for name := range currentStatements {
+		names[name] = true
+	}
+	for name := range targetStatements {
+		names[name] = true
+	}
+	sortedNames := make([]string, 0, len(names))
+	for name := range names {
+		sortedNames = append(sortedNames, name)
+	}
+	sort.Strings(sortedNames)
+
+	var result []PolicyStatementDiff
+	for _, name := range sortedNames {
+		currentStatement := currentStatements[name]
+		targetStatement := targetStatements[name]
+		diff := PolicyStatementDiff{Name: name}
+		diff.AddedActions, diff.RemovedActions = diffValues(
+			statementValues(currentStatement, func(s PolicyStatement) interface{} { return s.Action }),
+			statementValues(targetStatement, func(s PolicyStatement) interface{} { return s.Action }),
+		)
+		diff.AddedResources, diff.RemovedResources = diffValues(
+			statementValues(currentStatement, func(s PolicyStatement) interface{} { return s.Resource }),
+			statementValues(targetStatement, func(s PolicyStatement) interface{} { return s.Resource }),
+		)
+		if !diff.IsEmpty() {
+			result = append(result, diff)
+		}
+	}
+	return result
+}
+
+func statementsByName(document *PolicyDocument) map[string]*PolicyStatement {
+	result := map[string]*PolicyStatement{}
+	if document == nil {
+		return result
+	}
+	positions := map[string]int{}
+	for i := range document.Statement {
+		statement := &document.Statement[i]
+		name := statement.Sid
+		if name == "" {
+			positions[statement.Effect]++
+			name = fmt.Sprintf("%s #%d", statement.Effect, positions[statement.Effect])
+		}
+		result[name] = statement
+	}
+	return result
+}
+
+func statementValues(statement *PolicyStatement, field func(PolicyStatement) interface{}) []string {
+	if statement == nil {
+		return nil
+	}
+	switch value := field(*statement).(type) {
+	case string:
+		return []string{value}
+	case []string:
+		return value
+	case []interface{}:
+		var result []string
+		for _, item := range value {
+			if text, ok := item.(string); ok {
+				result = append(result, text)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+// diffValues returns the sorted values that are only in the target and only in the current list
+func diffValues(current []string, target []string) (added []string, removed []string) {
+	for _, value := range target {
+		if !slices.Contains(current, value) && !slices.Contains(added, value) {
+			added = append(added, value)
+		}
+	}
+	for _, value := range current {
+		if !slices.Contains(target, value) && !slices.Contains(removed, value) {
+			removed = append(removed, value)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
@@ -0,0 +1,65 @@
+package aws
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DiffPolicyDocuments", func() {
+	parse := func(document string) *PolicyDocument {
+		policy, err := ParsePolicyDocument(document)
+		Expect(err).NotTo(HaveOccurred())
+		return policy
+	}
+
+	It("Finds no changes between equal documents", func() {
+		policy := parse(`{"Statement": [{"Effect": "Allow", "Action": ["ec2:RunInstances"], "Resource": "*"}]}`)
+		Expect(DiffPolicyDocuments(policy, policy)).To(BeEmpty())
+	})
+
+	It("Compares the statements by their Sid", func() {
+		current := parse(`{"Statement": [
+			{"Sid": "EC2", "Effect": "Allow", "Action": ["ec2:RunInstances", "ec2:DeleteTags"], "Resource": "*"},
+			{"Sid": "S3", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::old/*"}
+		]}`)
+		target := parse(`{"Statement": [
+			{"Sid": "S3", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::new/*"},
+			{"Sid": "EC2", "Effect": "Allow", "Action": ["ec2:CreateTags", "ec2:RunInstances"], "Resource": "*"}
+		]}`)
+		Expect(DiffPolicyDocuments(current, target)).To(Equal([]PolicyStatementDiff{
+			{
+				Name:           "EC2",
+				AddedActions:   []string{"ec2:CreateTags"},
+				RemovedActions: []string{"ec2:DeleteTags"},
+			},
+			{
+				Name:             "S3",
+				AddedResources:   []string{"arn:aws:s3:::new/*"},
+				RemovedResources: []string{"arn:aws:s3:::old/*"},
+			},
+		}))
+	})
+
+	It("Compares the statements without Sid by their position", func() {
+		current := parse(`{"Statement": [{"Effect": "Allow", "Action": "iam:GetRole", "Resource": "*"}]}`)
+		target := parse(`{"Statement": [
+			{"Effect": "Allow", "Action": "iam:GetRole", "Resource": "*"},
+			{"Effect": "Allow", "Action": "iam:ListRoles", "Resource": "*"}
+		]}`)
+		diff := DiffPolicyDocuments(current, target)
+		Expect(diff).To(Equal([]PolicyStatementDiff{{
+			Name:           "Allow #2",
+			AddedActions:   []string{"iam:ListRoles"},
+			AddedResources: []string{"*"},
+		}}))
+		Expect(diff[0].Lines()).To(Equal([]string{"+ action: iam:ListRoles", "+ resource: *"}))
+	})
+
+	It("Reports all the statements as added when there is no current document", func() {
+		target := parse(`{"Statement": [{"Sid": "All", "Effect": "Allow", "Action": "sts:AssumeRole"}]}`)
+		Expect(DiffPolicyDocuments(NewPolicyDocument(), target)).To(Equal([]PolicyStatementDiff{{
+			Name:         "All",
+			AddedActions: []string{"sts:AssumeRole"},
+		}}))
+	})
+})
@@ -6,120 +6,295 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
 	servicequotastypes "github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
 )
 
-type quota struct {
-	ServiceCode  string
-	QuotaName    string
-	QuotaCode    string
-	DesiredValue *float64
+const IAMServiceCode = "iam"
+
+// Codes of the service quotas that limit the resources created for the nodes and the load balancers
+// of a cluster.
+const (
+	EC2ServiceCode = "ec2"
+	EBSServiceCode = "ebs"
+	ELBServiceCode = "elasticloadbalancing"
+
+	StandardInstancesQuotaCode    = "L-1216C47A"
+	GP3StorageQuotaCode           = "L-7A658B76"
+	NetworkLoadBalancersQuotaCode = "L-69A177A2"
+	ClassicLoadBalancersQuotaCode = "L-E9E9831D"
+)
+
+// instancesQuotaCodes maps the families of the instance types to the codes of the quotas that limit
+// the vCPUs of their running On-Demand instances.
+var instancesQuotaCodes = map[string]string{
+	"a":   StandardInstancesQuotaCode,
+	"c":   StandardInstancesQuotaCode,
+	"d":   StandardInstancesQuotaCode,
+	"h":   StandardInstancesQuotaCode,
+	"i":   StandardInstancesQuotaCode,
+	"im":  StandardInstancesQuotaCode,
+	"is":  StandardInstancesQuotaCode,
+	"m":   StandardInstancesQuotaCode,
+	"r":   StandardInstancesQuotaCode,
+	"t":   StandardInstancesQuotaCode,
+	"z":   StandardInstancesQuotaCode,
+	"dl":  "L-6E869C2A",
+	"f":   "L-74FC7D96",
+	"g":   "L-DB2E81BA",
+	"gr":  "L-DB2E81BA",
+	"vt":  "L-DB2E81BA",
+	"hpc": "L-F7808C92",
+	"inf": "L-1945791B",
+	"p":   "L-417A185B",
+	"trn": "L-2C3B7624",
+	"u":   "L-43DA4232",
+	"x":   "L-7295265B",
 }
 
-const IAMServiceCode = "iam"
+// QuotaNames are the names of the quotas that limit the resources created for clusters, as shown
+// by the Service Quotas console.
+var QuotaNames = map[string]string{
+	StandardInstancesQuotaCode:    "Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances",
+	"L-6E869C2A":                  "Running On-Demand DL instances",
+	"L-74FC7D96":                  "Running On-Demand F instances",
+	"L-DB2E81BA":                  "Running On-Demand G and VT instances",
+	"L-F7808C92":                  "Running On-Demand HPC instances",
+	"L-1945791B":                  "Running On-Demand Inf instances",
+	"L-417A185B":                  "Running On-Demand P instances",
+	"L-2C3B7624":                  "Running On-Demand Trn instances",
+	"L-43DA4232":                  "Running On-Demand High Memory instances",
+	"L-7295265B":                  "Running On-Demand X instances",
+	GP3StorageQuotaCode:           "Storage for General Purpose SSD (gp3) volumes, in TiB",
+	NetworkLoadBalancersQuotaCode: "Network Load Balancers per Region",
+	ClassicLoadBalancersQuotaCode: "Classic Load Balancers per Region",
+}
+
+// InstancesQuotaCode returns the code of the EC2 quota that limits the vCPUs of the running On-Demand
+// instances of the given type, or an empty string if it isn't known.
+func InstancesQuotaCode(instanceType string) string {
+	family := strings.ToLower(instanceType)
+	if i := strings.IndexAny(family, "0123456789-."); i >= 0 {
+		family = family[:i]
+	}
+	return instancesQuotaCodes[family]
+}
+
+// Topologies of the clusters that the quota requirements apply to.
+const (
+	ClassicTopology  = "classic"
+	HostedCPTopology = "hosted-cp"
+)
+
+// ClusterSizes are the sizes of the clusters that the quota can be verified for. The values of the
+// requirements that scale with the size of the clusters are multiplied by them.
+var ClusterSizes = map[string]float64{
+	"small":  1,
+	"medium": 2,
+	"large":  4,
+}
 
-// List of service quotas we verify for cluster installs
-// to support 5 x multi zone clusters
-var serviceQuotaServices = []quota{
+const DefaultClusterSize = "small"
+
+// QuotaRequirement is the value of a service quota needed to install clusters of each topology. A
+// zero value means that clusters of that topology don't use the quota.
+type QuotaRequirement struct {
+	ServiceCode string
+	QuotaCode   string
+	QuotaName   string
+	Classic     float64
+	HostedCP    float64
+	// Scales is true when the value grows with the size of the clusters.
+	Scales bool
+}
+
+// Required returns the value of the quota needed by clusters of the given topology and size.
+func (q *QuotaRequirement) Required(topology string, size float64) float64 {
+	required := q.Classic
+	if topology == HostedCPTopology {
+		required = q.HostedCP
+	}
+	if q.Scales {
+		required *= size
+	}
+	return required
+}
+
+// QuotaRequirements are the service quotas verified for cluster installs, with the values needed to
+// support 5 x multi zone clusters of the small size. Hosted control plane clusters run the control
+// plane outside of the account and use an existing VPC, so they need less.
+var QuotaRequirements = []*QuotaRequirement{
+	{
+		ServiceCode: EC2ServiceCode,
+		QuotaCode:   "L-0263D0A3",
+		QuotaName:   "Number of EIPs - VPC EIPs",
+		Classic:     5,
+	},
 	{
-		ServiceCode:  "ec2",
-		QuotaCode:    "L-0263D0A3",
-		QuotaName:    "Number of EIPs - VPC EIPs",
-		DesiredValue: aws.Float64(5.0),
+		ServiceCode: EC2ServiceCode,
+		QuotaCode:   StandardInstancesQuotaCode,
+		QuotaName:   QuotaNames[StandardInstancesQuotaCode],
+		Classic:     100,
+		HostedCP:    40,
+		Scales:      true,
 	},
 	{
-		ServiceCode:  "ec2",
-		QuotaCode:    "L-1216C47A",
-		QuotaName:    "Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances",
-		DesiredValue: aws.Float64(100.0),
+		ServiceCode: "vpc",
+		QuotaCode:   "L-F678F1CE",
+		QuotaName:   "VPCs per Region",
+		Classic:     5,
 	},
 	{
-		ServiceCode:  "vpc",
-		QuotaCode:    "L-F678F1CE",
-		QuotaName:    "VPCs per Region",
-		DesiredValue: aws.Float64(5.0),
+		ServiceCode: "vpc",
+		QuotaCode:   "L-A4707A72",
+		QuotaName:   "Internet gateways per Region",
+		Classic:     5,
 	},
 	{
-		ServiceCode:  "vpc",
-		QuotaCode:    "L-A4707A72",
-		QuotaName:    "Internet gateways per Region",
-		DesiredValue: aws.Float64(5.0),
+		ServiceCode: "vpc",
+		QuotaCode:   "L-DF5E4CA3",
+		QuotaName:   "Network interfaces per Region",
+		Classic:     5000,
+		HostedCP:    1000,
+		Scales:      true,
 	},
 	{
-		ServiceCode:  "vpc",
-		QuotaCode:    "L-DF5E4CA3",
-		QuotaName:    "Network interfaces per Region",
-		DesiredValue: aws.Float64(5000.0),
+		ServiceCode: EBSServiceCode,
+		QuotaCode:   "L-D18FCD1D",
+		QuotaName:   "General Purpose SSD (gp2) volume storage",
+		Classic:     50,
+		Scales:      true,
 	},
 	{
-		ServiceCode:  "ebs",
-		QuotaCode:    "L-D18FCD1D",
-		QuotaName:    "General Purpose SSD (gp2) volume storage",
-		DesiredValue: aws.Float64(50.0),
+		ServiceCode: EBSServiceCode,
+		QuotaCode:   GP3StorageQuotaCode,
+		QuotaName:   QuotaNames[GP3StorageQuotaCode],
+		HostedCP:    20,
+		Scales:      true,
 	},
 	{
-		ServiceCode:  "ebs",
-		QuotaCode:    "L-309BACF6",
-		QuotaName:    "Number of EBS snapshots",
-		DesiredValue: aws.Float64(300.0),
+		ServiceCode: EBSServiceCode,
+		QuotaCode:   "L-309BACF6",
+		QuotaName:   "Number of EBS snapshots",
+		Classic:     300,
 	},
 	{
-		ServiceCode:  "ebs",
-		QuotaCode:    "L-B3A130E6",
-		QuotaName:    "Provisioned IOPS",
-		DesiredValue: aws.Float64(300000.0),
+		ServiceCode: EBSServiceCode,
+		QuotaCode:   "L-B3A130E6",
+		QuotaName:   "Provisioned IOPS",
+		Classic:     300000,
+		Scales:      true,
 	},
 	{
-		ServiceCode:  "ebs",
-		QuotaCode:    "L-FD252861",
-		QuotaName:    "Provisioned IOPS SSD (io1) volume storage",
-		DesiredValue: aws.Float64(50.0),
+		ServiceCode: EBSServiceCode,
+		QuotaCode:   "L-FD252861",
+		QuotaName:   "Provisioned IOPS SSD (io1) volume storage",
+		Classic:     50,
+		Scales:      true,
 	},
 	{
-		ServiceCode:  "elasticloadbalancing",
-		QuotaCode:    "L-53DA6B97",
-		QuotaName:    "Application Load Balancers per Region",
-		DesiredValue: aws.Float64(50.0),
+		ServiceCode: ELBServiceCode,
+		QuotaCode:   "L-53DA6B97",
+		QuotaName:   "Application Load Balancers per Region",
+		Classic:     50,
 	},
 	{
-		ServiceCode:  "elasticloadbalancing",
-		QuotaCode:    "L-E9E9831D",
-		QuotaName:    "Classic Load Balancers per Region",
-		DesiredValue: aws.Float64(20.0),
+		ServiceCode: ELBServiceCode,
+		QuotaCode:   ClassicLoadBalancersQuotaCode,
+		QuotaName:   QuotaNames[ClassicLoadBalancersQuotaCode],
+		Classic:     20,
+	},
+	{
+		ServiceCode: ELBServiceCode,
+		QuotaCode:   NetworkLoadBalancersQuotaCode,
+		QuotaName:   QuotaNames[NetworkLoadBalancersQuotaCode],
+		HostedCP:    5,
 	},
 }
 
-// ValidateQuota
-func (c *awsClient) ValidateQuota() (bool, error) {
-	var invalidQuotas []string
-	for _, quota := range serviceQuotaServices {
-		serviceQuotas, err := ListServiceQuotas(c, quota.ServiceCode)
-		if err != nil {
-			return false, fmt.Errorf("Error listing AWS service quotas: %s %v", quota.ServiceCode, err)
-		}
+// Statuses of the quotas in the quota reports.
+const (
+	QuotaStatusOK           = "ok"
+	QuotaStatusInsufficient = "insufficient"
+	QuotaStatusError        = "error"
+)
 
-		serviceQuota, err := GetServiceQuota(serviceQuotas, quota.QuotaCode)
-		if err != nil || (serviceQuota).Value == nil {
-			return false, fmt.Errorf("Error getting AWS service quota: %s %v", quota.ServiceCode, err)
-		}
+// QuotaReport is the result of verifying a service quota in a region.
+type QuotaReport struct {
+	Region      string  `json:"region"`
+	ServiceCode string  `json:"service_code"`
+	QuotaCode   string  `json:"quota_code"`
+	QuotaName   string  `json:"quota_name"`
+	Value       float64 `json:"value"`
+	Required    float64 `json:"required"`
+	Status      string  `json:"status"`
+	Adjustable  bool    `json:"adjustable"`
+	Message     string  `json:"message,omitempty"`
+}
 
-		if *serviceQuota.Value < *quota.DesiredValue {
-			invalidQuotas = append(invalidQuotas, fmt.Sprintf(
-				"- Service %s quota code %s %s not valid, expected quota of at least %d, but got %d",
-				quota.ServiceCode, quota.QuotaCode, quota.QuotaName,
-				int(*quota.DesiredValue), int(*serviceQuota.Value)))
+// VerifyQuotas compares the values of the service quotas in the region of the client with the values
+// needed by clusters of the given topology and size. Quotas whose value can't be obtained are reported
+// with the error status, and the rest of the quotas are still verified.
+func (c *awsClient) VerifyQuotas(requirements []*QuotaRequirement, topology string,
+	size float64) []*QuotaReport {
+	reports := []*QuotaReport{}
+	for _, requirement := range requirements {
+		required := requirement.Required(topology, size)
+		if required == 0 {
+			continue
+		}
+		report := &QuotaReport{
+			Region:      c.GetRegion(),
+			ServiceCode: requirement.ServiceCode,
+			QuotaCode:   requirement.QuotaCode,
+			QuotaName:   requirement.QuotaName,
+			Required:    required,
+			Status:      QuotaStatusOK,
 		}
+		reports = append(reports, report)
 
-		c.logger.Debug(fmt.Sprintf("Service %s quota code %s is ok", quota.ServiceCode, quota.QuotaCode))
+		output, err := c.serviceQuotasClient.GetServiceQuota(context.Background(),
+			&servicequotas.GetServiceQuotaInput{
+				ServiceCode: aws.String(requirement.ServiceCode),
+				QuotaCode:   aws.String(requirement.QuotaCode),
+			})
+		if err == nil && (output.Quota == nil || output.Quota.Value == nil) {
+			err = fmt.Errorf("quota has no value")
+		}
+		if err != nil {
+			report.Status = QuotaStatusError
+			report.Message = fmt.Sprintf("Error getting AWS service quota: %v", err)
+			continue
+		}
+		report.Value = *output.Quota.Value
+		report.Adjustable = output.Quota.Adjustable
+		if report.Value < report.Required {
+			report.Status = QuotaStatusInsufficient
+		}
+		c.logger.Debug(fmt.Sprintf("Service %s quota code %s is %s", requirement.ServiceCode,
+			requirement.QuotaCode, report.Status))
 	}
+	return reports
+}
 
-	if len(invalidQuotas) > 0 {
-		return false, fmt.Errorf("Service quota is insufficient for the following service quota codes:\n%s",
-			strings.Join(invalidQuotas, "\n"))
+// RequestQuotaIncrease requests the increase of a service quota in the region of the client to the
+// given value, returning the identifier of the request.
+func (c *awsClient) RequestQuotaIncrease(serviceCode string, quotaCode string, value float64) (string, error) {
+	output, err := c.serviceQuotasClient.RequestServiceQuotaIncrease(context.Background(),
+		&servicequotas.RequestServiceQuotaIncreaseInput{
+			ServiceCode:  aws.String(serviceCode),
+			QuotaCode:    aws.String(quotaCode),
+			DesiredValue: aws.Float64(value),
+		})
+	if err != nil {
+		return "", err
 	}
-
-	return true, nil
+	if output.RequestedQuota == nil {
+		return "", nil
+	}
+	return aws.ToString(output.RequestedQuota.Id), nil
 }
 
 // ListServiceQuotas list available quotas for service
@@ -162,3 +337,114 @@ func (c *awsClient) GetIAMServiceQuota(quotaCode string) (
 		QuotaCode:   aws.String(quotaCode),
 	})
 }
+
+// GetServiceQuotaValue returns the applied value of a service quota in the region of the client.
+func (c *awsClient) GetServiceQuotaValue(serviceCode string, quotaCode string) (float64, error) {
+	output, err := c.serviceQuotasClient.GetServiceQuota(context.Background(), &servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if output.Quota == nil || output.Quota.Value == nil {
+		return 0, fmt.Errorf("service quota '%s' of service '%s' has no value", quotaCode, serviceCode)
+	}
+	return *output.Quota.Value, nil
+}
+
+// Types of load balancers counted in the quota usage.
+const (
+	ClassicLoadBalancer     = "classic"
+	NetworkLoadBalancer     = "network"
+	ApplicationLoadBalancer = "application"
+)
+
+// QuotaUsage is the usage of the resources limited by the service quotas of clusters, in the region
+// of the client.
+type QuotaUsage struct {
+	// VCPUs are the vCPUs of the running On-Demand instances, by the code of the quota that limits them.
+	VCPUs map[string]float64
+	// VolumeStorage is the size in GiB of the EBS volumes, by volume type.
+	VolumeStorage map[string]float64
+	// LoadBalancers is the number of load balancers, by type.
+	LoadBalancers map[string]int
+}
+
+// GetQuotaUsage returns the usage of the resources limited by the service quotas of clusters. Load
+// balancers are counted from the network interfaces that they create, so that only the EC2 API is
+// needed.
+func (c *awsClient) GetQuotaUsage() (*QuotaUsage, error) {
+	usage := &QuotaUsage{
+		VCPUs:         map[string]float64{},
+		VolumeStorage: map[string]float64{},
+		LoadBalancers: map[string]int{},
+	}
+
+	instances := ec2.NewDescribeInstancesPaginator(c.ec2Client, &ec2.DescribeInstancesInput{
+		Filters: []ec2types.Filter{{
+			Name:   aws.String("instance-state-name"),
+			Values: []string{"pending", "running"},
+		}},
+	})
+	for instances.HasMorePages() {
+		page, err := instances.NextPage(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe instances: %w", err)
+		}
+		for _, reservation := range page.Reservations {
+			for _, instance := range reservation.Instances {
+				quotaCode := InstancesQuotaCode(string(instance.InstanceType))
+				if quotaCode == "" || instance.InstanceLifecycle == ec2types.InstanceLifecycleTypeSpot ||
+					instance.CpuOptions == nil {
+					continue
+				}
+				usage.VCPUs[quotaCode] += float64(aws.ToInt32(instance.CpuOptions.CoreCount) *
+					aws.ToInt32(instance.CpuOptions.ThreadsPerCore))
+			}
+		}
+	}
+
+	volumes := ec2.NewDescribeVolumesPaginator(c.ec2Client, &ec2.DescribeVolumesInput{})
+	for volumes.HasMorePages() {
+		page, err := volumes.NextPage(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe volumes: %w", err)
+		}
+		for _, volume := range page.Volumes {
+			usage.VolumeStorage[string(volume.VolumeType)] += float64(aws.ToInt32(volume.Size))
+		}
+	}
+
+	loadBalancers := map[string]bool{}
+	interfaces := ec2.NewDescribeNetworkInterfacesPaginator(c.ec2Client, &ec2.DescribeNetworkInterfacesInput{
+		Filters: []ec2types.Filter{{
+			Name:   aws.String("requester-id"),
+			Values: []string{"amazon-elb"},
+		}},
+	})
+	for interfaces.HasMorePages() {
+		page, err := interfaces.NextPage(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe network interfaces: %w", err)
+		}
+		for _, networkInterface := range page.NetworkInterfaces {
+			// The descriptions are 'ELB net/name/id', 'ELB app/name/id' or 'ELB name', and are the same
+			// for all the interfaces of a load balancer:
+			description := aws.ToString(networkInterface.Description)
+			if !strings.HasPrefix(description, "ELB ") || loadBalancers[description] {
+				continue
+			}
+			loadBalancers[description] = true
+			switch {
+			case strings.HasPrefix(description, "ELB net/"):
+				usage.LoadBalancers[NetworkLoadBalancer]++
+			case strings.HasPrefix(description, "ELB app/"):
+				usage.LoadBalancers[ApplicationLoadBalancer]++
+			default:
+				usage.LoadBalancers[ClassicLoadBalancer]++
+			}
+		}
+	}
+	return usage, nil
+}
@@ -7,6 +7,8 @@ import (
 	gomock "go.uber.org/mock/gomock"
 
 	awsSdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
 	servicequotastypes "github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
 	. "github.com/onsi/ginkgo/v2"
@@ -156,4 +158,173 @@ var _ = Describe("Quota", func() {
 			Expect(result).To(BeNil())
 		})
 	})
+
+	DescribeTable("InstancesQuotaCode",
+		func(instanceType string, quotaCode string) {
+			Expect(InstancesQuotaCode(instanceType)).To(Equal(quotaCode))
+		},
+		Entry("standard", "m5.xlarge", StandardInstancesQuotaCode),
+		Entry("standard with suffixes", "c7gn.16xlarge", StandardInstancesQuotaCode),
+		Entry("storage optimized", "im4gn.large", StandardInstancesQuotaCode),
+		Entry("accelerated", "g4dn.xlarge", "L-DB2E81BA"),
+		Entry("inference", "inf2.xlarge", "L-1945791B"),
+		Entry("high memory", "u-6tb1.metal", "L-43DA4232"),
+		Entry("unknown", "mac2.metal", ""),
+	)
+
+	Context("GetQuotaUsage", func() {
+		var (
+			client           Client
+			mockCtrl         *gomock.Controller
+			mockEc2API       *mocks.MockEc2ApiClient
+			mockServiceQuota *mocks.MockServiceQuotasApiClient
+		)
+
+		BeforeEach(func() {
+			mockCtrl = gomock.NewController(GinkgoT())
+			mockEc2API = mocks.NewMockEc2ApiClient(mockCtrl)
+			mockServiceQuota = mocks.NewMockServiceQuotasApiClient(mockCtrl)
+			client = New(
+				awsSdk.Config{},
+				NewLoggerWrapper(logrus.New(), nil),
+				mocks.NewMockIamApiClient(mockCtrl),
+				mockEc2API,
+				mocks.NewMockOrganizationsApiClient(mockCtrl),
+				mocks.NewMockS3ApiClient(mockCtrl),
+				mocks.NewMockSecretsManagerApiClient(mockCtrl),
+				mocks.NewMockStsApiClient(mockCtrl),
+				mocks.NewMockCloudFormationApiClient(mockCtrl),
+				mockServiceQuota,
+				mocks.NewMockServiceQuotasApiClient(mockCtrl),
+				&AccessKey{},
+				false,
+			)
+		})
+
+		AfterEach(func() {
+			mockCtrl.Finish()
+		})
+
+		It("Adds the vCPUs, volumes and load balancers", func() {
+			instance := func(instanceType ec2types.InstanceType, cores int32,
+				lifecycle ec2types.InstanceLifecycleType) ec2types.Instance {
+				return ec2types.Instance{
+					InstanceType:      instanceType,
+					InstanceLifecycle: lifecycle,
+					CpuOptions: &ec2types.CpuOptions{
+						CoreCount:      awsSdk.Int32(cores),
+						ThreadsPerCore: awsSdk.Int32(2),
+					},
+				}
+			}
+			mockEc2API.EXPECT().DescribeInstances(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				&ec2.DescribeInstancesOutput{Reservations: []ec2types.Reservation{{Instances: []ec2types.Instance{
+					instance("m5.xlarge", 2, ""),
+					instance("r5.xlarge", 2, ""),
+					instance("m5.2xlarge", 4, ec2types.InstanceLifecycleTypeSpot),
+					instance("g4dn.xlarge", 2, ""),
+				}}}}, nil)
+			mockEc2API.EXPECT().DescribeVolumes(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				&ec2.DescribeVolumesOutput{Volumes: []ec2types.Volume{
+					{VolumeType: ec2types.VolumeTypeGp3, Size: awsSdk.Int32(300)},
+					{VolumeType: ec2types.VolumeTypeGp3, Size: awsSdk.Int32(350)},
+					{VolumeType: ec2types.VolumeTypeGp2, Size: awsSdk.Int32(100)},
+				}}, nil)
+			mockEc2API.EXPECT().DescribeNetworkInterfaces(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				&ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: []ec2types.NetworkInterface{
+					{Description: awsSdk.String("ELB net/api-int/1234")},
+					{Description: awsSdk.String("ELB net/api-int/1234")},
+					{Description: awsSdk.String("ELB net/api-ext/5678")},
+					{Description: awsSdk.String("ELB a1b2c3")},
+					{Description: awsSdk.String("ELB app/console/9abc")},
+				}}, nil)
+
+			usage, err := client.GetQuotaUsage()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage.VCPUs).To(Equal(map[string]float64{
+				StandardInstancesQuotaCode: 8,
+				"L-DB2E81BA":               4,
+			}))
+			Expect(usage.VolumeStorage).To(Equal(map[string]float64{"gp3": 650, "gp2": 100}))
+			Expect(usage.LoadBalancers).To(Equal(map[string]int{
+				NetworkLoadBalancer:     2,
+				ClassicLoadBalancer:     1,
+				ApplicationLoadBalancer: 1,
+			}))
+		})
+
+		It("Returns the value of a service quota", func() {
+			mockServiceQuota.EXPECT().GetServiceQuota(
+				context.Background(),
+				&servicequotas.GetServiceQuotaInput{
+					ServiceCode: awsSdk.String(EC2ServiceCode),
+					QuotaCode:   awsSdk.String(StandardInstancesQuotaCode),
+				},
+			).Return(&servicequotas.GetServiceQuotaOutput{
+				Quota: &servicequotastypes.ServiceQuota{Value: awsSdk.Float64(640)},
+			}, nil)
+
+			value, err := client.GetServiceQuotaValue(EC2ServiceCode, StandardInstancesQuotaCode)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal(640.0))
+		})
+
+		It("Verifies every quota even when some can't be read", func() {
+			requirements := []*QuotaRequirement{
+				{ServiceCode: "ec2", QuotaCode: "L-0263D0A3", QuotaName: "EIPs", Classic: 5},
+				{ServiceCode: "ec2", QuotaCode: "L-1216C47A", QuotaName: "vCPUs", Classic: 100, Scales: true},
+				{ServiceCode: "vpc", QuotaCode: "L-F678F1CE", QuotaName: "VPCs", Classic: 5},
+				{ServiceCode: "elasticloadbalancing", QuotaCode: "L-69A177A2", HostedCP: 5},
+			}
+			quota := func(value float64, adjustable bool) *servicequotas.GetServiceQuotaOutput {
+				return &servicequotas.GetServiceQuotaOutput{
+					Quota: &servicequotastypes.ServiceQuota{Value: awsSdk.Float64(value), Adjustable: adjustable},
+				}
+			}
+			gomock.InOrder(
+				mockServiceQuota.EXPECT().GetServiceQuota(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("access denied")),
+				mockServiceQuota.EXPECT().GetServiceQuota(gomock.Any(), gomock.Any()).Return(quota(160, true), nil),
+				mockServiceQuota.EXPECT().GetServiceQuota(gomock.Any(), gomock.Any()).Return(quota(5, false), nil),
+			)
+
+			reports := client.VerifyQuotas(requirements, ClassicTopology, 2)
+			Expect(reports).To(HaveLen(3))
+			Expect(reports[0].Status).To(Equal(QuotaStatusError))
+			Expect(reports[0].Message).To(ContainSubstring("access denied"))
+			Expect(reports[1].Required).To(Equal(200.0))
+			Expect(reports[1].Value).To(Equal(160.0))
+			Expect(reports[1].Status).To(Equal(QuotaStatusInsufficient))
+			Expect(reports[1].Adjustable).To(BeTrue())
+			Expect(reports[2].Status).To(Equal(QuotaStatusOK))
+		})
+
+		It("Requests the increase of a quota", func() {
+			mockServiceQuota.EXPECT().RequestServiceQuotaIncrease(
+				context.Background(),
+				&servicequotas.RequestServiceQuotaIncreaseInput{
+					ServiceCode:  awsSdk.String(EC2ServiceCode),
+					QuotaCode:    awsSdk.String(StandardInstancesQuotaCode),
+					DesiredValue: awsSdk.Float64(200),
+				},
+			).Return(&servicequotas.RequestServiceQuotaIncreaseOutput{
+				RequestedQuota: &servicequotastypes.RequestedServiceQuotaChange{Id: awsSdk.String("request-1")},
+			}, nil)
+
+			id, err := client.RequestQuotaIncrease(EC2ServiceCode, StandardInstancesQuotaCode, 200)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id).To(Equal("request-1"))
+		})
+	})
+
+	DescribeTable("QuotaRequirement.Required",
+		func(requirement *QuotaRequirement, topology string, size float64, expected float64) {
+			Expect(requirement.Required(topology, size)).To(Equal(expected))
+		},
+		Entry("classic", &QuotaRequirement{Classic: 100, HostedCP: 40}, ClassicTopology, 1.0, 100.0),
+		Entry("hosted control plane", &QuotaRequirement{Classic: 100, HostedCP: 40}, HostedCPTopology, 1.0, 40.0),
+		Entry("scaled", &QuotaRequirement{Classic: 100, Scales: true}, ClassicTopology, 4.0, 400.0),
+		Entry("not scaled", &QuotaRequirement{Classic: 5}, ClassicTopology, 4.0, 5.0),
+		Entry("not used", &QuotaRequirement{Classic: 5}, HostedCPTopology, 1.0, 0.0),
+	)
 })
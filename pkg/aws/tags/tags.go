@@ -54,6 +54,10 @@ const HcpSharedVpc = "hcp-shared-vpc"
 
 const HypershiftPolicies = prefix + "hcp_policies"
 
+// NetworkTemplate is the name of the tag that will contain the template used by 'rosa create network'
+// to create a network stack.
+const NetworkTemplate = prefix + "network_template"
+
 const OperatorNamespace = "operator_namespace"
 
 const OperatorName = "operator_name"
@@ -1,6 +1,8 @@
 package tags
 
 import (
+	"strings"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 )
@@ -14,3 +16,22 @@ func Ec2ResourceHasTag(ec2Tags []ec2types.Tag, tagKey string, tagValue string) b
 
 	return false
 }
+
+// RedHatPrefix is used by the service for the tags it manages on the cluster resources
+const RedHatPrefix = "red-hat-"
+
+// UserTags drops the tags that are added by the service so that only the tags supplied by the
+// user remain. It returns nil when there are none.
+func UserTags(tags map[string]string) map[string]string {
+	result := map[string]string{}
+	for key, value := range tags {
+		if strings.HasPrefix(key, RedHatPrefix) {
+			continue
+		}
+		result[key] = value
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
@@ -48,3 +48,18 @@ var _ = Describe("Ec2ResourceHasTag", func() {
 		})
 	})
 })
+
+var _ = Describe("UserTags", func() {
+	It("drops the tags managed by the service", func() {
+		Expect(UserTags(map[string]string{
+			RedHatManaged:         "true",
+			"red-hat-clustertype": "rosa",
+			"team":                "payments",
+		})).To(Equal(map[string]string{"team": "payments"}))
+	})
+
+	It("returns nil when there are no user tags", func() {
+		Expect(UserTags(map[string]string{RedHatManaged: "true"})).To(BeNil())
+		Expect(UserTags(nil)).To(BeNil())
+	})
+})
@@ -0,0 +1,288 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cleanup finds the AWS resources created for ROSA clusters that no cluster uses anymore,
+// like the ones left behind by failed installations or by clusters deleted without their
+// operator roles and OIDC provider. Only the clusters visible to the organization of the current
+// OCM user are checked, so the resources of the clusters of other organizations sharing the AWS
+// account look unused.
+package cleanup
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/helper"
+)
+
+// Kind is the kind of an orphaned resource.
+type Kind string
+
+const (
+	KindOperatorRoles Kind = "Operator roles"
+	KindOIDCProvider  Kind = "OIDC provider"
+	KindOIDCConfig    Kind = "OIDC config"
+	KindSecret        Kind = "Secret"
+	KindS3Bucket      Kind = "S3 bucket"
+)
+
+// privateKeySecretPrefix is the prefix of the name of the secrets created by 'rosa create
+// oidc-config' to store the private key of unmanaged OIDC configurations.
+const privateKeySecretPrefix = "rosa-private-key-"
+
+// Orphan is a resource that no cluster uses.
+type Orphan struct {
+	Kind Kind   `json:"kind"`
+	Name string `json:"name"`
+
+	// Resources are the AWS resources deleted together with the orphan: the roles that share an
+	// operator roles prefix, or the secret and the S3 bucket of an unmanaged OIDC config.
+	Resources []string `json:"resources,omitempty"`
+
+	Reason string `json:"reason"`
+
+	// CreationDate is when the resource was created, or when the newest of the operator roles was
+	// created. It is zero when it isn't known.
+	CreationDate time.Time `json:"creationDate"`
+
+	managedPolicies bool
+}
+
+// Inventory is the part of the OCM client used to find out which resources are in use.
+type Inventory interface {
+	ListOidcConfigs(awsAccountId string) ([]*cmv1.OidcConfig, error)
+	HasAClusterUsingOperatorRolesPrefix(prefix string) (bool, error)
+	HasAClusterUsingOidcEndpointUrl(issuerUrl string) (bool, error)
+	DeleteOidcConfig(id string) error
+}
+
+// Scan returns the ROSA resources of the AWS account that no cluster uses. Secrets and S3
+// buckets are regional, so only the ones of the region of the AWS client are returned. Unless
+// createdBefore is zero, only the resources known to be created before it are returned, so that
+// the resources of the clusters being installed aren't reported.
+func Scan(inventory Inventory, awsClient aws.Client, accountID string,
+	createdBefore time.Time) ([]*Orphan, error) {
+	oidcConfigs, err := inventory.ListOidcConfigs(accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list OIDC configs: %v", err)
+	}
+
+	orphans, err := scanOperatorRoles(inventory, awsClient)
+	if err != nil {
+		return nil, err
+	}
+	providers, err := scanOIDCProviders(inventory, awsClient)
+	if err != nil {
+		return nil, err
+	}
+	orphans = append(orphans, providers...)
+
+	// The secrets and the buckets of the registered OIDC configs aren't orphans even when the
+	// config itself is unused, as they are reported and deleted together with it:
+	secrets := map[string]bool{}
+	buckets := map[string]bool{}
+	for _, oidcConfig := range oidcConfigs {
+		used, err := inventory.HasAClusterUsingOidcEndpointUrl(oidcConfig.IssuerUrl())
+		if err != nil {
+			return nil, fmt.Errorf("failed to check the clusters using OIDC config '%s': %v", oidcConfig.ID(), err)
+		}
+		orphan := &Orphan{
+			Kind:         KindOIDCConfig,
+			Name:         oidcConfig.ID(),
+			Reason:       "no cluster uses its issuer URL",
+			CreationDate: oidcConfig.CreationTimestamp(),
+		}
+		if !oidcConfig.Managed() && oidcConfig.SecretArn() != "" {
+			secrets[oidcConfig.SecretArn()] = true
+			bucketName, err := aws.GetBucketNameFromSecretArn(oidcConfig.SecretArn())
+			if err != nil {
+				return nil, err
+			}
+			buckets[bucketName] = true
+			orphan.Resources = []string{oidcConfig.SecretArn(), bucketName}
+		}
+		if !used {
+			orphans = append(orphans, orphan)
+		}
+	}
+
+	managedSecrets, err := awsClient.ListRedHatManagedSecrets()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list secrets: %v", err)
+	}
+	for _, secret := range managedSecrets {
+		name, err := aws.GetResourceIdFromSecretArn(secret.ID)
+		if err != nil || !strings.HasPrefix(name, privateKeySecretPrefix) || secrets[secret.ID] {
+			continue
+		}
+		orphans = append(orphans, &Orphan{
+			Kind:         KindSecret,
+			Name:         secret.ID,
+			Reason:       "no OIDC config uses the private key",
+			CreationDate: secret.CreationDate,
+		})
+	}
+
+	managedBuckets, err := awsClient.ListRedHatManagedS3Buckets()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list S3 buckets: %v", err)
+	}
+	for _, bucket := range managedBuckets {
+		if !strings.Contains(bucket.ID, "-oidc-") || buckets[bucket.ID] {
+			continue
+		}
+		orphans = append(orphans, &Orphan{
+			Kind:         KindS3Bucket,
+			Name:         bucket.ID,
+			Reason:       "no OIDC config uses the bucket",
+			CreationDate: bucket.CreationDate,
+		})
+	}
+
+	if createdBefore.IsZero() {
+		return orphans, nil
+	}
+	old := []*Orphan{}
+	for _, orphan := range orphans {
+		// Resources whose creation date isn't known may be brand new, so they are kept:
+		if !orphan.CreationDate.IsZero() && orphan.CreationDate.Before(createdBefore) {
+			old = append(old, orphan)
+		}
+	}
+	return old, nil
+}
+
+// scanOperatorRoles returns the operator roles created by ROSA, grouped by prefix, whose prefix
+// isn't used by any cluster.
+func scanOperatorRoles(inventory Inventory, awsClient aws.Client) ([]*Orphan, error) {
+	roles, err := awsClient.ListOperatorRoles("", "", "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list operator roles: %v", err)
+	}
+	prefixes := make([]string, 0, len(roles))
+	for prefix := range roles {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+
+	orphans := []*Orphan{}
+	for _, prefix := range prefixes {
+		orphan := &Orphan{
+			Kind:   KindOperatorRoles,
+			Name:   prefix,
+			Reason: "no cluster uses the prefix",
+		}
+		for _, role := range roles[prefix] {
+			// Roles whose name looks like an operator role but that don't have the tags added
+			// by ROSA weren't created by it:
+			if role.OperatorNamespace == "" && role.ClusterID == "" {
+				continue
+			}
+			orphan.Resources = append(orphan.Resources, role.RoleName)
+			if role.CreateDate.After(orphan.CreationDate) {
+				orphan.CreationDate = role.CreateDate
+			}
+			orphan.managedPolicies = orphan.managedPolicies || role.ManagedPolicy
+		}
+		if len(orphan.Resources) == 0 {
+			continue
+		}
+		used, err := inventory.HasAClusterUsingOperatorRolesPrefix(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check the clusters using operator roles prefix '%s': %v", prefix, err)
+		}
+		if !used {
+			orphans = append(orphans, orphan)
+		}
+	}
+	return orphans, nil
+}
+
+// scanOIDCProviders returns the OIDC providers created by ROSA whose issuer URL isn't used by
+// any cluster.
+func scanOIDCProviders(inventory Inventory, awsClient aws.Client) ([]*Orphan, error) {
+	providers, err := awsClient.ListOidcProviders("", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list OIDC providers: %v", err)
+	}
+	orphans := []*Orphan{}
+	for _, provider := range providers {
+		resourceID, err := aws.GetResourceIdFromOidcProviderARN(provider.Arn)
+		if err != nil {
+			return nil, err
+		}
+		issuerURL := fmt.Sprintf("%s://%s", helper.ProtocolHttps, resourceID)
+		used, err := inventory.HasAClusterUsingOidcEndpointUrl(issuerURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check the clusters using OIDC provider '%s': %v", provider.Arn, err)
+		}
+		if used {
+			continue
+		}
+		createDate, err := awsClient.GetOpenIDConnectProviderCreateDate(provider.Arn)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the creation date of OIDC provider '%s': %v", provider.Arn, err)
+		}
+		orphans = append(orphans, &Orphan{
+			Kind:         KindOIDCProvider,
+			Name:         provider.Arn,
+			Reason:       "no cluster uses its issuer URL",
+			CreationDate: createDate,
+		})
+	}
+	return orphans, nil
+}
+
+// Delete deletes the orphan and the resources that belong to it.
+func Delete(inventory Inventory, awsClient aws.Client, orphan *Orphan) error {
+	switch orphan.Kind {
+	case KindOperatorRoles:
+		var errs []error
+		for _, roleName := range orphan.Resources {
+			_, err := awsClient.DeleteOperatorRole(roleName, orphan.managedPolicies, false)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete operator role '%s': %v", roleName, err))
+			}
+		}
+		return errors.Join(errs...)
+	case KindOIDCProvider:
+		return awsClient.DeleteOpenIDConnectProvider(orphan.Name)
+	case KindOIDCConfig:
+		if len(orphan.Resources) > 0 {
+			secretArn, bucketName := orphan.Resources[0], orphan.Resources[1]
+			err := awsClient.DeleteSecretInSecretsManager(secretArn)
+			if err != nil {
+				return fmt.Errorf("failed to delete private key secret: %v", err)
+			}
+			err = awsClient.DeleteS3Bucket(bucketName)
+			if err != nil {
+				return fmt.Errorf("failed to delete S3 bucket '%s': %v", bucketName, err)
+			}
+		}
+		return inventory.DeleteOidcConfig(orphan.Name)
+	case KindSecret:
+		return awsClient.DeleteSecretInSecretsManager(orphan.Name)
+	case KindS3Bucket:
+		return awsClient.DeleteS3Bucket(orphan.Name)
+	}
+	return fmt.Errorf("unknown resource kind '%s'", orphan.Kind)
+}
@@ -0,0 +1,203 @@
+package cleanup
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+)
+
+const secretArn = "arn:aws:secretsmanager:us-east-1:123456789012:secret:rosa-private-key-mine-oidc-ab1c-XyZ123"
+
+type fakeInventory struct {
+	oidcConfigs  []*cmv1.OidcConfig
+	usedPrefixes map[string]bool
+	usedURLs     map[string]bool
+	deleted      []string
+}
+
+func (f *fakeInventory) ListOidcConfigs(string) ([]*cmv1.OidcConfig, error) {
+	return f.oidcConfigs, nil
+}
+
+func (f *fakeInventory) HasAClusterUsingOperatorRolesPrefix(prefix string) (bool, error) {
+	return f.usedPrefixes[prefix], nil
+}
+
+func (f *fakeInventory) HasAClusterUsingOidcEndpointUrl(issuerUrl string) (bool, error) {
+	return f.usedURLs[issuerUrl], nil
+}
+
+func (f *fakeInventory) DeleteOidcConfig(id string) error {
+	f.deleted = append(f.deleted, id)
+	return nil
+}
+
+var _ = Describe("Cleanup", func() {
+	var (
+		inventory *fakeInventory
+		awsClient *aws.MockClient
+	)
+	old := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	recent := time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+		unmanaged, err := cmv1.NewOidcConfig().ID("unmanaged").
+			IssuerUrl("https://mine-oidc-ab1c.s3.us-east-1.amazonaws.com").SecretArn(secretArn).
+			CreationTimestamp(old).Build()
+		Expect(err).NotTo(HaveOccurred())
+		managed, err := cmv1.NewOidcConfig().ID("managed").
+			IssuerUrl("https://oidc.example.com/managed").Managed(true).Build()
+		Expect(err).NotTo(HaveOccurred())
+		inventory = &fakeInventory{
+			oidcConfigs:  []*cmv1.OidcConfig{unmanaged, managed},
+			usedPrefixes: map[string]bool{"live": true},
+			usedURLs:     map[string]bool{"https://oidc.example.com/managed": true},
+		}
+	})
+
+	Context("Scan", func() {
+		expectResources := func() {
+			awsClient.EXPECT().ListOperatorRoles("", "", "").Return(map[string][]aws.OperatorRoleDetail{
+				"live": {{RoleName: "live-openshift-ingress", OperatorNamespace: "openshift-ingress-operator"}},
+				"orphaned": {
+					{RoleName: "orphaned-openshift-ingress", ClusterID: "123", ManagedPolicy: true, CreateDate: old},
+					{RoleName: "orphaned-kube-system-csi", ClusterID: "123", CreateDate: old.Add(time.Hour)},
+				},
+				"untagged": {{RoleName: "untagged-openshift-ingress"}},
+			}, nil)
+			awsClient.EXPECT().ListOidcProviders("", nil).Return([]aws.OidcProviderOutput{
+				{Arn: "arn:aws:iam::123456789012:oidc-provider/oidc.example.com/managed"},
+				{Arn: "arn:aws:iam::123456789012:oidc-provider/oidc.example.com/deleted"},
+			}, nil)
+			awsClient.EXPECT().GetOpenIDConnectProviderCreateDate(
+				"arn:aws:iam::123456789012:oidc-provider/oidc.example.com/deleted").Return(recent, nil)
+			awsClient.EXPECT().ListRedHatManagedSecrets().Return([]aws.ManagedResource{
+				{ID: secretArn, CreationDate: old},
+				{
+					ID:           "arn:aws:secretsmanager:us-east-1:123456789012:secret:rosa-private-key-old-oidc-zz9z-AbC123",
+					CreationDate: old,
+				},
+				{ID: "arn:aws:secretsmanager:us-east-1:123456789012:secret:other-AbC123", CreationDate: old},
+			}, nil)
+			awsClient.EXPECT().ListRedHatManagedS3Buckets().Return([]aws.ManagedResource{
+				{ID: "mine-oidc-ab1c", CreationDate: old},
+				{ID: "old-oidc-zz9z"},
+				{ID: "other", CreationDate: old},
+			}, nil)
+		}
+
+		It("reports the resources that no cluster uses", func() {
+			expectResources()
+
+			orphans, err := Scan(inventory, awsClient, "123456789012", time.Time{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(orphans).To(Equal([]*Orphan{
+				{
+					Kind:            KindOperatorRoles,
+					Name:            "orphaned",
+					Resources:       []string{"orphaned-openshift-ingress", "orphaned-kube-system-csi"},
+					Reason:          "no cluster uses the prefix",
+					CreationDate:    old.Add(time.Hour),
+					managedPolicies: true,
+				},
+				{
+					Kind:         KindOIDCProvider,
+					Name:         "arn:aws:iam::123456789012:oidc-provider/oidc.example.com/deleted",
+					Reason:       "no cluster uses its issuer URL",
+					CreationDate: recent,
+				},
+				{
+					Kind:         KindOIDCConfig,
+					Name:         "unmanaged",
+					Resources:    []string{secretArn, "mine-oidc-ab1c"},
+					Reason:       "no cluster uses its issuer URL",
+					CreationDate: old,
+				},
+				{
+					Kind:         KindSecret,
+					Name:         "arn:aws:secretsmanager:us-east-1:123456789012:secret:rosa-private-key-old-oidc-zz9z-AbC123",
+					Reason:       "no OIDC config uses the private key",
+					CreationDate: old,
+				},
+				{
+					Kind:   KindS3Bucket,
+					Name:   "old-oidc-zz9z",
+					Reason: "no OIDC config uses the bucket",
+				},
+			}))
+		})
+
+		It("only reports the resources known to be created before the given date", func() {
+			expectResources()
+
+			orphans, err := Scan(inventory, awsClient, "123456789012", recent)
+			Expect(err).NotTo(HaveOccurred())
+			names := []string{}
+			for _, orphan := range orphans {
+				names = append(names, orphan.Name)
+			}
+			Expect(names).To(Equal([]string{
+				"orphaned",
+				"unmanaged",
+				"arn:aws:secretsmanager:us-east-1:123456789012:secret:rosa-private-key-old-oidc-zz9z-AbC123",
+			}))
+		})
+
+		It("fails when the resources can't be listed", func() {
+			awsClient.EXPECT().ListOperatorRoles("", "", "").Return(nil, errors.New("access denied"))
+
+			_, err := Scan(inventory, awsClient, "123456789012", time.Time{})
+			Expect(err).To(MatchError("failed to list operator roles: access denied"))
+		})
+	})
+
+	Context("Delete", func() {
+		It("deletes all the roles of an operator roles prefix", func() {
+			orphan := &Orphan{
+				Kind:            KindOperatorRoles,
+				Name:            "orphaned",
+				Resources:       []string{"orphaned-openshift-ingress", "orphaned-kube-system-csi"},
+				managedPolicies: true,
+			}
+			awsClient.EXPECT().DeleteOperatorRole("orphaned-openshift-ingress", true, false).Return(nil, nil)
+			awsClient.EXPECT().DeleteOperatorRole("orphaned-kube-system-csi", true, false).
+				Return(nil, errors.New("access denied"))
+
+			err := Delete(inventory, awsClient, orphan)
+			Expect(err).To(MatchError("failed to delete operator role 'orphaned-kube-system-csi': access denied"))
+		})
+
+		It("deletes the secret and the bucket of an unmanaged OIDC config", func() {
+			orphan := &Orphan{
+				Kind:      KindOIDCConfig,
+				Name:      "unmanaged",
+				Resources: []string{secretArn, "mine-oidc-ab1c"},
+			}
+			awsClient.EXPECT().DeleteSecretInSecretsManager(secretArn).Return(nil)
+			awsClient.EXPECT().DeleteS3Bucket("mine-oidc-ab1c").Return(nil)
+
+			Expect(Delete(inventory, awsClient, orphan)).To(Succeed())
+			Expect(inventory.deleted).To(Equal([]string{"unmanaged"}))
+		})
+
+		It("keeps the OIDC config when its secret can't be deleted", func() {
+			orphan := &Orphan{
+				Kind:      KindOIDCConfig,
+				Name:      "unmanaged",
+				Resources: []string{secretArn, "mine-oidc-ab1c"},
+			}
+			awsClient.EXPECT().DeleteSecretInSecretsManager(secretArn).Return(errors.New("access denied"))
+
+			err := Delete(inventory, awsClient, orphan)
+			Expect(err).To(MatchError("failed to delete private key secret: access denied"))
+			Expect(inventory.deleted).To(BeEmpty())
+		})
+	})
+})
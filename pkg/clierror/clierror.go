@@ -0,0 +1,255 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clierror classifies the errors of the commands so that automation can tell them apart,
+// both by the exit code of the process and by the JSON error object printed when a structured
+// output is requested. The class is taken from the errors of the OCM and AWS APIs found in the
+// chain of the error, or set explicitly with New.
+//
+// The commands that use rosa.DefaultRunner exit with the code of the error returned by their
+// runner. The rest of the commands exit with the code of the error they report when they fail
+// because of one, and with the generic code when they fail without an error value, like most of
+// their checks of the command line. Errors parsing the command line exit with the validation code.
+package clierror
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/aws/smithy-go"
+	ocmerrors "github.com/openshift-online/ocm-sdk-go/errors"
+	weberr "github.com/zgalor/weberr"
+)
+
+// Class is the class of an error.
+type Class string
+
+const (
+	Generic       Class = "generic"
+	Timeout       Class = "timeout"
+	FailedState   Class = "failed_state"
+	Validation    Class = "validation"
+	NotFound      Class = "not_found"
+	Unauthorized  Class = "unauthorized"
+	QuotaExceeded Class = "quota_exceeded"
+	Conflict      Class = "conflict"
+	Unavailable   Class = "unavailable"
+)
+
+// exitCodes are the exit codes of the classes. They are part of the interface of the CLI, so
+// existing values must not change.
+var exitCodes = map[Class]int{
+	Generic:       1,
+	Timeout:       2,
+	FailedState:   3,
+	Validation:    4,
+	NotFound:      5,
+	Unauthorized:  6,
+	QuotaExceeded: 7,
+	Conflict:      8,
+	Unavailable:   9,
+}
+
+// Error is an error with an explicit class.
+type Error struct {
+	Class Class
+	Err   error
+}
+
+// New sets the class of the given error.
+func New(class Class, err error) error {
+	return &Error{Class: class, Err: err}
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// ExitCode returns the exit code of the given class.
+func (c Class) ExitCode() int {
+	code, ok := exitCodes[c]
+	if !ok {
+		return exitCodes[Generic]
+	}
+	return code
+}
+
+// ExitCode returns the exit code that the process should use for the given error.
+func ExitCode(err error) int {
+	return Classify(err).ExitCode()
+}
+
+// Classify returns the class of the error. An explicit class takes precedence over the errors of
+// the OCM API, the AWS API and the type of the errors created with the weberr package, in that
+// order.
+func Classify(err error) Class {
+	var classified *Error
+	if errors.As(err, &classified) {
+		return classified.Class
+	}
+	var ocmErr *ocmerrors.Error
+	if errors.As(err, &ocmErr) {
+		if class := classifyStatus(ocmErr.Status()); class != Generic {
+			return class
+		}
+	}
+	var awsErr smithy.APIError
+	if errors.As(err, &awsErr) {
+		if class := classifyAWSCode(awsErr.ErrorCode()); class != Generic {
+			return class
+		}
+	}
+	var typed interface{ Type() weberr.ErrorType }
+	if errors.As(err, &typed) {
+		return classifyStatus(int(typed.Type()))
+	}
+	return Generic
+}
+
+func classifyStatus(status int) Class {
+	switch status {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return Validation
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusUnavailableForLegalReasons:
+		return Unauthorized
+	case http.StatusPaymentRequired:
+		return QuotaExceeded
+	case http.StatusNotFound, http.StatusGone:
+		return NotFound
+	case http.StatusConflict:
+		return Conflict
+	case http.StatusTooManyRequests:
+		return Unavailable
+	}
+	if status >= http.StatusInternalServerError {
+		return Unavailable
+	}
+	return Generic
+}
+
+var awsCodes = map[string]Class{
+	"AccessDenied":                    Unauthorized,
+	"AccessDeniedException":           Unauthorized,
+	"AuthFailure":                     Unauthorized,
+	"ExpiredToken":                    Unauthorized,
+	"Forbidden":                       Unauthorized,
+	"InvalidClientTokenId":            Unauthorized,
+	"OptInRequired":                   Unauthorized,
+	"SignatureDoesNotMatch":           Unauthorized,
+	"UnauthorizedOperation":           Unauthorized,
+	"UnrecognizedClientException":     Unauthorized,
+	"NoSuchBucket":                    NotFound,
+	"NoSuchEntity":                    NotFound,
+	"NoSuchHostedZone":                NotFound,
+	"NoSuchResourceException":         NotFound,
+	"ResourceNotFoundException":       NotFound,
+	"ServiceQuotaExceededException":   QuotaExceeded,
+	"InvalidInput":                    Validation,
+	"InvalidParameter":                Validation,
+	"InvalidParameterValue":           Validation,
+	"MalformedPolicyDocument":         Validation,
+	"ValidationError":                 Validation,
+	"ValidationException":             Validation,
+	"AlreadyExistsException":          Conflict,
+	"ConcurrentModificationException": Conflict,
+	"DeleteConflict":                  Conflict,
+	"DependencyViolation":             Conflict,
+	"EntityAlreadyExists":             Conflict,
+	"RequestLimitExceeded":            Unavailable,
+	"InternalFailure":                 Unavailable,
+	"ServiceUnavailable":              Unavailable,
+	"Throttling":                      Unavailable,
+	"ThrottlingException":             Unavailable,
+	"TooManyRequestsException":        Unavailable,
+}
+
+func classifyAWSCode(code string) Class {
+	if class, ok := awsCodes[code]; ok {
+		return class
+	}
+	switch {
+	case strings.HasSuffix(code, ".NotFound"):
+		return NotFound
+	case strings.HasSuffix(code, "LimitExceeded"), strings.HasSuffix(code, "LimitExceededException"):
+		return QuotaExceeded
+	}
+	return Generic
+}
+
+// Envelope is the JSON object used to report an error when a structured output is requested.
+// The 'error' field contains the message, as it did before the rest of the fields were added.
+type Envelope struct {
+	Message  string      `json:"error"`
+	Class    Class       `json:"class"`
+	ExitCode int         `json:"exit_code"`
+	OCM      *OCMDetails `json:"ocm,omitempty"`
+	AWS      *AWSDetails `json:"aws,omitempty"`
+}
+
+// OCMDetails are the details of an error returned by the OCM API.
+type OCMDetails struct {
+	Status      int    `json:"status,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Code        string `json:"code,omitempty"`
+	Reason      string `json:"reason,omitempty"`
+	OperationID string `json:"operation_id,omitempty"`
+}
+
+// AWSDetails are the details of an error returned by the AWS API.
+type AWSDetails struct {
+	Code      string `json:"code"`
+	Message   string `json:"message,omitempty"`
+	Fault     string `json:"fault,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// NewEnvelope creates the JSON object that describes the given error.
+func NewEnvelope(err error) *Envelope {
+	class := Classify(err)
+	envelope := &Envelope{
+		Message:  err.Error(),
+		Class:    class,
+		ExitCode: class.ExitCode(),
+	}
+	var ocmErr *ocmerrors.Error
+	if errors.As(err, &ocmErr) {
+		envelope.OCM = &OCMDetails{
+			Status:      ocmErr.Status(),
+			ID:          ocmErr.ID(),
+			Code:        ocmErr.Code(),
+			Reason:      ocmErr.Reason(),
+			OperationID: ocmErr.OperationID(),
+		}
+	}
+	var awsErr smithy.APIError
+	if errors.As(err, &awsErr) {
+		envelope.AWS = &AWSDetails{
+			Code:    awsErr.ErrorCode(),
+			Message: awsErr.ErrorMessage(),
+			Fault:   awsErr.ErrorFault().String(),
+		}
+		var requestErr interface{ ServiceRequestID() string }
+		if errors.As(err, &requestErr) {
+			envelope.AWS.RequestID = requestErr.ServiceRequestID()
+		}
+	}
+	return envelope
+}
@@ -0,0 +1,13 @@
+package clierror
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestClierror(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Clierror Suite")
+}
@@ -0,0 +1,106 @@
+package clierror
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/smithy-go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	ocmerrors "github.com/openshift-online/ocm-sdk-go/errors"
+	weberr "github.com/zgalor/weberr"
+)
+
+var _ = Describe("Classify", func() {
+	ocmError := func(status int) error {
+		err, _ := ocmerrors.NewError().
+			Status(status).
+			ID("404").
+			Code("CLUSTERS-MGMT-404").
+			Reason("Cluster not found").
+			OperationID("op-1").
+			Build()
+		return err
+	}
+
+	DescribeTable("Classifies the errors",
+		func(err error, class Class, code int) {
+			Expect(Classify(err)).To(Equal(class))
+			Expect(ExitCode(err)).To(Equal(code))
+		},
+		Entry("plain error", errors.New("failed"), Generic, 1),
+		Entry("explicit class", New(Timeout, errors.New("timed out")), Timeout, 2),
+		Entry("wrapped explicit class", fmt.Errorf("waiting: %w", New(FailedState, errors.New("failed"))),
+			FailedState, 3),
+		Entry("ocm bad request", ocmError(400), Validation, 4),
+		Entry("ocm not found", fmt.Errorf("getting cluster: %w", ocmError(404)), NotFound, 5),
+		Entry("ocm forbidden", ocmError(403), Unauthorized, 6),
+		Entry("ocm payment required", ocmError(402), QuotaExceeded, 7),
+		Entry("ocm conflict", ocmError(409), Conflict, 8),
+		Entry("ocm server error", ocmError(503), Unavailable, 9),
+		Entry("ocm other status", ocmError(418), Generic, 1),
+		Entry("aws known code", &smithy.GenericAPIError{Code: "NoSuchEntity"}, NotFound, 5),
+		Entry("aws not found suffix", &smithy.GenericAPIError{Code: "InvalidVpcID.NotFound"}, NotFound, 5),
+		Entry("aws limit suffix", &smithy.GenericAPIError{Code: "VpcLimitExceeded"}, QuotaExceeded, 7),
+		Entry("aws throttling", &smithy.GenericAPIError{Code: "RequestLimitExceeded"}, Unavailable, 9),
+		Entry("aws unknown code", &smithy.GenericAPIError{Code: "Unknown"}, Generic, 1),
+		Entry("weberr type", weberr.NotFound.Errorf("Cluster 'c1' not found"), NotFound, 5),
+		Entry("weberr without type", weberr.Errorf("failed"), Generic, 1),
+	)
+
+	It("Prefers the explicit class over the API errors", func() {
+		Expect(Classify(New(Timeout, ocmError(404)))).To(Equal(Timeout))
+	})
+
+	It("Uses the generic exit code for unknown classes", func() {
+		Expect(Class("unknown").ExitCode()).To(Equal(1))
+	})
+})
+
+var _ = Describe("Envelope", func() {
+	It("Describes a plain error", func() {
+		Expect(NewEnvelope(errors.New("failed"))).To(Equal(&Envelope{
+			Message:  "failed",
+			Class:    Generic,
+			ExitCode: 1,
+		}))
+	})
+
+	It("Adds the details of the OCM error", func() {
+		ocmErr, err := ocmerrors.NewError().
+			Status(404).
+			ID("404").
+			Code("CLUSTERS-MGMT-404").
+			Reason("Cluster not found").
+			OperationID("op-1").
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		envelope := NewEnvelope(fmt.Errorf("Failed to get cluster: %w", ocmErr))
+		Expect(envelope.Class).To(Equal(NotFound))
+		Expect(envelope.ExitCode).To(Equal(5))
+		Expect(envelope.AWS).To(BeNil())
+		Expect(envelope.OCM).To(Equal(&OCMDetails{
+			Status:      404,
+			ID:          "404",
+			Code:        "CLUSTERS-MGMT-404",
+			Reason:      "Cluster not found",
+			OperationID: "op-1",
+		}))
+	})
+
+	It("Adds the details of the AWS error", func() {
+		envelope := NewEnvelope(&smithy.GenericAPIError{
+			Code:    "AccessDenied",
+			Message: "User is not authorized",
+			Fault:   smithy.FaultClient,
+		})
+		Expect(envelope.Class).To(Equal(Unauthorized))
+		Expect(envelope.ExitCode).To(Equal(6))
+		Expect(envelope.OCM).To(BeNil())
+		Expect(envelope.AWS).To(Equal(&AWSDetails{
+			Code:    "AccessDenied",
+			Message: "User is not authorized",
+			Fault:   "client",
+		}))
+	})
+})
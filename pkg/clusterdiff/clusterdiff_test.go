@@ -0,0 +1,228 @@
+package clusterdiff
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/clusterspec"
+)
+
+const testSnapshot = `
+apiVersion: rosa.openshift.io/v1alpha1
+kind: ClusterSnapshot
+spec:
+  cluster:
+    version: 4.16.2
+    tags:
+      team: payments
+  machinePools:
+  - id: workers
+    instanceType: m5.2xlarge
+    replicas: 3
+  identityProviders:
+  - name: github
+    type: GitHub
+`
+
+func buildCluster(version string, tags map[string]string) *cmv1.Cluster {
+	cluster, err := cmv1.NewCluster().
+		ID("abc").
+		Name("mycluster").
+		Region(cmv1.NewCloudRegion().ID("us-east-1")).
+		Version(cmv1.NewVersion().RawID(version).ChannelGroup("stable")).
+		AWS(cmv1.NewAWS().Tags(tags)).
+		Build()
+	Expect(err).NotTo(HaveOccurred())
+	return cluster
+}
+
+func buildMachinePool(id string, instanceType string, replicas int) *cmv1.MachinePool {
+	machinePool, err := cmv1.NewMachinePool().
+		ID(id).
+		InstanceType(instanceType).
+		Replicas(replicas).
+		Taints(cmv1.NewTaint().Key("dedicated").Value("infra").Effect("NoSchedule")).
+		Build()
+	Expect(err).NotTo(HaveOccurred())
+	return machinePool
+}
+
+var _ = Describe("Snapshot", func() {
+	It("Builds the snapshot of a cluster and its resources", func() {
+		ingress, err := cmv1.NewIngress().ID("a1b2").Default(true).
+			Listening(cmv1.ListeningMethodExternal).Build()
+		Expect(err).NotTo(HaveOccurred())
+		nodePool, err := cmv1.NewNodePool().ID("workers").
+			AWSNodePool(cmv1.NewAWSNodePool().InstanceType("m5.xlarge")).
+			Autoscaling(cmv1.NewNodePoolAutoscaling().MinReplica(2).MaxReplica(4)).
+			Subnet("subnet-1").
+			Version(cmv1.NewVersion().RawID("4.16.2")).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+
+		snapshot := FromCluster(buildCluster("4.16.2", map[string]string{"red-hat-managed": "true"}),
+			&Resources{
+				NodePools: []*cmv1.NodePool{nodePool},
+				Ingresses: []*cmv1.Ingress{ingress},
+			})
+		Expect(snapshot.Cluster.Version).To(Equal("4.16.2"))
+		Expect(snapshot.Cluster.Tags).To(BeEmpty())
+		Expect(snapshot.MachinePools).To(Equal([]MachinePool{{
+			ID:           "workers",
+			InstanceType: "m5.xlarge",
+			Autoscaling:  &clusterspec.AutoscalingSpec{MinReplicas: 2, MaxReplicas: 4},
+			Subnets:      []string{"subnet-1"},
+			Version:      "4.16.2",
+		}}))
+		Expect(snapshot.Ingresses).To(Equal([]Ingress{{ID: "default", Listening: "external"}}))
+		Expect(snapshot.Autoscaler).To(BeNil())
+	})
+
+	Context("Parse", func() {
+		It("Parses a snapshot spec file", func() {
+			snapshot, err := Parse([]byte(testSnapshot))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshot.Cluster.Version).To(Equal("4.16.2"))
+			Expect(snapshot.MachinePools).To(HaveLen(1))
+			Expect(snapshot.IdentityProviders).To(Equal([]IdentityProvider{{Name: "github", Type: "GitHub"}}))
+		})
+
+		It("Parses a cluster spec file", func() {
+			snapshot, err := Parse([]byte("apiVersion: rosa.openshift.io/v1alpha1\nkind: Cluster\n" +
+				"spec:\n  name: mycluster\n"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshot.Cluster.Name).To(Equal("mycluster"))
+			Expect(snapshot.MachinePools).To(BeNil())
+		})
+
+		DescribeTable("Rejects invalid spec files",
+			func(content string, message string) {
+				_, err := Parse([]byte(content))
+				Expect(err).To(MatchError(ContainSubstring(message)))
+			},
+			Entry("unknown field", "apiVersion: rosa.openshift.io/v1alpha1\nkind: ClusterSnapshot\n"+
+				"spec:\n  nodes: 3\n", "unknown field"),
+			Entry("api version", "apiVersion: v1\nkind: ClusterSnapshot\n",
+				"unsupported spec apiVersion 'v1'"),
+			Entry("missing identifier", "apiVersion: rosa.openshift.io/v1alpha1\nkind: ClusterSnapshot\n"+
+				"spec:\n  machinePools:\n  - replicas: 2\n",
+				"all the items of 'machinePools' must have an identifier"),
+			Entry("repeated identifier", "apiVersion: rosa.openshift.io/v1alpha1\nkind: ClusterSnapshot\n"+
+				"spec:\n  ingresses:\n  - id: default\n  - id: default\n",
+				"'ingresses' contains 'default' more than once"),
+		)
+
+		It("Loads a spec file from disk", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "snapshot.yaml")
+			Expect(os.WriteFile(path, []byte(testSnapshot), 0600)).To(Succeed())
+			snapshot, err := Load(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshot.Cluster.Tags).To(Equal(map[string]string{"team": "payments"}))
+		})
+	})
+})
+
+var _ = Describe("Compare", func() {
+	var from *Snapshot
+
+	BeforeEach(func() {
+		from = FromCluster(buildCluster("4.15.9", map[string]string{"team": "payments", "env": "prod"}),
+			&Resources{
+				MachinePools: []*cmv1.MachinePool{
+					buildMachinePool("workers", "m5.xlarge", 3),
+					buildMachinePool("infra", "r5.xlarge", 2),
+				},
+			})
+	})
+
+	It("Finds no differences with itself", func() {
+		changes, err := Compare(from, from, Options{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+	})
+
+	It("Compares the settings and the keyed resources", func() {
+		to := FromCluster(buildCluster("4.16.2", map[string]string{"team": "payments", "owner": "sre"}),
+			&Resources{
+				MachinePools: []*cmv1.MachinePool{
+					buildMachinePool("infra", "r5.xlarge", 2),
+					buildMachinePool("workers", "m5.xlarge", 6),
+				},
+			})
+		changes, err := Compare(from, to, Options{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal([]Change{
+			{Path: "cluster.tags.env", Type: Removed, From: "prod"},
+			{Path: "cluster.tags.owner", Type: Added, To: "sre"},
+			{Path: "cluster.version", Type: Changed, From: "4.15.9", To: "4.16.2"},
+			{Path: "machinePools[workers].replicas", Type: Changed, From: float64(3), To: float64(6)},
+		}))
+		Expect(changes[0].String()).To(Equal("- cluster.tags.env: prod"))
+		Expect(changes[1].String()).To(Equal("+ cluster.tags.owner: sre"))
+		Expect(changes[3].String()).To(Equal("~ machinePools[workers].replicas: 3 -> 6"))
+	})
+
+	It("Reports the resources that exist on one side only", func() {
+		to := FromCluster(buildCluster("4.15.9", map[string]string{"team": "payments", "env": "prod"}),
+			&Resources{
+				MachinePools: []*cmv1.MachinePool{buildMachinePool("workers", "m5.xlarge", 3)},
+			})
+		changes, err := Compare(from, to, Options{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(HaveLen(1))
+		Expect(changes[0].Path).To(Equal("machinePools[infra]"))
+		Expect(changes[0].Type).To(Equal(Removed))
+	})
+
+	It("Ignores the order of lists", func() {
+		from.Cluster.AvailabilityZones = []string{"us-east-1a", "us-east-1b"}
+		to := FromCluster(buildCluster("4.15.9", map[string]string{"team": "payments", "env": "prod"}),
+			&Resources{
+				MachinePools: []*cmv1.MachinePool{
+					buildMachinePool("workers", "m5.xlarge", 3),
+					buildMachinePool("infra", "r5.xlarge", 2),
+				},
+			})
+		to.Cluster.AvailabilityZones = []string{"us-east-1b", "us-east-1a"}
+		changes, err := Compare(from, to, Options{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+	})
+
+	It("Only compares the settings of a partial spec", func() {
+		to, err := Parse([]byte(testSnapshot))
+		Expect(err).NotTo(HaveOccurred())
+		changes, err := Compare(from, to, Options{Partial: true})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal([]Change{
+			{Path: "cluster.version", Type: Changed, From: "4.15.9", To: "4.16.2"},
+			{Path: "identityProviders", Type: Added, To: map[string]interface{}{
+				"github": map[string]interface{}{"name": "github", "type": "GitHub"},
+			}},
+			{Path: "machinePools[workers].instanceType", Type: Changed, From: "m5.xlarge", To: "m5.2xlarge"},
+		}))
+	})
+
+	It("Compares the settings of a partial spec set to false", func() {
+		from.Cluster.FIPS = true
+		to, err := Parse([]byte("apiVersion: rosa.openshift.io/v1alpha1\nkind: Cluster\n" +
+			"spec:\n  name: mycluster\n  fips: false\n  multiAZ: false\n"))
+		Expect(err).NotTo(HaveOccurred())
+		changes, err := Compare(from, to, Options{Partial: true})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal([]Change{
+			{Path: "cluster.fips", Type: Changed, From: true, To: false},
+		}))
+	})
+
+	It("Skips the ignored paths", func() {
+		to := FromCluster(buildCluster("4.16.2", nil), &Resources{})
+		changes, err := Compare(from, to, Options{Ignore: []string{"cluster.version", "cluster.tags", "machinePools"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+	})
+})
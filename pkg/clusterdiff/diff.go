@@ -0,0 +1,253 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterdiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ChangeType tells how a setting differs between the two sides of a comparison
+type ChangeType string
+
+const (
+	Added   ChangeType = "added"
+	Removed ChangeType = "removed"
+	Changed ChangeType = "changed"
+)
+
+// Change is a setting that differs between the two sides of a comparison. The path uses dots to
+// separate the fields and brackets for the identifier of machine pools, identity providers and
+// ingresses, for example 'machinePools[workers].replicas'.
+type Change struct {
+	Path string      `json:"path"`
+	Type ChangeType  `json:"type"`
+	From interface{} `json:"from,omitempty"`
+	To   interface{} `json:"to,omitempty"`
+}
+
+// String returns the change as a single line prefixed with '+', '-' or '~'
+func (c Change) String() string {
+	switch c.Type {
+	case Added:
+		return fmt.Sprintf("+ %s: %s", c.Path, formatValue(c.To))
+	case Removed:
+		return fmt.Sprintf("- %s: %s", c.Path, formatValue(c.From))
+	}
+	return fmt.Sprintf("~ %s: %s -> %s", c.Path, formatValue(c.From), formatValue(c.To))
+}
+
+func formatValue(value interface{}) string {
+	if text, ok := value.(string); ok {
+		return text
+	}
+	content, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(content)
+}
+
+// Options control how two snapshots are compared
+type Options struct {
+	// Partial ignores the settings that are missing from the second snapshot, which is used when
+	// it comes from a spec file that only contains some of the settings
+	Partial bool
+
+	// Ignore contains the paths of the settings that aren't compared, for example 'cluster.name'
+	// or 'machinePools[workers]'
+	Ignore []string
+}
+
+// keyedLists are the sections of the snapshot that are compared by the identifier of their items
+// instead of by their position
+var keyedLists = map[string]string{
+	"machinePools":      "id",
+	"identityProviders": "name",
+	"ingresses":         "id",
+}
+
+// keyed is a list converted into a map from the identifier of the items to the items
+type keyed map[string]interface{}
+
+// Compare returns the settings that differ between two snapshots, sorted by path
+func Compare(from *Snapshot, to *Snapshot, options Options) ([]Change, error) {
+	fromValue, err := normalize(from)
+	if err != nil {
+		return nil, err
+	}
+	toValue, err := normalize(to)
+	if err != nil {
+		return nil, err
+	}
+	comparison := &comparison{options: options, changes: []Change{}}
+	comparison.compare("", fromValue, toValue)
+	sort.SliceStable(comparison.changes, func(i, j int) bool {
+		return comparison.changes[i].Path < comparison.changes[j].Path
+	})
+	return comparison.changes, nil
+}
+
+// normalize converts the snapshot into generic maps, lists and values, replacing the keyed lists
+// by maps. The settings of a snapshot loaded from a spec file are taken as written in the file.
+func normalize(snapshot *Snapshot) (map[string]interface{}, error) {
+	content := snapshot.settings
+	if content == nil {
+		var err error
+		content, err = json.Marshal(snapshot)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding snapshot: %w", err)
+		}
+	}
+	result := map[string]interface{}{}
+	err := json.Unmarshal(content, &result)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding snapshot: %w", err)
+	}
+	for section, key := range keyedLists {
+		items, ok := result[section].([]interface{})
+		if !ok {
+			continue
+		}
+		byKey := keyed{}
+		for _, item := range items {
+			fields, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			byKey[fmt.Sprintf("%v", fields[key])] = fields
+		}
+		result[section] = byKey
+	}
+	return result, nil
+}
+
+type comparison struct {
+	options Options
+	changes []Change
+}
+
+func (c *comparison) compare(path string, from interface{}, to interface{}) {
+	if c.ignored(path) {
+		return
+	}
+	switch {
+	case from == nil && to == nil:
+		return
+	case to == nil:
+		if !c.options.Partial {
+			c.add(path, Removed, from, nil)
+		}
+		return
+	case from == nil:
+		// The settings of clusters are omitted when they have the zero value, so a spec file
+		// that sets them to false or zero matches:
+		if !reflect.ValueOf(to).IsZero() {
+			c.add(path, Added, nil, to)
+		}
+		return
+	}
+
+	switch fromValue := from.(type) {
+	case map[string]interface{}:
+		if toValue, ok := to.(map[string]interface{}); ok {
+			c.compareMaps(path, fromValue, toValue, fieldPath)
+			return
+		}
+	case keyed:
+		if toValue, ok := to.(keyed); ok {
+			c.compareMaps(path, fromValue, toValue, keyPath)
+			return
+		}
+	case []interface{}:
+		if toValue, ok := to.([]interface{}); ok {
+			if !sameItems(fromValue, toValue) {
+				c.add(path, Changed, from, to)
+			}
+			return
+		}
+	}
+	if !reflect.DeepEqual(from, to) {
+		c.add(path, Changed, from, to)
+	}
+}
+
+func (c *comparison) compareMaps(path string, from map[string]interface{}, to map[string]interface{},
+	join func(string, string) string) {
+	keys := map[string]bool{}
+	for key := range from {
+		keys[key] = true
+	}
+	for key := range to {
+		keys[key] = true
+	}
+	for _, key := range sortedKeys(keys) {
+		c.compare(join(path, key), from[key], to[key])
+	}
+}
+
+func (c *comparison) add(path string, changeType ChangeType, from interface{}, to interface{}) {
+	c.changes = append(c.changes, Change{Path: path, Type: changeType, From: unwrap(from), To: unwrap(to)})
+}
+
+// unwrap returns the keyed lists as plain maps, so that the type used internally doesn't leak
+func unwrap(value interface{}) interface{} {
+	if byKey, ok := value.(keyed); ok {
+		return map[string]interface{}(byKey)
+	}
+	return value
+}
+
+func (c *comparison) ignored(path string) bool {
+	for _, ignored := range c.options.Ignore {
+		if path == ignored || strings.HasPrefix(path, ignored+".") || strings.HasPrefix(path, ignored+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+func fieldPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func keyPath(path string, key string) string {
+	return fmt.Sprintf("%s[%s]", path, key)
+}
+
+// sameItems checks if two lists contain the same items, regardless of their order
+func sameItems(from []interface{}, to []interface{}) bool {
+	if len(from) != len(to) {
+		return false
+	}
+	return reflect.DeepEqual(sortedItems(from), sortedItems(to))
+}
+
+func sortedItems(items []interface{}) []string {
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		result = append(result, formatValue(item))
+	}
+	sort.Strings(result)
+	return result
+}
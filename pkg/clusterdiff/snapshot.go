@@ -0,0 +1,405 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clusterdiff compares the configuration of a cluster with the configuration of another
+// cluster or with a spec file. Both sides are first normalized into a snapshot that only contains
+// the settings chosen by the user, so that identifiers, timestamps and status don't show up as
+// differences.
+package clusterdiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift/rosa/pkg/clusterspec"
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+const (
+	// SnapshotKind identifies a spec file that contains the resources of the cluster besides the
+	// cluster itself
+	SnapshotKind = "ClusterSnapshot"
+
+	// defaultIngressKey is used instead of the generated identifier of the default ingress, so that
+	// the default ingresses of two clusters are compared with each other
+	defaultIngressKey = "default"
+)
+
+// SnapshotFile is the top-level document of a cluster snapshot spec file
+type SnapshotFile struct {
+	APIVersion string   `json:"apiVersion"`
+	Kind       string   `json:"kind"`
+	Spec       Snapshot `json:"spec"`
+}
+
+// Snapshot is the normalized configuration of a cluster and of its resources
+type Snapshot struct {
+	Cluster           *clusterspec.ClusterSpec `json:"cluster,omitempty"`
+	MachinePools      []MachinePool            `json:"machinePools,omitempty"`
+	IdentityProviders []IdentityProvider       `json:"identityProviders,omitempty"`
+	Ingresses         []Ingress                `json:"ingresses,omitempty"`
+	Autoscaler        *Autoscaler              `json:"autoscaler,omitempty"`
+
+	// settings contains the settings as written in the spec file the snapshot was loaded from, in
+	// JSON, so that the settings explicitly set to false or zero are also compared
+	settings []byte
+}
+
+// MachinePool is the configuration of a machine pool, or of a node pool of a Hosted Control Plane
+// cluster
+type MachinePool struct {
+	ID                string                       `json:"id"`
+	InstanceType      string                       `json:"instanceType,omitempty"`
+	Replicas          int                          `json:"replicas,omitempty"`
+	Autoscaling       *clusterspec.AutoscalingSpec `json:"autoscaling,omitempty"`
+	Labels            map[string]string            `json:"labels,omitempty"`
+	Taints            []string                     `json:"taints,omitempty"`
+	AvailabilityZones []string                     `json:"availabilityZones,omitempty"`
+	Subnets           []string                     `json:"subnets,omitempty"`
+	Version           string                       `json:"version,omitempty"`
+	AutoRepair        bool                         `json:"autoRepair,omitempty"`
+}
+
+// IdentityProvider is the configuration of an identity provider, without its secrets
+type IdentityProvider struct {
+	Name          string   `json:"name"`
+	Type          string   `json:"type,omitempty"`
+	MappingMethod string   `json:"mappingMethod,omitempty"`
+	ClientID      string   `json:"clientID,omitempty"`
+	URL           string   `json:"url,omitempty"`
+	Issuer        string   `json:"issuer,omitempty"`
+	Hostname      string   `json:"hostname,omitempty"`
+	HostedDomain  string   `json:"hostedDomain,omitempty"`
+	Organizations []string `json:"organizations,omitempty"`
+	Teams         []string `json:"teams,omitempty"`
+}
+
+// Ingress is the configuration of an ingress. The default ingress has the 'default' identifier.
+type Ingress struct {
+	ID                       string            `json:"id"`
+	Listening                string            `json:"listening,omitempty"`
+	LoadBalancerType         string            `json:"loadBalancerType,omitempty"`
+	RouteSelectors           map[string]string `json:"routeSelectors,omitempty"`
+	ExcludedNamespaces       []string          `json:"excludedNamespaces,omitempty"`
+	WildcardPolicy           string            `json:"wildcardPolicy,omitempty"`
+	NamespaceOwnershipPolicy string            `json:"namespaceOwnershipPolicy,omitempty"`
+}
+
+// Autoscaler is the configuration of the cluster autoscaler
+type Autoscaler struct {
+	BalanceSimilarNodeGroups    bool       `json:"balanceSimilarNodeGroups,omitempty"`
+	SkipNodesWithLocalStorage   bool       `json:"skipNodesWithLocalStorage,omitempty"`
+	IgnoreDaemonsetsUtilization bool       `json:"ignoreDaemonsetsUtilization,omitempty"`
+	BalancingIgnoredLabels      []string   `json:"balancingIgnoredLabels,omitempty"`
+	LogVerbosity                int        `json:"logVerbosity,omitempty"`
+	MaxPodGracePeriod           int        `json:"maxPodGracePeriod,omitempty"`
+	PodPriorityThreshold        int        `json:"podPriorityThreshold,omitempty"`
+	MaxNodeProvisionTime        string     `json:"maxNodeProvisionTime,omitempty"`
+	MaxNodesTotal               int        `json:"maxNodesTotal,omitempty"`
+	Cores                       *Range     `json:"cores,omitempty"`
+	Memory                      *Range     `json:"memory,omitempty"`
+	ScaleDown                   *ScaleDown `json:"scaleDown,omitempty"`
+}
+
+// Range is a resource limit of the cluster autoscaler
+type Range struct {
+	Min int `json:"min"`
+	Max int `json:"max"`
+}
+
+// ScaleDown is the scale down configuration of the cluster autoscaler
+type ScaleDown struct {
+	Enabled              bool   `json:"enabled,omitempty"`
+	UnneededTime         string `json:"unneededTime,omitempty"`
+	UtilizationThreshold string `json:"utilizationThreshold,omitempty"`
+	DelayAfterAdd        string `json:"delayAfterAdd,omitempty"`
+	DelayAfterDelete     string `json:"delayAfterDelete,omitempty"`
+	DelayAfterFailure    string `json:"delayAfterFailure,omitempty"`
+}
+
+// Resources are the resources of a cluster that are included in its snapshot. Only one of the
+// machine pools and the node pools is expected, depending on the topology of the cluster.
+type Resources struct {
+	MachinePools      []*cmv1.MachinePool
+	NodePools         []*cmv1.NodePool
+	IdentityProviders []*cmv1.IdentityProvider
+	Ingresses         []*cmv1.Ingress
+	Autoscaler        *cmv1.ClusterAutoscaler
+}
+
+// FromCluster builds the snapshot of an existing cluster and its resources
+func FromCluster(cluster *cmv1.Cluster, resources *Resources) *Snapshot {
+	snapshot := &Snapshot{
+		Cluster: &clusterspec.FromCluster(cluster, resources.Ingresses).Spec,
+	}
+	for _, machinePool := range resources.MachinePools {
+		snapshot.MachinePools = append(snapshot.MachinePools, machinePoolFromCluster(machinePool))
+	}
+	for _, nodePool := range resources.NodePools {
+		snapshot.MachinePools = append(snapshot.MachinePools, nodePoolFromCluster(nodePool))
+	}
+	for _, idp := range resources.IdentityProviders {
+		snapshot.IdentityProviders = append(snapshot.IdentityProviders, identityProviderFromCluster(idp))
+	}
+	for _, ingress := range resources.Ingresses {
+		snapshot.Ingresses = append(snapshot.Ingresses, ingressFromCluster(ingress))
+	}
+	if resources.Autoscaler != nil {
+		snapshot.Autoscaler = autoscalerFromCluster(resources.Autoscaler)
+	}
+	return snapshot
+}
+
+func machinePoolFromCluster(machinePool *cmv1.MachinePool) MachinePool {
+	result := MachinePool{
+		ID:                machinePool.ID(),
+		InstanceType:      machinePool.InstanceType(),
+		Labels:            machinePool.Labels(),
+		Taints:            taints(machinePool.Taints()),
+		AvailabilityZones: machinePool.AvailabilityZones(),
+		Subnets:           machinePool.Subnets(),
+	}
+	if autoscaling, ok := machinePool.GetAutoscaling(); ok {
+		result.Autoscaling = &clusterspec.AutoscalingSpec{
+			MinReplicas: autoscaling.MinReplicas(),
+			MaxReplicas: autoscaling.MaxReplicas(),
+		}
+	} else {
+		result.Replicas = machinePool.Replicas()
+	}
+	return result
+}
+
+func nodePoolFromCluster(nodePool *cmv1.NodePool) MachinePool {
+	result := MachinePool{
+		ID:           nodePool.ID(),
+		InstanceType: nodePool.AWSNodePool().InstanceType(),
+		Labels:       nodePool.Labels(),
+		Taints:       taints(nodePool.Taints()),
+		Version:      nodePool.Version().RawID(),
+		AutoRepair:   nodePool.AutoRepair(),
+	}
+	if zone := nodePool.AvailabilityZone(); zone != "" {
+		result.AvailabilityZones = []string{zone}
+	}
+	if subnet := nodePool.Subnet(); subnet != "" {
+		result.Subnets = []string{subnet}
+	}
+	if autoscaling, ok := nodePool.GetAutoscaling(); ok {
+		result.Autoscaling = &clusterspec.AutoscalingSpec{
+			MinReplicas: autoscaling.MinReplica(),
+			MaxReplicas: autoscaling.MaxReplica(),
+		}
+	} else {
+		result.Replicas = nodePool.Replicas()
+	}
+	return result
+}
+
+func taints(taints []*cmv1.Taint) []string {
+	var result []string
+	for _, taint := range taints {
+		result = append(result, fmt.Sprintf("%s=%s:%s", taint.Key(), taint.Value(), taint.Effect()))
+	}
+	return result
+}
+
+func identityProviderFromCluster(idp *cmv1.IdentityProvider) IdentityProvider {
+	result := IdentityProvider{
+		Name:          idp.Name(),
+		Type:          ocm.IdentityProviderType(idp),
+		MappingMethod: string(idp.MappingMethod()),
+	}
+	switch idp.Type() {
+	case cmv1.IdentityProviderTypeGithub:
+		result.ClientID = idp.Github().ClientID()
+		result.Hostname = idp.Github().Hostname()
+		result.Organizations = idp.Github().Organizations()
+		result.Teams = idp.Github().Teams()
+	case cmv1.IdentityProviderTypeGitlab:
+		result.ClientID = idp.Gitlab().ClientID()
+		result.URL = idp.Gitlab().URL()
+	case cmv1.IdentityProviderTypeGoogle:
+		result.ClientID = idp.Google().ClientID()
+		result.HostedDomain = idp.Google().HostedDomain()
+	case cmv1.IdentityProviderTypeLDAP:
+		result.URL = idp.LDAP().URL()
+	case cmv1.IdentityProviderTypeOpenID:
+		result.ClientID = idp.OpenID().ClientID()
+		result.Issuer = idp.OpenID().Issuer()
+	}
+	return result
+}
+
+func ingressFromCluster(ingress *cmv1.Ingress) Ingress {
+	result := Ingress{
+		ID:                       ingress.ID(),
+		Listening:                string(ingress.Listening()),
+		LoadBalancerType:         string(ingress.LoadBalancerType()),
+		RouteSelectors:           ingress.RouteSelectors(),
+		ExcludedNamespaces:       ingress.ExcludedNamespaces(),
+		WildcardPolicy:           string(ingress.RouteWildcardPolicy()),
+		NamespaceOwnershipPolicy: string(ingress.RouteNamespaceOwnershipPolicy()),
+	}
+	if ingress.Default() {
+		result.ID = defaultIngressKey
+	}
+	return result
+}
+
+func autoscalerFromCluster(autoscaler *cmv1.ClusterAutoscaler) *Autoscaler {
+	result := &Autoscaler{
+		BalanceSimilarNodeGroups:    autoscaler.BalanceSimilarNodeGroups(),
+		SkipNodesWithLocalStorage:   autoscaler.SkipNodesWithLocalStorage(),
+		IgnoreDaemonsetsUtilization: autoscaler.IgnoreDaemonsetsUtilization(),
+		BalancingIgnoredLabels:      autoscaler.BalancingIgnoredLabels(),
+		LogVerbosity:                autoscaler.LogVerbosity(),
+		MaxPodGracePeriod:           autoscaler.MaxPodGracePeriod(),
+		PodPriorityThreshold:        autoscaler.PodPriorityThreshold(),
+		MaxNodeProvisionTime:        autoscaler.MaxNodeProvisionTime(),
+		MaxNodesTotal:               autoscaler.ResourceLimits().MaxNodesTotal(),
+	}
+	if cores, ok := autoscaler.ResourceLimits().GetCores(); ok {
+		result.Cores = &Range{Min: cores.Min(), Max: cores.Max()}
+	}
+	if memory, ok := autoscaler.ResourceLimits().GetMemory(); ok {
+		result.Memory = &Range{Min: memory.Min(), Max: memory.Max()}
+	}
+	if scaleDown, ok := autoscaler.GetScaleDown(); ok {
+		result.ScaleDown = &ScaleDown{
+			Enabled:              scaleDown.Enabled(),
+			UnneededTime:         scaleDown.UnneededTime(),
+			UtilizationThreshold: scaleDown.UtilizationThreshold(),
+			DelayAfterAdd:        scaleDown.DelayAfterAdd(),
+			DelayAfterDelete:     scaleDown.DelayAfterDelete(),
+			DelayAfterFailure:    scaleDown.DelayAfterFailure(),
+		}
+	}
+	return result
+}
+
+// Load reads a spec file to compare a cluster with. Both the cluster spec files produced by
+// 'rosa export cluster' and cluster snapshot files are accepted, in YAML or JSON.
+func Load(path string) (*Snapshot, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading spec file '%s': %w", path, err)
+	}
+	return Parse(content)
+}
+
+// Parse decodes the content of a spec file
+func Parse(content []byte) (*Snapshot, error) {
+	header := struct {
+		Kind string `json:"kind"`
+	}{}
+	err := yaml.Unmarshal(content, &header)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing spec file: %w", err)
+	}
+	if header.Kind != SnapshotKind {
+		file, err := clusterspec.Parse(content)
+		if err != nil {
+			return nil, err
+		}
+		settings, err := specSettings(content, "cluster")
+		if err != nil {
+			return nil, err
+		}
+		return &Snapshot{Cluster: &file.Spec, settings: settings}, nil
+	}
+
+	file := &SnapshotFile{}
+	err = yaml.UnmarshalStrict(content, file)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing spec file: %w", err)
+	}
+	if file.APIVersion != clusterspec.APIVersion {
+		return nil, fmt.Errorf("unsupported spec apiVersion '%s', expected '%s'",
+			file.APIVersion, clusterspec.APIVersion)
+	}
+	err = validateKeys(file.Spec)
+	if err != nil {
+		return nil, err
+	}
+	file.Spec.settings, err = specSettings(content, "")
+	if err != nil {
+		return nil, err
+	}
+	return &file.Spec, nil
+}
+
+// specSettings returns the 'spec' section of a spec file in JSON. When a section is given, the
+// settings are moved under it, as the cluster spec files only contain the 'cluster' section of a
+// snapshot.
+func specSettings(content []byte, section string) ([]byte, error) {
+	file := struct {
+		Spec json.RawMessage `json:"spec"`
+	}{}
+	err := yaml.Unmarshal(content, &file)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing spec file: %w", err)
+	}
+	if len(file.Spec) == 0 || string(file.Spec) == "null" {
+		return []byte("{}"), nil
+	}
+	if section == "" {
+		return file.Spec, nil
+	}
+	return json.Marshal(map[string]json.RawMessage{section: file.Spec})
+}
+
+// validateKeys checks that the resources that are compared by their identifier have one, and that
+// it isn't repeated
+func validateKeys(snapshot Snapshot) error {
+	keys := map[string][]string{}
+	for _, machinePool := range snapshot.MachinePools {
+		keys["machinePools"] = append(keys["machinePools"], machinePool.ID)
+	}
+	for _, idp := range snapshot.IdentityProviders {
+		keys["identityProviders"] = append(keys["identityProviders"], idp.Name)
+	}
+	for _, ingress := range snapshot.Ingresses {
+		keys["ingresses"] = append(keys["ingresses"], ingress.ID)
+	}
+	for _, section := range sortedKeys(keys) {
+		seen := map[string]bool{}
+		for _, key := range keys[section] {
+			if key == "" {
+				return fmt.Errorf("all the items of '%s' must have an identifier", section)
+			}
+			if seen[key] {
+				return fmt.Errorf("'%s' contains '%s' more than once", section, key)
+			}
+			seen[key] = true
+		}
+	}
+	return nil
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,313 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clusterspec contains the versioned file format used to describe a ROSA cluster
+// declaratively. A cluster spec file can be passed to 'rosa create cluster --from-file' and
+// is produced by 'rosa export cluster'. Its fields map onto the values collected in ocm.Spec.
+package clusterspec
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift/rosa/pkg/aws/tags"
+)
+
+const (
+	// APIVersion is the only version of the cluster spec file format currently understood
+	APIVersion = "rosa.openshift.io/v1alpha1"
+	// Kind identifies a cluster spec file
+	Kind = "Cluster"
+)
+
+// ClusterFile is the top-level document of a cluster spec file
+type ClusterFile struct {
+	APIVersion string      `json:"apiVersion"`
+	Kind       string      `json:"kind"`
+	Spec       ClusterSpec `json:"spec"`
+}
+
+// ClusterSpec holds the user facing cluster configuration
+type ClusterSpec struct {
+	Name                      string            `json:"name,omitempty"`
+	DomainPrefix              string            `json:"domainPrefix,omitempty"`
+	Region                    string            `json:"region,omitempty"`
+	HostedCP                  bool              `json:"hostedCP,omitempty"`
+	MultiAZ                   bool              `json:"multiAZ,omitempty"`
+	AvailabilityZones         []string          `json:"availabilityZones,omitempty"`
+	Version                   string            `json:"version,omitempty"`
+	ChannelGroup              string            `json:"channelGroup,omitempty"`
+	Channel                   string            `json:"channel,omitempty"`
+	FIPS                      bool              `json:"fips,omitempty"`
+	EtcdEncryption            bool              `json:"etcdEncryption,omitempty"`
+	EtcdEncryptionKMSArn      string            `json:"etcdEncryptionKMSArn,omitempty"`
+	KMSKeyArn                 string            `json:"kmsKeyArn,omitempty"`
+	DisableWorkloadMonitoring bool              `json:"disableWorkloadMonitoring,omitempty"`
+	EnableDeleteProtection    bool              `json:"enableDeleteProtection,omitempty"`
+	BillingAccount            string            `json:"billingAccount,omitempty"`
+	Ec2MetadataHttpTokens     string            `json:"ec2MetadataHttpTokens,omitempty"`
+	AuditLogRoleArn           string            `json:"auditLogRoleArn,omitempty"`
+	ExternalAuthProviders     bool              `json:"externalAuthProvidersEnabled,omitempty"`
+	Tags                      map[string]string `json:"tags,omitempty"`
+
+	STS     *STSSpec     `json:"sts,omitempty"`
+	Network *NetworkSpec `json:"network,omitempty"`
+	Proxy   *ProxySpec   `json:"proxy,omitempty"`
+	Compute *ComputeSpec `json:"compute,omitempty"`
+}
+
+// STSSpec holds the account and operator role configuration of an STS cluster
+type STSSpec struct {
+	RoleArn             string `json:"roleArn,omitempty"`
+	SupportRoleArn      string `json:"supportRoleArn,omitempty"`
+	ControlPlaneRoleArn string `json:"controlPlaneRoleArn,omitempty"`
+	WorkerRoleArn       string `json:"workerRoleArn,omitempty"`
+	ExternalID          string `json:"externalID,omitempty"`
+	OperatorRolesPrefix string `json:"operatorRolesPrefix,omitempty"`
+	PermissionsBoundary string `json:"permissionsBoundary,omitempty"`
+	OidcConfigID        string `json:"oidcConfigID,omitempty"`
+	Mode                string `json:"mode,omitempty"`
+}
+
+// NetworkSpec holds the networking configuration of the cluster
+type NetworkSpec struct {
+	Type                  string   `json:"type,omitempty"`
+	MachineCIDR           string   `json:"machineCIDR,omitempty"`
+	ServiceCIDR           string   `json:"serviceCIDR,omitempty"`
+	PodCIDR               string   `json:"podCIDR,omitempty"`
+	HostPrefix            int      `json:"hostPrefix,omitempty"`
+	SubnetIDs             []string `json:"subnetIDs,omitempty"`
+	Private               bool     `json:"private,omitempty"`
+	PrivateLink           bool     `json:"privateLink,omitempty"`
+	DefaultIngressPrivate bool     `json:"defaultIngressPrivate,omitempty"`
+}
+
+// ProxySpec holds the cluster-wide proxy configuration
+type ProxySpec struct {
+	HTTPProxy                 string   `json:"httpProxy,omitempty"`
+	HTTPSProxy                string   `json:"httpsProxy,omitempty"`
+	NoProxy                   []string `json:"noProxy,omitempty"`
+	AdditionalTrustBundleFile string   `json:"additionalTrustBundleFile,omitempty"`
+}
+
+// ComputeSpec holds the configuration of the default worker machine pool
+type ComputeSpec struct {
+	MachineType      string            `json:"machineType,omitempty"`
+	Replicas         int               `json:"replicas,omitempty"`
+	Autoscaling      *AutoscalingSpec  `json:"autoscaling,omitempty"`
+	Labels           map[string]string `json:"labels,omitempty"`
+	DiskSize         string            `json:"diskSize,omitempty"`
+	SecurityGroupIDs []string          `json:"securityGroupIDs,omitempty"`
+}
+
+// AutoscalingSpec holds the replica bounds of an autoscaling worker machine pool
+type AutoscalingSpec struct {
+	MinReplicas int `json:"minReplicas"`
+	MaxReplicas int `json:"maxReplicas"`
+}
+
+// NewClusterFile returns an empty cluster spec document with the type information filled in
+func NewClusterFile() *ClusterFile {
+	return &ClusterFile{
+		APIVersion: APIVersion,
+		Kind:       Kind,
+	}
+}
+
+// Load reads and validates a cluster spec file. Both YAML and JSON documents are accepted.
+func Load(path string) (*ClusterFile, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading cluster spec file '%s': %w", path, err)
+	}
+	return Parse(content)
+}
+
+// Parse decodes and validates the content of a cluster spec file
+func Parse(content []byte) (*ClusterFile, error) {
+	file := &ClusterFile{}
+	err := yaml.UnmarshalStrict(content, file)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing cluster spec file: %w", err)
+	}
+	err = file.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// Validate checks the type information and the settings that cannot be combined
+func (f *ClusterFile) Validate() error {
+	if f.APIVersion != APIVersion {
+		return fmt.Errorf("unsupported cluster spec apiVersion '%s', expected '%s'",
+			f.APIVersion, APIVersion)
+	}
+	if f.Kind != Kind {
+		return fmt.Errorf("unsupported cluster spec kind '%s', expected '%s'", f.Kind, Kind)
+	}
+	spec := f.Spec
+	if spec.Channel != "" && spec.ChannelGroup != "" {
+		return fmt.Errorf("only one of 'channel' and 'channelGroup' can be set")
+	}
+	if spec.Network != nil && len(spec.Network.SubnetIDs) > 0 && len(spec.AvailabilityZones) > 0 {
+		return fmt.Errorf("'availabilityZones' cannot be set together with 'network.subnetIDs'")
+	}
+	if spec.Compute != nil && spec.Compute.Autoscaling != nil {
+		if spec.Compute.Replicas != 0 {
+			return fmt.Errorf("'compute.replicas' cannot be set together with 'compute.autoscaling'")
+		}
+		if spec.Compute.Autoscaling.MinReplicas > spec.Compute.Autoscaling.MaxReplicas {
+			return fmt.Errorf("'compute.autoscaling.minReplicas' must be less than or equal to " +
+				"'compute.autoscaling.maxReplicas'")
+		}
+	}
+	return nil
+}
+
+// Marshal encodes the cluster spec document as YAML
+func (f *ClusterFile) Marshal() ([]byte, error) {
+	return yaml.Marshal(f)
+}
+
+// FromCluster rebuilds the cluster spec of an existing cluster. Values that are generated by
+// the service, such as identifiers and timestamps, are left out so the result can be used
+// to create a new cluster. The ingresses of the cluster are used to find out whether its default
+// ingress is private.
+func FromCluster(cluster *cmv1.Cluster, ingresses []*cmv1.Ingress) *ClusterFile {
+	file := NewClusterFile()
+	spec := &file.Spec
+
+	spec.Name = cluster.Name()
+	spec.DomainPrefix = cluster.DomainPrefix()
+	spec.Region = cluster.Region().ID()
+	spec.HostedCP = cluster.Hypershift().Enabled()
+	spec.MultiAZ = cluster.MultiAZ()
+	spec.Version = cluster.Version().RawID()
+	spec.Channel = cluster.Channel()
+	if spec.Channel == "" {
+		spec.ChannelGroup = cluster.Version().ChannelGroup()
+	}
+	spec.FIPS = cluster.FIPS()
+	spec.EtcdEncryption = cluster.EtcdEncryption()
+	spec.DisableWorkloadMonitoring = cluster.DisableUserWorkloadMonitoring()
+	spec.EnableDeleteProtection = cluster.DeleteProtection().Enabled()
+	spec.ExternalAuthProviders = cluster.ExternalAuthConfig().Enabled()
+
+	if awsConfig, ok := cluster.GetAWS(); ok {
+		spec.KMSKeyArn = awsConfig.KMSKeyArn()
+		spec.EtcdEncryptionKMSArn = awsConfig.EtcdEncryption().KMSKeyARN()
+		spec.BillingAccount = awsConfig.BillingAccountID()
+		spec.Ec2MetadataHttpTokens = string(awsConfig.Ec2MetadataHttpTokens())
+		spec.AuditLogRoleArn = awsConfig.AuditLog().RoleArn()
+		spec.Tags = tags.UserTags(awsConfig.Tags())
+		spec.STS = stsFromCluster(awsConfig.STS())
+	}
+
+	spec.Network = networkFromCluster(cluster, ingresses)
+	spec.Proxy = proxyFromCluster(cluster)
+	spec.Compute = computeFromCluster(cluster)
+	if spec.Network == nil || len(spec.Network.SubnetIDs) == 0 {
+		spec.AvailabilityZones = cluster.Nodes().AvailabilityZones()
+	}
+
+	return file
+}
+
+func stsFromCluster(sts *cmv1.STS) *STSSpec {
+	if !sts.Enabled() {
+		return nil
+	}
+	return &STSSpec{
+		RoleArn:             sts.RoleARN(),
+		SupportRoleArn:      sts.SupportRoleARN(),
+		ControlPlaneRoleArn: sts.InstanceIAMRoles().MasterRoleARN(),
+		WorkerRoleArn:       sts.InstanceIAMRoles().WorkerRoleARN(),
+		ExternalID:          sts.ExternalID(),
+		OperatorRolesPrefix: sts.OperatorRolePrefix(),
+		PermissionsBoundary: sts.PermissionBoundary(),
+		OidcConfigID:        sts.OidcConfig().ID(),
+	}
+}
+
+func networkFromCluster(cluster *cmv1.Cluster, ingresses []*cmv1.Ingress) *NetworkSpec {
+	network := &NetworkSpec{
+		Type:        cluster.Network().Type(),
+		MachineCIDR: cluster.Network().MachineCIDR(),
+		ServiceCIDR: cluster.Network().ServiceCIDR(),
+		PodCIDR:     cluster.Network().PodCIDR(),
+		HostPrefix:  cluster.Network().HostPrefix(),
+		SubnetIDs:   cluster.AWS().SubnetIDs(),
+		Private:     cluster.API().Listening() == cmv1.ListeningMethodInternal,
+		PrivateLink: cluster.AWS().PrivateLink(),
+	}
+	for _, ingress := range ingresses {
+		if ingress.Default() {
+			network.DefaultIngressPrivate = ingress.Listening() == cmv1.ListeningMethodInternal
+		}
+	}
+	if network.Type == "" && network.MachineCIDR == "" && len(network.SubnetIDs) == 0 &&
+		!network.Private && !network.PrivateLink && !network.DefaultIngressPrivate {
+		return nil
+	}
+	return network
+}
+
+func proxyFromCluster(cluster *cmv1.Cluster) *ProxySpec {
+	proxy, ok := cluster.GetProxy()
+	if !ok {
+		return nil
+	}
+	spec := &ProxySpec{
+		HTTPProxy:  proxy.HTTPProxy(),
+		HTTPSProxy: proxy.HTTPSProxy(),
+	}
+	if proxy.NoProxy() != "" {
+		spec.NoProxy = strings.Split(proxy.NoProxy(), ",")
+	}
+	if spec.HTTPProxy == "" && spec.HTTPSProxy == "" && len(spec.NoProxy) == 0 {
+		return nil
+	}
+	return spec
+}
+
+func computeFromCluster(cluster *cmv1.Cluster) *ComputeSpec {
+	nodes, ok := cluster.GetNodes()
+	if !ok {
+		return nil
+	}
+	compute := &ComputeSpec{
+		MachineType:      nodes.ComputeMachineType().ID(),
+		Labels:           nodes.ComputeLabels(),
+		SecurityGroupIDs: cluster.AWS().AdditionalComputeSecurityGroupIds(),
+	}
+	if autoscaling, ok := nodes.GetAutoscaleCompute(); ok {
+		compute.Autoscaling = &AutoscalingSpec{
+			MinReplicas: autoscaling.MinReplicas(),
+			MaxReplicas: autoscaling.MaxReplicas(),
+		}
+	} else {
+		compute.Replicas = nodes.Compute()
+	}
+	if size := nodes.ComputeRootVolume().AWS().Size(); size != 0 {
+		compute.DiskSize = fmt.Sprintf("%dGiB", size)
+	}
+	return compute
+}
@@ -0,0 +1,199 @@
+package clusterspec
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+const testClusterSpec = `
+apiVersion: rosa.openshift.io/v1alpha1
+kind: Cluster
+spec:
+  name: mycluster
+  region: us-east-1
+  hostedCP: true
+  version: 4.16.2
+  tags:
+    team: payments
+  sts:
+    roleArn: arn:aws:iam::123456789012:role/Installer
+    operatorRolesPrefix: mycluster
+    oidcConfigID: abc123
+  network:
+    machineCIDR: 10.0.0.0/16
+    subnetIDs: [subnet-1, subnet-2]
+  compute:
+    machineType: m5.xlarge
+    autoscaling:
+      minReplicas: 2
+      maxReplicas: 6
+`
+
+var _ = Describe("Cluster spec", func() {
+	Context("Parse", func() {
+		It("parses a YAML cluster spec", func() {
+			file, err := Parse([]byte(testClusterSpec))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(file.Spec.Name).To(Equal("mycluster"))
+			Expect(file.Spec.HostedCP).To(BeTrue())
+			Expect(file.Spec.Tags).To(HaveKeyWithValue("team", "payments"))
+			Expect(file.Spec.STS.OperatorRolesPrefix).To(Equal("mycluster"))
+			Expect(file.Spec.Network.SubnetIDs).To(Equal([]string{"subnet-1", "subnet-2"}))
+			Expect(file.Spec.Compute.Autoscaling.MaxReplicas).To(Equal(6))
+		})
+
+		It("parses a JSON cluster spec", func() {
+			file, err := Parse([]byte(`{"apiVersion": "rosa.openshift.io/v1alpha1", "kind": "Cluster",
+				"spec": {"name": "mycluster", "multiAZ": true}}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(file.Spec.Name).To(Equal("mycluster"))
+			Expect(file.Spec.MultiAZ).To(BeTrue())
+		})
+
+		It("fails on unknown fields", func() {
+			_, err := Parse([]byte("apiVersion: rosa.openshift.io/v1alpha1\nkind: Cluster\nspec:\n  foo: bar\n"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("error parsing cluster spec file"))
+		})
+
+		It("fails on an unsupported apiVersion", func() {
+			_, err := Parse([]byte("apiVersion: rosa.openshift.io/v2\nkind: Cluster\n"))
+			Expect(err).To(MatchError("unsupported cluster spec apiVersion 'rosa.openshift.io/v2', " +
+				"expected 'rosa.openshift.io/v1alpha1'"))
+		})
+
+		It("fails on an unsupported kind", func() {
+			_, err := Parse([]byte("apiVersion: rosa.openshift.io/v1alpha1\nkind: MachinePool\n"))
+			Expect(err).To(MatchError("unsupported cluster spec kind 'MachinePool', expected 'Cluster'"))
+		})
+	})
+
+	Context("Validate", func() {
+		var file *ClusterFile
+
+		BeforeEach(func() {
+			file = NewClusterFile()
+		})
+
+		It("rejects channel together with channel group", func() {
+			file.Spec.Channel = "stable-4.16"
+			file.Spec.ChannelGroup = "stable"
+			Expect(file.Validate()).To(MatchError("only one of 'channel' and 'channelGroup' can be set"))
+		})
+
+		It("rejects availability zones together with subnets", func() {
+			file.Spec.AvailabilityZones = []string{"us-east-1a"}
+			file.Spec.Network = &NetworkSpec{SubnetIDs: []string{"subnet-1"}}
+			Expect(file.Validate()).To(HaveOccurred())
+		})
+
+		It("rejects replicas together with autoscaling", func() {
+			file.Spec.Compute = &ComputeSpec{Replicas: 3, Autoscaling: &AutoscalingSpec{MinReplicas: 2, MaxReplicas: 3}}
+			Expect(file.Validate()).To(HaveOccurred())
+		})
+
+		It("rejects inverted autoscaling bounds", func() {
+			file.Spec.Compute = &ComputeSpec{Autoscaling: &AutoscalingSpec{MinReplicas: 4, MaxReplicas: 3}}
+			Expect(file.Validate()).To(HaveOccurred())
+		})
+	})
+
+	Context("Load", func() {
+		It("loads a spec file from disk", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "cluster.yaml")
+			Expect(os.WriteFile(path, []byte(testClusterSpec), 0600)).To(Succeed())
+			file, err := Load(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(file.Spec.Region).To(Equal("us-east-1"))
+		})
+
+		It("fails when the file does not exist", func() {
+			_, err := Load(filepath.Join(GinkgoT().TempDir(), "missing.yaml"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("error reading cluster spec file"))
+		})
+	})
+
+	Context("FromCluster", func() {
+		It("rebuilds the spec of a hosted cluster", func() {
+			cluster, err := cmv1.NewCluster().
+				ID("cluster-id").
+				Name("mycluster").
+				DomainPrefix("mycluster").
+				Region(cmv1.NewCloudRegion().ID("us-east-1")).
+				Hypershift(cmv1.NewHypershift().Enabled(true)).
+				Version(cmv1.NewVersion().RawID("4.16.2").ChannelGroup("stable")).
+				API(cmv1.NewClusterAPI().Listening(cmv1.ListeningMethodInternal)).
+				Network(cmv1.NewNetwork().MachineCIDR("10.0.0.0/16").HostPrefix(23)).
+				Proxy(cmv1.NewProxy().HTTPProxy("http://proxy:3128").NoProxy("a.com,b.com")).
+				Nodes(cmv1.NewClusterNodes().
+					ComputeMachineType(cmv1.NewMachineType().ID("m5.xlarge")).
+					AutoscaleCompute(cmv1.NewMachinePoolAutoscaling().MinReplicas(2).MaxReplicas(4)).
+					AvailabilityZones("us-east-1a")).
+				AWS(cmv1.NewAWS().
+					SubnetIDs("subnet-1").
+					BillingAccountID("123456789012").
+					Tags(map[string]string{"team": "payments", "red-hat-managed": "true"}).
+					STS(cmv1.NewSTS().
+						Enabled(true).
+						RoleARN("arn:aws:iam::123456789012:role/Installer").
+						OperatorRolePrefix("mycluster").
+						OidcConfig(cmv1.NewOidcConfig().ID("oidc-id")))).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+
+			ingress, err := cmv1.NewIngress().ID("a1b2").Default(true).
+				Listening(cmv1.ListeningMethodInternal).Build()
+			Expect(err).NotTo(HaveOccurred())
+
+			file := FromCluster(cluster, []*cmv1.Ingress{ingress})
+			Expect(file.APIVersion).To(Equal(APIVersion))
+			Expect(file.Kind).To(Equal(Kind))
+			Expect(file.Validate()).To(Succeed())
+
+			spec := file.Spec
+			Expect(spec.Name).To(Equal("mycluster"))
+			Expect(spec.Region).To(Equal("us-east-1"))
+			Expect(spec.HostedCP).To(BeTrue())
+			Expect(spec.Version).To(Equal("4.16.2"))
+			Expect(spec.ChannelGroup).To(Equal("stable"))
+			Expect(spec.BillingAccount).To(Equal("123456789012"))
+			Expect(spec.Tags).To(Equal(map[string]string{"team": "payments"}))
+			Expect(spec.AvailabilityZones).To(BeEmpty())
+			Expect(spec.STS.OperatorRolesPrefix).To(Equal("mycluster"))
+			Expect(spec.STS.OidcConfigID).To(Equal("oidc-id"))
+			Expect(spec.Network.Private).To(BeTrue())
+			Expect(spec.Network.DefaultIngressPrivate).To(BeTrue())
+			Expect(spec.Network.SubnetIDs).To(Equal([]string{"subnet-1"}))
+			Expect(spec.Network.HostPrefix).To(Equal(23))
+			Expect(spec.Proxy.NoProxy).To(Equal([]string{"a.com", "b.com"}))
+			Expect(spec.Compute.MachineType).To(Equal("m5.xlarge"))
+			Expect(spec.Compute.Replicas).To(BeZero())
+			Expect(*spec.Compute.Autoscaling).To(Equal(AutoscalingSpec{MinReplicas: 2, MaxReplicas: 4}))
+		})
+
+		It("round-trips through the file format", func() {
+			cluster, err := cmv1.NewCluster().
+				Name("mycluster").
+				Version(cmv1.NewVersion().RawID("4.16.2")).
+				Channel("stable-4.16").
+				Nodes(cmv1.NewClusterNodes().Compute(3).AvailabilityZones("us-east-1a")).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+
+			content, err := FromCluster(cluster, nil).Marshal()
+			Expect(err).NotTo(HaveOccurred())
+			file, err := Parse(content)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(file.Spec.Channel).To(Equal("stable-4.16"))
+			Expect(file.Spec.ChannelGroup).To(BeEmpty())
+			Expect(file.Spec.Compute.Replicas).To(Equal(3))
+			Expect(file.Spec.AvailabilityZones).To(Equal([]string{"us-east-1a"}))
+			Expect(file.Spec.STS).To(BeNil())
+		})
+	})
+})
@@ -20,17 +20,23 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/cmd/attach"
+	"github.com/openshift/rosa/cmd/cani"
+	"github.com/openshift/rosa/cmd/cleanup"
 	"github.com/openshift/rosa/cmd/completion"
 	"github.com/openshift/rosa/cmd/config"
 	"github.com/openshift/rosa/cmd/create"
 	"github.com/openshift/rosa/cmd/describe"
 	"github.com/openshift/rosa/cmd/detach"
+	"github.com/openshift/rosa/cmd/diff"
 	"github.com/openshift/rosa/cmd/dlt"
 	"github.com/openshift/rosa/cmd/docs"
+	"github.com/openshift/rosa/cmd/doctor"
 	"github.com/openshift/rosa/cmd/download"
 	"github.com/openshift/rosa/cmd/edit"
+	"github.com/openshift/rosa/cmd/export"
 	"github.com/openshift/rosa/cmd/grant"
 	"github.com/openshift/rosa/cmd/hibernate"
+	"github.com/openshift/rosa/cmd/hibernation"
 	"github.com/openshift/rosa/cmd/initialize"
 	"github.com/openshift/rosa/cmd/install"
 	"github.com/openshift/rosa/cmd/link"
@@ -39,14 +45,17 @@ import (
 	"github.com/openshift/rosa/cmd/logout"
 	"github.com/openshift/rosa/cmd/logs"
 	"github.com/openshift/rosa/cmd/register"
+	"github.com/openshift/rosa/cmd/replace"
 	"github.com/openshift/rosa/cmd/resume"
 	"github.com/openshift/rosa/cmd/revoke"
+	"github.com/openshift/rosa/cmd/sync"
 	"github.com/openshift/rosa/cmd/token"
 	"github.com/openshift/rosa/cmd/uninstall"
 	"github.com/openshift/rosa/cmd/unlink"
 	"github.com/openshift/rosa/cmd/upgrade"
 	"github.com/openshift/rosa/cmd/verify"
 	"github.com/openshift/rosa/cmd/version"
+	"github.com/openshift/rosa/cmd/wait"
 	"github.com/openshift/rosa/cmd/whoami"
 )
 
@@ -84,4 +93,13 @@ func RegisterCommands(root *cobra.Command) {
 	root.AddCommand(config.Cmd)
 	root.AddCommand(attach.NewRosaAttachCommand())
 	root.AddCommand(detach.NewRosaDetachCommand())
+	root.AddCommand(export.NewRosaExportCommand())
+	root.AddCommand(wait.NewRosaWaitCommand())
+	root.AddCommand(diff.NewRosaDiffCommand())
+	root.AddCommand(sync.NewRosaSyncCommand())
+	root.AddCommand(hibernation.NewRosaHibernationCommand())
+	root.AddCommand(replace.NewRosaReplaceCommand())
+	root.AddCommand(cleanup.NewRosaCleanupCommand())
+	root.AddCommand(doctor.NewRosaDoctorCommand())
+	root.AddCommand(cani.NewRosaCanICommand())
 }
@@ -28,8 +28,8 @@ var _ = Describe("RegisterCommands", func() {
 			Expect(commands).ToNot(BeEmpty())
 
 			// Verify the expected number of commands are registered
-			// As of this test, there should be 29 top-level commands
-			Expect(len(commands)).To(Equal(29))
+			// As of this test, there should be 38 top-level commands
+			Expect(len(commands)).To(Equal(38))
 
 			// Verify specific critical commands are present
 			commandNames := make(map[string]bool)
@@ -68,6 +68,15 @@ var _ = Describe("RegisterCommands", func() {
 				"config",
 				"attach",
 				"detach",
+				"export",
+				"wait",
+				"diff",
+				"sync",
+				"hibernation",
+				"replace",
+				"cleanup",
+				"doctor",
+				"can-i",
 			}
 
 			for _, cmdName := range expectedCommands {
@@ -106,7 +115,7 @@ var _ = Describe("RegisterCommands", func() {
 
 			// Both should have the same number of commands
 			Expect(firstCount).To(Equal(secondCount))
-			Expect(firstCount).To(Equal(29))
+			Expect(firstCount).To(Equal(38))
 		})
 	})
 })
@@ -58,20 +58,28 @@ type Config struct {
 	UserAgent    string   `json:"user_agent,omitempty" doc:"OCM client UserAgent. Default value is used if not set."`
 	Version      string   `json:"version,omitempty" doc:"OCM client version. Default value is used if not set."`
 	FedRAMP      bool     `json:"fedramp,omitempty" doc:"Indicates FedRAMP."`
+
+	CurrentContext string             `json:"current_context,omitempty" doc:"Name of the active login context."`
+	Contexts       map[string]*Config `json:"contexts,omitempty" doc:"Login contexts stored side by side."`
 }
 
 var DisallowedSetConfigProperties = []string{"scopes"}
 
+// contextConfigProperties are managed with the context commands instead of 'config get/set'
+var contextConfigProperties = []string{"current_context", "contexts"}
+
 func ConfigPropertiesNamesAndDocs() ([]string, []string) {
 	configType := reflect.ValueOf(Config{}).Type()
-	names := make([]string, configType.NumField())
-	docs := make([]string, configType.NumField())
+	names := make([]string, 0, configType.NumField())
+	docs := make([]string, 0, configType.NumField())
 	for i := 0; i < configType.NumField(); i++ {
 		tag := configType.Field(i).Tag
 		propName := strings.Split(tag.Get("json"), ",")[0]
-		names[i] = propName
-		propDoc := tag.Get("doc")
-		docs[i] = propDoc
+		if slices.Contains(contextConfigProperties, propName) {
+			continue
+		}
+		names = append(names, propName)
+		docs = append(docs, tag.Get("doc"))
 	}
 	return names, docs
 }
@@ -101,13 +109,18 @@ func GetAllowedConfigProperties() []string {
 	return allowedProperties
 }
 
-// Loads the configuration from the OS keyring if requested, load from the configuration file if not
+// Loads the configuration from the OS keyring if requested, load from the configuration file if not.
+// When a context other than the active one was selected with the '--context' flag only the settings
+// of that context are returned, or nil if it doesn't exist yet.
 func Load() (cfg *Config, err error) {
-	if keyring, ok := IsKeyringManaged(); ok {
-		return loadFromOS(keyring)
+	cfg, err = LoadAll()
+	if err != nil || cfg == nil {
+		return
 	}
-
-	return loadFromFile()
+	if selectedContext != "" && selectedContext != cfg.ActiveContext() {
+		return cfg.Context(selectedContext), nil
+	}
+	return
 }
 
 func IsNotValid(cfg *Config) bool {
@@ -168,8 +181,41 @@ func loadFromFile() (cfg *Config, err error) {
 	return
 }
 
-// Save saves the given configuration to the configuration file.
+// Save saves the given configuration to the configuration file. The settings are stored in the
+// context selected with the '--context' flag, or in the active one, keeping the rest of the contexts.
 func Save(cfg *Config) error {
+	if cfg == nil {
+		return saveAll(cfg)
+	}
+	// A configuration that can't be read is replaced, as it always has been:
+	stored, err := LoadAll()
+	if err != nil || stored == nil {
+		stored = &Config{
+			CurrentContext: cfg.CurrentContext,
+			Contexts:       cfg.Contexts,
+		}
+		if stored.CurrentContext == "" {
+			stored.CurrentContext = selectedContext
+		}
+	}
+	name := selectedContext
+	if name == "" {
+		name = stored.ActiveContext()
+	}
+	if name == stored.ActiveContext() {
+		stored.setCredentials(cfg)
+	} else {
+		if stored.Contexts == nil {
+			stored.Contexts = map[string]*Config{}
+		}
+		stored.Contexts[name] = cfg.credentials()
+	}
+	stored.syncActiveContext()
+	return saveAll(stored)
+}
+
+// saveAll saves the complete configuration, including all the contexts.
+func saveAll(cfg *Config) error {
 	file, err := Location()
 	if err != nil {
 		return err
@@ -200,8 +246,27 @@ func Save(cfg *Config) error {
 	return nil
 }
 
-// Remove removes the configuration file.
+// Remove removes the configuration file. When other contexts are stored only the settings of the
+// selected or active context are removed.
 func Remove() error {
+	cfg, err := LoadAll()
+	if err == nil && cfg != nil {
+		name := selectedContext
+		if name == "" {
+			name = cfg.ActiveContext()
+		}
+		if name != cfg.ActiveContext() {
+			delete(cfg.Contexts, name)
+			return saveAll(cfg)
+		}
+		delete(cfg.Contexts, name)
+		if len(cfg.Contexts) > 0 {
+			cfg.setCredentials(&Config{})
+			cfg.CurrentContext = ""
+			return saveAll(cfg)
+		}
+	}
+
 	if keyring, ok := IsKeyringManaged(); ok {
 		err := RemoveConfigFromKeyring(keyring)
 		if err != nil {
@@ -6,9 +6,11 @@ import (
 	"os"
 	"slices"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
 
 	"github.com/openshift/rosa/pkg/properties"
 )
@@ -301,3 +303,29 @@ func generateConfigBytes(config Config) []byte {
 
 	return jsonData
 }
+
+var _ = Describe("TokenExpiry", func() {
+	It("Returns the expiry of the refresh token", func() {
+		cfg := &Config{
+			AccessToken:  MakeTokenString("Bearer", time.Minute),
+			RefreshToken: MakeTokenString("Refresh", 10*time.Hour),
+		}
+		expiry, err := cfg.TokenExpiry()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expiry).To(BeTemporally("~", time.Now().Add(10*time.Hour), time.Minute))
+	})
+
+	It("Returns the expiry of the access token when there is no refresh token", func() {
+		cfg := &Config{AccessToken: MakeTokenString("Bearer", time.Hour)}
+		expiry, err := cfg.TokenExpiry()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expiry).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+	})
+
+	It("Returns the zero time for client credentials", func() {
+		cfg := &Config{ClientID: "id", ClientSecret: "secret"}
+		expiry, err := cfg.TokenExpiry()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expiry.IsZero()).To(BeTrue())
+	})
+})
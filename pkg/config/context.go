@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the types and functions used to manage named login contexts. The top level
+// settings of the configuration always hold the active context, so that the file stays compatible
+// with the 'ocm' command line tool, and the rest of the contexts are stored side by side in the
+// 'contexts' map.
+
+package config
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// DefaultContext is the name given to the active context when it was created without a name, for
+// example by a login that happened before named contexts were available.
+const DefaultContext = "default"
+
+// selectedContext is the name of the context requested with the global '--context' flag.
+var selectedContext string
+
+// SetSelectedContext selects the context that will be used by Load, Save and Remove instead of the
+// active one. An empty name selects the active context.
+func SetSelectedContext(name string) {
+	selectedContext = name
+}
+
+// SelectedContext returns the name of the context requested with the global '--context' flag.
+func SelectedContext() string {
+	return selectedContext
+}
+
+// ActiveContext returns the name of the context stored in the top level settings.
+func (c *Config) ActiveContext() string {
+	if c.CurrentContext != "" {
+		return c.CurrentContext
+	}
+	return DefaultContext
+}
+
+// ContextNames returns the sorted names of all the contexts, including the active one.
+func (c *Config) ContextNames() []string {
+	names := slices.Collect(maps.Keys(c.Contexts))
+	if !IsNotValid(c) && !slices.Contains(names, c.ActiveContext()) {
+		names = append(names, c.ActiveContext())
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Context returns the settings of the context with the given name, or nil if it doesn't exist.
+func (c *Config) Context(name string) *Config {
+	if name == c.ActiveContext() && !IsNotValid(c) {
+		return c.credentials()
+	}
+	ctx, ok := c.Contexts[name]
+	if !ok || ctx == nil {
+		return nil
+	}
+	return ctx.credentials()
+}
+
+// credentials returns a copy of the configuration without the context bookkeeping.
+func (c *Config) credentials() *Config {
+	result := *c
+	result.CurrentContext = ""
+	result.Contexts = nil
+	return &result
+}
+
+// setCredentials replaces the top level settings with the given ones, keeping the contexts.
+func (c *Config) setCredentials(value *Config) {
+	current := c.CurrentContext
+	contexts := c.Contexts
+	*c = *value.credentials()
+	c.CurrentContext = current
+	c.Contexts = contexts
+}
+
+// syncActiveContext stores a copy of the top level settings under the name of the active context,
+// so that switching away from it doesn't lose them.
+func (c *Config) syncActiveContext() {
+	if IsNotValid(c) || (c.CurrentContext == "" && len(c.Contexts) == 0) {
+		return
+	}
+	if c.Contexts == nil {
+		c.Contexts = map[string]*Config{}
+	}
+	c.Contexts[c.ActiveContext()] = c.credentials()
+}
+
+// LoadAll loads the complete configuration, including all the contexts, ignoring the context
+// selected with the '--context' flag.
+func LoadAll() (*Config, error) {
+	if keyring, ok := IsKeyringManaged(); ok {
+		return loadFromOS(keyring)
+	}
+
+	return loadFromFile()
+}
+
+// UseContext makes the context with the given name the active one.
+func UseContext(name string) error {
+	cfg, err := LoadAll()
+	if err != nil {
+		return err
+	}
+	if cfg == nil {
+		return fmt.Errorf("context '%s' doesn't exist", name)
+	}
+	ctx := cfg.Context(name)
+	if ctx == nil {
+		return fmt.Errorf("context '%s' doesn't exist", name)
+	}
+	cfg.syncActiveContext()
+	cfg.setCredentials(ctx)
+	cfg.CurrentContext = name
+	cfg.syncActiveContext()
+	return saveAll(cfg)
+}
+
+// DeleteContext removes the context with the given name. The active context can't be deleted, use
+// the 'logout' command instead.
+func DeleteContext(name string) error {
+	cfg, err := LoadAll()
+	if err != nil {
+		return err
+	}
+	if cfg == nil || cfg.Context(name) == nil {
+		return fmt.Errorf("context '%s' doesn't exist", name)
+	}
+	if name == cfg.ActiveContext() && !IsNotValid(cfg) {
+		return fmt.Errorf("context '%s' is the active context, switch to another context "+
+			"or log out instead", name)
+	}
+	delete(cfg.Contexts, name)
+	return saveAll(cfg)
+}
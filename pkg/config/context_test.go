@@ -0,0 +1,138 @@
+package config
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Contexts", Ordered, func() {
+	contextConfig := func(url string) *Config {
+		return &Config{
+			AccessToken: "access-" + url,
+			ClientID:    "cloud-services",
+			TokenURL:    "https://sso.example.com/token",
+			URL:         url,
+		}
+	}
+
+	BeforeAll(func() {
+		tmpdir, err := os.MkdirTemp("/tmp", ".ocm-config-*")
+		Expect(err).NotTo(HaveOccurred())
+		os.Setenv("OCM_CONFIG", tmpdir+"/ocm_config.json")
+	})
+
+	AfterAll(func() {
+		os.Setenv("OCM_CONFIG", "")
+	})
+
+	AfterEach(func() {
+		SetSelectedContext("")
+	})
+
+	It("Names the first login after the selected context", func() {
+		SetSelectedContext("production")
+		Expect(Save(contextConfig("https://api.openshift.com"))).To(Succeed())
+
+		cfg, err := LoadAll()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.CurrentContext).To(Equal("production"))
+		Expect(cfg.URL).To(Equal("https://api.openshift.com"))
+		Expect(cfg.ContextNames()).To(Equal([]string{"production"}))
+	})
+
+	It("Stores a login to another context side by side", func() {
+		SetSelectedContext("staging")
+		Expect(Save(contextConfig("https://api.stage.openshift.com"))).To(Succeed())
+
+		cfg, err := Load()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.URL).To(Equal("https://api.stage.openshift.com"))
+
+		SetSelectedContext("")
+		cfg, err = Load()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.URL).To(Equal("https://api.openshift.com"))
+		Expect(cfg.ContextNames()).To(Equal([]string{"production", "staging"}))
+	})
+
+	It("Returns nil for a context that doesn't exist", func() {
+		SetSelectedContext("integration")
+		cfg, err := Load()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg).To(BeNil())
+	})
+
+	It("Persists tokens into the selected context", func() {
+		SetSelectedContext("staging")
+		Expect(PersistTokens(nil, "new-access", "new-refresh")).To(Succeed())
+
+		cfg, err := LoadAll()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.AccessToken).To(Equal("access-https://api.openshift.com"))
+		Expect(cfg.Context("staging").AccessToken).To(Equal("new-access"))
+		Expect(cfg.Context("staging").RefreshToken).To(Equal("new-refresh"))
+	})
+
+	It("Switches the active context", func() {
+		Expect(UseContext("staging")).To(Succeed())
+
+		cfg, err := LoadAll()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.ActiveContext()).To(Equal("staging"))
+		Expect(cfg.URL).To(Equal("https://api.stage.openshift.com"))
+		Expect(cfg.Context("production").URL).To(Equal("https://api.openshift.com"))
+	})
+
+	It("Fails to switch to a context that doesn't exist", func() {
+		err := UseContext("integration")
+		Expect(err).To(MatchError("context 'integration' doesn't exist"))
+	})
+
+	It("Doesn't delete the active context", func() {
+		err := DeleteContext("staging")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("is the active context"))
+	})
+
+	It("Deletes another context", func() {
+		Expect(DeleteContext("production")).To(Succeed())
+
+		cfg, err := LoadAll()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.ContextNames()).To(Equal([]string{"staging"}))
+	})
+
+	It("Keeps other contexts when logging out of the active one", func() {
+		SetSelectedContext("production")
+		Expect(Save(contextConfig("https://api.openshift.com"))).To(Succeed())
+		SetSelectedContext("")
+
+		Expect(Remove()).To(Succeed())
+
+		cfg, err := LoadAll()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(IsNotValid(cfg)).To(BeTrue())
+		Expect(cfg.ContextNames()).To(Equal([]string{"production"}))
+	})
+
+	It("Names a legacy login 'default' when switching away from it", func() {
+		Expect(Remove()).To(Succeed())
+		Expect(UseContext("production")).To(Succeed())
+		Expect(Remove()).To(Succeed())
+		_, err := os.Stat(os.Getenv("OCM_CONFIG"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+
+		Expect(Save(contextConfig("https://api.legacy.example.com"))).To(Succeed())
+		SetSelectedContext("staging")
+		Expect(Save(contextConfig("https://api.stage.openshift.com"))).To(Succeed())
+		SetSelectedContext("")
+		Expect(UseContext("staging")).To(Succeed())
+
+		cfg, err := LoadAll()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.ContextNames()).To(Equal([]string{DefaultContext, "staging"}))
+		Expect(cfg.Context(DefaultContext).URL).To(Equal("https://api.legacy.example.com"))
+	})
+})
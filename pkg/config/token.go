@@ -87,3 +87,30 @@ func getTokenExpiry(token *jwt.Token, now time.Time) (expires bool, left time.Du
 	}
 	return
 }
+
+// TokenExpiry returns the time when the configuration stops being usable to authenticate: the
+// expiry of the refresh token, or of the access token when there is no refresh token. The zero time
+// means that the credentials don't expire, or that the expiry can't be known because the token is
+// encrypted.
+func (c *Config) TokenExpiry() (time.Time, error) {
+	if c.ClientID != "" && c.ClientSecret != "" {
+		return time.Time{}, nil
+	}
+	textToken := c.RefreshToken
+	if textToken == "" {
+		textToken = c.AccessToken
+	}
+	if textToken == "" || IsEncryptedToken(textToken) {
+		return time.Time{}, nil
+	}
+	token, err := ParseToken(textToken)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Failed to parse token: %v", err)
+	}
+	now := time.Now()
+	expires, left, err := getTokenExpiry(token, now)
+	if err != nil || !expires {
+		return time.Time{}, err
+	}
+	return now.Add(left), nil
+}
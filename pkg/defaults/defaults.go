@@ -0,0 +1,379 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package defaults contains the defaults profile used to pre-fill the flags of the 'create cluster'
+// and 'create machinepool' commands. Defaults are read from a per-user '~/.rosa.yaml' file and from the nearest
+// '.rosa.yaml' file found walking up from the working directory, which takes precedence:
+//
+//	defaults:
+//	  region: us-east-2
+//	  channel-group: stable
+//	  operator-roles-prefix: payments
+//	  tags:
+//	    team: payments
+//
+// The keys are flag names. Flags given in the command line always win over the defaults. The
+// flags that set the number of nodes are taken as a group: when any of them is given in the
+// command line none of them takes its default, as fixed replicas and autoscaling can't be mixed.
+package defaults
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift/rosa/pkg/clierror"
+	"github.com/openshift/rosa/pkg/reporter"
+)
+
+// FileName is the name of the defaults files.
+const FileName = ".rosa.yaml"
+
+const (
+	SourceUser    = "user"
+	SourceProject = "project"
+)
+
+// reservedFlags can't be given a default value, as they would skip confirmations or hide the
+// help of the commands.
+var reservedFlags = []string{"help", "yes"}
+
+// ScalingFlags are the flags that set the number of nodes of clusters and machine pools. Fixed
+// replicas and autoscaling are mutually exclusive, so these flags are only set as a whole.
+var ScalingFlags = []string{"replicas", "compute-nodes", "enable-autoscaling", "min-replicas", "max-replicas"}
+
+// flagGroups are the groups of flags whose defaults are only applied when none of the flags of
+// the group was given in the command line.
+var flagGroups = [][]string{ScalingFlags}
+
+// Value is the default value of a flag and the file where it was defined.
+type Value struct {
+	Flag   string `json:"flag"`
+	Value  string `json:"value"`
+	Source string `json:"source"`
+	Path   string `json:"path"`
+}
+
+// Defaults contains the defaults loaded from the user and project files.
+type Defaults struct {
+	values map[string]*Value
+}
+
+// applied contains the flags whose value was set from the defaults. They aren't marked as changed,
+// so that the commands can still tell the flags given in the command line.
+var applied = map[*pflag.Flag]*Value{}
+
+type file struct {
+	Defaults map[string]interface{} `json:"defaults"`
+}
+
+// Load reads the defaults of the current user and of the project containing the working
+// directory.
+func Load() (*Defaults, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		home = ""
+	}
+	return LoadFrom(dir, home)
+}
+
+// LoadFrom reads the defaults file of the given home directory and the nearest defaults file
+// found walking up from the given directory.
+func LoadFrom(dir string, home string) (*Defaults, error) {
+	result := &Defaults{values: map[string]*Value{}}
+
+	userPath := ""
+	if home != "" {
+		userPath = filepath.Join(home, FileName)
+		err := result.read(userPath, SourceUser)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	projectPath, err := findProjectFile(dir)
+	if err != nil {
+		return nil, err
+	}
+	if projectPath != "" && projectPath != userPath {
+		err = result.read(projectPath, SourceProject)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// findProjectFile returns the path of the nearest defaults file in the given directory or any
+// of its parents, or an empty string if there is none.
+func findProjectFile(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for {
+		path := filepath.Join(dir, FileName)
+		info, err := os.Stat(path)
+		if err == nil && !info.IsDir() {
+			return path, nil
+		}
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// read loads the given file, if it exists, overriding the defaults loaded before.
+func (d *Defaults) read(path string, source string) error {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read defaults file '%s': %v", path, err)
+	}
+	data := &file{}
+	err = yaml.UnmarshalStrict(content, data)
+	if err != nil {
+		return fmt.Errorf("failed to parse defaults file '%s': %v", path, err)
+	}
+	for flag, value := range data.Defaults {
+		if slices.Contains(reservedFlags, flag) {
+			return fmt.Errorf("invalid defaults file '%s': flag '%s' can't have a default value", path, flag)
+		}
+		text, err := formatValue(value)
+		if err != nil {
+			return fmt.Errorf("invalid defaults file '%s': flag '%s': %v", path, flag, err)
+		}
+		d.values[flag] = &Value{
+			Flag:   flag,
+			Value:  text,
+			Source: source,
+			Path:   path,
+		}
+	}
+	return nil
+}
+
+// formatValue converts a value of the file into the text accepted by the flag. Lists are
+// joined with commas and maps, used for tags, are converted into comma separated 'key:value'
+// pairs.
+func formatValue(value interface{}) (string, error) {
+	switch typed := value.(type) {
+	case []interface{}:
+		items := make([]string, len(typed))
+		for i, item := range typed {
+			text, err := formatScalar(item)
+			if err != nil {
+				return "", err
+			}
+			items[i] = text
+		}
+		return strings.Join(items, ","), nil
+	case map[string]interface{}:
+		items := make([]string, 0, len(typed))
+		for _, key := range slices.Sorted(maps.Keys(typed)) {
+			text, err := formatScalar(typed[key])
+			if err != nil {
+				return "", err
+			}
+			items = append(items, fmt.Sprintf("%s:%s", key, text))
+		}
+		return strings.Join(items, ","), nil
+	default:
+		return formatScalar(value)
+	}
+}
+
+func formatScalar(value interface{}) (string, error) {
+	switch typed := value.(type) {
+	case string:
+		return typed, nil
+	case bool:
+		return strconv.FormatBool(typed), nil
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64), nil
+	case nil:
+		return "", nil
+	default:
+		return "", fmt.Errorf("unsupported value '%v'", value)
+	}
+}
+
+// List returns the defaults sorted by flag name.
+func (d *Defaults) List() []*Value {
+	result := make([]*Value, 0, len(d.values))
+	for _, flag := range slices.Sorted(maps.Keys(d.values)) {
+		result = append(result, d.values[flag])
+	}
+	return result
+}
+
+// Apply sets the value of the flags that haven't been given in the command line and that have
+// a default. The flags aren't marked as changed, use Changed to check if a flag was given in the
+// command line or set from the defaults. The flags of a group that has any flag given in the
+// command line keep their value. It returns the defaults that were applied.
+func (d *Defaults) Apply(flags *pflag.FlagSet) ([]*Value, error) {
+	skipped := map[string]bool{}
+	for _, group := range flagGroups {
+		if AnySet(flags, group) {
+			for _, name := range group {
+				skipped[name] = true
+			}
+		}
+	}
+	result := []*Value{}
+	for _, value := range d.List() {
+		flag := flags.Lookup(value.Flag)
+		if flag == nil || flag.Changed || skipped[value.Flag] {
+			continue
+		}
+		err := flag.Value.Set(value.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid default value '%s' for flag '%s' in '%s': %v",
+				value.Value, value.Flag, value.Path, err)
+		}
+		applied[flag] = value
+		result = append(result, value)
+	}
+	return result, nil
+}
+
+// IsDefault returns true when the value of the flag comes from the defaults instead of the
+// command line.
+func IsDefault(flag *pflag.Flag) bool {
+	_, ok := applied[flag]
+	return ok && !flag.Changed
+}
+
+// Changed returns true when the flag was given in the command line or set from the defaults.
+func Changed(flags *pflag.FlagSet, name string) bool {
+	flag := flags.Lookup(name)
+	return flag != nil && (flag.Changed || IsDefault(flag))
+}
+
+// CopyDefault sets a flag from the same default as another flag, for the commands that copy their
+// flags to other commands.
+func CopyDefault(from *pflag.Flag, to *pflag.Flag) error {
+	value, ok := applied[from]
+	if !ok || from.Changed {
+		return nil
+	}
+	err := to.Value.Set(value.Value)
+	if err != nil {
+		return err
+	}
+	applied[to] = value
+	return nil
+}
+
+// AnySet returns true when any of the given flags was given in the command line.
+func AnySet(flags *pflag.FlagSet, names []string) bool {
+	for _, name := range names {
+		if flags.Changed(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reset restores the original value of the given flags that were set from the defaults, so that
+// another source, like a spec file, can set the whole group without mixing it with the defaults.
+func Reset(flags *pflag.FlagSet, names []string) error {
+	for _, name := range names {
+		flag := flags.Lookup(name)
+		if flag == nil || !IsDefault(flag) {
+			continue
+		}
+		var err error
+		if slice, ok := flag.Value.(pflag.SliceValue); ok {
+			err = slice.Replace([]string{})
+		} else {
+			err = flag.Value.Set(flag.DefValue)
+		}
+		if err != nil {
+			return err
+		}
+		delete(applied, flag)
+	}
+	return nil
+}
+
+// PrepareScaling prepares the values that another source, like a spec file, is going to set in the
+// flags, so that the scaling flags are only taken from one place. When any of them was given in
+// the command line the scaling values are removed, otherwise when the values contain scaling flags
+// the scaling defaults are reset.
+func PrepareScaling(flags *pflag.FlagSet, values map[string]string) error {
+	if AnySet(flags, ScalingFlags) {
+		for _, name := range ScalingFlags {
+			delete(values, name)
+		}
+		return nil
+	}
+	for _, name := range ScalingFlags {
+		if _, ok := values[name]; ok {
+			return Reset(flags, ScalingFlags)
+		}
+	}
+	return nil
+}
+
+// ApplyToCommands makes the given commands pre-fill their flags with the defaults before they
+// run. Only the commands that create clusters and machine pools are meant to take defaults.
+func ApplyToCommands(cmds ...*cobra.Command) {
+	for _, cmd := range cmds {
+		if cmd.Run == nil {
+			continue
+		}
+		currentRun := cmd.Run
+		cmd.Run = func(c *cobra.Command, args []string) {
+			r := reporter.CreateReporter()
+			defaults, err := Load()
+			if err == nil {
+				var values []*Value
+				values, err = defaults.Apply(c.Flags())
+				for _, value := range values {
+					r.Debugf("Using default value '%s' for flag '%s' from '%s'", value.Value, value.Flag, value.Path)
+				}
+			}
+			if err != nil {
+				_ = r.Errorf("%v", err)
+				os.Exit(clierror.ExitCode(err))
+			}
+			currentRun(c, args)
+		}
+	}
+}
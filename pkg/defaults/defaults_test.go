@@ -0,0 +1,173 @@
+package defaults
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+)
+
+var _ = Describe("Defaults", func() {
+	var home, project, workdir string
+
+	write := func(dir string, content string) string {
+		path := filepath.Join(dir, FileName)
+		Expect(os.WriteFile(path, []byte(content), 0600)).To(Succeed())
+		return path
+	}
+
+	BeforeEach(func() {
+		root := GinkgoT().TempDir()
+		home = filepath.Join(root, "home")
+		project = filepath.Join(root, "src", "project")
+		workdir = filepath.Join(project, "clusters", "prod")
+		Expect(os.MkdirAll(home, 0700)).To(Succeed())
+		Expect(os.MkdirAll(workdir, 0700)).To(Succeed())
+	})
+
+	It("Loads nothing when there are no files", func() {
+		values, err := LoadFrom(workdir, home)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(values.List()).To(BeEmpty())
+	})
+
+	It("Lets the nearest project file override the user file", func() {
+		userPath := write(home, "defaults:\n  region: us-east-1\n  channel-group: stable\n")
+		projectPath := write(project, "defaults:\n  region: eu-west-1\n")
+
+		values, err := LoadFrom(workdir, home)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(values.List()).To(Equal([]*Value{
+			{Flag: "channel-group", Value: "stable", Source: SourceUser, Path: userPath},
+			{Flag: "region", Value: "eu-west-1", Source: SourceProject, Path: projectPath},
+		}))
+	})
+
+	It("Doesn't load the user file twice when the project is inside the home directory", func() {
+		userPath := write(home, "defaults:\n  region: us-east-1\n")
+
+		values, err := LoadFrom(home, home)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(values.List()).To(Equal([]*Value{
+			{Flag: "region", Value: "us-east-1", Source: SourceUser, Path: userPath},
+		}))
+	})
+
+	It("Converts lists, maps, numbers and booleans into flag values", func() {
+		write(project, `
+defaults:
+  tags:
+    team: payments
+    env: prod
+  availability-zones: [us-east-1a, us-east-1b]
+  compute-nodes: 3
+  multi-az: true
+`)
+		values, err := LoadFrom(workdir, "")
+		Expect(err).NotTo(HaveOccurred())
+		texts := map[string]string{}
+		for _, value := range values.List() {
+			texts[value.Flag] = value.Value
+		}
+		Expect(texts).To(Equal(map[string]string{
+			"tags":               "env:prod,team:payments",
+			"availability-zones": "us-east-1a,us-east-1b",
+			"compute-nodes":      "3",
+			"multi-az":           "true",
+		}))
+	})
+
+	DescribeTable("Rejects invalid files",
+		func(content string, message string) {
+			write(project, content)
+			_, err := LoadFrom(workdir, "")
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("unknown section", "region: us-east-1\n", "failed to parse defaults file"),
+		Entry("reserved flag", "defaults:\n  'yes': true\n", "flag 'yes' can't have a default value"),
+		Entry("nested list", "defaults:\n  tags: [[a]]\n", "unsupported value"),
+	)
+
+	It("Only applies the defaults of the flags that weren't given", func() {
+		write(project, "defaults:\n  region: eu-west-1\n  tags: {team: payments}\n  unknown: x\n")
+		values, err := LoadFrom(workdir, "")
+		Expect(err).NotTo(HaveOccurred())
+
+		flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		region := flags.String("region", "", "")
+		channelGroup := flags.String("channel-group", "stable", "")
+		tags := flags.StringSlice("tags", nil, "")
+		Expect(flags.Parse([]string{"--region", "us-east-2"})).To(Succeed())
+
+		applied, err := values.Apply(flags)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applied).To(HaveLen(1))
+		Expect(applied[0].Flag).To(Equal("tags"))
+		Expect(*region).To(Equal("us-east-2"))
+		Expect(*channelGroup).To(Equal("stable"))
+		Expect(*tags).To(Equal([]string{"team:payments"}))
+		Expect(flags.Changed("tags")).To(BeFalse())
+		Expect(Changed(flags, "tags")).To(BeTrue())
+		Expect(Changed(flags, "channel-group")).To(BeFalse())
+		Expect(IsDefault(flags.Lookup("tags"))).To(BeTrue())
+		Expect(IsDefault(flags.Lookup("region"))).To(BeFalse())
+	})
+
+	It("Doesn't apply default replicas when autoscaling is given in the command line", func() {
+		write(project, "defaults:\n  replicas: 5\n  max-replicas: 9\n  region: eu-west-1\n")
+		values, err := LoadFrom(workdir, "")
+		Expect(err).NotTo(HaveOccurred())
+
+		flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		replicas := flags.Int("replicas", 2, "")
+		flags.Bool("enable-autoscaling", false, "")
+		flags.Int("min-replicas", 2, "")
+		maxReplicas := flags.Int("max-replicas", 2, "")
+		flags.String("region", "", "")
+		Expect(flags.Parse([]string{"--enable-autoscaling", "--min-replicas", "3"})).To(Succeed())
+
+		applied, err := values.Apply(flags)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applied).To(HaveLen(1))
+		Expect(applied[0].Flag).To(Equal("region"))
+		Expect(*replicas).To(Equal(2))
+		Expect(*maxReplicas).To(Equal(2))
+		Expect(flags.Changed("replicas")).To(BeFalse())
+		Expect(flags.Changed("max-replicas")).To(BeFalse())
+	})
+
+	It("Resets the flags set from the defaults", func() {
+		write(project, "defaults:\n  replicas: 5\n  tags: {team: payments}\n")
+		values, err := LoadFrom(workdir, "")
+		Expect(err).NotTo(HaveOccurred())
+
+		flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		replicas := flags.Int("replicas", 2, "")
+		tags := flags.StringSlice("tags", nil, "")
+		region := flags.String("region", "", "")
+		Expect(flags.Parse([]string{"--region", "us-east-2"})).To(Succeed())
+		_, err = values.Apply(flags)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(Reset(flags, []string{"replicas", "tags", "region"})).To(Succeed())
+		Expect(*replicas).To(Equal(2))
+		Expect(*tags).To(BeEmpty())
+		Expect(*region).To(Equal("us-east-2"))
+		Expect(Changed(flags, "replicas")).To(BeFalse())
+		Expect(IsDefault(flags.Lookup("replicas"))).To(BeFalse())
+		Expect(flags.Changed("region")).To(BeTrue())
+	})
+
+	It("Fails to apply a value the flag doesn't accept", func() {
+		write(project, "defaults:\n  compute-nodes: many\n")
+		values, err := LoadFrom(workdir, "")
+		Expect(err).NotTo(HaveOccurred())
+
+		flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		flags.Int("compute-nodes", 2, "")
+		_, err = values.Apply(flags)
+		Expect(err).To(MatchError(ContainSubstring("invalid default value 'many' for flag 'compute-nodes'")))
+	})
+})
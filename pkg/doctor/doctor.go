@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package doctor runs the checks of 'rosa doctor', which verify that the environment and the
+// accounts are ready to create clusters.
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Status is the outcome of a check.
+type Status string
+
+const (
+	StatusPass Status = "pass"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+
+	// StatusSkip is used for the checks that can't run because a check they depend on failed.
+	StatusSkip Status = "skip"
+)
+
+// Result is the outcome of a check, with the command that fixes the problem when it didn't pass.
+type Result struct {
+	Check       string `json:"check"`
+	Status      Status `json:"status"`
+	Message     string `json:"message"`
+	Remediation string `json:"remediation,omitempty"`
+}
+
+// Check is one of the verifications. The name of the result is filled with the name of the check.
+type Check struct {
+	Name string
+	Run  func(ctx context.Context) Result
+}
+
+func Pass(format string, args ...interface{}) Result {
+	return Result{Status: StatusPass, Message: fmt.Sprintf(format, args...)}
+}
+
+func Warn(remediation string, format string, args ...interface{}) Result {
+	return Result{Status: StatusWarn, Message: fmt.Sprintf(format, args...), Remediation: remediation}
+}
+
+func Fail(remediation string, format string, args ...interface{}) Result {
+	return Result{Status: StatusFail, Message: fmt.Sprintf(format, args...), Remediation: remediation}
+}
+
+func Skip(format string, args ...interface{}) Result {
+	return Result{Status: StatusSkip, Message: fmt.Sprintf(format, args...)}
+}
+
+// Run runs the checks concurrently and returns their results in the order of the checks.
+func Run(ctx context.Context, checks []Check) []Result {
+	results := make([]Result, len(checks))
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = check.Run(ctx)
+			results[i].Check = check.Name
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// Count returns the number of results with the given status.
+func Count(results []Result, status Status) int {
+	count := 0
+	for _, result := range results {
+		if result.Status == status {
+			count++
+		}
+	}
+	return count
+}
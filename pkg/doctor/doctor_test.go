@@ -0,0 +1,39 @@
+package doctor_test
+
+import (
+	"context"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift/rosa/pkg/doctor"
+)
+
+var _ = Describe("Run", func() {
+	It("runs the checks concurrently and keeps their order", func() {
+		// Every check waits for all the others to start, so this only finishes when they run
+		// concurrently:
+		var started sync.WaitGroup
+		started.Add(3)
+		check := func(result doctor.Result) func(context.Context) doctor.Result {
+			return func(context.Context) doctor.Result {
+				started.Done()
+				started.Wait()
+				return result
+			}
+		}
+		results := doctor.Run(context.Background(), []doctor.Check{
+			{Name: "first", Run: check(doctor.Pass("ok"))},
+			{Name: "second", Run: check(doctor.Warn("rosa download oc", "oc is %s", "missing"))},
+			{Name: "third", Run: check(doctor.Fail("rosa login", "not logged in"))},
+		})
+		Expect(results).To(Equal([]doctor.Result{
+			{Check: "first", Status: doctor.StatusPass, Message: "ok"},
+			{Check: "second", Status: doctor.StatusWarn, Message: "oc is missing", Remediation: "rosa download oc"},
+			{Check: "third", Status: doctor.StatusFail, Message: "not logged in", Remediation: "rosa login"},
+		}))
+		Expect(doctor.Count(results, doctor.StatusFail)).To(Equal(1))
+		Expect(doctor.Count(results, doctor.StatusSkip)).To(Equal(0))
+	})
+})
@@ -0,0 +1,318 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package estimate estimates the OCM and AWS quota that new clusters and machine pools use, so that
+// requests that would go over the quota fail before anything is created.
+package estimate
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	amsv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+const (
+	// DefaultMasterInstanceType and DefaultInfraInstanceType are the instance types used for the
+	// control plane and infrastructure nodes of classic clusters when none is given.
+	DefaultMasterInstanceType = "m5.2xlarge"
+	DefaultInfraInstanceType  = "r5.xlarge"
+
+	// Sizes in GiB of the root volumes of the nodes, when they aren't explicitly set.
+	MasterRootDiskSize  = 350
+	InfraRootDiskSize   = 300
+	DefaultRootDiskSize = 300
+
+	masterNodes = 3
+)
+
+// Pool is a group of nodes of the same instance type created by the request.
+type Pool struct {
+	Name         string `json:"name"`
+	InstanceType string `json:"instance_type"`
+	MinReplicas  int    `json:"min_replicas"`
+	MaxReplicas  int    `json:"max_replicas"`
+	RootDiskSize int    `json:"root_disk_size"`
+}
+
+// Request describes the nodes and the load balancers that creating a cluster or a machine pool adds
+// to the AWS account.
+type Request struct {
+	Pools                []*Pool
+	NetworkLoadBalancers int
+	ClassicLoadBalancers int
+}
+
+// ClusterRequest returns the request of a new cluster with the given compute pool. Classic clusters
+// also run the control plane and infrastructure nodes, and the load balancers of the API and the
+// default ingress, in the account. Hosted control plane clusters only add the load balancer of the
+// default ingress.
+func ClusterRequest(hostedCP bool, multiAZ bool, compute *Pool, masterType string, infraType string) *Request {
+	if hostedCP {
+		return &Request{Pools: []*Pool{compute}, NetworkLoadBalancers: 1}
+	}
+	if masterType == "" {
+		masterType = DefaultMasterInstanceType
+	}
+	if infraType == "" {
+		infraType = DefaultInfraInstanceType
+	}
+	infraNodes := 2
+	if multiAZ {
+		infraNodes = 3
+	}
+	return &Request{
+		Pools: []*Pool{
+			{
+				Name:         "master",
+				InstanceType: masterType,
+				MinReplicas:  masterNodes,
+				MaxReplicas:  masterNodes,
+				RootDiskSize: MasterRootDiskSize,
+			},
+			{
+				Name:         "infra",
+				InstanceType: infraType,
+				MinReplicas:  infraNodes,
+				MaxReplicas:  infraNodes,
+				RootDiskSize: InfraRootDiskSize,
+			},
+			compute,
+		},
+		NetworkLoadBalancers: 2,
+		ClassicLoadBalancers: 1,
+	}
+}
+
+// MachinePoolRequest returns the request of a new machine pool of a classic cluster.
+func MachinePoolRequest(machinePool *cmv1.MachinePool, defaultRootDiskSize int) *Request {
+	pool := &Pool{
+		Name:         machinePool.ID(),
+		InstanceType: machinePool.InstanceType(),
+		MinReplicas:  machinePool.Replicas(),
+		MaxReplicas:  machinePool.Replicas(),
+		RootDiskSize: machinePool.RootVolume().AWS().Size(),
+	}
+	if autoscaling, ok := machinePool.GetAutoscaling(); ok {
+		pool.MinReplicas = autoscaling.MinReplicas()
+		pool.MaxReplicas = autoscaling.MaxReplicas()
+	}
+	if pool.RootDiskSize == 0 {
+		pool.RootDiskSize = defaultRootDiskSize
+	}
+	return &Request{Pools: []*Pool{pool}}
+}
+
+// NodePoolRequest returns the request of a new machine pool of a hosted control plane cluster.
+func NodePoolRequest(nodePool *cmv1.NodePool) *Request {
+	pool := &Pool{
+		Name:         nodePool.ID(),
+		InstanceType: nodePool.AWSNodePool().InstanceType(),
+		MinReplicas:  nodePool.Replicas(),
+		MaxReplicas:  nodePool.Replicas(),
+		RootDiskSize: nodePool.AWSNodePool().RootVolume().Size(),
+	}
+	if autoscaling, ok := nodePool.GetAutoscaling(); ok {
+		pool.MinReplicas = autoscaling.MinReplica()
+		pool.MaxReplicas = autoscaling.MaxReplica()
+	}
+	if pool.RootDiskSize == 0 {
+		pool.RootDiskSize = DefaultRootDiskSize
+	}
+	return &Request{Pools: []*Pool{pool}}
+}
+
+// Status is the result of comparing what a request needs of a quota with what is available.
+type Status string
+
+const (
+	StatusOK       Status = "ok"
+	StatusExceeded Status = "exceeded"
+	StatusUnknown  Status = "unknown"
+)
+
+// QuotaCheck compares what the request needs of a quota with what is still available of it.
+type QuotaCheck struct {
+	Source    string  `json:"source"`
+	Quota     string  `json:"quota"`
+	Required  float64 `json:"required"`
+	Available float64 `json:"available"`
+	Status    Status  `json:"status"`
+	Message   string  `json:"message,omitempty"`
+}
+
+func newQuotaCheck(source string, quota string, required float64, available float64) *QuotaCheck {
+	check := &QuotaCheck{
+		Source:    source,
+		Quota:     quota,
+		Required:  required,
+		Available: available,
+		Status:    StatusOK,
+	}
+	if required > available {
+		check.Status = StatusExceeded
+	}
+	return check
+}
+
+func unknownQuotaCheck(source string, quota string, required float64, err error) *QuotaCheck {
+	return &QuotaCheck{
+		Source:   source,
+		Quota:    quota,
+		Required: required,
+		Status:   StatusUnknown,
+		Message:  err.Error(),
+	}
+}
+
+// PoolEstimate is a pool of the request together with the vCPUs of its instance type.
+type PoolEstimate struct {
+	*Pool
+	VCPUs int `json:"vcpus"`
+}
+
+// MaxVCPUs returns the vCPUs used by the pool when it has its maximum number of nodes.
+func (p *PoolEstimate) MaxVCPUs() int {
+	return p.MaxReplicas * p.VCPUs
+}
+
+// Result is the estimation of the quota used by a request.
+type Result struct {
+	Pools  []*PoolEstimate `json:"pools"`
+	Checks []*QuotaCheck   `json:"quotas"`
+}
+
+// Exceeded returns the checks of the quotas that the request would go over.
+func (r *Result) Exceeded() []*QuotaCheck {
+	exceeded := []*QuotaCheck{}
+	for _, check := range r.Checks {
+		if check.Status == StatusExceeded {
+			exceeded = append(exceeded, check)
+		}
+	}
+	return exceeded
+}
+
+// Compute estimates the quota used by the request. The vCPUs of the instance types are taken from
+// the machine types, and autoscaling pools are counted with their maximum number of nodes. Quotas
+// whose available value can't be obtained are reported with the unknown status instead of failing.
+func Compute(request *Request, machineTypes *ocm.MachineTypeList, quotaCosts *amsv1.QuotaCostList,
+	awsClient aws.Client) *Result {
+	result := &Result{}
+	nodes := map[string]int{}
+	for _, pool := range request.Pools {
+		estimate := &PoolEstimate{Pool: pool}
+		if machineType := machineTypes.Find(pool.InstanceType); machineType != nil {
+			estimate.VCPUs = int(machineType.MachineType.CPU().Value())
+		}
+		result.Pools = append(result.Pools, estimate)
+		nodes[pool.InstanceType] += pool.MaxReplicas
+	}
+
+	for _, requirement := range machineTypes.RequiredQuota(quotaCosts, nodes) {
+		result.Checks = append(result.Checks, newQuotaCheck("OCM", requirement.QuotaID,
+			float64(requirement.Required), float64(requirement.Available)))
+	}
+	result.Checks = append(result.Checks, awsQuotaChecks(request, result.Pools, awsClient)...)
+	return result
+}
+
+func awsQuotaChecks(request *Request, pools []*PoolEstimate, awsClient aws.Client) []*QuotaCheck {
+	type requirement struct {
+		serviceCode string
+		quotaCode   string
+		required    float64
+		used        func(*aws.QuotaUsage) float64
+	}
+	requirements := []*requirement{}
+
+	vcpus := map[string]*requirement{}
+	storage := 0
+	for _, pool := range pools {
+		storage += pool.MaxReplicas * pool.RootDiskSize
+		quotaCode := aws.InstancesQuotaCode(pool.InstanceType)
+		if quotaCode == "" || pool.VCPUs == 0 {
+			continue
+		}
+		if _, ok := vcpus[quotaCode]; !ok {
+			vcpus[quotaCode] = &requirement{
+				serviceCode: aws.EC2ServiceCode,
+				quotaCode:   quotaCode,
+				used:        func(usage *aws.QuotaUsage) float64 { return usage.VCPUs[quotaCode] },
+			}
+			requirements = append(requirements, vcpus[quotaCode])
+		}
+		vcpus[quotaCode].required += float64(pool.MaxVCPUs())
+	}
+	slices.SortFunc(requirements, func(a, b *requirement) int { return strings.Compare(a.quotaCode, b.quotaCode) })
+
+	if storage > 0 {
+		requirements = append(requirements, &requirement{
+			serviceCode: aws.EBSServiceCode,
+			quotaCode:   aws.GP3StorageQuotaCode,
+			required:    float64(storage) / 1024,
+			used:        func(usage *aws.QuotaUsage) float64 { return usage.VolumeStorage["gp3"] / 1024 },
+		})
+	}
+	if request.NetworkLoadBalancers > 0 {
+		requirements = append(requirements, &requirement{
+			serviceCode: aws.ELBServiceCode,
+			quotaCode:   aws.NetworkLoadBalancersQuotaCode,
+			required:    float64(request.NetworkLoadBalancers),
+			used: func(usage *aws.QuotaUsage) float64 {
+				return float64(usage.LoadBalancers[aws.NetworkLoadBalancer])
+			},
+		})
+	}
+	if request.ClassicLoadBalancers > 0 {
+		requirements = append(requirements, &requirement{
+			serviceCode: aws.ELBServiceCode,
+			quotaCode:   aws.ClassicLoadBalancersQuotaCode,
+			required:    float64(request.ClassicLoadBalancers),
+			used: func(usage *aws.QuotaUsage) float64 {
+				return float64(usage.LoadBalancers[aws.ClassicLoadBalancer])
+			},
+		})
+	}
+	if len(requirements) == 0 {
+		return nil
+	}
+
+	checks := []*QuotaCheck{}
+	usage, usageErr := awsClient.GetQuotaUsage()
+	for _, requirement := range requirements {
+		name := aws.QuotaNames[requirement.quotaCode]
+		if usageErr != nil {
+			checks = append(checks, unknownQuotaCheck("AWS", name, requirement.required,
+				fmt.Errorf("failed to get usage: %v", usageErr)))
+			continue
+		}
+		value, err := awsClient.GetServiceQuotaValue(requirement.serviceCode, requirement.quotaCode)
+		if err != nil {
+			checks = append(checks, unknownQuotaCheck("AWS", name, requirement.required,
+				fmt.Errorf("failed to get quota value: %v", err)))
+			continue
+		}
+		checks = append(checks, newQuotaCheck("AWS", name, requirement.required,
+			value-requirement.used(usage)))
+	}
+	return checks
+}
@@ -0,0 +1,174 @@
+package estimate
+
+import (
+	"bytes"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	amsv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+func buildMachineTypes(vcpus map[string]float64) *ocm.MachineTypeList {
+	list := &ocm.MachineTypeList{}
+	for id, cpu := range vcpus {
+		machineType, err := cmv1.NewMachineType().ID(id).GenericName(id + "-generic").
+			CPU(cmv1.NewValue().Value(cpu).Unit("vCPU")).Build()
+		Expect(err).NotTo(HaveOccurred())
+		list.Items = append(list.Items, &ocm.MachineType{MachineType: machineType, Available: true})
+	}
+	return list
+}
+
+func buildQuotaCosts(quotaID string, resourceName string, cost int, allowed int, consumed int) *amsv1.QuotaCostList {
+	list, err := amsv1.NewQuotaCostList().Items(
+		amsv1.NewQuotaCost().QuotaID(quotaID).Allowed(allowed).Consumed(consumed).RelatedResources(
+			amsv1.NewRelatedResource().ResourceName(resourceName).Cost(cost).Product("any").
+				CloudProvider("aws").BYOC("byoc"),
+		),
+	).Build()
+	Expect(err).NotTo(HaveOccurred())
+	return list
+}
+
+var _ = Describe("Estimate", func() {
+	var ctrl *gomock.Controller
+	var awsClient *aws.MockClient
+	var machineTypes *ocm.MachineTypeList
+	var noQuotaCosts *amsv1.QuotaCostList
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		awsClient = aws.NewMockClient(ctrl)
+		machineTypes = buildMachineTypes(map[string]float64{
+			"m5.xlarge":   4,
+			"m5.2xlarge":  8,
+			"r5.xlarge":   4,
+			"g4dn.xlarge": 4,
+		})
+		var err error
+		noQuotaCosts, err = amsv1.NewQuotaCostList().Build()
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	expectQuotaValues := func(values map[string]float64) {
+		for code, value := range values {
+			awsClient.EXPECT().GetServiceQuotaValue(gomock.Any(), code).Return(value, nil)
+		}
+	}
+
+	It("Adds the control plane and infrastructure nodes of classic clusters", func() {
+		compute := &Pool{Name: "workers", InstanceType: "m5.xlarge", MinReplicas: 3, MaxReplicas: 6, RootDiskSize: 300}
+		request := ClusterRequest(false, true, compute, "", "")
+
+		awsClient.EXPECT().GetQuotaUsage().Return(&aws.QuotaUsage{
+			VCPUs:         map[string]float64{aws.StandardInstancesQuotaCode: 32},
+			VolumeStorage: map[string]float64{"gp3": 1024},
+			LoadBalancers: map[string]int{aws.NetworkLoadBalancer: 49},
+		}, nil)
+		expectQuotaValues(map[string]float64{
+			aws.StandardInstancesQuotaCode:    100,
+			aws.GP3StorageQuotaCode:           50,
+			aws.NetworkLoadBalancersQuotaCode: 50,
+			aws.ClassicLoadBalancersQuotaCode: 20,
+		})
+
+		result := Compute(request, machineTypes, noQuotaCosts, awsClient)
+		Expect(result.Pools).To(HaveLen(3))
+		Expect(result.Pools[0].MaxVCPUs()).To(Equal(24))
+		Expect(result.Pools[1].MaxVCPUs()).To(Equal(12))
+		Expect(result.Pools[2].MaxVCPUs()).To(Equal(24))
+		Expect(result.Checks).To(Equal([]*QuotaCheck{
+			{
+				Source:    "AWS",
+				Quota:     aws.QuotaNames[aws.StandardInstancesQuotaCode],
+				Required:  60,
+				Available: 68,
+				Status:    StatusOK,
+			},
+			{
+				Source:    "AWS",
+				Quota:     aws.QuotaNames[aws.GP3StorageQuotaCode],
+				Required:  float64(3*350+3*300+6*300) / 1024,
+				Available: 49,
+				Status:    StatusOK,
+			},
+			{
+				Source:    "AWS",
+				Quota:     aws.QuotaNames[aws.NetworkLoadBalancersQuotaCode],
+				Required:  2,
+				Available: 1,
+				Status:    StatusExceeded,
+			},
+			{
+				Source:    "AWS",
+				Quota:     aws.QuotaNames[aws.ClassicLoadBalancersQuotaCode],
+				Required:  1,
+				Available: 20,
+				Status:    StatusOK,
+			},
+		}))
+		Expect(result.Exceeded()).To(HaveLen(1))
+	})
+
+	It("Checks the OCM quota of the machine types that have a cost", func() {
+		nodePool, err := cmv1.NewNodePool().ID("gpu").
+			AWSNodePool(cmv1.NewAWSNodePool().InstanceType("g4dn.xlarge")).
+			Autoscaling(cmv1.NewNodePoolAutoscaling().MinReplica(1).MaxReplica(4)).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		quotaCosts := buildQuotaCosts("compute.node|gpu", "g4dn.xlarge-generic", 1, 10, 8)
+
+		awsClient.EXPECT().GetQuotaUsage().Return(&aws.QuotaUsage{}, nil)
+		expectQuotaValues(map[string]float64{
+			"L-DB2E81BA":            64,
+			aws.GP3StorageQuotaCode: 50,
+		})
+
+		result := Compute(NodePoolRequest(nodePool), machineTypes, quotaCosts, awsClient)
+		Expect(result.Pools[0].Pool).To(Equal(&Pool{
+			Name:         "gpu",
+			InstanceType: "g4dn.xlarge",
+			MinReplicas:  1,
+			MaxReplicas:  4,
+			RootDiskSize: DefaultRootDiskSize,
+		}))
+		Expect(result.Checks[0]).To(Equal(&QuotaCheck{
+			Source:    "OCM",
+			Quota:     "compute.node|gpu",
+			Required:  4,
+			Available: 2,
+			Status:    StatusExceeded,
+		}))
+		Expect(result.Checks[1].Required).To(Equal(16.0))
+		Expect(result.Checks[1].Status).To(Equal(StatusOK))
+	})
+
+	It("Reports the AWS quotas as unknown when the usage can't be obtained", func() {
+		machinePool, err := cmv1.NewMachinePool().ID("mp-1").InstanceType("m5.xlarge").Replicas(2).Build()
+		Expect(err).NotTo(HaveOccurred())
+		awsClient.EXPECT().GetQuotaUsage().Return(nil, fmt.Errorf("access denied"))
+
+		result := Compute(MachinePoolRequest(machinePool, 128), machineTypes, noQuotaCosts, awsClient)
+		Expect(result.Pools[0].RootDiskSize).To(Equal(128))
+		Expect(result.Checks).To(HaveLen(2))
+		Expect(result.Checks[0].Status).To(Equal(StatusUnknown))
+		Expect(result.Checks[0].Message).To(Equal("failed to get usage: access denied"))
+		Expect(result.Exceeded()).To(BeEmpty())
+
+		var buffer bytes.Buffer
+		Expect(result.Write(&buffer)).To(Succeed())
+		Expect(buffer.String()).To(HavePrefix("" +
+			"POOL  INSTANCE TYPE  NODES  VCPUS PER NODE  MAX VCPUS  ROOT DISK\n" +
+			"mp-1  m5.xlarge      2      4               8          128 GiB\n" +
+			"\n"))
+		Expect(buffer.String()).To(MatchRegexp(
+			`AWS +Running On-Demand Standard .* instances +8 +- +unknown \(failed to get usage: access denied\)`))
+		Expect(buffer.String()).To(MatchRegexp(`AWS +Storage for .* in TiB +0.25 +- +unknown`))
+	})
+})
@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package estimate
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clierror"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+// Run estimates the quota used by the request and prints the estimation. It returns an error when
+// the request would go over the OCM or the AWS quota.
+func Run(r *rosa.Runtime, awsClient aws.Client, machineTypes *ocm.MachineTypeList, request *Request) error {
+	quotaCosts, err := r.OCMClient.GetQuotaCosts("")
+	if err != nil {
+		return fmt.Errorf("failed to get OCM quota costs: %v", err)
+	}
+	result := Compute(request, machineTypes, quotaCosts, awsClient)
+	if output.HasFlag() {
+		err = output.Print(result)
+	} else {
+		err = result.Write(os.Stdout)
+	}
+	if err != nil {
+		return err
+	}
+
+	exceeded := result.Exceeded()
+	if len(exceeded) == 0 {
+		return nil
+	}
+	quotas := make([]string, len(exceeded))
+	for i, check := range exceeded {
+		quotas[i] = fmt.Sprintf("%s quota '%s'", check.Source, check.Quota)
+	}
+	return clierror.New(clierror.QuotaExceeded,
+		fmt.Errorf("the request exceeds the %s", strings.Join(quotas, ", ")))
+}
+
+// Write writes the nodes of the request and the quota checks as tables.
+func (r *Result) Write(w io.Writer) error {
+	writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(writer, "POOL\tINSTANCE TYPE\tNODES\tVCPUS PER NODE\tMAX VCPUS\tROOT DISK\n")
+	for _, pool := range r.Pools {
+		nodes := fmt.Sprint(pool.MaxReplicas)
+		if pool.MinReplicas != pool.MaxReplicas {
+			nodes = fmt.Sprintf("%d-%d", pool.MinReplicas, pool.MaxReplicas)
+		}
+		vcpus, maxVCPUs := "unknown", "unknown"
+		if pool.VCPUs > 0 {
+			vcpus, maxVCPUs = fmt.Sprint(pool.VCPUs), fmt.Sprint(pool.MaxVCPUs())
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%d GiB\n",
+			pool.Name, pool.InstanceType, nodes, vcpus, maxVCPUs, pool.RootDiskSize)
+	}
+	fmt.Fprintf(writer, "\nSOURCE\tQUOTA\tREQUIRED\tAVAILABLE\tSTATUS\n")
+	for _, check := range r.Checks {
+		available := formatValue(check.Available)
+		status := string(check.Status)
+		if check.Status == StatusUnknown {
+			available = "-"
+			status = fmt.Sprintf("%s (%s)", status, check.Message)
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n",
+			check.Source, check.Quota, formatValue(check.Required), available, status)
+	}
+	return writer.Flush()
+}
+
+// formatValue formats quota values, that are fractional only for storage quotas measured in TiB.
+func formatValue(value float64) string {
+	if value == math.Trunc(value) {
+		return fmt.Sprintf("%.0f", value)
+	}
+	return fmt.Sprintf("%.2f", value)
+}
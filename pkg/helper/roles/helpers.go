@@ -3,8 +3,10 @@ package roles
 import (
 	"fmt"
 	"os"
+	"sort"
 	"time"
 
+	awserr "github.com/openshift-online/ocm-common/pkg/aws/errors"
 	awsCommonUtils "github.com/openshift-online/ocm-common/pkg/aws/utils"
 	awsCommonValidations "github.com/openshift-online/ocm-common/pkg/aws/validations"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
@@ -13,6 +15,7 @@ import (
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
 	awscbRoles "github.com/openshift/rosa/pkg/aws/commandbuilder/helper/roles"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
@@ -242,7 +245,7 @@ func CreateMissingRoles(
 			err = createOperatorRole(mode, r, cluster, prefix, missingRolesInCS, policies, unifiedPath, managedPolicies)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			createdMissingRoles++
 		}
@@ -280,7 +283,7 @@ func createOperatorRole(
 		fmt.Println(commands)
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		os.Exit(clierror.Validation.ExitCode())
 	}
 	return nil
 }
@@ -352,3 +355,52 @@ func ValidateAdditionalAllowedPrincipals(aapARNs []string) error {
 	}
 	return nil
 }
+
+// PolicyUpgrade is the policy of a role together with the document it will be upgraded to
+type PolicyUpgrade struct {
+	RoleName  string
+	PolicyARN string
+	Document  string
+}
+
+// ShowPolicyDiff prints, for each role, the actions and resources that the upgrade adds to and
+// removes from the statements of the default version of its policy
+func ShowPolicyDiff(r *rosa.Runtime, upgrades []PolicyUpgrade) error {
+	sort.Slice(upgrades, func(i, j int) bool {
+		return upgrades[i].RoleName < upgrades[j].RoleName
+	})
+	for _, upgrade := range upgrades {
+		target, err := aws.ParsePolicyDocument(upgrade.Document)
+		if err != nil {
+			return fmt.Errorf("Failed to parse the target policy of role '%s': %v", upgrade.RoleName, err)
+		}
+		current := aws.NewPolicyDocument()
+		document, err := r.AWSClient.GetDefaultPolicyDocument(upgrade.PolicyARN)
+		switch {
+		case awserr.IsNoSuchEntityException(err):
+			r.Reporter.Infof("Policy '%s' of role '%s' doesn't exist and will be created",
+				upgrade.PolicyARN, upgrade.RoleName)
+		case err != nil:
+			return fmt.Errorf("Failed to get the default version of policy '%s': %v", upgrade.PolicyARN, err)
+		default:
+			current, err = aws.ParsePolicyDocument(document)
+			if err != nil {
+				return fmt.Errorf("Failed to parse policy '%s': %v", upgrade.PolicyARN, err)
+			}
+		}
+
+		statements := aws.DiffPolicyDocuments(current, target)
+		if len(statements) == 0 {
+			r.Reporter.Infof("Policy '%s' of role '%s' doesn't change", upgrade.PolicyARN, upgrade.RoleName)
+			continue
+		}
+		r.Reporter.Infof("Changes to policy '%s' of role '%s':", upgrade.PolicyARN, upgrade.RoleName)
+		for _, statement := range statements {
+			fmt.Printf("  Statement '%s':\n", statement.Name)
+			for _, line := range statement.Lines() {
+				fmt.Printf("    %s\n", line)
+			}
+		}
+	}
+	return nil
+}
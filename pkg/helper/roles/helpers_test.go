@@ -23,12 +23,15 @@ import (
 
 	"go.uber.org/mock/gomock"
 
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
 
 	mock "github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/rosa"
 	"github.com/openshift/rosa/pkg/test"
 )
 
@@ -195,4 +198,58 @@ var _ = Describe("Roles Helper", func() {
 			Expect(err).To(MatchError(ContainSubstring("could not find")))
 		})
 	})
+
+	Context("ShowPolicyDiff", func() {
+		var t *test.TestingRuntime
+		var mockAWS *mock.MockClient
+
+		BeforeEach(func() {
+			t = test.NewTestRuntime()
+			mockAWS = mock.NewMockClient(gomock.NewController(GinkgoT()))
+			t.RosaRuntime.AWSClient = mockAWS
+		})
+
+		It("Prints the changes of each policy", func() {
+			mockAWS.EXPECT().GetDefaultPolicyDocument("arn:aws:iam::123:policy/worker").Return(
+				`{"Statement": [{"Sid": "EC2", "Effect": "Allow", "Action": ["ec2:DescribeTags"], "Resource": "*"}]}`,
+				nil)
+			mockAWS.EXPECT().GetDefaultPolicyDocument("arn:aws:iam::123:policy/installer").Return(
+				"", &iamtypes.NoSuchEntityException{})
+			stdout, _, err := test.RunWithOutputCapture(func(r *rosa.Runtime, _ *cobra.Command) error {
+				return ShowPolicyDiff(r, []PolicyUpgrade{
+					{
+						RoleName:  "worker",
+						PolicyARN: "arn:aws:iam::123:policy/worker",
+						Document: `{"Statement": [{"Sid": "EC2", "Effect": "Allow", ` +
+							`"Action": ["ec2:DescribeInstances"], "Resource": "*"}]}`,
+					},
+					{
+						RoleName:  "installer",
+						PolicyARN: "arn:aws:iam::123:policy/installer",
+						Document:  `{"Statement": [{"Sid": "IAM", "Effect": "Allow", "Action": "iam:GetRole"}]}`,
+					},
+				})
+			}, t.RosaRuntime, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(ContainSubstring(
+				"Policy 'arn:aws:iam::123:policy/installer' of role 'installer' doesn't exist and will be created"))
+			Expect(stdout).To(ContainSubstring("  Statement 'IAM':\n    + action: iam:GetRole\n"))
+			Expect(stdout).To(ContainSubstring(
+				"Changes to policy 'arn:aws:iam::123:policy/worker' of role 'worker':"))
+			Expect(stdout).To(ContainSubstring("  Statement 'EC2':\n" +
+				"    + action: ec2:DescribeInstances\n    - action: ec2:DescribeTags\n"))
+		})
+
+		It("Fails when the policy can't be read", func() {
+			mockAWS.EXPECT().GetDefaultPolicyDocument("arn:aws:iam::123:policy/worker").Return(
+				"", fmt.Errorf("access denied"))
+			err := ShowPolicyDiff(t.RosaRuntime, []PolicyUpgrade{{
+				RoleName:  "worker",
+				PolicyARN: "arn:aws:iam::123:policy/worker",
+				Document:  `{"Statement": []}`,
+			}})
+			Expect(err).To(MatchError("Failed to get the default version of policy " +
+				"'arn:aws:iam::123:policy/worker': access denied"))
+		})
+	})
 })
@@ -0,0 +1,13 @@
+package hibernation
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestHibernation(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Hibernation Suite")
+}
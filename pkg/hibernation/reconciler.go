@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hibernation
+
+import (
+	"fmt"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// Action is the action taken on a cluster to bring it to the state selected by its schedule.
+type Action string
+
+const (
+	ActionHibernate Action = "hibernate"
+	ActionResume    Action = "resume"
+	ActionNone      Action = "none"
+	ActionSkip      Action = "skip"
+)
+
+// Decision is the result of evaluating the schedule of a cluster.
+type Decision struct {
+	Cluster  *cmv1.Cluster
+	Schedule *Schedule
+	Desired  State
+	Since    time.Time
+	Action   Action
+	Message  string
+}
+
+// Decide evaluates the schedule of the cluster at the given time. Clusters are only hibernated
+// when they are ready and only resumed when they are hibernating, the same checks done by 'rosa
+// hibernate cluster' and 'rosa resume cluster', so clusters in any other state are skipped.
+func Decide(cluster *cmv1.Cluster, schedule *Schedule, now time.Time) *Decision {
+	decision := &Decision{Cluster: cluster, Schedule: schedule, Action: ActionSkip}
+	desired, since, err := schedule.Desired(now)
+	if err != nil {
+		decision.Message = err.Error()
+		return decision
+	}
+	decision.Desired = desired
+	decision.Since = since
+	switch {
+	case desired == StateNone:
+		decision.Message = fmt.Sprintf("the schedule didn't match any time in the last %d days",
+			int(Lookback.Hours()/24))
+	case desired == StateHibernating && cluster.State() == cmv1.ClusterStateHibernating,
+		desired == StateReady && cluster.State() == cmv1.ClusterStateReady:
+		decision.Action = ActionNone
+	case desired == StateHibernating && cluster.State() == cmv1.ClusterStateReady:
+		decision.Action = ActionHibernate
+	case desired == StateReady && cluster.State() == cmv1.ClusterStateHibernating:
+		decision.Action = ActionResume
+	default:
+		decision.Message = fmt.Sprintf("cluster is in '%s' state", cluster.State())
+	}
+	return decision
+}
+
+// Hibernator hibernates and resumes clusters.
+type Hibernator interface {
+	HibernateCluster(clusterID string) error
+	ResumeCluster(clusterID string) error
+}
+
+// Apply hibernates or resumes the clusters of the decisions, recording the failures in their
+// messages. It returns the number of failures.
+func Apply(hibernator Hibernator, decisions []*Decision) int {
+	failures := 0
+	for _, decision := range decisions {
+		var err error
+		switch decision.Action {
+		case ActionHibernate:
+			err = hibernator.HibernateCluster(decision.Cluster.ID())
+		case ActionResume:
+			err = hibernator.ResumeCluster(decision.Cluster.ID())
+		default:
+			continue
+		}
+		if err != nil {
+			decision.Message = err.Error()
+			failures++
+		}
+	}
+	return failures
+}
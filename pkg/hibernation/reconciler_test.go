@@ -0,0 +1,80 @@
+package hibernation
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+func buildCluster(name string, state cmv1.ClusterState) *cmv1.Cluster {
+	cluster, err := cmv1.NewCluster().ID(name + "-id").Name(name).State(state).Build()
+	Expect(err).NotTo(HaveOccurred())
+	return cluster
+}
+
+type fakeHibernator struct {
+	calls  []string
+	failed map[string]bool
+}
+
+func (f *fakeHibernator) call(action string, clusterID string) error {
+	f.calls = append(f.calls, action+" "+clusterID)
+	if f.failed[clusterID] {
+		return fmt.Errorf("failed to %s cluster '%s'", action, clusterID)
+	}
+	return nil
+}
+
+func (f *fakeHibernator) HibernateCluster(clusterID string) error {
+	return f.call("hibernate", clusterID)
+}
+
+func (f *fakeHibernator) ResumeCluster(clusterID string) error {
+	return f.call("resume", clusterID)
+}
+
+var _ = Describe("Reconciler", func() {
+	nights := &Schedule{Hibernate: "0 20 * * *", Resume: "0 8 * * *"}
+	night := time.Date(2026, 10, 16, 23, 0, 0, 0, time.UTC)
+	day := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	DescribeTable("Decides the action following the state guards",
+		func(state cmv1.ClusterState, now time.Time, action Action, message string) {
+			decision := Decide(buildCluster("a", state), nights, now)
+			Expect(decision.Action).To(Equal(action))
+			Expect(decision.Message).To(Equal(message))
+		},
+		Entry("hibernates a ready cluster", cmv1.ClusterStateReady, night, ActionHibernate, ""),
+		Entry("resumes a hibernating cluster", cmv1.ClusterStateHibernating, day, ActionResume, ""),
+		Entry("leaves a hibernating cluster", cmv1.ClusterStateHibernating, night, ActionNone, ""),
+		Entry("leaves a ready cluster", cmv1.ClusterStateReady, day, ActionNone, ""),
+		Entry("skips a cluster being resumed", cmv1.ClusterStateResuming, night, ActionSkip,
+			"cluster is in 'resuming' state"),
+		Entry("skips an installing cluster", cmv1.ClusterStateInstalling, day, ActionSkip,
+			"cluster is in 'installing' state"),
+	)
+
+	It("Skips clusters whose schedule didn't match recently", func() {
+		decision := Decide(buildCluster("a", cmv1.ClusterStateReady),
+			&Schedule{Hibernate: "0 0 29 2 *", Resume: "0 0 29 2 *"}, day)
+		Expect(decision.Action).To(Equal(ActionSkip))
+		Expect(decision.Message).To(Equal("the schedule didn't match any time in the last 31 days"))
+	})
+
+	It("Applies the actions and records the failures", func() {
+		decisions := []*Decision{
+			Decide(buildCluster("a", cmv1.ClusterStateReady), nights, night),
+			Decide(buildCluster("b", cmv1.ClusterStateReady), nights, night),
+			Decide(buildCluster("c", cmv1.ClusterStateHibernating), nights, day),
+			Decide(buildCluster("d", cmv1.ClusterStateReady), nights, day),
+		}
+		hibernator := &fakeHibernator{failed: map[string]bool{"b-id": true}}
+		Expect(Apply(hibernator, decisions)).To(Equal(1))
+		Expect(hibernator.calls).To(Equal([]string{"hibernate a-id", "hibernate b-id", "resume c-id"}))
+		Expect(decisions[1].Message).To(Equal("failed to hibernate cluster 'b-id'"))
+		Expect(decisions[0].Message).To(BeEmpty())
+	})
+})
@@ -0,0 +1,219 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package hibernation hibernates and resumes clusters following recurring schedules, stored
+// either in a property of the cluster or in a schedule file.
+package hibernation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	APIVersion = "rosa.openshift.io/v1alpha1"
+	Kind       = "HibernationSchedules"
+
+	// Lookback is how far back in time the schedules are evaluated. A schedule whose expressions
+	// didn't match any time in this period doesn't select a state.
+	Lookback = 31 * 24 * time.Hour
+)
+
+var parser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// State is the state that a schedule selects for a cluster at a given time.
+type State string
+
+const (
+	StateNone        State = ""
+	StateHibernating State = "hibernating"
+	StateReady       State = "ready"
+)
+
+// Schedule hibernates a cluster at the times matched by the Hibernate cron expression and resumes
+// it at the times matched by the Resume one, both evaluated in the Timezone.
+type Schedule struct {
+	// Cluster is the identifier or name of the cluster, only used in schedule files.
+	Cluster   string `json:"cluster,omitempty"`
+	Hibernate string `json:"hibernate"`
+	Resume    string `json:"resume"`
+	Timezone  string `json:"timezone,omitempty"`
+}
+
+func (s *Schedule) location() (*time.Location, error) {
+	if s.Timezone == "" {
+		return time.UTC, nil
+	}
+	location, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone '%s': %v", s.Timezone, err)
+	}
+	return location, nil
+}
+
+func parseExpression(name string, expression string, location *time.Location) (cron.Schedule, error) {
+	if expression == "" {
+		return nil, fmt.Errorf("the %s cron expression is required", name)
+	}
+	schedule, err := parser.Parse(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s cron expression '%s': %v", name, expression, err)
+	}
+	if specSchedule, ok := schedule.(*cron.SpecSchedule); ok {
+		specSchedule.Location = location
+	}
+	return schedule, nil
+}
+
+// Validate checks the timezone and the cron expressions of the schedule.
+func (s *Schedule) Validate() error {
+	_, _, err := s.parse()
+	return err
+}
+
+func (s *Schedule) parse() (cron.Schedule, cron.Schedule, error) {
+	location, err := s.location()
+	if err != nil {
+		return nil, nil, err
+	}
+	hibernate, err := parseExpression("hibernate", s.Hibernate, location)
+	if err != nil {
+		return nil, nil, err
+	}
+	resume, err := parseExpression("resume", s.Resume, location)
+	if err != nil {
+		return nil, nil, err
+	}
+	return hibernate, resume, nil
+}
+
+// lastMatch returns the last time before or at now matched by the schedule within the lookback
+// period, or the zero time if there is none.
+func lastMatch(schedule cron.Schedule, now time.Time) time.Time {
+	last := time.Time{}
+	for next := schedule.Next(now.Add(-Lookback)); !next.IsZero() && !next.After(now); {
+		last = next
+		next = schedule.Next(next)
+	}
+	return last
+}
+
+// Desired returns the state selected by the schedule at the given time, which is the one of the
+// expression that matched last, and the time when it matched.
+func (s *Schedule) Desired(now time.Time) (State, time.Time, error) {
+	hibernate, resume, err := s.parse()
+	if err != nil {
+		return StateNone, time.Time{}, err
+	}
+	lastHibernate := lastMatch(hibernate, now)
+	lastResume := lastMatch(resume, now)
+	switch {
+	case lastHibernate.IsZero() && lastResume.IsZero():
+		return StateNone, time.Time{}, nil
+	case lastHibernate.After(lastResume):
+		return StateHibernating, lastHibernate, nil
+	default:
+		return StateReady, lastResume, nil
+	}
+}
+
+func (s *Schedule) String() string {
+	timezone := s.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	return fmt.Sprintf("hibernate '%s', resume '%s' (%s)", s.Hibernate, s.Resume, timezone)
+}
+
+// ParseProperty parses the value of the cluster property that contains the schedule.
+func ParseProperty(value string) (*Schedule, error) {
+	schedule := &Schedule{}
+	err := json.Unmarshal([]byte(value), schedule)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hibernation schedule property: %v", err)
+	}
+	return schedule, schedule.Validate()
+}
+
+// Property returns the value of the cluster property that contains the schedule.
+func (s *Schedule) Property() (string, error) {
+	property := *s
+	property.Cluster = ""
+	value, err := json.Marshal(property)
+	return string(value), err
+}
+
+// File is a schedule file, containing the schedules of multiple clusters.
+type File struct {
+	APIVersion string      `json:"apiVersion"`
+	Kind       string      `json:"kind"`
+	Schedules  []*Schedule `json:"schedules"`
+}
+
+// LoadFile loads a schedule file, returning an empty one if it doesn't exist yet.
+func LoadFile(path string) (*File, error) {
+	file := &File{APIVersion: APIVersion, Kind: Kind}
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return file, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = yaml.UnmarshalStrict(content, file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schedule file '%s': %v", path, err)
+	}
+	if file.Kind != Kind {
+		return nil, fmt.Errorf("schedule file '%s' has kind '%s', expected '%s'", path, file.Kind, Kind)
+	}
+	for _, schedule := range file.Schedules {
+		if schedule.Cluster == "" {
+			return nil, fmt.Errorf("schedule file '%s' contains a schedule without cluster", path)
+		}
+		err = schedule.Validate()
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule of cluster '%s' in file '%s': %v", schedule.Cluster, path, err)
+		}
+	}
+	return file, nil
+}
+
+// Set adds the schedule to the file, replacing the existing schedule of the same cluster.
+func (f *File) Set(schedule *Schedule) {
+	for i, existing := range f.Schedules {
+		if existing.Cluster == schedule.Cluster {
+			f.Schedules[i] = schedule
+			return
+		}
+	}
+	f.Schedules = append(f.Schedules, schedule)
+}
+
+// Save writes the schedule file.
+func (f *File) Save(path string) error {
+	content, err := yaml.Marshal(f)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, content, 0600)
+}
@@ -0,0 +1,114 @@
+package hibernation
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schedule", func() {
+	weekdays := &Schedule{Hibernate: "0 20 * * 1-5", Resume: "0 8 * * 1-5", Timezone: "Europe/Madrid"}
+
+	DescribeTable("Rejects invalid schedules",
+		func(schedule *Schedule, message string) {
+			Expect(schedule.Validate()).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("missing hibernate", &Schedule{Resume: "0 8 * * *"}, "the hibernate cron expression is required"),
+		Entry("invalid resume", &Schedule{Hibernate: "0 20 * * *", Resume: "0 25 * * *"},
+			"invalid resume cron expression '0 25 * * *'"),
+		Entry("seconds field", &Schedule{Hibernate: "0 0 20 * * *", Resume: "0 8 * * *"},
+			"invalid hibernate cron expression"),
+		Entry("unknown timezone", &Schedule{Hibernate: "@daily", Resume: "@hourly", Timezone: "Mars/Olympus"},
+			"invalid timezone 'Mars/Olympus'"),
+	)
+
+	It("Evaluates the expressions in the timezone", func() {
+		// Thursday at 19:30 UTC is 21:30 in Madrid, after the hibernation time:
+		desired, since, err := weekdays.Desired(time.Date(2026, 10, 15, 19, 30, 0, 0, time.UTC))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(desired).To(Equal(StateHibernating))
+		Expect(since).To(BeTemporally("==", time.Date(2026, 10, 15, 18, 0, 0, 0, time.UTC)))
+	})
+
+	It("Keeps the cluster hibernated during the weekend", func() {
+		desired, since, err := weekdays.Desired(time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(desired).To(Equal(StateHibernating))
+		Expect(since.Weekday()).To(Equal(time.Friday))
+
+		desired, since, err = weekdays.Desired(time.Date(2026, 10, 19, 7, 0, 0, 0, time.UTC))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(desired).To(Equal(StateReady))
+		Expect(since).To(BeTemporally("==", time.Date(2026, 10, 19, 6, 0, 0, 0, time.UTC)))
+	})
+
+	It("Doesn't select a state when nothing matched in the lookback period", func() {
+		schedule := &Schedule{Hibernate: "0 0 29 2 *", Resume: "0 0 29 2 *"}
+		desired, _, err := schedule.Desired(time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(desired).To(Equal(StateNone))
+	})
+
+	It("Round trips the cluster property without the cluster", func() {
+		schedule := *weekdays
+		schedule.Cluster = "mycluster"
+		value, err := schedule.Property()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(Equal(`{"hibernate":"0 20 * * 1-5","resume":"0 8 * * 1-5","timezone":"Europe/Madrid"}`))
+		parsed, err := ParseProperty(value)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parsed).To(Equal(weekdays))
+	})
+
+	It("Rejects an invalid cluster property", func() {
+		_, err := ParseProperty("hibernate at night")
+		Expect(err).To(MatchError(ContainSubstring("invalid hibernation schedule property")))
+	})
+})
+
+var _ = Describe("File", func() {
+	var path string
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "schedules.yaml")
+	})
+
+	It("Returns an empty file when it doesn't exist", func() {
+		file, err := LoadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(file.Kind).To(Equal(Kind))
+		Expect(file.Schedules).To(BeEmpty())
+	})
+
+	It("Replaces the schedule of the same cluster", func() {
+		file, err := LoadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		file.Set(&Schedule{Cluster: "a", Hibernate: "@daily", Resume: "@hourly"})
+		file.Set(&Schedule{Cluster: "b", Hibernate: "@daily", Resume: "@hourly"})
+		file.Set(&Schedule{Cluster: "a", Hibernate: "0 20 * * *", Resume: "0 8 * * *"})
+		Expect(file.Save(path)).To(Succeed())
+
+		loaded, err := LoadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loaded.Schedules).To(HaveLen(2))
+		Expect(loaded.Schedules[0].Hibernate).To(Equal("0 20 * * *"))
+		Expect(loaded.Schedules[1].Cluster).To(Equal("b"))
+	})
+
+	DescribeTable("Rejects invalid files",
+		func(content string, message string) {
+			Expect(os.WriteFile(path, []byte(content), 0600)).To(Succeed())
+			_, err := LoadFile(path)
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("wrong kind", "kind: Clusters\n", "has kind 'Clusters'"),
+		Entry("unknown field", "kind: HibernationSchedules\nschedule: []\n", "failed to parse schedule file"),
+		Entry("missing cluster", "kind: HibernationSchedules\nschedules:\n- hibernate: '@daily'\n  resume: '@hourly'\n",
+			"contains a schedule without cluster"),
+		Entry("invalid schedule", "kind: HibernationSchedules\nschedules:\n- cluster: a\n  hibernate: '@daily'\n",
+			"invalid schedule of cluster 'a'"),
+	)
+})
@@ -0,0 +1,586 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package idp contains the settings of the identity providers that can be described and edited.
+package idp
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/interactive"
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+const hiddenValue = "********"
+
+// MappingMethods are the valid mapping methods of the identity providers
+var MappingMethods = []string{"add", "claim", "generate", "lookup"}
+
+// Change is a change to a setting of an identity provider, shown in the preview of an edit.
+type Change struct {
+	Setting string
+	Current string
+	New     string
+}
+
+// idpPatch contains the builders of the parts of an identity provider changed by an edit.
+type idpPatch struct {
+	mappingMethod string
+	github        *cmv1.GithubIdentityProviderBuilder
+	gitlab        *cmv1.GitlabIdentityProviderBuilder
+	google        *cmv1.GoogleIdentityProviderBuilder
+	ldap          *cmv1.LDAPIdentityProviderBuilder
+	ldapAttrs     *cmv1.LDAPAttributesBuilder
+	openid        *cmv1.OpenIDIdentityProviderBuilder
+	openidClaims  *cmv1.OpenIDClaimsBuilder
+}
+
+// setting is a value of an identity provider that can be shown and edited with the flag of the
+// same name of 'rosa create idp'.
+type setting struct {
+	flag   string
+	name   string
+	secret bool
+	file   bool
+	get    func(idp *cmv1.IdentityProvider) string
+	set    func(patch *idpPatch, value string) error
+}
+
+func list(value string) []string {
+	result := []string{}
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func join(values []string) string {
+	return strings.Join(values, ",")
+}
+
+func readCA(path string) (string, error) {
+	cert, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("expected a valid certificate bundle: %s", err)
+	}
+	return string(cert), nil
+}
+
+func configured(value string) string {
+	if value == "" {
+		return ""
+	}
+	return "configured"
+}
+
+var mappingMethodSetting = setting{
+	flag: "mapping-method",
+	name: "Mapping method",
+	get:  func(idp *cmv1.IdentityProvider) string { return string(idp.MappingMethod()) },
+	set: func(patch *idpPatch, value string) error {
+		if !slices.Contains(MappingMethods, value) {
+			return fmt.Errorf("expected a valid mapping method; options are %s", MappingMethods)
+		}
+		patch.mappingMethod = value
+		return nil
+	},
+}
+
+var settings = map[cmv1.IdentityProviderType][]setting{
+	cmv1.IdentityProviderTypeGithub: {
+		mappingMethodSetting,
+		{
+			flag: "client-id",
+			name: "Client ID",
+			get:  func(idp *cmv1.IdentityProvider) string { return idp.Github().ClientID() },
+			set: func(patch *idpPatch, value string) error {
+				patch.github.ClientID(value)
+				return nil
+			},
+		},
+		{
+			flag:   "client-secret",
+			name:   "Client secret",
+			secret: true,
+			get:    func(idp *cmv1.IdentityProvider) string { return idp.Github().ClientSecret() },
+			set: func(patch *idpPatch, value string) error {
+				patch.github.ClientSecret(value)
+				return nil
+			},
+		},
+		{
+			flag: "hostname",
+			name: "Hostname",
+			get:  func(idp *cmv1.IdentityProvider) string { return idp.Github().Hostname() },
+			set: func(patch *idpPatch, value string) error {
+				err := interactive.IsValidHostname(value)
+				if err != nil {
+					return err
+				}
+				patch.github.Hostname(value)
+				return nil
+			},
+		},
+		{
+			flag: "ca",
+			name: "CA",
+			file: true,
+			get:  func(idp *cmv1.IdentityProvider) string { return configured(idp.Github().CA()) },
+			set: func(patch *idpPatch, value string) error {
+				ca, err := readCA(value)
+				patch.github.CA(ca)
+				return err
+			},
+		},
+		{
+			flag: "organizations",
+			name: "Organizations",
+			get:  func(idp *cmv1.IdentityProvider) string { return join(idp.Github().Organizations()) },
+			set: func(patch *idpPatch, value string) error {
+				// GitHub IDPs restrict access either by organizations or by teams:
+				patch.github.Organizations(list(value)...).Teams()
+				return nil
+			},
+		},
+		{
+			flag: "teams",
+			name: "Teams",
+			get:  func(idp *cmv1.IdentityProvider) string { return join(idp.Github().Teams()) },
+			set: func(patch *idpPatch, value string) error {
+				for _, team := range list(value) {
+					if len(strings.Split(team, "/")) != 2 {
+						return fmt.Errorf("expected a GitHub team to follow the form '<org>/<team>'")
+					}
+				}
+				patch.github.Teams(list(value)...).Organizations()
+				return nil
+			},
+		},
+	},
+	cmv1.IdentityProviderTypeGitlab: {
+		mappingMethodSetting,
+		{
+			flag: "client-id",
+			name: "Client ID",
+			get:  func(idp *cmv1.IdentityProvider) string { return idp.Gitlab().ClientID() },
+			set: func(patch *idpPatch, value string) error {
+				patch.gitlab.ClientID(value)
+				return nil
+			},
+		},
+		{
+			flag:   "client-secret",
+			name:   "Client secret",
+			secret: true,
+			get:    func(idp *cmv1.IdentityProvider) string { return idp.Gitlab().ClientSecret() },
+			set: func(patch *idpPatch, value string) error {
+				patch.gitlab.ClientSecret(value)
+				return nil
+			},
+		},
+		{
+			flag: "host-url",
+			name: "Host URL",
+			get:  func(idp *cmv1.IdentityProvider) string { return idp.Gitlab().URL() },
+			set: func(patch *idpPatch, value string) error {
+				err := ValidateGitlabHostURL(value)
+				if err != nil {
+					return err
+				}
+				patch.gitlab.URL(value)
+				return nil
+			},
+		},
+		{
+			flag: "ca",
+			name: "CA",
+			file: true,
+			get:  func(idp *cmv1.IdentityProvider) string { return configured(idp.Gitlab().CA()) },
+			set: func(patch *idpPatch, value string) error {
+				ca, err := readCA(value)
+				patch.gitlab.CA(ca)
+				return err
+			},
+		},
+	},
+	cmv1.IdentityProviderTypeGoogle: {
+		mappingMethodSetting,
+		{
+			flag: "client-id",
+			name: "Client ID",
+			get:  func(idp *cmv1.IdentityProvider) string { return idp.Google().ClientID() },
+			set: func(patch *idpPatch, value string) error {
+				patch.google.ClientID(value)
+				return nil
+			},
+		},
+		{
+			flag:   "client-secret",
+			name:   "Client secret",
+			secret: true,
+			get:    func(idp *cmv1.IdentityProvider) string { return idp.Google().ClientSecret() },
+			set: func(patch *idpPatch, value string) error {
+				patch.google.ClientSecret(value)
+				return nil
+			},
+		},
+		{
+			flag: "hosted-domain",
+			name: "Hosted domain",
+			get:  func(idp *cmv1.IdentityProvider) string { return idp.Google().HostedDomain() },
+			set: func(patch *idpPatch, value string) error {
+				patch.google.HostedDomain(value)
+				return nil
+			},
+		},
+	},
+	cmv1.IdentityProviderTypeLDAP: {
+		mappingMethodSetting,
+		{
+			flag: "url",
+			name: "URL",
+			get:  func(idp *cmv1.IdentityProvider) string { return idp.LDAP().URL() },
+			set: func(patch *idpPatch, value string) error {
+				err := ValidateLdapURL(value)
+				if err != nil {
+					return err
+				}
+				patch.ldap.URL(value)
+				return nil
+			},
+		},
+		{
+			flag: "insecure",
+			name: "Insecure",
+			get:  func(idp *cmv1.IdentityProvider) string { return strconv.FormatBool(idp.LDAP().Insecure()) },
+			set: func(patch *idpPatch, value string) error {
+				insecure, err := strconv.ParseBool(value)
+				if err != nil {
+					return fmt.Errorf("expected a valid insecure value: %s", err)
+				}
+				patch.ldap.Insecure(insecure)
+				return nil
+			},
+		},
+		{
+			flag: "ca",
+			name: "CA",
+			file: true,
+			get:  func(idp *cmv1.IdentityProvider) string { return configured(idp.LDAP().CA()) },
+			set: func(patch *idpPatch, value string) error {
+				ca, err := readCA(value)
+				patch.ldap.CA(ca)
+				return err
+			},
+		},
+		{
+			flag: "bind-dn",
+			name: "Bind DN",
+			get:  func(idp *cmv1.IdentityProvider) string { return idp.LDAP().BindDN() },
+			set: func(patch *idpPatch, value string) error {
+				patch.ldap.BindDN(value)
+				return nil
+			},
+		},
+		{
+			flag:   "bind-password",
+			name:   "Bind password",
+			secret: true,
+			get:    func(idp *cmv1.IdentityProvider) string { return idp.LDAP().BindPassword() },
+			set: func(patch *idpPatch, value string) error {
+				patch.ldap.BindPassword(value)
+				return nil
+			},
+		},
+		{
+			flag: "id-attributes",
+			name: "ID attributes",
+			get:  func(idp *cmv1.IdentityProvider) string { return join(idp.LDAP().Attributes().ID()) },
+			set: func(patch *idpPatch, value string) error {
+				patch.ldapAttrs.ID(list(value)...)
+				return nil
+			},
+		},
+		{
+			flag: "username-attributes",
+			name: "Username attributes",
+			get: func(idp *cmv1.IdentityProvider) string {
+				return join(idp.LDAP().Attributes().PreferredUsername())
+			},
+			set: func(patch *idpPatch, value string) error {
+				patch.ldapAttrs.PreferredUsername(list(value)...)
+				return nil
+			},
+		},
+		{
+			flag: "name-attributes",
+			name: "Name attributes",
+			get:  func(idp *cmv1.IdentityProvider) string { return join(idp.LDAP().Attributes().Name()) },
+			set: func(patch *idpPatch, value string) error {
+				patch.ldapAttrs.Name(list(value)...)
+				return nil
+			},
+		},
+		{
+			flag: "email-attributes",
+			name: "Email attributes",
+			get:  func(idp *cmv1.IdentityProvider) string { return join(idp.LDAP().Attributes().Email()) },
+			set: func(patch *idpPatch, value string) error {
+				patch.ldapAttrs.Email(list(value)...)
+				return nil
+			},
+		},
+	},
+	cmv1.IdentityProviderTypeOpenID: {
+		mappingMethodSetting,
+		{
+			flag: "client-id",
+			name: "Client ID",
+			get:  func(idp *cmv1.IdentityProvider) string { return idp.OpenID().ClientID() },
+			set: func(patch *idpPatch, value string) error {
+				patch.openid.ClientID(value)
+				return nil
+			},
+		},
+		{
+			flag:   "client-secret",
+			name:   "Client secret",
+			secret: true,
+			get:    func(idp *cmv1.IdentityProvider) string { return idp.OpenID().ClientSecret() },
+			set: func(patch *idpPatch, value string) error {
+				patch.openid.ClientSecret(value)
+				return nil
+			},
+		},
+		{
+			flag: "issuer-url",
+			name: "Issuer URL",
+			get:  func(idp *cmv1.IdentityProvider) string { return idp.OpenID().Issuer() },
+			set: func(patch *idpPatch, value string) error {
+				err := ValidateOpenidIssuerURL(value)
+				if err != nil {
+					return err
+				}
+				patch.openid.Issuer(value)
+				return nil
+			},
+		},
+		{
+			flag: "ca",
+			name: "CA",
+			file: true,
+			get:  func(idp *cmv1.IdentityProvider) string { return configured(idp.OpenID().CA()) },
+			set: func(patch *idpPatch, value string) error {
+				ca, err := readCA(value)
+				patch.openid.CA(ca)
+				return err
+			},
+		},
+		{
+			flag: "email-claims",
+			name: "Email claims",
+			get:  func(idp *cmv1.IdentityProvider) string { return join(idp.OpenID().Claims().Email()) },
+			set: func(patch *idpPatch, value string) error {
+				patch.openidClaims.Email(list(value)...)
+				return nil
+			},
+		},
+		{
+			flag: "name-claims",
+			name: "Name claims",
+			get:  func(idp *cmv1.IdentityProvider) string { return join(idp.OpenID().Claims().Name()) },
+			set: func(patch *idpPatch, value string) error {
+				patch.openidClaims.Name(list(value)...)
+				return nil
+			},
+		},
+		{
+			flag: "username-claims",
+			name: "Username claims",
+			get: func(idp *cmv1.IdentityProvider) string {
+				return join(idp.OpenID().Claims().PreferredUsername())
+			},
+			set: func(patch *idpPatch, value string) error {
+				patch.openidClaims.PreferredUsername(list(value)...)
+				return nil
+			},
+		},
+		{
+			flag: "groups-claims",
+			name: "Groups claims",
+			get:  func(idp *cmv1.IdentityProvider) string { return join(idp.OpenID().Claims().Groups()) },
+			set: func(patch *idpPatch, value string) error {
+				patch.openidClaims.Groups(list(value)...)
+				return nil
+			},
+		},
+		{
+			flag: "extra-scopes",
+			name: "Extra scopes",
+			get:  func(idp *cmv1.IdentityProvider) string { return join(idp.OpenID().ExtraScopes()) },
+			set: func(patch *idpPatch, value string) error {
+				patch.openid.ExtraScopes(list(value)...)
+				return nil
+			},
+		},
+	},
+	cmv1.IdentityProviderTypeHtpasswd: {
+		mappingMethodSetting,
+	},
+}
+
+// EditIdentityProvider builds the patch that applies the given values, by flag name of 'rosa create
+// idp', to the identity provider, together with the list of changes. Values equal to the current ones are ignored.
+func EditIdentityProvider(idp *cmv1.IdentityProvider,
+	values map[string]string) (*cmv1.IdentityProvider, []Change, error) {
+	idpSettings := settings[idp.Type()]
+	for flag := range values {
+		if !slices.ContainsFunc(idpSettings, func(s setting) bool { return s.flag == flag }) {
+			return nil, nil, fmt.Errorf("the '--%s' flag doesn't apply to %s identity providers",
+				flag, ocm.IdentityProviderType(idp))
+		}
+	}
+	_, organizations := values["organizations"]
+	_, teams := values["teams"]
+	if organizations && teams {
+		return nil, nil, fmt.Errorf("GitHub IDP only allows either organizations or teams, but not both")
+	}
+
+	patch := &idpPatch{
+		github:       cmv1.NewGithubIdentityProvider(),
+		gitlab:       cmv1.NewGitlabIdentityProvider(),
+		google:       cmv1.NewGoogleIdentityProvider(),
+		ldap:         cmv1.NewLDAPIdentityProvider(),
+		ldapAttrs:    cmv1.NewLDAPAttributes().Copy(idp.LDAP().Attributes()),
+		openid:       cmv1.NewOpenIDIdentityProvider(),
+		openidClaims: cmv1.NewOpenIDClaims().Copy(idp.OpenID().Claims()),
+	}
+	changes := []Change{}
+	for _, s := range idpSettings {
+		value, ok := values[s.flag]
+		if !ok {
+			continue
+		}
+		current := s.get(idp)
+		if !s.secret && !s.file && strings.Join(list(value), ",") == current {
+			continue
+		}
+		err := s.set(patch, value)
+		if err != nil {
+			return nil, nil, err
+		}
+		change := Change{Setting: s.name, Current: current, New: value}
+		switch {
+		case s.secret:
+			change.Current = hiddenValue
+			change.New = hiddenValue + " (new)"
+		case s.file:
+			change.New = fmt.Sprintf("contents of '%s'", value)
+		}
+		changes = append(changes, change)
+		if organizations && s.flag == "organizations" && len(idp.Github().Teams()) > 0 {
+			changes = append(changes, Change{Setting: "Teams", Current: join(idp.Github().Teams())})
+		}
+		if teams && s.flag == "teams" && len(idp.Github().Organizations()) > 0 {
+			changes = append(changes, Change{Setting: "Organizations", Current: join(idp.Github().Organizations())})
+		}
+	}
+	if len(changes) == 0 {
+		return nil, nil, nil
+	}
+
+	builder := cmv1.NewIdentityProvider().Type(idp.Type())
+	if patch.mappingMethod != "" {
+		builder.MappingMethod(cmv1.IdentityProviderMappingMethod(patch.mappingMethod))
+	}
+	// Only the settings specific to the type are part of its sub-object:
+	typeChanged := slices.ContainsFunc(changes, func(c Change) bool {
+		return c.Setting != mappingMethodSetting.name
+	})
+	switch idp.Type() {
+	case cmv1.IdentityProviderTypeLDAP:
+		if hasAnyChange(changes, "ID attributes", "Username attributes", "Name attributes", "Email attributes") {
+			patch.ldap.Attributes(patch.ldapAttrs)
+		}
+	case cmv1.IdentityProviderTypeOpenID:
+		if hasAnyChange(changes, "Email claims", "Name claims", "Username claims", "Groups claims") {
+			patch.openid.Claims(patch.openidClaims)
+		}
+	}
+	if typeChanged {
+		switch idp.Type() {
+		case cmv1.IdentityProviderTypeGithub:
+			builder.Github(patch.github)
+		case cmv1.IdentityProviderTypeGitlab:
+			builder.Gitlab(patch.gitlab)
+		case cmv1.IdentityProviderTypeGoogle:
+			builder.Google(patch.google)
+		case cmv1.IdentityProviderTypeLDAP:
+			builder.LDAP(patch.ldap)
+		case cmv1.IdentityProviderTypeOpenID:
+			builder.OpenID(patch.openid)
+		}
+	}
+	result, err := builder.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, changes, nil
+}
+
+func hasAnyChange(changes []Change, settings ...string) bool {
+	return slices.ContainsFunc(changes, func(c Change) bool {
+		return slices.Contains(settings, c.Setting)
+	})
+}
+
+// DescribeIdentityProvider returns the details of an identity provider. Secrets aren't returned by
+// the API, so they are never shown.
+func DescribeIdentityProvider(idp *cmv1.IdentityProvider, authURL string, users []string) string {
+	lines := [][2]string{
+		{"ID", idp.ID()},
+		{"Name", idp.Name()},
+		{"Type", ocm.IdentityProviderType(idp)},
+	}
+	if authURL != "" {
+		lines = append(lines, [2]string{"Auth URL", authURL})
+	}
+	for _, s := range settings[idp.Type()] {
+		if s.secret {
+			continue
+		}
+		if value := s.get(idp); value != "" {
+			lines = append(lines, [2]string{s.name, strings.ReplaceAll(value, ",", ", ")})
+		}
+	}
+	if idp.Type() == cmv1.IdentityProviderTypeHtpasswd {
+		lines = append(lines, [2]string{"Users", strings.Join(users, ", ")})
+	}
+	result := "\n"
+	for _, line := range lines {
+		result += fmt.Sprintf("%-22s%s\n", line[0]+":", line[1])
+	}
+	return result
+}
@@ -0,0 +1,144 @@
+package idp_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/idp"
+)
+
+var _ = Describe("Settings", func() {
+	var github, ldap, openid *cmv1.IdentityProvider
+
+	BeforeEach(func() {
+		var err error
+		github, err = cmv1.NewIdentityProvider().ID("idp-1").Name("github-1").
+			Type(cmv1.IdentityProviderTypeGithub).MappingMethod(cmv1.IdentityProviderMappingMethodClaim).
+			Github(cmv1.NewGithubIdentityProvider().ClientID("client").Teams("org/team")).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		ldap, err = cmv1.NewIdentityProvider().ID("idp-2").Name("ldap-1").
+			Type(cmv1.IdentityProviderTypeLDAP).MappingMethod(cmv1.IdentityProviderMappingMethodClaim).
+			LDAP(cmv1.NewLDAPIdentityProvider().URL("ldap://example.com/ou=users").
+				Attributes(cmv1.NewLDAPAttributes().ID("dn").Email("mail"))).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		openid, err = cmv1.NewIdentityProvider().ID("idp-3").Name("openid-1").
+			Type(cmv1.IdentityProviderTypeOpenID).MappingMethod(cmv1.IdentityProviderMappingMethodClaim).
+			OpenID(cmv1.NewOpenIDIdentityProvider().ClientID("client").Issuer("https://example.com").
+				Claims(cmv1.NewOpenIDClaims().Email("email").Groups("groups"))).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Context("EditIdentityProvider", func() {
+		It("builds a patch with the changed settings of the type", func() {
+			patch, changes, err := idp.EditIdentityProvider(github, map[string]string{
+				"client-id":     "new",
+				"client-secret": "secret",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(patch.Type()).To(Equal(cmv1.IdentityProviderTypeGithub))
+			Expect(patch.MappingMethod()).To(BeEmpty())
+			Expect(patch.Github().ClientID()).To(Equal("new"))
+			Expect(patch.Github().ClientSecret()).To(Equal("secret"))
+			Expect(changes).To(Equal([]idp.Change{
+				{Setting: "Client ID", Current: "client", New: "new"},
+				{Setting: "Client secret", Current: "********", New: "******** (new)"},
+			}))
+		})
+
+		It("ignores the settings that don't change", func() {
+			patch, changes, err := idp.EditIdentityProvider(github, map[string]string{
+				"client-id":      "client",
+				"mapping-method": "lookup",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(patch.MappingMethod()).To(Equal(cmv1.IdentityProviderMappingMethodLookup))
+			_, ok := patch.GetGithub()
+			Expect(ok).To(BeFalse())
+			Expect(changes).To(HaveLen(1))
+
+			patch, changes, err = idp.EditIdentityProvider(github, map[string]string{"client-id": "client"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(patch).To(BeNil())
+			Expect(changes).To(BeEmpty())
+		})
+
+		It("replaces the teams with the organizations", func() {
+			patch, changes, err := idp.EditIdentityProvider(github, map[string]string{"organizations": "a, b"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(patch.Github().Organizations()).To(Equal([]string{"a", "b"}))
+			teams, ok := patch.Github().GetTeams()
+			Expect(ok).To(BeTrue())
+			Expect(teams).To(BeEmpty())
+			Expect(changes).To(Equal([]idp.Change{
+				{Setting: "Organizations", New: "a, b"},
+				{Setting: "Teams", Current: "org/team"},
+			}))
+		})
+
+		It("fails with both organizations and teams", func() {
+			_, _, err := idp.EditIdentityProvider(github, map[string]string{"organizations": "a", "teams": "a/b"})
+			Expect(err).To(MatchError("GitHub IDP only allows either organizations or teams, but not both"))
+		})
+
+		It("fails with a flag of a different type", func() {
+			_, _, err := idp.EditIdentityProvider(github, map[string]string{"bind-dn": "cn=admin"})
+			Expect(err).To(MatchError("the '--bind-dn' flag doesn't apply to GitHub identity providers"))
+		})
+
+		It("fails with an invalid value", func() {
+			_, _, err := idp.EditIdentityProvider(github, map[string]string{"mapping-method": "none"})
+			Expect(err).To(HaveOccurred())
+			_, _, err = idp.EditIdentityProvider(ldap, map[string]string{"url": "https://example.com"})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("keeps the other LDAP attributes", func() {
+			patch, _, err := idp.EditIdentityProvider(ldap, map[string]string{"name-attributes": "cn"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(patch.LDAP().Attributes().Name()).To(Equal([]string{"cn"}))
+			Expect(patch.LDAP().Attributes().ID()).To(Equal([]string{"dn"}))
+			Expect(patch.LDAP().Attributes().Email()).To(Equal([]string{"mail"}))
+			Expect(patch.LDAP().URL()).To(BeEmpty())
+		})
+
+		It("keeps the other OpenID claims", func() {
+			patch, _, err := idp.EditIdentityProvider(openid, map[string]string{"groups-claims": "groups,roles"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(patch.OpenID().Claims().Groups()).To(Equal([]string{"groups", "roles"}))
+			Expect(patch.OpenID().Claims().Email()).To(Equal([]string{"email"}))
+		})
+
+		It("reads the CA from a file", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "ca.pem")
+			Expect(os.WriteFile(path, []byte("certificate"), 0600)).To(Succeed())
+			patch, changes, err := idp.EditIdentityProvider(openid, map[string]string{"ca": path})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(patch.OpenID().CA()).To(Equal("certificate"))
+			Expect(changes[0].New).To(Equal("contents of '" + path + "'"))
+		})
+	})
+
+	Context("DescribeIdentityProvider", func() {
+		It("prints the settings without secrets", func() {
+			Expect(idp.DescribeIdentityProvider(openid, "https://oauth.example.com/oauth2callback/openid-1", nil)).
+				To(Equal(`
+ID:                   idp-3
+Name:                 openid-1
+Type:                 OpenID
+Auth URL:             https://oauth.example.com/oauth2callback/openid-1
+Mapping method:       claim
+Client ID:            client
+Issuer URL:           https://example.com
+Email claims:         email
+Groups claims:        groups
+`))
+		})
+	})
+})
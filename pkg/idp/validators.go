@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package idp
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/openshift/rosa/pkg/helper"
+	urlHelper "github.com/openshift/rosa/pkg/helper/url"
+)
+
+// ValidateGitlabHostURL checks that the value is a valid URL of a GitLab provider
+func ValidateGitlabHostURL(val interface{}) error {
+	gitlabURL := fmt.Sprintf("%v", val)
+	parsedIssuerURL, err := urlHelper.ParseRequestURI(gitlabURL)
+	if err != nil {
+		return fmt.Errorf("expected a valid GitLab provider URL: %s", err)
+	}
+	if parsedIssuerURL.Scheme != helper.ProtocolHttps {
+		return errors.New("expected GitLab provider URL to use an https:// scheme")
+	}
+	if parsedIssuerURL.RawQuery != "" {
+		return errors.New("GitLab provider URL must not have query parameters")
+	}
+	if parsedIssuerURL.Fragment != "" {
+		return errors.New("GitLab provider URL must not have a fragment")
+	}
+	return nil
+}
+
+// ValidateLdapURL checks that the value is a valid LDAP URL
+func ValidateLdapURL(val interface{}) error {
+	ldapURL := fmt.Sprintf("%v", val)
+	parsedLdapURL, err := urlHelper.ParseRequestURI(ldapURL)
+	if err != nil {
+		return fmt.Errorf("expected a valid LDAP URL: %v", err)
+	}
+	if parsedLdapURL.Scheme != "ldap" && parsedLdapURL.Scheme != "ldaps" {
+		return errors.New("expected LDAP URL to have an ldap:// or ldaps:// scheme")
+	}
+	return nil
+}
+
+// ValidateOpenidIssuerURL checks that the value is a valid URL of an OpenID issuer
+func ValidateOpenidIssuerURL(val interface{}) error {
+	issuerURL := fmt.Sprintf("%v", val)
+	parsedIssuerURL, err := urlHelper.ParseRequestURI(issuerURL)
+	if err != nil {
+		return fmt.Errorf("expected a valid OpenID issuer URL: %v", err)
+	}
+	if parsedIssuerURL.Scheme != helper.ProtocolHttps {
+		return errors.New("expected OpenID issuer URL to use an https:// scheme")
+	}
+	if parsedIssuerURL.RawQuery != "" {
+		return errors.New("OpenID issuer URL must not have query parameters")
+	}
+	if parsedIssuerURL.Fragment != "" {
+		return errors.New("OpenID issuer URL must not have a fragment")
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package idp_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift/rosa/pkg/idp"
+)
+
+var _ = Describe("Validators", func() {
+	Context("ValidateGitlabHostURL", func() {
+		It("accepts a valid HTTPS URL", func() {
+			err := idp.ValidateGitlabHostURL("https://gitlab.example.com")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("rejects a non-HTTPS URL", func() {
+			err := idp.ValidateGitlabHostURL("http://gitlab.example.com")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("https://"))
+		})
+
+		It("rejects a URL with query parameters", func() {
+			err := idp.ValidateGitlabHostURL("https://gitlab.example.com?foo=bar")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("query parameters"))
+		})
+
+		It("rejects an invalid URL", func() {
+			err := idp.ValidateGitlabHostURL("not-a-url")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("valid GitLab provider URL"))
+		})
+
+		It("rejects a URL with a fragment", func() {
+			err := idp.ValidateGitlabHostURL("https://gitlab.example.com#section")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("valid GitLab provider URL"))
+		})
+	})
+
+	Context("ValidateLdapURL", func() {
+		It("accepts an ldap:// URL", func() {
+			err := idp.ValidateLdapURL("ldap://ldap.example.com/ou=users,dc=example,dc=com?uid")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("accepts an ldaps:// URL", func() {
+			err := idp.ValidateLdapURL("ldaps://ldap.example.com/ou=users,dc=example,dc=com?uid")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("rejects a non-LDAP scheme", func() {
+			err := idp.ValidateLdapURL("https://ldap.example.com")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ldap://"))
+		})
+
+		It("rejects a bare URL with no scheme", func() {
+			err := idp.ValidateLdapURL("ldap.com")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("expected a valid LDAP URL"))
+		})
+	})
+
+	Context("ValidateOpenidIssuerURL", func() {
+		It("accepts a valid HTTPS URL", func() {
+			err := idp.ValidateOpenidIssuerURL("https://accounts.google.com")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("rejects a non-HTTPS URL", func() {
+			err := idp.ValidateOpenidIssuerURL("http://accounts.google.com")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("https://"))
+		})
+
+		It("rejects a URL with query parameters", func() {
+			err := idp.ValidateOpenidIssuerURL("https://accounts.google.com?foo=bar")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("query parameters"))
+		})
+
+		It("rejects an invalid URL", func() {
+			err := idp.ValidateOpenidIssuerURL("not-a-url")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("valid OpenID issuer URL"))
+		})
+
+		It("rejects a URL with a fragment", func() {
+			err := idp.ValidateOpenidIssuerURL("https://accounts.google.com#section")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("valid OpenID issuer URL"))
+		})
+	})
+})
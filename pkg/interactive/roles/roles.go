@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/output"
@@ -27,7 +28,7 @@ func GetInstallerRoleArn(r *rosa.Runtime, cmd *cobra.Command,
 	roleARNs, err := findRoleARNs(aws.InstallerAccountRole, minMinorVersion)
 	if err != nil {
 		r.Reporter.Errorf("Failed to find %s role: %s", role.Name, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	spin.Stop()
 
@@ -59,7 +60,7 @@ func GetInstallerRoleArn(r *rosa.Runtime, cmd *cobra.Command,
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid role ARN: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		}
 	} else if len(roleARNs) == 1 {
@@ -13,13 +13,14 @@ func PrintKubeletConfigsForTabularOutput(configs []*cmv1.KubeletConfig) string {
 	var output strings.Builder
 	output.WriteString("ID\tNAME\tPOD PIDS LIMIT\n")
 	for _, config := range configs {
-		fmt.Fprintf(&output, "%s\t%s\t%d\n", config.ID(), getName(config), config.PodPidsLimit())
+		fmt.Fprintf(&output, "%s\t%s\t%d\n", config.ID(), DisplayName(config), config.PodPidsLimit())
 	}
 
 	return output.String()
 }
 
-func getName(config *cmv1.KubeletConfig) string {
+// DisplayName returns the name of the KubeletConfig, or a dash when it doesn't have one.
+func DisplayName(config *cmv1.KubeletConfig) string {
 	if config.Name() == "" {
 		return emptyName
 	}
@@ -45,7 +46,7 @@ func PrintKubeletConfigForClassic(config *cmv1.KubeletConfig) string {
 		"Name:                                 %s\n"+
 		"Pod Pids Limit:                       %d\n",
 		config.ID(),
-		getName(config),
+		DisplayName(config),
 		config.PodPidsLimit(),
 	)
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"regexp"
 	"slices"
@@ -19,6 +20,9 @@ import (
 	"github.com/spf13/cobra"
 	errors "github.com/zgalor/weberr"
 
+	"github.com/openshift/rosa/pkg/clierror"
+	"github.com/openshift/rosa/pkg/defaults"
+	"github.com/openshift/rosa/pkg/estimate"
 	"github.com/openshift/rosa/pkg/fedramp"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/helper/features"
@@ -62,6 +66,8 @@ type MachinePoolService interface {
 	CreateMachinePoolBasedOnClusterType(r *rosa.Runtime, cmd *cobra.Command,
 		clusterKey string, cluster *cmv1.Cluster, clusterAutoscaler *cmv1.ClusterAutoscaler,
 		options *mpOpts.CreateMachinepoolUserOptions) error
+	ReplaceNodePool(r *rosa.Runtime, nodePoolID string, clusterKey string, cluster *cmv1.Cluster,
+		options *ReplaceNodePoolOptions) error
 }
 
 type machinePool struct {
@@ -503,6 +509,17 @@ func (m *machinePool) CreateMachinePool(r *rosa.Runtime, cmd *cobra.Command, clu
 		return fmt.Errorf("failed to create machine pool for cluster '%s': %v", clusterKey, err)
 	}
 
+	if args.DryRun {
+		err = estimate.Run(r, r.AWSClient, &instanceTypeList,
+			estimate.MachinePoolRequest(machinePool, defaultRootDiskSize))
+		if err != nil {
+			return err
+		}
+		r.Reporter.Infof("Creating machine pool '%s' should succeed. Run without the '--dry-run' flag to "+
+			"create the machine pool.", name)
+		return nil
+	}
+
 	createdMachinePool, err := r.OCMClient.CreateMachinePool(cluster.ID(), machinePool)
 	if err != nil {
 		return fmt.Errorf("failed to add machine pool to cluster '%s': %v", clusterKey, err)
@@ -1146,6 +1163,16 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 		return fmt.Errorf("failed to create machine pool for hosted cluster '%s': %v", clusterKey, err)
 	}
 
+	if args.DryRun {
+		err = estimate.Run(r, r.AWSClient, &instanceTypeList, estimate.NodePoolRequest(nodePool))
+		if err != nil {
+			return err
+		}
+		r.Reporter.Infof("Creating machine pool '%s' should succeed. Run without the '--dry-run' flag to "+
+			"create the machine pool.", name)
+		return nil
+	}
+
 	createdNodePool, err := r.OCMClient.CreateNodePool(cluster.ID(), nodePool)
 	if err != nil {
 		return fmt.Errorf("failed to add machine pool to hosted cluster '%s': %v", clusterKey, err)
@@ -1186,23 +1213,10 @@ func (m *machinePool) ListMachinePools(r *rosa.Runtime, clusterKey string, clust
 		}
 	}
 
-	if output.HasFlag() {
-		if isHypershift {
-			return output.Print(nodePools)
-		}
-		return output.Print(machinePools)
-	}
-
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-
-	finalStringToOutput := getMachinePoolsString(r, machinePools, args)
 	if isHypershift {
-		finalStringToOutput = getNodePoolsString(nodePools)
+		return nodePoolsTable.Print(nodePools)
 	}
-	fmt.Fprint(writer, finalStringToOutput)
-	writer.Flush()
-	return nil
+	return machinePoolsTable(r, machinePools, args).Print(machinePools)
 }
 
 // DescribeMachinePool describes either a machinepool, or, a nodepool (if hypershift)
@@ -1370,18 +1384,18 @@ func appendUpgradesIfExist(scheduledUpgrade *cmv1.NodePoolUpgradePolicy, output
 	return output
 }
 
-func getMachinePoolsString(
-	runtime *rosa.Runtime,
-	machinePools []*cmv1.MachinePool,
-	args ListMachinePoolArgs,
-) string {
-	type columnDefinition struct {
-		header      string
-		isVisible   bool
-		extractData func(*cmv1.MachinePool) string
-	}
+// machinePoolColumn describes one of the columns printed when listing the machine pools.
+type machinePoolColumn struct {
+	header      string
+	isVisible   bool
+	extractData func(*cmv1.MachinePool) string
+}
 
-	allColumnDefinitions := []columnDefinition{
+// machinePoolColumns returns the columns printed when listing the machine pools, visible when
+// they are printed by default or requested with the '--az-type', '--dedicated-host' or '--win-li'
+// flags.
+func machinePoolColumns(runtime *rosa.Runtime, args ListMachinePoolArgs) []machinePoolColumn {
+	return []machinePoolColumn{
 		{"ID", true, func(mp *cmv1.MachinePool) string { return mp.ID() }},
 		{"AUTOSCALING", true, func(mp *cmv1.MachinePool) string {
 			return ocmOutput.PrintMachinePoolAutoscaling(mp.Autoscaling())
@@ -1421,6 +1435,56 @@ func getMachinePoolsString(
 		{"DEDICATED HOST", args.ShowDedicated || args.ShowAll,
 			func(mp *cmv1.MachinePool) string { return isDedicatedHost(mp, runtime) }},
 	}
+}
+
+// machinePoolsTable returns the table used to list the machine pools. Columns that weren't requested
+// with the '--az-type', '--dedicated-host' or '--win-li' flags, or that are empty for all the machine
+// pools, are only printed with '--output wide'.
+func machinePoolsTable(
+	runtime *rosa.Runtime,
+	machinePools []*cmv1.MachinePool,
+	args ListMachinePoolArgs,
+) output.Table[*cmv1.MachinePool] {
+	table := output.Table[*cmv1.MachinePool]{
+		Default: func(w io.Writer, machinePools []*cmv1.MachinePool) error {
+			writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+			fmt.Fprint(writer, getMachinePoolsString(runtime, machinePools, args))
+			return writer.Flush()
+		},
+	}
+	for _, column := range machinePoolColumns(runtime, args) {
+		extractData := column.extractData
+		value := func(pool *cmv1.MachinePool) string {
+			if pool == nil {
+				return "-"
+			}
+			return extractData(pool)
+		}
+
+		hasNonEmptyValue := false
+		for _, pool := range machinePools {
+			if data := value(pool); data != "" && data != "-" {
+				hasNonEmptyValue = true
+				break
+			}
+		}
+
+		visible := column.isVisible && (args.ShowAll || hasNonEmptyValue || len(machinePools) == 0)
+		table.Columns = append(table.Columns, output.Column[*cmv1.MachinePool]{
+			Header: column.header,
+			Value:  value,
+			Wide:   !visible,
+		})
+	}
+	return table
+}
+
+func getMachinePoolsString(
+	runtime *rosa.Runtime,
+	machinePools []*cmv1.MachinePool,
+	args ListMachinePoolArgs,
+) string {
+	allColumnDefinitions := machinePoolColumns(runtime, args)
 
 	var visibleColumnHeaders []string
 	var visibleColumnData [][]string
@@ -1474,6 +1538,48 @@ func getMachinePoolsString(
 	return tableBuilder.String()
 }
 
+var nodePoolsTable = output.Table[*cmv1.NodePool]{
+	Columns: []output.Column[*cmv1.NodePool]{
+		{Header: "ID", Value: func(nodePool *cmv1.NodePool) string { return nodePool.ID() }},
+		{Header: "AUTOSCALING", Value: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintNodePoolAutoscaling(nodePool.Autoscaling())
+		}},
+		{Header: "REPLICAS", Value: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintNodePoolReplicasShort(
+				ocmOutput.PrintNodePoolCurrentReplicas(nodePool.Status()),
+				ocmOutput.PrintNodePoolReplicasInline(nodePool.Autoscaling(), nodePool.Replicas()),
+			)
+		}},
+		{Header: "INSTANCE TYPE", Value: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintNodePoolInstanceType(nodePool.AWSNodePool())
+		}},
+		{Header: "LABELS", Value: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintLabels(nodePool.Labels())
+		}},
+		{Header: "TAINTS", Value: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintTaints(nodePool.Taints())
+		}},
+		{Header: "AVAILABILITY ZONE", Value: func(nodePool *cmv1.NodePool) string {
+			return nodePool.AvailabilityZone()
+		}},
+		{Header: "SUBNET", Value: func(nodePool *cmv1.NodePool) string { return nodePool.Subnet() }},
+		{Header: "DISK SIZE", Value: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintNodePoolDiskSize(nodePool.AWSNodePool())
+		}},
+		{Header: "VERSION", Value: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintNodePoolVersion(nodePool.Version())
+		}},
+		{Header: "AUTOREPAIR", Value: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintNodePoolAutorepair(nodePool.AutoRepair())
+		}},
+	},
+	Default: func(w io.Writer, nodePools []*cmv1.NodePool) error {
+		writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprint(writer, getNodePoolsString(nodePools))
+		return writer.Flush()
+	},
+}
+
 func getNodePoolsString(nodePools []*cmv1.NodePool) string {
 	outputString := "ID\tAUTOSCALING\tREPLICAS\t" +
 		"INSTANCE TYPE\tLABELS\t\tTAINTS\t\tAVAILABILITY ZONE\tSUBNET\tDISK SIZE\tVERSION\tAUTOREPAIR\t\n"
@@ -1964,7 +2070,7 @@ func editNodePool(cmd *cobra.Command, nodePoolID string,
 		err = ValidateKubeletConfig(inputKubeletConfig)
 		if err != nil {
 			r.Reporter.Errorf(err.Error())
-			os.Exit(1)
+			os.Exit(clierror.Validation.ExitCode())
 		}
 		npBuilder.KubeletConfigs(inputKubeletConfig...)
 		isKubeletConfigSet = true
@@ -2023,7 +2129,7 @@ func editNodePool(cmd *cobra.Command, nodePoolID string,
 			})
 			if err != nil {
 				r.Reporter.Errorf("expected a valid value for max surge: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 
 			maxUnavailable, err = interactive.GetString(interactive.Input{
@@ -2037,7 +2143,7 @@ func editNodePool(cmd *cobra.Command, nodePoolID string,
 			})
 			if err != nil {
 				r.Reporter.Errorf("expected a valid value for max unavailable: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		}
 
@@ -2365,10 +2471,10 @@ func editAutoscaling(nodePool *cmv1.NodePool, minReplicas int, maxReplicas int,
 func manageReplicas(cmd *cobra.Command, args *mpOpts.CreateMachinepoolUserOptions,
 	replicaSizeValidation *ReplicaSizeValidation) (
 	minReplicas, maxReplicas, replicas int, autoscaling bool, err error) {
-	isMinReplicasSet := cmd.Flags().Changed("min-replicas")
-	isMaxReplicasSet := cmd.Flags().Changed("max-replicas")
-	isAutoscalingSet := cmd.Flags().Changed("enable-autoscaling")
-	isReplicasSet := cmd.Flags().Changed("replicas")
+	isMinReplicasSet := defaults.Changed(cmd.Flags(), "min-replicas")
+	isMaxReplicasSet := defaults.Changed(cmd.Flags(), "max-replicas")
+	isAutoscalingSet := defaults.Changed(cmd.Flags(), "enable-autoscaling")
+	isReplicasSet := defaults.Changed(cmd.Flags(), "replicas")
 
 	minReplicas = args.MinReplicas
 	maxReplicas = args.MaxReplicas
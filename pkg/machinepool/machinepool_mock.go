@@ -111,3 +111,17 @@ func (mr *MockMachinePoolServiceMockRecorder) ListMachinePools(r, clusterKey, cl
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMachinePools", reflect.TypeOf((*MockMachinePoolService)(nil).ListMachinePools), r, clusterKey, cluster, args)
 }
+
+// ReplaceNodePool mocks base method.
+func (m *MockMachinePoolService) ReplaceNodePool(r *rosa.Runtime, nodePoolID, clusterKey string, cluster *v1.Cluster, options *ReplaceNodePoolOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceNodePool", r, nodePoolID, clusterKey, cluster, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceNodePool indicates an expected call of ReplaceNodePool.
+func (mr *MockMachinePoolServiceMockRecorder) ReplaceNodePool(r, nodePoolID, clusterKey, cluster, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceNodePool", reflect.TypeOf((*MockMachinePoolService)(nil).ReplaceNodePool), r, nodePoolID, clusterKey, cluster, options)
+}
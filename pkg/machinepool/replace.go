@@ -0,0 +1,300 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	diskValidator "github.com/openshift-online/ocm-common/pkg/machinepool/validations"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/aws/tags"
+	mpHelpers "github.com/openshift/rosa/pkg/helper/machinepools"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/wait"
+)
+
+// NodePoolOverrides are the attributes that the replacement of a node pool changes. Empty values
+// keep the attributes of the replaced node pool.
+type NodePoolOverrides struct {
+	InstanceType     string
+	RootDiskSize     int
+	Subnet           string
+	SecurityGroupIDs []string
+	ImageType        string
+}
+
+// Validate checks the values of the overrides that can be checked without calling the API.
+func (o *NodePoolOverrides) Validate() error {
+	if o.RootDiskSize != 0 {
+		err := diskValidator.ValidateNodePoolRootDiskSize(o.RootDiskSize)
+		if err != nil {
+			return err
+		}
+	}
+	if o.ImageType != "" && !mpHelpers.IsValidImageType(o.ImageType) {
+		return fmt.Errorf("invalid image type: '%s' - please use one of: %v", o.ImageType, prettyPrintImageTypes())
+	}
+	return nil
+}
+
+// ReplaceNodePoolOptions configure the replacement of a node pool.
+type ReplaceNodePoolOptions struct {
+	// ID is the identifier of the new node pool. When empty one is derived from the replaced one.
+	ID        string
+	Overrides NodePoolOverrides
+	Interval  time.Duration
+	Timeout   time.Duration
+	// NoRollback keeps the new node pool when it doesn't become ready, so that it can be inspected.
+	NoRollback bool
+}
+
+// CloneNodePool returns a node pool with the identifier and the attributes of the given one, except
+// for the overridden ones. The replicas and the autoscaling aren't copied, and neither are the
+// attributes computed by the service, like the availability zone, the instance profile or the
+// 'red-hat-' tags.
+func CloneNodePool(nodePool *cmv1.NodePool, id string, overrides *NodePoolOverrides) *cmv1.NodePoolBuilder {
+	source := nodePool.AWSNodePool()
+	awsNodePool := cmv1.NewAWSNodePool().
+		InstanceType(source.InstanceType()).
+		AdditionalSecurityGroupIds(source.AdditionalSecurityGroupIds()...)
+	// The tags managed by the service are added again to the new node pool by the service itself:
+	if userTags := tags.UserTags(source.Tags()); userTags != nil {
+		awsNodePool.Tags(userTags)
+	}
+	if value, ok := source.GetEc2MetadataHttpTokens(); ok {
+		awsNodePool.Ec2MetadataHttpTokens(value)
+	}
+	if value, ok := source.GetCapacityReservation(); ok {
+		awsNodePool.CapacityReservation(cmv1.NewAWSCapacityReservation().Copy(value))
+	}
+	if size := source.RootVolume().Size(); size != 0 {
+		awsNodePool.RootVolume(cmv1.NewAWSVolume().Size(size))
+	}
+
+	builder := cmv1.NewNodePool().
+		ID(id).
+		Subnet(nodePool.Subnet()).
+		AutoRepair(nodePool.AutoRepair()).
+		Labels(nodePool.Labels()).
+		TuningConfigs(nodePool.TuningConfigs()...).
+		KubeletConfigs(nodePool.KubeletConfigs()...)
+	taints := []*cmv1.TaintBuilder{}
+	for _, taint := range nodePool.Taints() {
+		taints = append(taints, cmv1.NewTaint().Copy(taint))
+	}
+	builder.Taints(taints...)
+	if value, ok := nodePool.GetNodeDrainGracePeriod(); ok {
+		builder.NodeDrainGracePeriod(cmv1.NewValue().Copy(value))
+	}
+	if value, ok := nodePool.GetManagementUpgrade(); ok {
+		builder.ManagementUpgrade(cmv1.NewNodePoolManagementUpgrade().Copy(value))
+	}
+	if value, ok := nodePool.GetVersion(); ok {
+		builder.Version(cmv1.NewVersion().ID(value.ID()))
+	}
+	if value, ok := nodePool.GetImageType(); ok {
+		builder.ImageType(value)
+	}
+
+	if overrides.InstanceType != "" {
+		awsNodePool.InstanceType(overrides.InstanceType)
+	}
+	if overrides.RootDiskSize != 0 {
+		awsNodePool.RootVolume(cmv1.NewAWSVolume().Size(overrides.RootDiskSize))
+	}
+	if overrides.SecurityGroupIDs != nil {
+		awsNodePool.AdditionalSecurityGroupIds(overrides.SecurityGroupIDs...)
+	}
+	if overrides.Subnet != "" {
+		builder.Subnet(overrides.Subnet)
+	}
+	if overrides.ImageType != "" {
+		builder.ImageType(cmv1.ImageType(overrides.ImageType))
+	}
+	return builder.AWSNodePool(awsNodePool)
+}
+
+var idSuffixRE = regexp.MustCompile(`^(.*)-(\d+)$`)
+
+// replacementID returns the identifier of the node pool that replaces the given one, incrementing its
+// numeric suffix, or adding one, until it doesn't match any of the existing node pools.
+func replacementID(id string, nodePools []*cmv1.NodePool) string {
+	base, number := id, 0
+	if matches := idSuffixRE.FindStringSubmatch(id); matches != nil {
+		base = matches[1]
+		number, _ = strconv.Atoi(matches[2])
+	}
+	existing := map[string]bool{}
+	for _, nodePool := range nodePools {
+		existing[nodePool.ID()] = true
+	}
+	for {
+		number++
+		candidate := fmt.Sprintf("%s-%d", base, number)
+		if !existing[candidate] {
+			return candidate
+		}
+	}
+}
+
+// ReplaceNodePool replaces a node pool of a hosted cluster with a new one that has the same
+// attributes except for the overridden ones, which can't be edited in place. The new node pool is
+// created with the current replicas of the old one, and once they are ready the autoscaling bounds
+// of the old node pool are moved to it and the old node pool is deleted. If the new node pool
+// doesn't become ready it is deleted, leaving the old one untouched.
+func (m *machinePool) ReplaceNodePool(r *rosa.Runtime, nodePoolID string, clusterKey string,
+	cluster *cmv1.Cluster, options *ReplaceNodePoolOptions) error {
+	if !cluster.Hypershift().Enabled() {
+		return fmt.Errorf("replacing machine pools is only supported for Hosted Control Plane clusters")
+	}
+	err := options.Overrides.Validate()
+	if err != nil {
+		return err
+	}
+
+	r.Reporter.Debugf("Loading machine pools for hosted cluster '%s'", clusterKey)
+	nodePools, err := r.OCMClient.GetNodePools(cluster.ID())
+	if err != nil {
+		return fmt.Errorf("failed to get machine pools for hosted cluster '%s': %v", clusterKey, err)
+	}
+	var oldNodePool *cmv1.NodePool
+	for _, nodePool := range nodePools {
+		if nodePool.ID() == nodePoolID {
+			oldNodePool = nodePool
+		}
+	}
+	if oldNodePool == nil {
+		return fmt.Errorf("machine pool '%s' does not exist for hosted cluster '%s'", nodePoolID, clusterKey)
+	}
+	newID := options.ID
+	if newID == "" {
+		newID = replacementID(nodePoolID, nodePools)
+	}
+	if !MachinePoolKeyRE.MatchString(newID) {
+		return fmt.Errorf("expected a valid identifier for the new machine pool, got '%s'", newID)
+	}
+	for _, nodePool := range nodePools {
+		if nodePool.ID() == newID {
+			return fmt.Errorf("machine pool '%s' already exists for hosted cluster '%s'", newID, clusterKey)
+		}
+	}
+
+	// The new node pool starts with the replicas that the old one has now, so that the workloads
+	// have room to move before the old nodes are drained:
+	replicas := oldNodePool.Replicas()
+	autoscaling, isAutoscaling := oldNodePool.GetAutoscaling()
+	if isAutoscaling {
+		replicas = max(oldNodePool.Status().CurrentReplicas(), autoscaling.MinReplica())
+	}
+
+	if !confirm.Confirm("replace machine pool '%s' with machine pool '%s' on hosted cluster '%s'",
+		nodePoolID, newID, clusterKey) {
+		return nil
+	}
+
+	newNodePool, err := CloneNodePool(oldNodePool, newID, &options.Overrides).Replicas(replicas).Build()
+	if err != nil {
+		return fmt.Errorf("failed to build machine pool '%s': %v", newID, err)
+	}
+	r.Reporter.Infof("Creating machine pool '%s' with %d replicas on hosted cluster '%s'", newID, replicas,
+		clusterKey)
+	_, err = r.OCMClient.CreateNodePool(cluster.ID(), newNodePool)
+	if err != nil {
+		return fmt.Errorf("failed to create machine pool '%s' on hosted cluster '%s': %v", newID, clusterKey, err)
+	}
+
+	waiter := wait.NewWaiter(r.Reporter, &wait.Options{Interval: options.Interval, Timeout: options.Timeout},
+		fmt.Sprintf("machine pool '%s' to be ready", newID), nodePoolReady, describeReplicas(newID))
+	_, err = r.OCMClient.PollNodePool(cluster.ID(), newID, options.Interval, options.Timeout, waiter.Done)
+	err = waiter.Result(err)
+	if err != nil {
+		return rollbackNodePool(r, cluster, clusterKey, newID, options, err)
+	}
+
+	if isAutoscaling {
+		r.Reporter.Infof("Moving the autoscaling bounds %d-%d to machine pool '%s'",
+			autoscaling.MinReplica(), autoscaling.MaxReplica(), newID)
+		update, err := cmv1.NewNodePool().
+			ID(newID).
+			Autoscaling(cmv1.NewNodePoolAutoscaling().
+				MinReplica(autoscaling.MinReplica()).
+				MaxReplica(autoscaling.MaxReplica())).
+			Build()
+		if err == nil {
+			_, err = r.OCMClient.UpdateNodePool(cluster.ID(), update)
+		}
+		if err != nil {
+			return rollbackNodePool(r, cluster, clusterKey, newID, options,
+				fmt.Errorf("failed to enable autoscaling: %v", err))
+		}
+	}
+
+	r.Reporter.Infof("Deleting machine pool '%s' from hosted cluster '%s'", nodePoolID, clusterKey)
+	err = r.OCMClient.DeleteNodePool(cluster.ID(), nodePoolID)
+	if err != nil {
+		return fmt.Errorf("machine pool '%s' is ready, but failed to delete machine pool '%s' from hosted "+
+			"cluster '%s', delete it with 'rosa delete machinepool': %v", newID, nodePoolID, clusterKey, err)
+	}
+	r.Reporter.Infof("Successfully replaced machine pool '%s' with machine pool '%s' on hosted cluster '%s'",
+		nodePoolID, newID, clusterKey)
+	return nil
+}
+
+// rollbackNodePool deletes the new node pool of a replacement that failed, unless the rollback was
+// disabled.
+func rollbackNodePool(r *rosa.Runtime, cluster *cmv1.Cluster, clusterKey string, newID string,
+	options *ReplaceNodePoolOptions, cause error) error {
+	if options.NoRollback {
+		return fmt.Errorf("%v. Machine pool '%s' was kept because rollback is disabled", cause, newID)
+	}
+	r.Reporter.Warnf("Rolling back, deleting machine pool '%s' from hosted cluster '%s'", newID, clusterKey)
+	err := r.OCMClient.DeleteNodePool(cluster.ID(), newID)
+	if err != nil {
+		return fmt.Errorf("%v. Failed to roll back, delete machine pool '%s' with "+
+			"'rosa delete machinepool': %v", cause, newID, err)
+	}
+	return fmt.Errorf("%v. Machine pool '%s' was deleted and the original machine pool is unchanged",
+		cause, newID)
+}
+
+// nodePoolReady checks that the new node pool has all its replicas. It only has a fixed number of
+// replicas while it's being replaced.
+func nodePoolReady(nodePool *cmv1.NodePool) (bool, error) {
+	if nodePool == nil {
+		return false, fmt.Errorf("the machine pool has been deleted")
+	}
+	return nodePool.Status().CurrentReplicas() == nodePool.Replicas(), nil
+}
+
+func describeReplicas(nodePoolID string) func(*cmv1.NodePool) string {
+	return func(nodePool *cmv1.NodePool) string {
+		if nodePool == nil {
+			return fmt.Sprintf("Machine pool '%s' doesn't exist", nodePoolID)
+		}
+		status := fmt.Sprintf("Machine pool '%s' has %d of %d replicas",
+			nodePoolID, nodePool.Status().CurrentReplicas(), nodePool.Replicas())
+		if message := nodePool.Status().Message(); message != "" {
+			status = fmt.Sprintf("%s: %s", status, message)
+		}
+		return status
+	}
+}
@@ -0,0 +1,182 @@
+package machinepool
+
+import (
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/pflag"
+
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Replace node pool", func() {
+	nodePoolsPath := "/api/clusters_mgmt/v1/clusters/" + test.MockClusterID + "/node_pools"
+
+	oldNodePool := test.MockNodePool(func(n *cmv1.NodePoolBuilder) {
+		n.ID("workers").
+			Subnet("subnet-a").
+			Labels(map[string]string{"team": "a"}).
+			Taints(cmv1.NewTaint().Key("dedicated").Value("a").Effect("NoSchedule")).
+			Version(cmv1.NewVersion().ID("openshift-v4.18.1")).
+			Autoscaling(cmv1.NewNodePoolAutoscaling().MinReplica(2).MaxReplica(6)).
+			Status(cmv1.NewNodePoolStatus().CurrentReplicas(3)).
+			AWSNodePool(cmv1.NewAWSNodePool().
+				InstanceType("m5.xlarge").
+				InstanceProfile("profile").
+				AdditionalSecurityGroupIds("sg-1").
+				Tags(map[string]string{"red-hat-managed": "true", "team": "a"}).
+				RootVolume(cmv1.NewAWSVolume().Size(300)))
+	})
+
+	It("Clones the node pool with the overrides", func() {
+		nodePool, err := CloneNodePool(oldNodePool, "workers-1", &NodePoolOverrides{
+			InstanceType:     "m6i.2xlarge",
+			SecurityGroupIDs: []string{"sg-2", "sg-3"},
+		}).Build()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nodePool.ID()).To(Equal("workers-1"))
+		Expect(nodePool.Subnet()).To(Equal("subnet-a"))
+		Expect(nodePool.Labels()).To(Equal(map[string]string{"team": "a"}))
+		Expect(nodePool.Taints()).To(HaveLen(1))
+		Expect(nodePool.Version().ID()).To(Equal("openshift-v4.18.1"))
+		Expect(nodePool.AWSNodePool().InstanceType()).To(Equal("m6i.2xlarge"))
+		Expect(nodePool.AWSNodePool().AdditionalSecurityGroupIds()).To(Equal([]string{"sg-2", "sg-3"}))
+		Expect(nodePool.AWSNodePool().RootVolume().Size()).To(Equal(300))
+		Expect(nodePool.AWSNodePool().InstanceProfile()).To(BeEmpty())
+		Expect(nodePool.AWSNodePool().Tags()).To(Equal(map[string]string{"team": "a"}))
+		_, ok := nodePool.GetAutoscaling()
+		Expect(ok).To(BeFalse())
+		_, ok = nodePool.GetStatus()
+		Expect(ok).To(BeFalse())
+	})
+
+	DescribeTable("Derives the identifier of the new node pool",
+		func(id string, existing []string, expected string) {
+			nodePools := []*cmv1.NodePool{}
+			for _, existingID := range existing {
+				nodePools = append(nodePools, test.MockNodePool(func(n *cmv1.NodePoolBuilder) { n.ID(existingID) }))
+			}
+			Expect(replacementID(id, nodePools)).To(Equal(expected))
+		},
+		Entry("without suffix", "workers", []string{"workers"}, "workers-1"),
+		Entry("with suffix", "workers-1", []string{"workers-1"}, "workers-2"),
+		Entry("skipping existing", "workers", []string{"workers", "workers-1"}, "workers-2"),
+	)
+
+	It("Rejects invalid overrides", func() {
+		overrides := &NodePoolOverrides{ImageType: "Unknown"}
+		Expect(overrides.Validate()).To(MatchError(ContainSubstring("invalid image type: 'Unknown'")))
+	})
+
+	Context("ReplaceNodePool", func() {
+		var t *test.TestingRuntime
+		var cluster *cmv1.Cluster
+		var options *ReplaceNodePoolOptions
+
+		BeforeEach(func() {
+			t = test.NewTestRuntime()
+			cluster = test.MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.Hypershift(cmv1.NewHypershift().Enabled(true))
+			})
+			options = &ReplaceNodePoolOptions{
+				Overrides: NodePoolOverrides{InstanceType: "m6i.2xlarge"},
+				Interval:  10 * time.Millisecond,
+				Timeout:   time.Second,
+			}
+			flags := pflag.NewFlagSet("replace", pflag.ContinueOnError)
+			confirm.AddFlag(flags)
+			Expect(flags.Set("yes", "true")).To(Succeed())
+			DeferCleanup(flags.Set, "yes", "false")
+		})
+
+		newNodePool := func(current int) string {
+			return test.FormatResource(test.MockNodePool(func(n *cmv1.NodePoolBuilder) {
+				n.ID("workers-1").Replicas(3).Status(cmv1.NewNodePoolStatus().CurrentReplicas(current))
+			}))
+		}
+
+		It("Creates the new node pool, moves the autoscaling and deletes the old one", func() {
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, test.FormatNodePoolList([]*cmv1.NodePool{oldNodePool})),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, nodePoolsPath),
+					func(_ http.ResponseWriter, request *http.Request) {
+						nodePool, err := cmv1.UnmarshalNodePool(request.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(nodePool.ID()).To(Equal("workers-1"))
+						Expect(nodePool.Replicas()).To(Equal(3))
+						Expect(nodePool.AWSNodePool().InstanceType()).To(Equal("m6i.2xlarge"))
+					},
+					RespondWithJSON(http.StatusCreated, newNodePool(0)),
+				),
+				RespondWithJSON(http.StatusOK, newNodePool(3)),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPatch, nodePoolsPath+"/workers-1"),
+					ghttp.VerifyJSON(`{"kind": "NodePool", "id": "workers-1",
+						"autoscaling": {"kind": "NodePoolAutoscaling", "min_replica": 2, "max_replica": 6}}`),
+					RespondWithJSON(http.StatusOK, newNodePool(3)),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodDelete, nodePoolsPath+"/workers"),
+					RespondWithJSON(http.StatusNoContent, ""),
+				),
+			)
+
+			err := NewMachinePoolService().ReplaceNodePool(t.RosaRuntime, "workers", "cluster", cluster, options)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(5))
+		})
+
+		It("Deletes the new node pool when it doesn't become ready", func() {
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, test.FormatNodePoolList([]*cmv1.NodePool{oldNodePool})),
+				RespondWithJSON(http.StatusCreated, newNodePool(0)),
+				RespondWithJSON(http.StatusNotFound, "{}"),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodDelete, nodePoolsPath+"/workers-1"),
+					RespondWithJSON(http.StatusNoContent, ""),
+				),
+			)
+
+			err := NewMachinePoolService().ReplaceNodePool(t.RosaRuntime, "workers", "cluster", cluster, options)
+			Expect(err).To(MatchError(ContainSubstring("the machine pool has been deleted")))
+			Expect(err).To(MatchError(ContainSubstring("the original machine pool is unchanged")))
+			Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(4))
+		})
+
+		It("Keeps the new node pool when rollback is disabled", func() {
+			options.NoRollback = true
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, test.FormatNodePoolList([]*cmv1.NodePool{oldNodePool})),
+				RespondWithJSON(http.StatusCreated, newNodePool(0)),
+				RespondWithJSON(http.StatusNotFound, "{}"),
+			)
+
+			err := NewMachinePoolService().ReplaceNodePool(t.RosaRuntime, "workers", "cluster", cluster, options)
+			Expect(err).To(MatchError(ContainSubstring("was kept because rollback is disabled")))
+			Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(3))
+		})
+
+		It("Fails for classic clusters", func() {
+			err := NewMachinePoolService().ReplaceNodePool(t.RosaRuntime, "workers", "cluster",
+				test.MockCluster(nil), options)
+			Expect(err).To(MatchError(ContainSubstring("only supported for Hosted Control Plane clusters")))
+		})
+
+		It("Fails when the new node pool already exists", func() {
+			options.ID = "workers"
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, test.FormatNodePoolList([]*cmv1.NodePool{oldNodePool})),
+			)
+
+			err := NewMachinePoolService().ReplaceNodePool(t.RosaRuntime, "workers", "cluster", cluster, options)
+			Expect(err).To(MatchError("machine pool 'workers' already exists for hosted cluster 'cluster'"))
+		})
+	})
+})
@@ -0,0 +1,510 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift/rosa/pkg/aws/tags"
+	mpHelpers "github.com/openshift/rosa/pkg/helper/machinepools"
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+const (
+	// SpecAPIVersion is the only version of the machine pool spec file format currently understood
+	SpecAPIVersion = "rosa.openshift.io/v1alpha1"
+	// SpecKind identifies a machine pool spec file describing a single machine pool
+	SpecKind = "MachinePool"
+	// SpecListKind identifies a machine pool spec file describing several machine pools
+	SpecListKind = "MachinePoolList"
+)
+
+// defaultMachinePoolRE matches the names of the machine pools created together with the cluster,
+// 'worker' in classic clusters and 'workers' or 'workers-N' in Hosted Control Plane clusters
+var defaultMachinePoolRE = regexp.MustCompile(`^(worker|workers(-[0-9]+)?)$`)
+
+// MachinePoolFile is the top-level document of a machine pool spec file. Documents of kind
+// 'MachinePool' hold a single spec, documents of kind 'MachinePoolList' hold a list of items.
+type MachinePoolFile struct {
+	APIVersion string             `json:"apiVersion"`
+	Kind       string             `json:"kind"`
+	Spec       *MachinePoolSpec   `json:"spec,omitempty"`
+	Items      []*MachinePoolSpec `json:"items,omitempty"`
+}
+
+// MachinePoolSpec holds the user facing configuration of a machine pool. The same spec describes
+// machine pools of classic clusters and node pools of Hosted Control Plane clusters, fields that
+// only apply to one of them are rejected for the other one.
+type MachinePoolSpec struct {
+	Name             string            `json:"name"`
+	InstanceType     string            `json:"instanceType,omitempty"`
+	Replicas         *int              `json:"replicas,omitempty"`
+	Autoscaling      *AutoscalingSpec  `json:"autoscaling,omitempty"`
+	Labels           map[string]string `json:"labels,omitempty"`
+	Taints           []TaintSpec       `json:"taints,omitempty"`
+	DiskSize         string            `json:"diskSize,omitempty"`
+	SecurityGroupIDs []string          `json:"securityGroupIDs,omitempty"`
+	Subnet           string            `json:"subnet,omitempty"`
+	Tags             map[string]string `json:"tags,omitempty"`
+
+	// Classic clusters only
+	MultiAZ          *bool  `json:"multiAZ,omitempty"`
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+	UseSpotInstances bool   `json:"useSpotInstances,omitempty"`
+	SpotMaxPrice     string `json:"spotMaxPrice,omitempty"`
+
+	// Hosted Control Plane clusters only
+	Version                       string   `json:"version,omitempty"`
+	Autorepair                    *bool    `json:"autorepair,omitempty"`
+	TuningConfigs                 []string `json:"tuningConfigs,omitempty"`
+	KubeletConfigs                []string `json:"kubeletConfigs,omitempty"`
+	NodeDrainGracePeriod          string   `json:"nodeDrainGracePeriod,omitempty"`
+	MaxSurge                      string   `json:"maxSurge,omitempty"`
+	MaxUnavailable                string   `json:"maxUnavailable,omitempty"`
+	ImageType                     string   `json:"imageType,omitempty"`
+	Ec2MetadataHttpTokens         string   `json:"ec2MetadataHttpTokens,omitempty"`
+	CapacityReservationID         string   `json:"capacityReservationID,omitempty"`
+	CapacityReservationPreference string   `json:"capacityReservationPreference,omitempty"`
+}
+
+// AutoscalingSpec holds the replica bounds of an autoscaling machine pool
+type AutoscalingSpec struct {
+	MinReplicas int `json:"minReplicas"`
+	MaxReplicas int `json:"maxReplicas"`
+}
+
+// TaintSpec holds a single taint of the nodes of a machine pool
+type TaintSpec struct {
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+	Effect string `json:"effect"`
+}
+
+// NewMachinePoolListFile returns a machine pool spec document holding the given specs
+func NewMachinePoolListFile(specs []*MachinePoolSpec) *MachinePoolFile {
+	return &MachinePoolFile{
+		APIVersion: SpecAPIVersion,
+		Kind:       SpecListKind,
+		Items:      specs,
+	}
+}
+
+// LoadMachinePoolFile reads and validates a machine pool spec file. Both YAML and JSON documents
+// are accepted.
+func LoadMachinePoolFile(path string) (*MachinePoolFile, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading machine pool spec file '%s': %w", path, err)
+	}
+	return ParseMachinePoolFile(content)
+}
+
+// ParseMachinePoolFile decodes and validates the content of a machine pool spec file
+func ParseMachinePoolFile(content []byte) (*MachinePoolFile, error) {
+	file := &MachinePoolFile{}
+	err := yaml.UnmarshalStrict(content, file)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing machine pool spec file: %w", err)
+	}
+	err = file.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// Pools returns the machine pool specs of the document regardless of its kind
+func (f *MachinePoolFile) Pools() []*MachinePoolSpec {
+	if f.Kind == SpecKind {
+		return []*MachinePoolSpec{f.Spec}
+	}
+	return f.Items
+}
+
+// Validate checks the type information and every machine pool spec of the document
+func (f *MachinePoolFile) Validate() error {
+	if f.APIVersion != SpecAPIVersion {
+		return fmt.Errorf("unsupported machine pool spec apiVersion '%s', expected '%s'",
+			f.APIVersion, SpecAPIVersion)
+	}
+	switch f.Kind {
+	case SpecKind:
+		if f.Spec == nil {
+			return fmt.Errorf("machine pool spec file of kind '%s' requires 'spec'", SpecKind)
+		}
+		if len(f.Items) > 0 {
+			return fmt.Errorf("'items' can only be set in machine pool spec files of kind '%s'", SpecListKind)
+		}
+	case SpecListKind:
+		if len(f.Items) == 0 {
+			return fmt.Errorf("machine pool spec file of kind '%s' requires at least one item", SpecListKind)
+		}
+		if f.Spec != nil {
+			return fmt.Errorf("'spec' can only be set in machine pool spec files of kind '%s'", SpecKind)
+		}
+	default:
+		return fmt.Errorf("unsupported machine pool spec kind '%s', expected '%s' or '%s'",
+			f.Kind, SpecKind, SpecListKind)
+	}
+	names := map[string]bool{}
+	for _, spec := range f.Pools() {
+		if spec == nil {
+			return fmt.Errorf("machine pool spec file contains an empty item")
+		}
+		err := spec.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid machine pool '%s': %w", spec.Name, err)
+		}
+		if names[spec.Name] {
+			return fmt.Errorf("machine pool '%s' is defined more than once", spec.Name)
+		}
+		names[spec.Name] = true
+	}
+	return nil
+}
+
+// Marshal encodes the machine pool spec document as YAML
+func (f *MachinePoolFile) Marshal() ([]byte, error) {
+	return yaml.Marshal(f)
+}
+
+// Validate checks the values of the spec that can be checked without knowing the cluster
+func (s *MachinePoolSpec) Validate() error {
+	if !MachinePoolKeyRE.MatchString(s.Name) {
+		return fmt.Errorf("expected a valid name for the machine pool")
+	}
+	if s.Replicas != nil && *s.Replicas < 0 {
+		return fmt.Errorf("'replicas' must be a non-negative integer")
+	}
+	if s.Autoscaling != nil {
+		if s.Replicas != nil {
+			return fmt.Errorf("'replicas' cannot be set together with 'autoscaling'")
+		}
+		if s.Autoscaling.MinReplicas < 0 {
+			return fmt.Errorf("'autoscaling.minReplicas' must be a non-negative integer")
+		}
+		if s.Autoscaling.MinReplicas > s.Autoscaling.MaxReplicas {
+			return fmt.Errorf("'autoscaling.minReplicas' must be less than or equal to " +
+				"'autoscaling.maxReplicas'")
+		}
+	}
+	if len(s.Labels) > 0 {
+		if _, err := mpHelpers.ParseLabels(s.labelsFlagValue()); err != nil {
+			return err
+		}
+	}
+	if len(s.Taints) > 0 {
+		if _, err := mpHelpers.ParseTaints(s.taintsFlagValue()); err != nil {
+			return err
+		}
+	}
+	if _, err := ocm.ParseDiskSizeToGigibyte(s.DiskSize); err != nil {
+		return fmt.Errorf("invalid 'diskSize': %w", err)
+	}
+	if s.AvailabilityZone != "" && s.Subnet != "" {
+		return fmt.Errorf("'availabilityZone' cannot be set together with 'subnet'")
+	}
+	if s.MultiAZ != nil && *s.MultiAZ && (s.AvailabilityZone != "" || s.Subnet != "") {
+		return fmt.Errorf("'multiAZ' cannot be enabled together with 'availabilityZone' or 'subnet'")
+	}
+	if s.SpotMaxPrice != "" && s.SpotMaxPrice != "on-demand" {
+		if !s.UseSpotInstances {
+			return fmt.Errorf("'spotMaxPrice' requires 'useSpotInstances'")
+		}
+		if price, err := strconv.ParseFloat(s.SpotMaxPrice, 64); err != nil || price <= 0 {
+			return fmt.Errorf("'spotMaxPrice' must be 'on-demand' or a positive number")
+		}
+	}
+	if err := ValidateKubeletConfig(s.KubeletConfigs); err != nil {
+		return err
+	}
+	if err := mpHelpers.ValidateNodeDrainGracePeriod(s.NodeDrainGracePeriod); err != nil {
+		return fmt.Errorf("invalid 'nodeDrainGracePeriod': %w", err)
+	}
+	if err := mpHelpers.ValidateUpgradeMaxSurgeUnavailable(s.MaxSurge); err != nil {
+		return fmt.Errorf("invalid 'maxSurge': %w", err)
+	}
+	if err := mpHelpers.ValidateUpgradeMaxSurgeUnavailable(s.MaxUnavailable); err != nil {
+		return fmt.Errorf("invalid 'maxUnavailable': %w", err)
+	}
+	if s.ImageType != "" && !mpHelpers.IsValidImageType(s.ImageType) {
+		return fmt.Errorf("invalid image type: '%s' - please use one of: %v", s.ImageType, prettyPrintImageTypes())
+	}
+	return mpHelpers.ValidateCapacityReservationPreference(s.CapacityReservationPreference,
+		s.CapacityReservationID)
+}
+
+// ValidateTopology checks that the spec only uses the fields supported by the type of the cluster
+func (s *MachinePoolSpec) ValidateTopology(hostedCP bool) error {
+	var fields []string
+	if hostedCP {
+		fields = setFields(map[string]bool{
+			"multiAZ":          s.MultiAZ != nil,
+			"availabilityZone": s.AvailabilityZone != "",
+			"useSpotInstances": s.UseSpotInstances,
+			"spotMaxPrice":     s.SpotMaxPrice != "",
+		})
+	} else {
+		fields = setFields(map[string]bool{
+			"version":                       s.Version != "",
+			"autorepair":                    s.Autorepair != nil,
+			"tuningConfigs":                 len(s.TuningConfigs) > 0,
+			"kubeletConfigs":                len(s.KubeletConfigs) > 0,
+			"nodeDrainGracePeriod":          s.NodeDrainGracePeriod != "",
+			"maxSurge":                      s.MaxSurge != "",
+			"maxUnavailable":                s.MaxUnavailable != "",
+			"imageType":                     s.ImageType != "",
+			"ec2MetadataHttpTokens":         s.Ec2MetadataHttpTokens != "",
+			"capacityReservationID":         s.CapacityReservationID != "",
+			"capacityReservationPreference": s.CapacityReservationPreference != "",
+		})
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	if hostedCP {
+		return fmt.Errorf("machine pool '%s' sets fields only supported for classic clusters: %s",
+			s.Name, strings.Join(fields, ", "))
+	}
+	return fmt.Errorf("machine pool '%s' sets fields only supported for Hosted Control Plane clusters: %s",
+		s.Name, strings.Join(fields, ", "))
+}
+
+func setFields(fields map[string]bool) []string {
+	result := []string{}
+	for name, set := range fields {
+		if set {
+			result = append(result, name)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// FlagValues maps the spec onto the values of the 'rosa create machinepool' flags
+func (s *MachinePoolSpec) FlagValues() map[string]string {
+	values := map[string]string{
+		"name": s.Name,
+	}
+	setValue := func(flag, value string) {
+		if value != "" {
+			values[flag] = value
+		}
+	}
+	setValue("instance-type", s.InstanceType)
+	if s.Replicas != nil {
+		values["replicas"] = strconv.Itoa(*s.Replicas)
+	}
+	if s.Autoscaling != nil {
+		values["enable-autoscaling"] = "true"
+		values["min-replicas"] = strconv.Itoa(s.Autoscaling.MinReplicas)
+		values["max-replicas"] = strconv.Itoa(s.Autoscaling.MaxReplicas)
+	}
+	setValue("labels", s.labelsFlagValue())
+	setValue("taints", s.taintsFlagValue())
+	setValue("disk-size", s.DiskSize)
+	setValue("additional-security-group-ids", strings.Join(s.SecurityGroupIDs, ","))
+	setValue("subnet", s.Subnet)
+	setValue("tags", s.tagsFlagValue())
+	if s.MultiAZ != nil {
+		values["multi-availability-zone"] = strconv.FormatBool(*s.MultiAZ)
+	}
+	setValue("availability-zone", s.AvailabilityZone)
+	if s.UseSpotInstances {
+		values["use-spot-instances"] = "true"
+	}
+	setValue("spot-max-price", s.SpotMaxPrice)
+	setValue("version", s.Version)
+	if s.Autorepair != nil {
+		values["autorepair"] = strconv.FormatBool(*s.Autorepair)
+	}
+	setValue("tuning-configs", strings.Join(s.TuningConfigs, ","))
+	setValue("kubelet-configs", strings.Join(s.KubeletConfigs, ","))
+	setValue("node-drain-grace-period", s.NodeDrainGracePeriod)
+	setValue("max-surge", s.MaxSurge)
+	setValue("max-unavailable", s.MaxUnavailable)
+	setValue("type", s.ImageType)
+	setValue("ec2-metadata-http-tokens", s.Ec2MetadataHttpTokens)
+	setValue("capacity-reservation-id", s.CapacityReservationID)
+	setValue("capacity-reservation-preference", s.CapacityReservationPreference)
+	return values
+}
+
+func (s *MachinePoolSpec) labelsFlagValue() string {
+	labels := []string{}
+	for _, key := range sortedKeys(s.Labels) {
+		labels = append(labels, fmt.Sprintf("%s=%s", key, s.Labels[key]))
+	}
+	return strings.Join(labels, ",")
+}
+
+func (s *MachinePoolSpec) taintsFlagValue() string {
+	taints := []string{}
+	for _, taint := range s.Taints {
+		taints = append(taints, fmt.Sprintf("%s=%s:%s", taint.Key, taint.Value, taint.Effect))
+	}
+	return strings.Join(taints, ",")
+}
+
+// tagsFlagValue uses a space as the delimiter between keys and values when any of them contains
+// a colon, which matches the delimiter detection of the 'tags' flag
+func (s *MachinePoolSpec) tagsFlagValue() string {
+	delimiter := ":"
+	for key, value := range s.Tags {
+		if strings.Contains(key, ":") || strings.Contains(value, ":") {
+			delimiter = " "
+		}
+	}
+	tags := []string{}
+	for _, key := range sortedKeys(s.Tags) {
+		tags = append(tags, key+delimiter+s.Tags[key])
+	}
+	return strings.Join(tags, ",")
+}
+
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// IsDefaultMachinePool checks if the machine pool is one of the ones created together with the
+// cluster, which already exist in any other cluster
+func IsDefaultMachinePool(name string) bool {
+	return defaultMachinePoolRE.MatchString(name)
+}
+
+// ClearClusterSpecificFields removes the values that only make sense in the cluster the spec was
+// exported from, like the subnet, so that the spec can be used to create machine pools in other
+// clusters
+func (s *MachinePoolSpec) ClearClusterSpecificFields() {
+	s.Subnet = ""
+	s.AvailabilityZone = ""
+	s.SecurityGroupIDs = nil
+	s.Version = ""
+}
+
+// SpecFromMachinePool rebuilds the spec of a machine pool of a classic cluster. Values that are
+// generated by the service are left out so the result can be used to create a new machine pool.
+func SpecFromMachinePool(machinePool *cmv1.MachinePool) *MachinePoolSpec {
+	spec := &MachinePoolSpec{
+		Name:             machinePool.ID(),
+		InstanceType:     machinePool.InstanceType(),
+		Labels:           emptyToNil(machinePool.Labels()),
+		Taints:           taintSpecs(machinePool.Taints()),
+		SecurityGroupIDs: machinePool.AWS().AdditionalSecurityGroupIds(),
+		Tags:             tags.UserTags(machinePool.AWS().Tags()),
+	}
+	if autoscaling, ok := machinePool.GetAutoscaling(); ok {
+		spec.Autoscaling = &AutoscalingSpec{
+			MinReplicas: autoscaling.MinReplicas(),
+			MaxReplicas: autoscaling.MaxReplicas(),
+		}
+	} else {
+		replicas := machinePool.Replicas()
+		spec.Replicas = &replicas
+	}
+	if size := machinePool.RootVolume().AWS().Size(); size != 0 {
+		spec.DiskSize = fmt.Sprintf("%dGiB", size)
+	}
+	if subnets := machinePool.Subnets(); len(subnets) == 1 {
+		spec.Subnet = subnets[0]
+	} else if zones := machinePool.AvailabilityZones(); len(subnets) == 0 && len(zones) == 1 {
+		spec.AvailabilityZone = zones[0]
+	}
+	if spotMarketOptions, ok := machinePool.AWS().GetSpotMarketOptions(); ok {
+		spec.UseSpotInstances = true
+		if maxPrice, ok := spotMarketOptions.GetMaxPrice(); ok {
+			spec.SpotMaxPrice = strconv.FormatFloat(maxPrice, 'f', -1, 64)
+		}
+	}
+	return spec
+}
+
+// SpecFromNodePool rebuilds the spec of a node pool of a Hosted Control Plane cluster. Values
+// that are generated by the service are left out so the result can be used to create a new node
+// pool.
+func SpecFromNodePool(nodePool *cmv1.NodePool) *MachinePoolSpec {
+	awsNodePool := nodePool.AWSNodePool()
+	spec := &MachinePoolSpec{
+		Name:                          nodePool.ID(),
+		InstanceType:                  awsNodePool.InstanceType(),
+		Labels:                        emptyToNil(nodePool.Labels()),
+		Taints:                        taintSpecs(nodePool.Taints()),
+		SecurityGroupIDs:              awsNodePool.AdditionalSecurityGroupIds(),
+		Subnet:                        nodePool.Subnet(),
+		Tags:                          tags.UserTags(awsNodePool.Tags()),
+		Version:                       nodePool.Version().RawID(),
+		TuningConfigs:                 nodePool.TuningConfigs(),
+		KubeletConfigs:                nodePool.KubeletConfigs(),
+		MaxSurge:                      nodePool.ManagementUpgrade().MaxSurge(),
+		MaxUnavailable:                nodePool.ManagementUpgrade().MaxUnavailable(),
+		ImageType:                     string(nodePool.ImageType()),
+		Ec2MetadataHttpTokens:         string(awsNodePool.Ec2MetadataHttpTokens()),
+		CapacityReservationID:         awsNodePool.CapacityReservation().Id(),
+		CapacityReservationPreference: string(awsNodePool.CapacityReservation().Preference()),
+	}
+	if autoscaling, ok := nodePool.GetAutoscaling(); ok {
+		spec.Autoscaling = &AutoscalingSpec{
+			MinReplicas: autoscaling.MinReplica(),
+			MaxReplicas: autoscaling.MaxReplica(),
+		}
+	} else {
+		replicas := nodePool.Replicas()
+		spec.Replicas = &replicas
+	}
+	if autorepair, ok := nodePool.GetAutoRepair(); ok {
+		spec.Autorepair = &autorepair
+	}
+	if size := awsNodePool.RootVolume().Size(); size != 0 {
+		spec.DiskSize = fmt.Sprintf("%dGiB", size)
+	}
+	if gracePeriod, ok := nodePool.GetNodeDrainGracePeriod(); ok && gracePeriod.Value() != 0 {
+		spec.NodeDrainGracePeriod = fmt.Sprintf("%v %s", gracePeriod.Value(), gracePeriod.Unit())
+	}
+	return spec
+}
+
+func taintSpecs(taints []*cmv1.Taint) []TaintSpec {
+	var result []TaintSpec
+	for _, taint := range taints {
+		result = append(result, TaintSpec{
+			Key:    taint.Key(),
+			Value:  taint.Value(),
+			Effect: taint.Effect(),
+		})
+	}
+	return result
+}
+
+func emptyToNil(values map[string]string) map[string]string {
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
@@ -0,0 +1,205 @@
+package machinepool
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Machine pool spec", func() {
+	Context("ParseMachinePoolFile", func() {
+		It("parses a single machine pool", func() {
+			file, err := ParseMachinePoolFile([]byte(`
+apiVersion: rosa.openshift.io/v1alpha1
+kind: MachinePool
+spec:
+  name: gpu
+  instanceType: g5.xlarge
+  autoscaling:
+    minReplicas: 1
+    maxReplicas: 3
+  labels:
+    team: ml
+  taints:
+  - key: nvidia.com/gpu
+    effect: NoSchedule
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(file.Pools()).To(HaveLen(1))
+			Expect(file.Pools()[0].Name).To(Equal("gpu"))
+			Expect(file.Pools()[0].Autoscaling.MaxReplicas).To(Equal(3))
+		})
+
+		It("parses a list of machine pools", func() {
+			file, err := ParseMachinePoolFile([]byte(`{
+  "apiVersion": "rosa.openshift.io/v1alpha1",
+  "kind": "MachinePoolList",
+  "items": [{"name": "a", "replicas": 2}, {"name": "b", "replicas": 0}]
+}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(file.Pools()).To(HaveLen(2))
+			Expect(*file.Pools()[1].Replicas).To(Equal(0))
+		})
+
+		DescribeTable("rejects invalid documents",
+			func(content string, expected string) {
+				_, err := ParseMachinePoolFile([]byte(content))
+				Expect(err).To(MatchError(ContainSubstring(expected)))
+			},
+			Entry("unknown field",
+				"apiVersion: rosa.openshift.io/v1alpha1\nkind: MachinePool\nspec:\n  name: a\n  size: 3\n",
+				`unknown field "size"`),
+			Entry("wrong kind",
+				"apiVersion: rosa.openshift.io/v1alpha1\nkind: Cluster\n",
+				"unsupported machine pool spec kind 'Cluster'"),
+			Entry("missing spec",
+				"apiVersion: rosa.openshift.io/v1alpha1\nkind: MachinePool\n",
+				"requires 'spec'"),
+			Entry("empty list",
+				"apiVersion: rosa.openshift.io/v1alpha1\nkind: MachinePoolList\n",
+				"requires at least one item"),
+			Entry("duplicated name",
+				"apiVersion: rosa.openshift.io/v1alpha1\nkind: MachinePoolList\nitems:\n- name: a\n- name: a\n",
+				"machine pool 'a' is defined more than once"),
+			Entry("invalid name",
+				"apiVersion: rosa.openshift.io/v1alpha1\nkind: MachinePool\nspec:\n  name: A_1\n",
+				"expected a valid name for the machine pool"),
+			Entry("replicas with autoscaling",
+				"apiVersion: rosa.openshift.io/v1alpha1\nkind: MachinePool\nspec:\n  name: a\n  replicas: 2\n"+
+					"  autoscaling:\n    minReplicas: 1\n    maxReplicas: 2\n",
+				"'replicas' cannot be set together with 'autoscaling'"),
+			Entry("invalid taint effect",
+				"apiVersion: rosa.openshift.io/v1alpha1\nkind: MachinePool\nspec:\n  name: a\n  taints:\n"+
+					"  - key: a\n    effect: Sometimes\n",
+				"Sometimes"),
+			Entry("invalid node drain grace period",
+				"apiVersion: rosa.openshift.io/v1alpha1\nkind: MachinePool\nspec:\n  name: a\n"+
+					"  nodeDrainGracePeriod: 2 weeks\n",
+				"invalid 'nodeDrainGracePeriod'"),
+			Entry("invalid max surge",
+				"apiVersion: rosa.openshift.io/v1alpha1\nkind: MachinePool\nspec:\n  name: a\n  maxSurge: 120%\n",
+				"invalid 'maxSurge'"),
+			Entry("too many kubelet configs",
+				"apiVersion: rosa.openshift.io/v1alpha1\nkind: MachinePool\nspec:\n  name: a\n"+
+					"  kubeletConfigs: [a, b]\n",
+				"only a single kubelet config is supported"),
+		)
+	})
+
+	It("rejects the fields of the other topology", func() {
+		multiAZ := false
+		spec := &MachinePoolSpec{Name: "a", MultiAZ: &multiAZ, SpotMaxPrice: "0.5"}
+		Expect(spec.ValidateTopology(false)).To(Succeed())
+		Expect(spec.ValidateTopology(true)).To(MatchError(
+			"machine pool 'a' sets fields only supported for classic clusters: multiAZ, spotMaxPrice"))
+
+		spec = &MachinePoolSpec{Name: "a", Version: "4.18.1", MaxSurge: "1"}
+		Expect(spec.ValidateTopology(true)).To(Succeed())
+		Expect(spec.ValidateTopology(false)).To(MatchError("machine pool 'a' sets fields only supported " +
+			"for Hosted Control Plane clusters: maxSurge, version"))
+	})
+
+	It("maps the spec onto the create flags", func() {
+		replicas := 0
+		autorepair := false
+		spec := &MachinePoolSpec{
+			Name:             "a",
+			Replicas:         &replicas,
+			Labels:           map[string]string{"b": "2", "a": "1"},
+			Taints:           []TaintSpec{{Key: "k", Value: "v", Effect: "NoSchedule"}},
+			SecurityGroupIDs: []string{"sg-1", "sg-2"},
+			Tags:             map[string]string{"owner": "team:a"},
+			Autorepair:       &autorepair,
+		}
+		Expect(spec.FlagValues()).To(Equal(map[string]string{
+			"name":                          "a",
+			"replicas":                      "0",
+			"labels":                        "a=1,b=2",
+			"taints":                        "k=v:NoSchedule",
+			"additional-security-group-ids": "sg-1,sg-2",
+			"tags":                          "owner team:a",
+			"autorepair":                    "false",
+		}))
+	})
+
+	It("rebuilds the spec of a node pool", func() {
+		nodePool := test.MockNodePool(func(n *cmv1.NodePoolBuilder) {
+			n.ID("workers").
+				Subnet("subnet-a").
+				Version(cmv1.NewVersion().RawID("4.18.1")).
+				AutoRepair(true).
+				Autoscaling(cmv1.NewNodePoolAutoscaling().MinReplica(2).MaxReplica(6)).
+				NodeDrainGracePeriod(cmv1.NewValue().Value(90).Unit("minutes")).
+				AWSNodePool(cmv1.NewAWSNodePool().
+					InstanceType("m5.xlarge").
+					Tags(map[string]string{"red-hat-managed": "true", "team": "a"}).
+					RootVolume(cmv1.NewAWSVolume().Size(300)))
+		})
+		spec := SpecFromNodePool(nodePool)
+		Expect(spec.Name).To(Equal("workers"))
+		Expect(spec.InstanceType).To(Equal("m5.xlarge"))
+		Expect(spec.Replicas).To(BeNil())
+		Expect(spec.Autoscaling).To(Equal(&AutoscalingSpec{MinReplicas: 2, MaxReplicas: 6}))
+		Expect(spec.DiskSize).To(Equal("300GiB"))
+		Expect(spec.Subnet).To(Equal("subnet-a"))
+		Expect(spec.Version).To(Equal("4.18.1"))
+		Expect(*spec.Autorepair).To(BeTrue())
+		Expect(spec.NodeDrainGracePeriod).To(Equal("90 minutes"))
+		Expect(spec.Tags).To(Equal(map[string]string{"team": "a"}))
+		Expect(spec.Validate()).To(Succeed())
+		Expect(spec.ValidateTopology(true)).To(Succeed())
+	})
+
+	It("rebuilds the spec of a machine pool", func() {
+		machinePool, err := cmv1.NewMachinePool().
+			ID("spot").
+			InstanceType("m5.xlarge").
+			Replicas(3).
+			AvailabilityZones("us-east-1a").
+			Taints(cmv1.NewTaint().Key("k").Value("v").Effect("NoExecute")).
+			AWS(cmv1.NewAWSMachinePool().SpotMarketOptions(cmv1.NewAWSSpotMarketOptions().MaxPrice(0.5))).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		spec := SpecFromMachinePool(machinePool)
+		Expect(*spec.Replicas).To(Equal(3))
+		Expect(spec.AvailabilityZone).To(Equal("us-east-1a"))
+		Expect(spec.Taints).To(Equal([]TaintSpec{{Key: "k", Value: "v", Effect: "NoExecute"}}))
+		Expect(spec.UseSpotInstances).To(BeTrue())
+		Expect(spec.SpotMaxPrice).To(Equal("0.5"))
+		Expect(spec.Validate()).To(Succeed())
+		Expect(spec.ValidateTopology(false)).To(Succeed())
+	})
+
+	It("clears the cluster specific fields", func() {
+		spec := &MachinePoolSpec{
+			Name:             "gpu",
+			InstanceType:     "g4dn.xlarge",
+			Subnet:           "subnet-a",
+			AvailabilityZone: "us-east-1a",
+			SecurityGroupIDs: []string{"sg-1"},
+			Version:          "4.16.1",
+		}
+		spec.ClearClusterSpecificFields()
+		Expect(spec).To(Equal(&MachinePoolSpec{Name: "gpu", InstanceType: "g4dn.xlarge"}))
+	})
+
+	It("recognizes the default machine pools", func() {
+		Expect(IsDefaultMachinePool("worker")).To(BeTrue())
+		Expect(IsDefaultMachinePool("workers")).To(BeTrue())
+		Expect(IsDefaultMachinePool("workers-1")).To(BeTrue())
+		Expect(IsDefaultMachinePool("workers-gpu")).To(BeFalse())
+		Expect(IsDefaultMachinePool("infra")).To(BeFalse())
+	})
+
+	It("round-trips a list file", func() {
+		replicas := 2
+		file := NewMachinePoolListFile([]*MachinePoolSpec{{Name: "a", Replicas: &replicas}})
+		content, err := file.Marshal()
+		Expect(err).NotTo(HaveOccurred())
+		parsed, err := ParseMachinePoolFile(content)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parsed).To(Equal(file))
+	})
+})
@@ -0,0 +1,234 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cfTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/reporter"
+)
+
+const subnetResourceType = "AWS::EC2::Subnet"
+
+// DefaultTemplate is the name of the built-in template of 'rosa create network'.
+const DefaultTemplate = "rosa-quickstart-default-vpc"
+
+// defaultTemplateDescription is the beginning of the description of the built-in template. It
+// identifies the stacks created before 'rosa create network' started to add the template tag.
+const defaultTemplateDescription = "CloudFormation template to create a ROSA Quickstart"
+
+// Stack is a CloudFormation stack created by 'rosa create network'.
+type Stack struct {
+	Name           string            `json:"name"`
+	ID             string            `json:"id"`
+	Status         string            `json:"status"`
+	StatusReason   string            `json:"status_reason,omitempty"`
+	Template       string            `json:"template"`
+	VpcID          string            `json:"vpc_id,omitempty"`
+	PublicSubnets  []string          `json:"public_subnets,omitempty"`
+	PrivateSubnets []string          `json:"private_subnets,omitempty"`
+	CreationTime   time.Time         `json:"creation_time"`
+	Tags           map[string]string `json:"tags,omitempty"`
+}
+
+// StackResource is one of the resources of a network stack.
+type StackResource struct {
+	LogicalID  string `json:"logical_id"`
+	PhysicalID string `json:"physical_id,omitempty"`
+	Type       string `json:"type"`
+	Status     string `json:"status"`
+}
+
+// IsNetworkStack checks if the stack has the tag applied by 'rosa create network', or if it was
+// created from the built-in template by a version that didn't apply the tag yet.
+func IsNetworkStack(stack cfTypes.Stack) bool {
+	for _, tag := range stack.Tags {
+		if awssdk.ToString(tag.Key) == tags.NetworkTemplate {
+			return true
+		}
+	}
+	return isDefaultTemplateStack(stack)
+}
+
+func isDefaultTemplateStack(stack cfTypes.Stack) bool {
+	return strings.HasPrefix(awssdk.ToString(stack.Description), defaultTemplateDescription)
+}
+
+// NewStack converts a CloudFormation stack into a network stack, reading the VPC and the subnets
+// from the outputs of the template.
+func NewStack(stack cfTypes.Stack) *Stack {
+	result := &Stack{
+		Name:         awssdk.ToString(stack.StackName),
+		ID:           awssdk.ToString(stack.StackId),
+		Status:       string(stack.StackStatus),
+		StatusReason: awssdk.ToString(stack.StackStatusReason),
+		CreationTime: awssdk.ToTime(stack.CreationTime),
+		Tags:         map[string]string{},
+	}
+	for _, tag := range stack.Tags {
+		result.Tags[awssdk.ToString(tag.Key)] = awssdk.ToString(tag.Value)
+	}
+	result.Template = result.Tags[tags.NetworkTemplate]
+	if result.Template == "" && isDefaultTemplateStack(stack) {
+		result.Template = DefaultTemplate
+	}
+	for _, output := range stack.Outputs {
+		value := awssdk.ToString(output.OutputValue)
+		switch awssdk.ToString(output.OutputKey) {
+		case "VPCId":
+			result.VpcID = value
+		case "PublicSubnets":
+			result.PublicSubnets = splitList(value)
+		case "PrivateSubnets":
+			result.PrivateSubnets = splitList(value)
+		}
+	}
+	return result
+}
+
+func splitList(value string) []string {
+	result := []string{}
+	for item := range strings.SplitSeq(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" && !slices.Contains(result, item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// ListStacks returns the network stacks of the region of the client, sorted by name.
+func ListStacks(ctx context.Context, awsClient aws.Client) ([]*Stack, error) {
+	stacks, err := awsClient.ListCFStacks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CloudFormation stacks: %w", err)
+	}
+	result := []*Stack{}
+	for _, stack := range stacks {
+		if IsNetworkStack(stack) {
+			result = append(result, NewStack(stack))
+		}
+	}
+	slices.SortFunc(result, func(a, b *Stack) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return result, nil
+}
+
+// GetStack returns the network stack with the given name or identifier. It fails if the stack
+// doesn't exist or if it wasn't created by 'rosa create network'.
+func GetStack(ctx context.Context, awsClient aws.Client, name string) (*Stack, error) {
+	stack, err := awsClient.GetCFStack(ctx, name)
+	if err != nil {
+		if IsStackNotFound(err) {
+			return nil, fmt.Errorf("network stack '%s' not found", name)
+		}
+		return nil, fmt.Errorf("failed to get CloudFormation stack '%s': %w", name, err)
+	}
+	if !IsNetworkStack(*stack) {
+		return nil, fmt.Errorf("stack '%s' wasn't created by 'rosa create network', it doesn't have the '%s' tag "+
+			"nor the description of the built-in template", name, tags.NetworkTemplate)
+	}
+	return NewStack(*stack), nil
+}
+
+// IsStackNotFound checks if the error is the one returned by CloudFormation for a stack that
+// doesn't exist.
+func IsStackNotFound(err error) bool {
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == "ValidationError" &&
+		strings.Contains(apiErr.ErrorMessage(), "does not exist")
+}
+
+// ListStackResources returns the resources of the stack with the given name or identifier.
+func ListStackResources(ctx context.Context, awsClient aws.Client, name string) ([]StackResource, error) {
+	resources, err := awsClient.DescribeCFStackResources(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe the resources of stack '%s': %w", name, err)
+	}
+	result := []StackResource{}
+	if resources == nil {
+		return result, nil
+	}
+	for _, resource := range *resources {
+		result = append(result, StackResource{
+			LogicalID:  awssdk.ToString(resource.LogicalResourceId),
+			PhysicalID: awssdk.ToString(resource.PhysicalResourceId),
+			Type:       awssdk.ToString(resource.ResourceType),
+			Status:     string(resource.ResourceStatus),
+		})
+	}
+	return result, nil
+}
+
+// SubnetIDs returns the identifiers of the subnets of the stack. The subnets are taken from the
+// resources, so that stacks created from custom templates without the subnet outputs are
+// also covered.
+func SubnetIDs(stack *Stack, resources []StackResource) []string {
+	result := []string{}
+	for _, resource := range resources {
+		if resource.Type == subnetResourceType && resource.PhysicalID != "" {
+			result = append(result, resource.PhysicalID)
+		}
+	}
+	for _, subnet := range slices.Concat(stack.PublicSubnets, stack.PrivateSubnets) {
+		if !slices.Contains(result, subnet) {
+			result = append(result, subnet)
+		}
+	}
+	slices.Sort(result)
+	return result
+}
+
+// WatchInterval is the time between two checks of the status of a stack that is being deleted.
+var WatchInterval = 10 * time.Second
+
+// WatchStackDeletion waits until the stack with the given identifier is deleted, reporting the
+// changes of the status of its resources. The identifier must be the stack ID and not the name,
+// as deleted stacks can only be described by ID.
+func WatchStackDeletion(ctx context.Context, awsClient aws.Client, stackID string,
+	logger reporter.Logger) error {
+	statuses := map[string]string{}
+	for {
+		stack, err := awsClient.GetCFStack(ctx, stackID)
+		if err != nil {
+			if IsStackNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("failed to get the status of stack '%s': %w", stackID, err)
+		}
+		switch stack.StackStatus {
+		case cfTypes.StackStatusDeleteComplete:
+			return nil
+		case cfTypes.StackStatusDeleteFailed:
+			return fmt.Errorf("failed to delete stack '%s': %s", awssdk.ToString(stack.StackName),
+				awssdk.ToString(stack.StackStatusReason))
+		}
+
+		resources, err := ListStackResources(ctx, awsClient, stackID)
+		if err != nil {
+			return err
+		}
+		for _, resource := range resources {
+			if statuses[resource.LogicalID] != resource.Status {
+				statuses[resource.LogicalID] = resource.Status
+				logger.Infof("%s (%s): %s", resource.LogicalID, resource.Type, resource.Status)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(WatchInterval):
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cfTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/smithy-go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/reporter"
+)
+
+func cfStack(name string, stackTags map[string]string, outputs map[string]string) cfTypes.Stack {
+	stack := cfTypes.Stack{
+		StackName:    awssdk.String(name),
+		StackId:      awssdk.String("arn:aws:cloudformation:us-east-1:123:stack/" + name + "/1"),
+		StackStatus:  cfTypes.StackStatusCreateComplete,
+		CreationTime: awssdk.Time(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)),
+	}
+	for key, value := range stackTags {
+		stack.Tags = append(stack.Tags, cfTypes.Tag{Key: awssdk.String(key), Value: awssdk.String(value)})
+	}
+	for key, value := range outputs {
+		stack.Outputs = append(stack.Outputs, cfTypes.Output{
+			OutputKey:   awssdk.String(key),
+			OutputValue: awssdk.String(value),
+		})
+	}
+	return stack
+}
+
+var _ = Describe("Network stacks", func() {
+	var (
+		ctx        context.Context
+		mockClient *aws.MockClient
+	)
+
+	networkTags := map[string]string{tags.NetworkTemplate: "rosa-quickstart-default-vpc", "team": "a"}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+	})
+
+	It("Reads the VPC and the subnets from the outputs", func() {
+		stack := NewStack(cfStack("net", networkTags, map[string]string{
+			"VPCId":          "vpc-1",
+			"PublicSubnets":  "subnet-a,subnet-b",
+			"PrivateSubnets": "subnet-c,",
+		}))
+		Expect(stack.Name).To(Equal("net"))
+		Expect(stack.Template).To(Equal("rosa-quickstart-default-vpc"))
+		Expect(stack.VpcID).To(Equal("vpc-1"))
+		Expect(stack.PublicSubnets).To(Equal([]string{"subnet-a", "subnet-b"}))
+		Expect(stack.PrivateSubnets).To(Equal([]string{"subnet-c"}))
+		Expect(stack.Tags).To(HaveKeyWithValue("team", "a"))
+	})
+
+	It("Lists only the stacks created by 'rosa create network'", func() {
+		mockClient.EXPECT().ListCFStacks(ctx).Return([]cfTypes.Stack{
+			cfStack("zeta", networkTags, nil),
+			cfStack("other", map[string]string{"team": "a"}, nil),
+			cfStack("alpha", networkTags, nil),
+		}, nil)
+		stacks, err := ListStacks(ctx, mockClient)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stacks).To(HaveLen(2))
+		Expect(stacks[0].Name).To(Equal("alpha"))
+		Expect(stacks[1].Name).To(Equal("zeta"))
+	})
+
+	It("Recognizes the untagged stacks created from the built-in template", func() {
+		legacy := cfStack("legacy", map[string]string{"team": "a"}, map[string]string{"VPCId": "vpc-2"})
+		legacy.Description = awssdk.String("CloudFormation template to create a ROSA Quickstart default VPC. " +
+			"This CloudFormation template may not work with rosa CLI versions later than 1.2.48.")
+		mockClient.EXPECT().ListCFStacks(ctx).Return([]cfTypes.Stack{
+			legacy,
+			cfStack("other", map[string]string{"team": "a"}, nil),
+		}, nil)
+		stacks, err := ListStacks(ctx, mockClient)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stacks).To(HaveLen(1))
+		Expect(stacks[0].Name).To(Equal("legacy"))
+		Expect(stacks[0].Template).To(Equal(DefaultTemplate))
+		Expect(stacks[0].VpcID).To(Equal("vpc-2"))
+
+		mockClient.EXPECT().GetCFStack(ctx, "legacy").Return(&legacy, nil)
+		stack, err := GetStack(ctx, mockClient, "legacy")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stack.Template).To(Equal(DefaultTemplate))
+	})
+
+	It("Refuses to get a stack without the network tag", func() {
+		stack := cfStack("other", nil, nil)
+		mockClient.EXPECT().GetCFStack(ctx, "other").Return(&stack, nil)
+		_, err := GetStack(ctx, mockClient, "other")
+		Expect(err).To(MatchError(ContainSubstring("wasn't created by 'rosa create network'")))
+	})
+
+	It("Reports a stack that doesn't exist", func() {
+		mockClient.EXPECT().GetCFStack(ctx, "missing").Return(nil, &smithy.GenericAPIError{
+			Code:    "ValidationError",
+			Message: "Stack with id missing does not exist",
+		})
+		_, err := GetStack(ctx, mockClient, "missing")
+		Expect(err).To(MatchError("network stack 'missing' not found"))
+	})
+
+	It("Combines the subnets of the resources and of the outputs", func() {
+		stack := &Stack{PublicSubnets: []string{"subnet-b"}, PrivateSubnets: []string{"subnet-c"}}
+		resources := []StackResource{
+			{LogicalID: "SubnetPublic1", PhysicalID: "subnet-b", Type: "AWS::EC2::Subnet"},
+			{LogicalID: "SubnetPrivate1", PhysicalID: "subnet-a", Type: "AWS::EC2::Subnet"},
+			{LogicalID: "VPC", PhysicalID: "vpc-1", Type: "AWS::EC2::VPC"},
+		}
+		Expect(SubnetIDs(stack, resources)).To(Equal([]string{"subnet-a", "subnet-b", "subnet-c"}))
+	})
+
+	Context("Watching the deletion", func() {
+		var interval time.Duration
+
+		BeforeEach(func() {
+			interval = WatchInterval
+			WatchInterval = 0
+		})
+
+		AfterEach(func() {
+			WatchInterval = interval
+		})
+
+		It("Reports the resources until the stack is deleted", func() {
+			const id = "arn:aws:cloudformation:us-east-1:123:stack/net/1"
+			deleting := cfStack("net", networkTags, nil)
+			deleting.StackStatus = cfTypes.StackStatusDeleteInProgress
+			deleted := deleting
+			deleted.StackStatus = cfTypes.StackStatusDeleteComplete
+			resources := []cfTypes.StackResource{{
+				LogicalResourceId: awssdk.String("VPC"),
+				ResourceType:      awssdk.String("AWS::EC2::VPC"),
+				ResourceStatus:    cfTypes.ResourceStatusDeleteInProgress,
+			}}
+			gomock.InOrder(
+				mockClient.EXPECT().GetCFStack(ctx, id).Return(&deleting, nil),
+				mockClient.EXPECT().DescribeCFStackResources(ctx, id).Return(&resources, nil),
+				mockClient.EXPECT().GetCFStack(ctx, id).Return(&deleting, nil),
+				mockClient.EXPECT().DescribeCFStackResources(ctx, id).Return(&resources, nil),
+				mockClient.EXPECT().GetCFStack(ctx, id).Return(&deleted, nil),
+			)
+			Expect(WatchStackDeletion(ctx, mockClient, id, reporter.CreateReporter())).To(Succeed())
+		})
+
+		It("Fails when the deletion fails", func() {
+			failed := cfStack("net", networkTags, nil)
+			failed.StackStatus = cfTypes.StackStatusDeleteFailed
+			failed.StackStatusReason = awssdk.String("subnet has dependencies")
+			mockClient.EXPECT().GetCFStack(ctx, "id").Return(&failed, nil)
+			err := WatchStackDeletion(ctx, mockClient, "id", reporter.CreateReporter())
+			Expect(err).To(MatchError(fmt.Sprintf("failed to delete stack '%s': %s", "net", "subnet has dependencies")))
+		})
+	})
+})
@@ -3,6 +3,7 @@ package ocm
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	v1 "github.com/openshift-online/ocm-sdk-go/accesstransparency/v1"
 )
@@ -38,13 +39,41 @@ func (c *Client) GetAccessRequest(id string) (*v1.AccessRequest, bool, error) {
 	return resp.Body(), true, nil
 }
 
-func (c *Client) ListAccessRequest(cluster string) ([]*v1.AccessRequest, error) {
-	query := "status.state in ('Pending', 'Approved')"
-	order := "status.state desc, updated_at desc"
-	if cluster != "" {
-		query = fmt.Sprintf("cluster_id='%s'", cluster)
-		order = "updated_at desc"
+// AccessRequestFilter selects the Access Requests returned by ListAccessRequest. When no state
+// is given, only the Pending and Approved Access Requests are returned, unless a cluster is given,
+// in which case the Access Requests of the cluster in any state are returned.
+type AccessRequestFilter struct {
+	ClusterID   string
+	RequestedBy string
+	States      []string
+}
+
+func (f AccessRequestFilter) query() (string, string) {
+	terms := []string{}
+	order := "updated_at desc"
+	if f.ClusterID != "" {
+		terms = append(terms, fmt.Sprintf("cluster_id=%s", quoteSearchValue(f.ClusterID)))
+	}
+	if f.RequestedBy != "" {
+		terms = append(terms, fmt.Sprintf("requested_by=%s", quoteSearchValue(f.RequestedBy)))
+	}
+	states := f.States
+	if len(states) == 0 && f.ClusterID == "" {
+		states = []string{string(v1.AccessRequestStatePending), string(v1.AccessRequestStateApproved)}
+		order = "status.state desc, updated_at desc"
 	}
+	if len(states) > 0 {
+		values := []string{}
+		for _, state := range states {
+			values = append(values, quoteSearchValue(state))
+		}
+		terms = append(terms, fmt.Sprintf("status.state in (%s)", strings.Join(values, ", ")))
+	}
+	return strings.Join(terms, " and "), order
+}
+
+func (c *Client) ListAccessRequest(filter AccessRequestFilter) ([]*v1.AccessRequest, error) {
+	query, order := filter.query()
 	page := 1
 	size := 100
 	accessRequests := []*v1.AccessRequest{}
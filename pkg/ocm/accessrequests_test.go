@@ -0,0 +1,30 @@
+package ocm
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AccessRequestFilter", func() {
+	DescribeTable("builds the search query and order",
+		func(filter AccessRequestFilter, expectedQuery string, expectedOrder string) {
+			query, order := filter.query()
+			Expect(query).To(Equal(expectedQuery))
+			Expect(order).To(Equal(expectedOrder))
+		},
+		Entry("pending and approved by default", AccessRequestFilter{},
+			"status.state in ('Pending', 'Approved')", "status.state desc, updated_at desc"),
+		Entry("any state for a cluster", AccessRequestFilter{ClusterID: "123"},
+			"cluster_id='123'", "updated_at desc"),
+		Entry("requester and states", AccessRequestFilter{RequestedBy: "jdoe", States: []string{"Denied", "Expired"}},
+			"requested_by='jdoe' and status.state in ('Denied', 'Expired')", "updated_at desc"),
+		Entry("requester with quotes", AccessRequestFilter{RequestedBy: "o'brien", States: []string{"Pending"}},
+			"requested_by='o''brien' and status.state in ('Pending')", "updated_at desc"),
+		Entry("cluster with quotes", AccessRequestFilter{ClusterID: "a' or '1'='1"},
+			"cluster_id='a'' or ''1''=''1'", "updated_at desc"),
+		Entry("states with quotes", AccessRequestFilter{States: []string{"Pending') or ('1"}},
+			"status.state in ('Pending'') or (''1')", "updated_at desc"),
+		Entry("requester across the organization", AccessRequestFilter{RequestedBy: "jdoe"},
+			"requested_by='jdoe' and status.state in ('Pending', 'Approved')", "status.state desc, updated_at desc"),
+	)
+})
@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	azv1 "github.com/openshift-online/ocm-sdk-go/authorizations/v1"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// Actions of the self access reviews
+const (
+	ActionGet    = "get"
+	ActionList   = "list"
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+// Types of the resources of the self access reviews, as known by the accounts management service
+const (
+	ClusterResourceType           = "Cluster"
+	MachinePoolResourceType       = "MachinePool"
+	IdpResourceType               = "Idp"
+	AddOnInstallationResourceType = "AddOnInstallation"
+)
+
+// AccessReview is an action on a type of resource checked by SelfAccessReview. The review is
+// scoped to the cluster when there is one, and to the organization otherwise.
+type AccessReview struct {
+	Action         string
+	ResourceType   string
+	OrganizationID string
+	Cluster        *cmv1.Cluster
+}
+
+// SelfAccessReview returns whether the current user is allowed to perform the action, and the
+// reason given by OCM.
+func (c *Client) SelfAccessReview(review AccessReview) (bool, string, error) {
+	builder := azv1.NewSelfAccessReviewRequest().
+		Action(review.Action).
+		ResourceType(review.ResourceType)
+	if review.OrganizationID != "" {
+		builder.OrganizationID(review.OrganizationID)
+	}
+	if review.Cluster != nil {
+		builder.ClusterID(review.Cluster.ID())
+		if subscriptionID := review.Cluster.Subscription().ID(); subscriptionID != "" {
+			builder.SubscriptionID(subscriptionID)
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return false, "", err
+	}
+	response, err := c.ocm.Authorizations().V1().SelfAccessReview().Post().
+		Request(request).
+		Send()
+	if err != nil {
+		return false, "", handleErr(response.Error(), err)
+	}
+	return response.Response().Allowed(), response.Response().Reason(), nil
+}
@@ -0,0 +1,94 @@
+package ocm
+
+import (
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/ocm-sdk-go/logging"
+	"github.com/openshift-online/ocm-sdk-go/testing"
+)
+
+var _ = Describe("SelfAccessReview", func() {
+	var ocmClient *Client
+	var apiServer, ssoServer *ghttp.Server
+
+	BeforeEach(func() {
+		ssoServer = testing.MakeTCPServer()
+		apiServer = testing.MakeTCPServer()
+		accessToken := testing.MakeTokenString("Bearer", 15*time.Minute)
+		ssoServer.AppendHandlers(testing.RespondWithAccessToken(accessToken))
+		logger, err := logging.NewGoLoggerBuilder().
+			Debug(false).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		connection, err := sdk.NewConnectionBuilder().
+			Logger(logger).
+			Tokens(accessToken).
+			URL(apiServer.URL()).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		ocmClient = NewClientWithConnection(connection)
+	})
+	AfterEach(func() {
+		apiServer.Close()
+		ssoServer.Close()
+		Expect(ocmClient.Close()).To(Succeed())
+	})
+
+	It("reviews the action on the resources of a cluster", func() {
+		cluster, err := cmv1.NewCluster().ID("123").Subscription(cmv1.NewSubscription().ID("456")).Build()
+		Expect(err).NotTo(HaveOccurred())
+		apiServer.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest(http.MethodPost, "/api/authorizations/v1/self_access_review"),
+			ghttp.VerifyJSON(`{
+				"action": "create",
+				"resource_type": "MachinePool",
+				"cluster_id": "123",
+				"subscription_id": "456"
+			}`),
+			testing.RespondWithJSON(http.StatusOK, `{
+				"action": "create",
+				"resource_type": "MachinePool",
+				"allowed": false,
+				"reason": "missing role MachinePoolEditor"
+			}`),
+		))
+
+		allowed, reason, err := ocmClient.SelfAccessReview(AccessReview{
+			Action:       ActionCreate,
+			ResourceType: MachinePoolResourceType,
+			Cluster:      cluster,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+		Expect(reason).To(Equal("missing role MachinePoolEditor"))
+	})
+
+	It("reviews the action in an organization", func() {
+		apiServer.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyJSON(`{"action": "create", "resource_type": "Cluster", "organization_id": "org-1"}`),
+			testing.RespondWithJSON(http.StatusOK, `{"allowed": true}`),
+		))
+
+		allowed, _, err := ocmClient.SelfAccessReview(AccessReview{
+			Action:         ActionCreate,
+			ResourceType:   ClusterResourceType,
+			OrganizationID: "org-1",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+	})
+
+	It("fails when the review fails", func() {
+		apiServer.AppendHandlers(testing.RespondWithJSON(http.StatusBadRequest,
+			`{"kind": "Error", "reason": "invalid resource type"}`))
+
+		_, _, err := ocmClient.SelfAccessReview(AccessReview{Action: ActionCreate, ResourceType: "Unknown"})
+		Expect(err).To(MatchError(ContainSubstring("invalid resource type")))
+	})
+})
@@ -27,6 +27,7 @@ import (
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	"github.com/sirupsen/logrus"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/config"
 	"github.com/openshift/rosa/pkg/fedramp"
 	"github.com/openshift/rosa/pkg/info"
@@ -63,7 +64,7 @@ func CreateNewClientOrExit(logger *logrus.Logger, reporter reporter.Logger) *Cli
 		Build()
 	if err != nil {
 		reporter.Errorf("Failed to create OCM connection: %v", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 
 	return client
@@ -92,6 +93,11 @@ func (b *ClientBuilder) Build() (result *Client, err error) {
 			return nil, err
 		}
 		if b.cfg == nil {
+			if name := config.SelectedContext(); name != "" {
+				err = fmt.Errorf("Not logged in to context '%s', run the 'rosa login --context %s' command",
+					name, name)
+				return nil, err
+			}
 			err = fmt.Errorf("Not logged in, run the 'rosa login' command")
 			return nil, err
 		}
@@ -0,0 +1,250 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/aws"
+)
+
+const (
+	TopologyClassic = "classic"
+	TopologyHCP     = "hcp"
+)
+
+// ClusterTopologies are the values accepted by the topology filter.
+var ClusterTopologies = []string{TopologyClassic, TopologyHCP}
+
+// ClusterStates are the values accepted by the state filter.
+var ClusterStates = []string{
+	string(cmv1.ClusterStateError),
+	string(cmv1.ClusterStateHibernating),
+	string(cmv1.ClusterStateInstalling),
+	string(cmv1.ClusterStatePending),
+	string(cmv1.ClusterStatePoweringDown),
+	string(cmv1.ClusterStateReady),
+	string(cmv1.ClusterStateResuming),
+	string(cmv1.ClusterStateUninstalling),
+	string(cmv1.ClusterStateUpdating),
+	string(cmv1.ClusterStateValidating),
+	string(cmv1.ClusterStateWaiting),
+}
+
+// clusterSortFields maps the names accepted by the sort option to the fields of the clusters
+// collection.
+var clusterSortFields = map[string]string{
+	"id":      "id",
+	"name":    "name",
+	"state":   "state",
+	"region":  "region.id",
+	"version": "openshift_version",
+	"created": "creation_timestamp",
+}
+
+// ClusterSortFields returns the sorted names accepted by the sort option.
+func ClusterSortFields() []string {
+	fields := make([]string, 0, len(clusterSortFields))
+	for field := range clusterSortFields {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+	return fields
+}
+
+// tagKeyRE matches the tag keys that can be used in the search. The key is part of the field
+// name, so it is restricted to the characters of an identifier.
+var tagKeyRE = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ClusterListOptions contains the filters, the sort order and the maximum number of results used
+// to list clusters. The zero value lists all the clusters.
+type ClusterListOptions struct {
+	States     []string
+	Topology   string
+	Region     string
+	Version    string
+	NamePrefix string
+	Tags       map[string]string
+
+	// Search is a raw OCM search expression that is combined with the rest of the filters.
+	Search string
+
+	// SortBy contains the names of the fields used to sort the results. A leading '-' sorts
+	// in descending order.
+	SortBy []string
+
+	// Limit is the maximum number of clusters returned, zero means no limit.
+	Limit int
+}
+
+// query returns the search expression that selects the clusters matching the given base filter
+// and all the options.
+func (o ClusterListOptions) query(base string) (string, error) {
+	terms := []string{base}
+
+	if len(o.States) > 0 {
+		values := make([]string, 0, len(o.States))
+		for _, state := range o.States {
+			state = strings.ToLower(strings.TrimSpace(state))
+			if !slices.Contains(ClusterStates, state) {
+				return "", fmt.Errorf("invalid cluster state '%s'. Valid states are %s",
+					state, strings.Join(ClusterStates, ", "))
+			}
+			values = append(values, quoteSearchValue(state))
+		}
+		terms = append(terms, fmt.Sprintf("state IN (%s)", strings.Join(values, ", ")))
+	}
+
+	switch strings.ToLower(o.Topology) {
+	case "":
+	case TopologyClassic:
+		terms = append(terms, "hypershift.enabled = 'false'")
+	case TopologyHCP:
+		terms = append(terms, "hypershift.enabled = 'true'")
+	default:
+		return "", fmt.Errorf("invalid topology '%s'. Valid topologies are %s",
+			o.Topology, strings.Join(ClusterTopologies, ", "))
+	}
+
+	if o.Region != "" {
+		terms = append(terms, fmt.Sprintf("region.id = %s", quoteSearchValue(o.Region)))
+	}
+
+	if o.Version != "" {
+		// A version with only the major and minor parts selects all the patch releases:
+		version := strings.TrimPrefix(o.Version, VersionPrefix)
+		if strings.Count(version, ".") < 2 {
+			terms = append(terms, fmt.Sprintf("openshift_version LIKE %s",
+				quoteSearchValue(escapeSearchPattern(version)+".%")))
+		} else {
+			terms = append(terms, fmt.Sprintf("openshift_version = %s", quoteSearchValue(version)))
+		}
+	}
+
+	if o.NamePrefix != "" {
+		terms = append(terms, fmt.Sprintf("name LIKE %s",
+			quoteSearchValue(escapeSearchPattern(o.NamePrefix)+"%")))
+	}
+
+	tagKeys := make([]string, 0, len(o.Tags))
+	for key := range o.Tags {
+		tagKeys = append(tagKeys, key)
+	}
+	slices.Sort(tagKeys)
+	for _, key := range tagKeys {
+		if !tagKeyRE.MatchString(key) {
+			return "", fmt.Errorf("invalid tag key '%s'. Only letters, digits and '_' are supported", key)
+		}
+		terms = append(terms, fmt.Sprintf("aws.tags.%s = %s", key, quoteSearchValue(o.Tags[key])))
+	}
+
+	if strings.TrimSpace(o.Search) != "" {
+		terms = append(terms, fmt.Sprintf("(%s)", strings.TrimSpace(o.Search)))
+	}
+
+	return strings.Join(terms, " AND "), nil
+}
+
+// order returns the order expression for the sort fields of the options.
+func (o ClusterListOptions) order() (string, error) {
+	clauses := make([]string, 0, len(o.SortBy))
+	for _, name := range o.SortBy {
+		name = strings.ToLower(strings.TrimSpace(name))
+		direction := "asc"
+		if strings.HasPrefix(name, "-") {
+			direction = "desc"
+			name = name[1:]
+		}
+		field, ok := clusterSortFields[name]
+		if !ok {
+			return "", fmt.Errorf("invalid sort field '%s'. Valid fields are %s",
+				name, strings.Join(ClusterSortFields(), ", "))
+		}
+		clauses = append(clauses, fmt.Sprintf("%s %s", field, direction))
+	}
+	return strings.Join(clauses, ", "), nil
+}
+
+// quoteSearchValue returns the value as a string literal of the search language, where single
+// quotes are escaped by doubling them.
+func quoteSearchValue(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// escapeSearchPattern escapes the wildcards of a 'LIKE' pattern so that the value is matched
+// literally.
+func escapeSearchPattern(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, "%", `\%`)
+	return strings.ReplaceAll(value, "_", `\_`)
+}
+
+// ListClusters returns the clusters created by the given creator, or all the clusters of the
+// organization when it is nil, that match the options.
+func (c *Client) ListClusters(creator *aws.Creator, options ClusterListOptions) ([]*cmv1.Cluster, error) {
+	return c.listClusters(getClusterFilter(creator), options)
+}
+
+// ListClustersUsingAccountRole is like ListClusters, but only returns the clusters that use the
+// given account role.
+func (c *Client) ListClustersUsingAccountRole(creator *aws.Creator, role aws.Role,
+	options ClusterListOptions) ([]*cmv1.Cluster, error) {
+	base, err := getAccountRoleClusterFilter(creator, role)
+	if err != nil {
+		return nil, err
+	}
+	return c.listClusters(base, options)
+}
+
+func (c *Client) listClusters(base string, options ClusterListOptions) ([]*cmv1.Cluster, error) {
+	query, err := options.query(base)
+	if err != nil {
+		return nil, err
+	}
+	order, err := options.order()
+	if err != nil {
+		return nil, err
+	}
+	return c.queryClusters(query, order, options.Limit)
+}
+
+// GetClustersUsingSubnets returns the clusters of the organization in the given region that use
+// any of the given subnets.
+func (c *Client) GetClustersUsingSubnets(region string, subnetIDs []string) ([]*cmv1.Cluster, error) {
+	if len(subnetIDs) == 0 {
+		return nil, nil
+	}
+	clusters, err := c.ListClusters(nil, ClusterListOptions{Region: region})
+	if err != nil {
+		return nil, err
+	}
+	result := []*cmv1.Cluster{}
+	for _, cluster := range clusters {
+		for _, subnet := range cluster.AWS().SubnetIDs() {
+			if slices.Contains(subnetIDs, subnet) {
+				result = append(result, cluster)
+				break
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,185 @@
+package ocm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	"github.com/openshift-online/ocm-sdk-go/logging"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/aws"
+)
+
+var _ = Describe("Cluster list options", func() {
+	const base = "product.id = 'rosa'"
+
+	It("Returns the base filter without options", func() {
+		query, err := ClusterListOptions{}.query(base)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(query).To(Equal(base))
+	})
+
+	It("Combines all the filters", func() {
+		query, err := ClusterListOptions{
+			States:     []string{"ready", "Error"},
+			Topology:   "hcp",
+			Region:     "us-east-1",
+			Version:    "4.14",
+			NamePrefix: "prod_",
+			Tags:       map[string]string{"team": "pay'ments", "env": "prod"},
+			Search:     "multi_az = 'true'",
+		}.query(base)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(query).To(Equal(base +
+			" AND state IN ('ready', 'error')" +
+			" AND hypershift.enabled = 'true'" +
+			" AND region.id = 'us-east-1'" +
+			" AND openshift_version LIKE '4.14.%'" +
+			` AND name LIKE 'prod\_%'` +
+			" AND aws.tags.env = 'prod'" +
+			" AND aws.tags.team = 'pay''ments'" +
+			" AND (multi_az = 'true')"))
+	})
+
+	It("Matches a complete version exactly", func() {
+		query, err := ClusterListOptions{Version: "openshift-v4.14.5", Topology: "classic"}.query(base)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(query).To(Equal(base + " AND hypershift.enabled = 'false' AND openshift_version = '4.14.5'"))
+	})
+
+	DescribeTable("Rejects invalid options",
+		func(options ClusterListOptions, message string) {
+			_, err := options.query(base)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(message))
+		},
+		Entry("state", ClusterListOptions{States: []string{"running"}}, "invalid cluster state 'running'"),
+		Entry("topology", ClusterListOptions{Topology: "rosa"}, "invalid topology 'rosa'"),
+		Entry("tag key", ClusterListOptions{Tags: map[string]string{"a' OR 1=1": "x"}}, "invalid tag key"),
+		Entry("tag key with separators", ClusterListOptions{Tags: map[string]string{"team = 'x' or name": "y"}},
+			"invalid tag key"),
+		Entry("tag key with path", ClusterListOptions{Tags: map[string]string{"kubernetes.io/cluster": "x"}},
+			"invalid tag key"),
+	)
+
+	It("Builds the order", func() {
+		order, err := ClusterListOptions{SortBy: []string{"name", "-created"}}.order()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(order).To(Equal("name asc, creation_timestamp desc"))
+	})
+
+	It("Rejects an unknown sort field", func() {
+		_, err := ClusterListOptions{SortBy: []string{"owner"}}.order()
+		Expect(err).To(MatchError(
+			"invalid sort field 'owner'. Valid fields are created, id, name, region, state, version"))
+	})
+})
+
+var _ = Describe("List clusters", func() {
+	var ssoServer, apiServer *ghttp.Server
+	var ocmClient *Client
+
+	clusterPage := func(page, size, total int) string {
+		items := make([]string, size)
+		for i := range items {
+			items[i] = fmt.Sprintf(`{"kind": "Cluster", "id": "cluster-%d-%d"}`, page, i)
+		}
+		return fmt.Sprintf(`{"kind": "ClusterList", "page": %d, "size": %d, "total": %d, "items": [%s]}`,
+			page, size, total, strings.Join(items, ","))
+	}
+
+	BeforeEach(func() {
+		ssoServer = MakeTCPServer()
+		apiServer = MakeTCPServer()
+		accessToken := MakeTokenString("Bearer", 15*time.Minute)
+		ssoServer.AppendHandlers(RespondWithAccessToken(accessToken))
+		logger, err := logging.NewGoLoggerBuilder().Debug(false).Build()
+		Expect(err).NotTo(HaveOccurred())
+		connection, err := sdk.NewConnectionBuilder().
+			Logger(logger).
+			Tokens(accessToken).
+			URL(apiServer.URL()).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		ocmClient = &Client{ocm: connection}
+	})
+
+	AfterEach(func() {
+		ssoServer.Close()
+		apiServer.Close()
+		Expect(ocmClient.Close()).To(Succeed())
+	})
+
+	It("Follows the pages past the first one", func() {
+		apiServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyFormKV("page", "1"),
+				ghttp.VerifyFormKV("size", "100"),
+				ghttp.VerifyFormKV("order", "name asc"),
+				RespondWithJSON(http.StatusOK, clusterPage(1, 100, 150)),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyFormKV("page", "2"),
+				RespondWithJSON(http.StatusOK, clusterPage(2, 50, 150)),
+			),
+		)
+		clusters, err := ocmClient.ListClusters(nil, ClusterListOptions{SortBy: []string{"name"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusters).To(HaveLen(150))
+		Expect(clusters[149].ID()).To(Equal("cluster-2-49"))
+	})
+
+	It("Stops at the limit", func() {
+		apiServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyFormKV("page", "1"),
+				ghttp.VerifyFormKV("size", "100"),
+				RespondWithJSON(http.StatusOK, clusterPage(1, 100, 500)),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyFormKV("page", "2"),
+				RespondWithJSON(http.StatusOK, clusterPage(2, 100, 500)),
+			),
+		)
+		clusters, err := ocmClient.ListClusters(nil, ClusterListOptions{Limit: 120})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusters).To(HaveLen(120))
+		Expect(apiServer.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("Sends the filters in the search parameter", func() {
+		creator := &aws.Creator{AccountID: "123"}
+		apiServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyFormKV("size", "5"),
+				ghttp.VerifyFormKV("search", getClusterFilter(creator)+" AND region.id = 'eu-west-1'"),
+				RespondWithJSON(http.StatusOK, clusterPage(1, 2, 2)),
+			),
+		)
+		clusters, err := ocmClient.ListClusters(creator, ClusterListOptions{Region: "eu-west-1", Limit: 5})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusters).To(HaveLen(2))
+	})
+
+	It("Returns the clusters using the subnets", func() {
+		apiServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyFormKV("search", getClusterFilter(nil)+" AND region.id = 'us-east-1'"),
+				RespondWithJSON(http.StatusOK, `{"kind": "ClusterList", "page": 1, "size": 2, "total": 2, "items": [
+					{"kind": "Cluster", "id": "a", "aws": {"subnet_ids": ["subnet-1", "subnet-2"]}},
+					{"kind": "Cluster", "id": "b", "aws": {"subnet_ids": ["subnet-3"]}}
+				]}`),
+			),
+		)
+		clusters, err := ocmClient.GetClustersUsingSubnets("us-east-1", []string{"subnet-2", "subnet-4"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusters).To(HaveLen(1))
+		Expect(clusters[0].ID()).To(Equal("a"))
+	})
+})
@@ -31,6 +31,7 @@ import (
 	errors "github.com/zgalor/weberr"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/fedramp"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/info"
@@ -311,49 +312,47 @@ func getAccountRoleClusterFilter(aws *aws.Creator, role aws.Role) (string, error
 	return fmt.Sprintf("%s AND %s='%s'", query, accountRoleField, role.RoleARN), nil
 }
 
-func (c *Client) GetClustersUsingAccountRole(aws *aws.Creator, role aws.Role, count int) ([]*cmv1.Cluster, error) {
-	query, err := getAccountRoleClusterFilter(aws, role)
-	if err != nil {
-		return nil, err
-	}
+// clusterPageSize is the number of clusters requested in each page.
+const clusterPageSize = 100
 
-	return c.queryClusters(query, count)
-}
-
-func (c *Client) queryClusters(query string, count int) (clusters []*cmv1.Cluster, err error) {
-
-	if count < 0 {
+// queryClusters returns the clusters that match the query, sorted by the given order, following
+// the pages of results until 'limit' clusters have been collected. A limit of zero returns all
+// the matching clusters.
+func (c *Client) queryClusters(query string, order string, limit int) (clusters []*cmv1.Cluster, err error) {
+	if limit < 0 {
 		err = errors.Errorf("Invalid Cluster count")
 		return
 	}
 
 	request := c.ocm.ClustersMgmt().V1().Clusters().List().Search(query)
-	page := 1
-	for {
-		clusterRequestList := request.Page(page)
-		if count > 0 {
-			clusterRequestList = clusterRequestList.Size(count)
-		}
-		response, err := clusterRequestList.Send()
+	if order != "" {
+		request = request.Order(order)
+	}
+	size := clusterPageSize
+	if limit > 0 && limit < size {
+		size = limit
+	}
+	for page := 1; ; page++ {
+		response, err := request.Page(page).Size(size).Send()
 		if err != nil {
 			return clusters, err
 		}
 
-		response.Items().Each(func(cluster *cmv1.Cluster) bool {
-			clusters = append(clusters, cluster)
-			return true
-		})
-		if response.Size() != count {
+		clusters = append(clusters, response.Items().Slice()...)
+		if limit > 0 && len(clusters) >= limit {
+			return clusters[:limit], nil
+		}
+		if response.Size() < size || len(clusters) >= response.Total() {
 			break
 		}
-		page++
 	}
 	return clusters, nil
 }
 
-// Pass 0 to get all clusters
+// GetClusters returns at most 'count' clusters, the pages are requested as needed. Pass 0 to get all
+// clusters.
 func (c *Client) GetClusters(creator *aws.Creator, count int) (clusters []*cmv1.Cluster, err error) {
-	return c.queryClusters(getClusterFilter(creator), count)
+	return c.ListClusters(creator, ClusterListOptions{Limit: count})
 }
 
 func (c *Client) GetAllClusters(creator *aws.Creator) (clusters []*cmv1.Cluster, err error) {
@@ -801,6 +800,24 @@ func (c *Client) UpdateClusterDeleteProtection(clusterId string, deleteProtectio
 	return nil
 }
 
+// UpdateClusterProperties replaces the properties of the given cluster. The properties not
+// included are removed, so callers need to start from the current ones.
+func (c *Client) UpdateClusterProperties(clusterID string, properties map[string]string) error {
+	cluster, err := cmv1.NewCluster().Properties(properties).Build()
+	if err != nil {
+		return err
+	}
+	response, err := c.ocm.ClustersMgmt().V1().Clusters().
+		Cluster(clusterID).
+		Update().
+		Body(cluster).
+		Send()
+	if err != nil {
+		return handleErr(response.Error(), err)
+	}
+	return nil
+}
+
 // EnsureNoPendingClusters ensures that no clusters are pending in the account. For non-STS clusters,
 // the osdCcsAdmin user credentials are used to create the cluster, and it is required that these credentials
 // are rotated between cluster creation. If a user is creating a non-STS cluster, we need to therefore make sure
@@ -817,7 +834,7 @@ func (c *Client) EnsureNoPendingClusters(awsCreator *aws.Creator) error {
 		pendingCluster, err := c.GetPendingClusterForARN(awsCreator)
 		if err != nil {
 			reporter.Errorf("Error getting cluster using ARN '%s'", awsCreator.ARN)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 		if time.Now().After(deadline) {
 			reporter.Errorf("Timeout waiting for the cluster '%s' installation. Try again in a few minutes",
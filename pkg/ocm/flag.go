@@ -84,7 +84,8 @@ func clusterCompletion(_ *cobra.Command, _ []string, _ string) ([]string, cobra.
 		return []string{}, cobra.ShellCompDirectiveDefault
 	}
 
-	clusters, err := ocmClient.GetClusters(awsCreator, 10)
+	// Offer all the clusters, the count is a limit and not the size of the pages:
+	clusters, err := ocmClient.GetClusters(awsCreator, 0)
 	if err != nil {
 		return []string{}, cobra.ShellCompDirectiveDefault
 	}
@@ -319,7 +319,25 @@ func handleErr(res *ocmerrors.Error, err error) error {
 	}
 	// The error type set will be No Type though
 	errType := errors.ErrorType(res.Status())
-	return errType.Set(errors.Errorf("%s", msg))
+	if res == nil {
+		return errType.Set(errors.Errorf("%s", msg))
+	}
+	return errType.Set(&apiError{message: msg, cause: res})
+}
+
+// apiError keeps the error returned by the OCM API in the chain of the errors returned by the
+// client, so that its code and identifiers can be reported to automation.
+type apiError struct {
+	message string
+	cause   *ocmerrors.Error
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func (e *apiError) Unwrap() error {
+	return e.cause
 }
 
 func (c *Client) GetDefaultClusterFlavors(flavour string) (dMachinecidr *net.IPNet, dPodcidr *net.IPNet,
@@ -17,7 +17,9 @@ limitations under the License.
 package ocm
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 	"time"
@@ -32,6 +34,7 @@ import (
 	commonUtils "github.com/openshift-online/ocm-common/pkg/utils"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	ocmerrors "github.com/openshift-online/ocm-sdk-go/errors"
+	weberr "github.com/zgalor/weberr"
 
 	mock "github.com/openshift/rosa/pkg/aws"
 )
@@ -51,6 +54,21 @@ var _ = Describe("Error Handler", func() {
 		Expect(err).To(HaveOccurred())
 		Expect(err.Error()).To(Equal(ocmError.Error()))
 	})
+	It("Keeps the ocm error in the chain", func() {
+		ocmError, err := ocmerrors.NewError().
+			Status(http.StatusNotFound).
+			ID("404").
+			Code("CLUSTERS-MGMT-404").
+			Reason("Cluster 'abc' not found").
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		handled := handleErr(ocmError, sendError)
+		Expect(weberr.GetType(handled)).To(Equal(weberr.NotFound))
+		err = fmt.Errorf("Failed to get cluster: %w", handled)
+		var cause *ocmerrors.Error
+		Expect(errors.As(err, &cause)).To(BeTrue())
+		Expect(cause.Code()).To(Equal("CLUSTERS-MGMT-404"))
+	})
 	It("Populates error message from send error", func() {
 		now := time.Now().UTC()
 		ocmError, err := ocmerrors.NewError().
@@ -127,6 +127,26 @@ func (c *Client) DeleteHTPasswdUser(username, clusterID string, htpasswdIDP *cmv
 	return nil
 }
 
+func (c *Client) UpdateHTPasswdUser(clusterID, idpID string, user *cmv1.HTPasswdUser) error {
+	response, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).
+		IdentityProviders().IdentityProvider(idpID).HtpasswdUsers().
+		HtpasswdUser(user.ID()).Update().Body(user).Send()
+	if err != nil {
+		return handleErr(response.Error(), err)
+	}
+	return nil
+}
+
+func (c *Client) DeleteHTPasswdUserByID(clusterID, idpID, userID string) error {
+	response, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).
+		IdentityProviders().IdentityProvider(idpID).HtpasswdUsers().
+		HtpasswdUser(userID).Delete().Send()
+	if err != nil {
+		return handleErr(response.Error(), err)
+	}
+	return nil
+}
+
 func (c *Client) DeleteIdentityProvider(clusterID string, idpID string) error {
 	response, err := c.ocm.ClustersMgmt().V1().
 		Clusters().Cluster(clusterID).
@@ -210,3 +230,31 @@ func GetOAuthURL(cluster *cmv1.Cluster, idp *cmv1.IdentityProvider) (string, err
 	}
 	return fmt.Sprintf("%s/oauth2callback/%s", oauthURL, idp.Name()), nil
 }
+
+// FindIdentityProvider returns the identity provider of the cluster with the given name or ID.
+func (c *Client) FindIdentityProvider(clusterID string, key string) (*cmv1.IdentityProvider, bool, error) {
+	idps, err := c.GetIdentityProviders(clusterID)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, idp := range idps {
+		if idp.Name() == key || idp.ID() == key {
+			return idp, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// UpdateIdentityProvider patches the identity provider with the fields set in the given one.
+func (c *Client) UpdateIdentityProvider(clusterID string, idpID string,
+	patch *cmv1.IdentityProvider) (*cmv1.IdentityProvider, error) {
+	response, err := c.ocm.ClustersMgmt().V1().
+		Clusters().Cluster(clusterID).
+		IdentityProviders().IdentityProvider(idpID).
+		Update().Body(patch).
+		Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Body(), nil
+}
@@ -19,6 +19,8 @@ package ocm
 import (
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	v1 "github.com/openshift-online/ocm-api-model/clientapi/clustersmgmt/v1"
@@ -171,6 +173,12 @@ func (c *Client) GetAvailableMachineTypes(featureFilters FeatureFilters) (Machin
 }
 
 func (c *Client) getQuotaCosts() (*amsv1.QuotaCostList, error) {
+	return c.GetQuotaCosts("quota_id~='gpu'")
+}
+
+// GetQuotaCosts returns the quota costs of the organization of the current account that match the
+// given search, or all of them if the search is empty.
+func (c *Client) GetQuotaCosts(search string) (*amsv1.QuotaCostList, error) {
 	acctResponse, err := c.ocm.AccountsMgmt().V1().CurrentAccount().
 		Get().
 		Send()
@@ -178,15 +186,17 @@ func (c *Client) getQuotaCosts() (*amsv1.QuotaCostList, error) {
 		return nil, handleErr(acctResponse.Error(), err)
 	}
 	organization := acctResponse.Body().Organization().ID()
-	quotaCostResponse, err := c.ocm.AccountsMgmt().V1().Organizations().
+	request := c.ocm.AccountsMgmt().V1().Organizations().
 		Organization(organization).
 		QuotaCost().
 		List().
 		Parameter("fetchRelatedResources", true).
-		Parameter("search", "quota_id~='gpu'").
 		Page(1).
-		Size(-1).
-		Send()
+		Size(-1)
+	if search != "" {
+		request = request.Parameter("search", search)
+	}
+	quotaCostResponse, err := request.Send()
 	if err != nil {
 		return nil, handleErr(quotaCostResponse.Error(), err)
 	}
@@ -252,6 +262,48 @@ func (mtl *MachineTypeList) UpdateAvailableQuota(quotaCosts *amsv1.QuotaCostList
 	}
 }
 
+// QuotaRequirement is the OCM quota needed to run a number of nodes.
+type QuotaRequirement struct {
+	QuotaID   string
+	Required  int
+	Available int
+}
+
+// RequiredQuota returns the OCM quota needed to run the given number of nodes of each machine type,
+// only including the quotas that the nodes consume.
+func (mtl *MachineTypeList) RequiredQuota(quotaCosts *amsv1.QuotaCostList,
+	nodes map[string]int) []*QuotaRequirement {
+	requirements := []*QuotaRequirement{}
+	byQuota := map[string]*QuotaRequirement{}
+	for _, id := range slices.Sorted(maps.Keys(nodes)) {
+		machineType := mtl.Find(id)
+		if machineType == nil || nodes[id] == 0 {
+			continue
+		}
+		quotaCosts.Each(func(quotaCost *amsv1.QuotaCost) bool {
+			for _, relatedResource := range quotaCost.RelatedResources() {
+				if machineType.MachineType.GenericName() != relatedResource.ResourceName() ||
+					!isCompatible(relatedResource) || relatedResource.Cost() == 0 {
+					continue
+				}
+				requirement, ok := byQuota[quotaCost.QuotaID()]
+				if !ok {
+					requirement = &QuotaRequirement{
+						QuotaID:   quotaCost.QuotaID(),
+						Available: quotaCost.Allowed() - quotaCost.Consumed(),
+					}
+					byQuota[quotaCost.QuotaID()] = requirement
+					requirements = append(requirements, requirement)
+				}
+				requirement.Required += nodes[id] * relatedResource.Cost()
+				break
+			}
+			return true
+		})
+	}
+	return requirements
+}
+
 func (mtl *MachineTypeList) GetAvailableIDs(multiAZ bool) *MachineTypeList {
 	list := mtl.Filter(func(mt *MachineType) bool {
 		return mt.Available && mt.HasQuota(multiAZ)
@@ -0,0 +1,251 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	ocmerrors "github.com/openshift-online/ocm-sdk-go/errors"
+)
+
+// ErrPollTimeout is returned by the poll functions when the timeout expires before the object
+// satisfies the condition.
+var ErrPollTimeout = errors.New("timed out")
+
+// retryableStatuses are the error statuses that don't stop the polling, as they are expected to go
+// away by themselves.
+var retryableStatuses = []int{
+	http.StatusRequestTimeout,
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// pollStatuses are the statuses of the responses that the poll requests hand to the predicate to
+// decide if polling is over: the object, its absence and the errors that retrying won't fix.
+var pollStatuses = func() []int {
+	statuses := []int{http.StatusOK, http.StatusNotFound}
+	for status := 400; status < 600; status++ {
+		if status != http.StatusNotFound && !slices.Contains(retryableStatuses, status) {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}()
+
+// getResponse is the response of the get requests of the polled objects.
+type getResponse[T any] interface {
+	Status() int
+	Body() T
+	Error() *ocmerrors.Error
+}
+
+// poll runs the poll request built by start until done returns true for the object. The request
+// must accept the given statuses and use the given predicate. The condition receives nil once the
+// object doesn't exist. Transient errors of the API and of the connection are ignored, other
+// errors of the API are returned right away, and ErrPollTimeout is returned when the context
+// expires. The last object seen is always returned.
+func poll[T any, R getResponse[T], P any](ctx context.Context, interval time.Duration, done func(T) bool,
+	start func(ctx context.Context, statuses []int, predicate func(R) bool) (P, error)) (T, error) {
+	var last T
+	var satisfied bool
+	var failure *ocmerrors.Error
+	var failed bool
+	predicate := func(response R) bool {
+		switch status := response.Status(); {
+		case status == http.StatusOK:
+			last = response.Body()
+		case status == http.StatusNotFound:
+			var none T
+			last = none
+		case slices.Contains(retryableStatuses, status):
+			return false
+		default:
+			failure, failed = response.Error(), true
+			return true
+		}
+		satisfied = done(last)
+		return satisfied
+	}
+	for {
+		_, err := start(ctx, pollStatuses, predicate)
+		switch {
+		case failed:
+			return last, handleErr(failure, err)
+		case satisfied:
+			return last, nil
+		case err == nil || ctx.Err() != nil:
+			// The SDK stops without an error when the next attempt would be after the deadline:
+			return last, ErrPollTimeout
+		}
+		// The request failed without a response, like when the connection is reset, so start
+		// polling again as the problem is expected to go away by itself:
+		select {
+		case <-ctx.Done():
+			return last, ErrPollTimeout
+		case <-time.After(interval):
+		}
+	}
+}
+
+// PollCluster gets the cluster every interval until done returns true for it, or returns
+// ErrPollTimeout when the timeout expires.
+func (c *Client) PollCluster(clusterID string, interval time.Duration, timeout time.Duration,
+	done func(*cmv1.Cluster) bool) (*cmv1.Cluster, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return poll(ctx, interval, done, func(ctx context.Context, statuses []int,
+		predicate func(*cmv1.ClusterGetResponse) bool) (*cmv1.ClusterPollResponse, error) {
+		request := c.ocm.ClustersMgmt().V1().Clusters().
+			Cluster(clusterID).
+			Poll().
+			Interval(interval).
+			Predicate(predicate)
+		for _, status := range statuses {
+			request.Status(status)
+		}
+		return request.StartContext(ctx)
+	})
+}
+
+// PollMachinePool gets the machine pool every interval until done returns true for it, or
+// returns ErrPollTimeout when the timeout expires.
+func (c *Client) PollMachinePool(clusterID string, machinePoolID string, interval time.Duration,
+	timeout time.Duration, done func(*cmv1.MachinePool) bool) (*cmv1.MachinePool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return poll(ctx, interval, done, func(ctx context.Context, statuses []int,
+		predicate func(*cmv1.MachinePoolGetResponse) bool) (*cmv1.MachinePoolPollResponse, error) {
+		request := c.ocm.ClustersMgmt().V1().Clusters().
+			Cluster(clusterID).
+			MachinePools().
+			MachinePool(machinePoolID).
+			Poll().
+			Interval(interval).
+			Predicate(predicate)
+		for _, status := range statuses {
+			request.Status(status)
+		}
+		return request.StartContext(ctx)
+	})
+}
+
+// PollNodePool gets the node pool every interval until done returns true for it, or returns
+// ErrPollTimeout when the timeout expires.
+func (c *Client) PollNodePool(clusterID string, nodePoolID string, interval time.Duration,
+	timeout time.Duration, done func(*cmv1.NodePool) bool) (*cmv1.NodePool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return poll(ctx, interval, done, func(ctx context.Context, statuses []int,
+		predicate func(*cmv1.NodePoolGetResponse) bool) (*cmv1.NodePoolPollResponse, error) {
+		request := c.ocm.ClustersMgmt().V1().Clusters().
+			Cluster(clusterID).
+			NodePools().
+			NodePool(nodePoolID).
+			Poll().
+			Interval(interval).
+			Predicate(predicate)
+		for _, status := range statuses {
+			request.Status(status)
+		}
+		return request.StartContext(ctx)
+	})
+}
+
+// PollUpgradePolicyState gets the state of the upgrade policy of a classic cluster every interval
+// until done returns true for it, or returns ErrPollTimeout when the timeout expires.
+func (c *Client) PollUpgradePolicyState(clusterID string, upgradePolicyID string, interval time.Duration,
+	timeout time.Duration, done func(*cmv1.UpgradePolicyState) bool) (*cmv1.UpgradePolicyState, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return poll(ctx, interval, done, func(ctx context.Context, statuses []int,
+		predicate func(*cmv1.UpgradePolicyStateGetResponse) bool) (*cmv1.UpgradePolicyStatePollResponse, error) {
+		request := c.ocm.ClustersMgmt().V1().Clusters().
+			Cluster(clusterID).
+			UpgradePolicies().
+			UpgradePolicy(upgradePolicyID).
+			State().
+			Poll().
+			Interval(interval).
+			Predicate(predicate)
+		for _, status := range statuses {
+			request.Status(status)
+		}
+		return request.StartContext(ctx)
+	})
+}
+
+// PollControlPlaneUpgradePolicy gets the control plane upgrade policy of a hosted cluster every
+// interval until done returns true for it, or returns ErrPollTimeout when the timeout expires.
+func (c *Client) PollControlPlaneUpgradePolicy(clusterID string, upgradePolicyID string, interval time.Duration,
+	timeout time.Duration,
+	done func(*cmv1.ControlPlaneUpgradePolicy) bool) (*cmv1.ControlPlaneUpgradePolicy, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return poll(ctx, interval, done, func(ctx context.Context, statuses []int,
+		predicate func(*cmv1.ControlPlaneUpgradePolicyGetResponse) bool) (*cmv1.ControlPlaneUpgradePolicyPollResponse, error) {
+		request := c.ocm.ClustersMgmt().V1().Clusters().
+			Cluster(clusterID).
+			ControlPlane().
+			UpgradePolicies().
+			ControlPlaneUpgradePolicy(upgradePolicyID).
+			Poll().
+			Interval(interval).
+			Predicate(predicate)
+		for _, status := range statuses {
+			request.Status(status)
+		}
+		return request.StartContext(ctx)
+	})
+}
+
+// PollNodePoolUpgradePolicy gets the upgrade policy of a node pool every interval until done
+// returns true for it, or returns ErrPollTimeout when the timeout expires.
+func (c *Client) PollNodePoolUpgradePolicy(clusterID string, nodePoolID string, upgradePolicyID string,
+	interval time.Duration, timeout time.Duration,
+	done func(*cmv1.NodePoolUpgradePolicy) bool) (*cmv1.NodePoolUpgradePolicy, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return poll(ctx, interval, done, func(ctx context.Context, statuses []int,
+		predicate func(*cmv1.NodePoolUpgradePolicyGetResponse) bool) (*cmv1.NodePoolUpgradePolicyPollResponse, error) {
+		request := c.ocm.ClustersMgmt().V1().Clusters().
+			Cluster(clusterID).
+			NodePools().
+			NodePool(nodePoolID).
+			UpgradePolicies().
+			NodePoolUpgradePolicy(upgradePolicyID).
+			Poll().
+			Interval(interval).
+			Predicate(predicate)
+		for _, status := range statuses {
+			request.Status(status)
+		}
+		return request.StartContext(ctx)
+	})
+}
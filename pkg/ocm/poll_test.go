@@ -0,0 +1,129 @@
+package ocm
+
+import (
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/ocm-sdk-go/logging"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+)
+
+var _ = Describe("Poll", func() {
+	const (
+		interval    = 10 * time.Millisecond
+		clusterPath = "/api/clusters_mgmt/v1/clusters/123"
+		notFound    = `{"kind": "Error", "id": "404", "code": "CLUSTERS-MGMT-404", "reason": "Not found"}`
+	)
+
+	var ssoServer, apiServer *ghttp.Server
+	var ocmClient *Client
+
+	BeforeEach(func() {
+		ssoServer = MakeTCPServer()
+		apiServer = MakeTCPServer()
+		accessToken := MakeTokenString("Bearer", 15*time.Minute)
+		ssoServer.AppendHandlers(RespondWithAccessToken(accessToken))
+		logger, err := logging.NewGoLoggerBuilder().Debug(false).Build()
+		Expect(err).NotTo(HaveOccurred())
+		connection, err := sdk.NewConnectionBuilder().
+			Logger(logger).
+			Tokens(accessToken).
+			URL(apiServer.URL()).
+			RetryLimit(0).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		ocmClient = &Client{ocm: connection}
+	})
+
+	AfterEach(func() {
+		ssoServer.Close()
+		apiServer.Close()
+		Expect(ocmClient.Close()).To(Succeed())
+	})
+
+	isReady := func(cluster *cmv1.Cluster) bool {
+		return cluster != nil && cluster.State() == cmv1.ClusterStateReady
+	}
+
+	It("Polls until the condition is met, ignoring transient errors", func() {
+		apiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{"kind": "Cluster", "id": "123", "state": "installing"}`),
+			RespondWithJSON(http.StatusServiceUnavailable, `{"kind": "Error", "id": "503"}`),
+			RespondWithJSON(http.StatusOK, `{"kind": "Cluster", "id": "123", "state": "ready"}`),
+		)
+		cluster, err := ocmClient.PollCluster("123", interval, time.Minute, isReady)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cluster.State()).To(Equal(cmv1.ClusterStateReady))
+		Expect(apiServer.ReceivedRequests()).To(HaveLen(3))
+	})
+
+	It("Polls again after the connection fails", func() {
+		apiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{"kind": "Cluster", "id": "123", "state": "installing"}`),
+			func(w http.ResponseWriter, _ *http.Request) {
+				conn, _, err := w.(http.Hijacker).Hijack()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(conn.Close()).To(Succeed())
+			},
+			RespondWithJSON(http.StatusOK, `{"kind": "Cluster", "id": "123", "state": "ready"}`),
+		)
+		cluster, err := ocmClient.PollCluster("123", interval, time.Minute, isReady)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cluster.State()).To(Equal(cmv1.ClusterStateReady))
+		Expect(apiServer.ReceivedRequests()).To(HaveLen(3))
+	})
+
+	It("Returns the errors that retrying won't fix right away", func() {
+		apiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{"kind": "Cluster", "id": "123", "state": "installing"}`),
+			RespondWithJSON(http.StatusForbidden,
+				`{"kind": "Error", "id": "403", "code": "CLUSTERS-MGMT-403", "reason": "Forbidden"}`),
+		)
+		cluster, err := ocmClient.PollCluster("123", interval, time.Minute, isReady)
+		Expect(err).To(MatchError(ContainSubstring("Forbidden")))
+		Expect(err).NotTo(MatchError(ErrPollTimeout))
+		Expect(cluster.State()).To(Equal(cmv1.ClusterStateInstalling))
+		Expect(apiServer.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("Passes nil to the condition once the object doesn't exist", func() {
+		apiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{"kind": "Cluster", "id": "123", "state": "uninstalling"}`),
+			RespondWithJSON(http.StatusNotFound, notFound),
+		)
+		cluster, err := ocmClient.PollCluster("123", interval, time.Minute, func(cluster *cmv1.Cluster) bool {
+			return cluster == nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cluster).To(BeNil())
+	})
+
+	It("Returns the last object seen when the timeout expires", func() {
+		apiServer.RouteToHandler(http.MethodGet, clusterPath,
+			RespondWithJSON(http.StatusOK, `{"kind": "Cluster", "id": "123", "state": "installing"}`))
+		cluster, err := ocmClient.PollCluster("123", interval, 50*time.Millisecond, isReady)
+		Expect(err).To(MatchError(ErrPollTimeout))
+		Expect(cluster.State()).To(Equal(cmv1.ClusterStateInstalling))
+	})
+
+	It("Polls the state of an upgrade policy", func() {
+		apiServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, clusterPath+"/upgrade_policies/456/state"),
+				RespondWithJSON(http.StatusOK, `{"kind": "UpgradePolicyState", "value": "started"}`),
+			),
+			RespondWithJSON(http.StatusOK, `{"kind": "UpgradePolicyState", "value": "completed"}`),
+		)
+		state, err := ocmClient.PollUpgradePolicyState("123", "456", interval, time.Minute,
+			func(state *cmv1.UpgradePolicyState) bool {
+				return state.Value() == cmv1.UpgradePolicyStateValueCompleted
+			})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state.Value()).To(Equal(cmv1.UpgradePolicyStateValueCompleted))
+	})
+})
@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+)
+
+// ServiceLogSeverities are the severities of the service log entries, from the least to the most
+// severe
+var ServiceLogSeverities = []string{
+	string(slv1.SeverityDebug),
+	string(slv1.SeverityInfo),
+	string(slv1.SeverityLow),
+	string(slv1.SeverityModerate),
+	string(slv1.SeverityWarning),
+	string(slv1.SeverityImportant),
+	string(slv1.SeverityMajor),
+	string(slv1.SeverityError),
+	string(slv1.SeverityCritical),
+	string(slv1.SeverityFatal),
+}
+
+// ServiceLogListOptions contains the filters used to list service log entries. The zero value
+// lists the entries of all the clusters of the organization.
+type ServiceLogListOptions struct {
+	ClusterID    string
+	Severities   []string
+	ServiceNames []string
+
+	// Since and Until select the entries with a timestamp in the range, zero values leave the
+	// range open.
+	Since time.Time
+	Until time.Time
+
+	// Limit is the maximum number of entries returned, the most recent ones are kept. Zero means
+	// no limit.
+	Limit int
+}
+
+func (o ServiceLogListOptions) query() (string, error) {
+	terms := []string{}
+	if o.ClusterID != "" {
+		terms = append(terms, fmt.Sprintf("cluster_id=%s", quoteSearchValue(o.ClusterID)))
+	}
+	if len(o.Severities) > 0 {
+		values := make([]string, 0, len(o.Severities))
+		for _, severity := range o.Severities {
+			normalized, err := NormalizeServiceLogSeverity(severity)
+			if err != nil {
+				return "", err
+			}
+			values = append(values, quoteSearchValue(normalized))
+		}
+		terms = append(terms, fmt.Sprintf("severity in (%s)", strings.Join(values, ", ")))
+	}
+	if len(o.ServiceNames) > 0 {
+		values := make([]string, 0, len(o.ServiceNames))
+		for _, name := range o.ServiceNames {
+			values = append(values, quoteSearchValue(strings.TrimSpace(name)))
+		}
+		terms = append(terms, fmt.Sprintf("service_name in (%s)", strings.Join(values, ", ")))
+	}
+	if !o.Since.IsZero() {
+		terms = append(terms, fmt.Sprintf("timestamp >= '%s'", o.Since.UTC().Format(time.RFC3339)))
+	}
+	if !o.Until.IsZero() {
+		terms = append(terms, fmt.Sprintf("timestamp <= '%s'", o.Until.UTC().Format(time.RFC3339)))
+	}
+	return strings.Join(terms, " and "), nil
+}
+
+// NormalizeServiceLogSeverity returns the severity with the capitalization used by the service
+func NormalizeServiceLogSeverity(severity string) (string, error) {
+	for _, valid := range ServiceLogSeverities {
+		if strings.EqualFold(strings.TrimSpace(severity), valid) {
+			return valid, nil
+		}
+	}
+	return "", fmt.Errorf("invalid service log severity '%s'. Valid severities are %s",
+		severity, strings.Join(ServiceLogSeverities, ", "))
+}
+
+// ListServiceLogs returns the service log entries that match the options, sorted from the oldest
+// to the most recent one.
+func (c *Client) ListServiceLogs(options ServiceLogListOptions) ([]*slv1.LogEntry, error) {
+	query, err := options.query()
+	if err != nil {
+		return nil, err
+	}
+	page := 1
+	size := 100
+	if options.Limit > 0 && options.Limit < size {
+		size = options.Limit
+	}
+	entries := []*slv1.LogEntry{}
+	for {
+		request := c.ocm.ServiceLogs().V1().ClusterLogs().List().
+			Order("timestamp desc").
+			Page(page).
+			Size(size)
+		if query != "" {
+			request = request.Search(query)
+		}
+		response, err := request.Send()
+		if err != nil {
+			return nil, handleErr(response.Error(), err)
+		}
+		response.Items().Each(func(entry *slv1.LogEntry) bool {
+			entries = append(entries, entry)
+			return options.Limit == 0 || len(entries) < options.Limit
+		})
+		if response.Size() < size || (options.Limit > 0 && len(entries) >= options.Limit) {
+			break
+		}
+		page++
+	}
+	// The entries are requested from the most recent one, so that the limit keeps the most recent
+	// ones, but they are returned in chronological order, like any other log:
+	for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+		entries[i], entries[j] = entries[j], entries[i]
+	}
+	return entries, nil
+}
+
+// GetServiceLog returns the service log entry with the given identifier
+func (c *Client) GetServiceLog(id string) (*slv1.LogEntry, bool, error) {
+	response, err := c.ocm.ServiceLogs().V1().ClusterLogs().LogEntry(id).Get().Send()
+	if response != nil && response.Status() == http.StatusNotFound {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, handleErr(response.Error(), err)
+	}
+	return response.Body(), true, nil
+}
@@ -0,0 +1,34 @@
+package ocm
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ServiceLogListOptions", func() {
+	since := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	until := time.Date(2026, 10, 2, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+
+	DescribeTable("builds the search query",
+		func(options ServiceLogListOptions, expected string) {
+			query, err := options.query()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(query).To(Equal(expected))
+		},
+		Entry("all the entries of the organization", ServiceLogListOptions{}, ""),
+		Entry("entries of a cluster", ServiceLogListOptions{ClusterID: "123"}, "cluster_id='123'"),
+		Entry("severities with any capitalization", ServiceLogListOptions{Severities: []string{"warning", "ERROR"}},
+			"severity in ('Warning', 'Error')"),
+		Entry("services with quotes", ServiceLogListOptions{ServiceNames: []string{"SREManualAction", "o'neil"}},
+			"service_name in ('SREManualAction', 'o''neil')"),
+		Entry("time range in UTC", ServiceLogListOptions{ClusterID: "123", Since: since, Until: until},
+			"cluster_id='123' and timestamp >= '2026-10-01T12:00:00Z' and timestamp <= '2026-10-02T10:00:00Z'"),
+	)
+
+	It("rejects an invalid severity", func() {
+		_, err := ServiceLogListOptions{Severities: []string{"Urgent"}}.query()
+		Expect(err).To(MatchError(ContainSubstring("invalid service log severity 'Urgent'")))
+	})
+})
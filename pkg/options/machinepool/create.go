@@ -37,6 +37,8 @@ type CreateMachinepoolUserOptions struct {
 	CapacityReservationId         string
 	Type                          string
 	CapacityReservationPreference string
+	DryRun                        bool
+	FromFile                      string
 }
 
 const (
@@ -58,7 +60,9 @@ const (
   rosa create machinepool -c mycluster --name=mp-1 --replicas=2 --instance-type=r5.2xlarge --use-spot-instances \
     --spot-max-price=0.5
   # Add a machine pool to a cluster and set the node drain grace period
-  rosa create machinepool -c mycluster --name=mp-1 --node-drain-grace-period="90 minutes"`
+  rosa create machinepool -c mycluster --name=mp-1 --node-drain-grace-period="90 minutes"
+  # Add the machine pools described in a machine pool spec file to a cluster
+  rosa create machinepool -c mycluster --from-file=machinepools.yaml`
 )
 
 type CreateMachinepoolOptions struct {
@@ -291,6 +295,20 @@ func BuildMachinePoolCreateCommandWithOptions() (*cobra.Command, *CreateMachinep
 		"",
 		"A configurable preference for a capacity-reservation. Options are: 'none' | "+
 			"'capacity-reservations-only' | 'open'")
+
+	flags.BoolVar(&options.DryRun,
+		"dry-run",
+		false,
+		"Estimate the OCM and AWS quota used by the machine pool without creating it. Fails when the "+
+			"machine pool would go over the available quota.")
+
+	flags.StringVar(&options.FromFile,
+		"from-file",
+		"",
+		"Path to a YAML or JSON machine pool spec file, such as one produced by 'rosa export machinepools'. "+
+			"The file can describe a single machine pool or a list of them. Flags set on the command line "+
+			"override the values in the file. Setting any of the replicas or autoscaling flags overrides "+
+			"the whole scaling of the file.")
 	output.AddFlag(cmd)
 	interactive.AddFlag(flags)
 	return cmd, options
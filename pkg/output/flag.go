@@ -29,12 +29,27 @@ const (
 	YAML           = "yaml"
 	FLAG_NAME      = "output"
 	FLAG_SHORTHAND = "o"
+
+	TABLE      = "table"
+	WIDE       = "wide"
+	NAME       = "name"
+	JSONPATH   = "jsonpath"
+	GOTEMPLATE = "go-template"
+
+	COLUMNS_FLAG_NAME    = "columns"
+	NO_HEADERS_FLAG_NAME = "no-headers"
 )
 
 var o string
 
 var formats = []string{JSON, YAML}
 
+var tableFormats = []string{TABLE, WIDE, NAME, JSON, YAML, JSONPATH + "=", GOTEMPLATE + "="}
+
+var columns []string
+
+var noHeaders bool
+
 // AddFlag adds the interactive flag to the given set of command line flags.
 func AddFlag(cmd *cobra.Command) {
 	cmd.Flags().StringVarP(
@@ -48,6 +63,36 @@ func AddFlag(cmd *cobra.Command) {
 	cmd.RegisterFlagCompletionFunc(FLAG_NAME, completion)
 }
 
+// AddTableFlags adds the output flag with the formats supported by the table renderer, together
+// with the flags that select the columns and hide the headers.
+func AddTableFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(
+		&o,
+		FLAG_NAME,
+		FLAG_SHORTHAND,
+		"",
+		fmt.Sprintf("Output format. Allowed formats are %s", tableFormats),
+	)
+	cmd.Flags().StringSliceVar(
+		&columns,
+		COLUMNS_FLAG_NAME,
+		nil,
+		"Comma-separated list of the columns to print, for example 'id,name'.",
+	)
+	cmd.Flags().BoolVar(
+		&noHeaders,
+		NO_HEADERS_FLAG_NAME,
+		false,
+		"Don't print the column headers.",
+	)
+
+	cmd.RegisterFlagCompletionFunc(FLAG_NAME, tableCompletion)
+}
+
+func tableCompletion(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	return tableFormats, cobra.ShellCompDirectiveNoSpace
+}
+
 func completion(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 	return formats, cobra.ShellCompDirectiveDefault
 }
@@ -63,6 +108,12 @@ func IsStructuredOutput() bool {
 	return o == JSON || o == YAML
 }
 
+// IsTableOutput returns true when the items are going to be printed as a table for humans, either
+// because no output format was requested or because one of the table formats was.
+func IsTableOutput() bool {
+	return o == "" || o == TABLE || o == WIDE
+}
+
 // Enabled retursn a boolean flag that indicates if the interactive mode is enabled.
 func Output() string {
 	return o
@@ -71,3 +122,13 @@ func Output() string {
 func SetOutput(output string) {
 	o = output
 }
+
+// SetColumns sets the columns printed by the table renderer.
+func SetColumns(value []string) {
+	columns = value
+}
+
+// SetNoHeaders sets whether the table renderer omits the column headers.
+func SetNoHeaders(value bool) {
+	noHeaders = value
+}
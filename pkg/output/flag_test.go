@@ -112,9 +112,11 @@ var _ = Describe("PrintError", func() {
 		result := PrintError(errors.New("connection failed"))
 		captured := captureOutput()
 		Expect(result).To(BeTrue())
-		var parsed map[string]string
+		var parsed map[string]interface{}
 		Expect(json.Unmarshal([]byte(captured), &parsed)).To(Succeed())
 		Expect(parsed["error"]).To(Equal("connection failed"))
+		Expect(parsed["class"]).To(Equal("generic"))
+		Expect(parsed["exit_code"]).To(BeEquivalentTo(1))
 	})
 
 	It("returns true and prints JSON error when YAML output is set", func() {
@@ -122,7 +124,7 @@ var _ = Describe("PrintError", func() {
 		result := PrintError(errors.New("token expired"))
 		captured := captureOutput()
 		Expect(result).To(BeTrue())
-		var parsed map[string]string
+		var parsed map[string]interface{}
 		Expect(json.Unmarshal([]byte(captured), &parsed)).To(Succeed())
 		Expect(parsed["error"]).To(Equal("token expired"))
 	})
@@ -30,9 +30,11 @@ import (
 
 	arv1 "github.com/openshift-online/ocm-sdk-go/accesstransparency/v1"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
 	msv1 "github.com/openshift-online/ocm-sdk-go/servicemgmt/v1"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clierror"
 )
 
 // When ocm-sdk-go encounters an empty resource list, it marshals it as a
@@ -54,18 +56,34 @@ func PrintWarn(err error) bool {
 }
 
 // PrintError outputs an error as JSON to stderr when a structured output format is requested.
+// Besides the message the object contains the class and exit code of the error, and the details
+// of the OCM or AWS API error that caused it, if any.
 // Returns true if the error was printed in structured format, false otherwise.
 func PrintError(err error) bool {
 	if !IsStructuredOutput() {
 		return false
 	}
-	errObj := map[string]string{"error": err.Error()}
-	b, _ := json.Marshal(errObj)
+	b, _ := json.Marshal(clierror.NewEnvelope(err))
 	fmt.Fprintln(os.Stderr, string(b))
 	return true
 }
 
 func Print(resource interface{}) error {
+	b, err := marshal(resource)
+	if err != nil {
+		return err
+	}
+	str, err := parseResource(b)
+	if err != nil {
+		return err
+	}
+	fmt.Print(str)
+	return nil
+}
+
+// marshal returns the JSON representation of the resource, using the ocm-sdk-go marshallers for
+// the types that have them.
+func marshal(resource interface{}) (bytes.Buffer, error) {
 	var b bytes.Buffer
 
 	switch reflect.TypeOf(resource).String() {
@@ -193,21 +211,21 @@ func Print(resource interface{}) error {
 		if imageMirror, ok := resource.(*cmv1.ImageMirror); ok {
 			err := cmv1.MarshalImageMirror(imageMirror, &b)
 			if err != nil {
-				return err
+				return b, err
 			}
 		}
 	case "[]*v1.ImageMirror":
 		if imageMirrors, ok := resource.([]*cmv1.ImageMirror); ok {
 			err := cmv1.MarshalImageMirrorList(imageMirrors, &b)
 			if err != nil {
-				return err
+				return b, err
 			}
 		}
 	case "[]aws.Role", "[]aws.OidcProviderOutput":
 		{
 			err := defaultEncode(resource, &b)
 			if err != nil {
-				return err
+				return b, err
 			}
 		}
 	case "map[string][]aws.Role":
@@ -215,7 +233,7 @@ func Print(resource interface{}) error {
 			for _, operatorRoles := range resource.(map[string][]aws.Role) {
 				err := Print(operatorRoles)
 				if err != nil {
-					return err
+					return b, err
 				}
 			}
 		}
@@ -227,12 +245,20 @@ func Print(resource interface{}) error {
 		if accessRequests, ok := resource.([]*arv1.AccessRequest); ok {
 			arv1.MarshalAccessRequestList(accessRequests, &b)
 		}
+	case "*v1.LogEntry":
+		if logEntry, ok := resource.(*slv1.LogEntry); ok {
+			slv1.MarshalLogEntry(logEntry, &b)
+		}
+	case "[]*v1.LogEntry":
+		if logEntries, ok := resource.([]*slv1.LogEntry); ok {
+			slv1.MarshalLogEntryList(logEntries, &b)
+		}
 	// default to catch non concrete types
 	default:
 		{
 			err := defaultEncode(resource, &b)
 			if err != nil {
-				return err
+				return b, err
 			}
 		}
 	}
@@ -241,12 +267,7 @@ func Print(resource interface{}) error {
 	if b.String() == string(emptyBuffer) {
 		b = *bytes.NewBufferString("[]")
 	}
-	str, err := parseResource(b)
-	if err != nil {
-		return err
-	}
-	fmt.Print(str)
-	return nil
+	return b, nil
 }
 
 // Provides a default encoding to JSON for types not being marshalled via the cmv1 package
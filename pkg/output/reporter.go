@@ -17,6 +17,7 @@ limitations under the License.
 package output
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/openshift/rosa/pkg/reporter"
@@ -38,6 +39,13 @@ func NewStructuredReporter(r reporter.Logger) reporter.Logger {
 
 func (r *StructuredReporter) Errorf(format string, args ...any) error {
 	err := fmt.Errorf(format, args...)
+	// Most callers format the cause with '%v', so keep it in the chain to classify the error
+	for _, arg := range args {
+		if cause, ok := arg.(error); ok && !errors.Is(err, cause) {
+			err = &formattedError{message: err.Error(), cause: cause}
+			break
+		}
+	}
 	if !PrintError(err) {
 		return r.inner.Errorf(format, args...)
 	}
@@ -61,3 +69,18 @@ func (r *StructuredReporter) Infof(format string, args ...any) {
 func (r *StructuredReporter) IsTerminal() bool {
 	return r.inner.IsTerminal()
 }
+
+// formattedError is an error whose message was formatted from another error, which is kept as
+// its cause.
+type formattedError struct {
+	message string
+	cause   error
+}
+
+func (e *formattedError) Error() string {
+	return e.message
+}
+
+func (e *formattedError) Unwrap() error {
+	return e.cause
+}
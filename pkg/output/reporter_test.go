@@ -2,6 +2,7 @@ package output
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -10,6 +11,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/reporter"
 )
 
@@ -102,6 +104,18 @@ var _ = Describe("StructuredReporter", func() {
 			Expect(captured).To(ContainSubstring("connection failed"))
 		})
 
+		It("classifies the error formatted in the message when JSON flag is set", func() {
+			SetOutput(JSON)
+			structured.Errorf("Failed to get cluster: %v",
+				clierror.New(clierror.NotFound, errors.New("cluster 'abc' not found")))
+			captured := captureStderr()
+			var parsed map[string]interface{}
+			Expect(json.Unmarshal([]byte(captured), &parsed)).To(Succeed())
+			Expect(parsed["error"]).To(Equal("Failed to get cluster: cluster 'abc' not found"))
+			Expect(parsed["class"]).To(Equal("not_found"))
+			Expect(parsed["exit_code"]).To(BeEquivalentTo(5))
+		})
+
 		It("prints JSON to stderr and skips inner reporter when YAML flag is set", func() {
 			SetOutput(YAML)
 			structured.Errorf("connection failed")
@@ -0,0 +1,239 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the table renderer shared by the 'list' commands.
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// Column describes one of the columns of a table.
+type Column[T any] struct {
+	// Header is the title of the column, it is also the name used to select it with '--columns'.
+	Header string
+
+	// Value returns the content of the cell for the given item.
+	Value func(T) string
+
+	// Wide columns are only printed with '--output wide' or when explicitly selected.
+	Wide bool
+}
+
+// Table describes how the items of a 'list' command are printed.
+type Table[T any] struct {
+	Columns []Column[T]
+
+	// Name returns the value printed for each item with '--output name'. When it isn't set the
+	// value of the first column is used.
+	Name func(T) string
+
+	// Default writes the default table, the one printed without '--output wide', '--columns' or
+	// '--no-headers'. It is used by the commands whose table layout predates the renderer, so that
+	// their default output stays the same for the scripts that parse it.
+	Default func(w io.Writer, items []T) error
+}
+
+// Print prints the items using the format selected with the '--output' flag.
+func (t Table[T]) Print(items []T) error {
+	return t.Write(os.Stdout, items)
+}
+
+// Write writes the items to the given writer using the format selected with the '--output' flag.
+func (t Table[T]) Write(w io.Writer, items []T) error {
+	switch {
+	case o == JSON || o == YAML:
+		b, err := marshal(items)
+		if err != nil {
+			return err
+		}
+		str, err := parseResource(b)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprint(w, str)
+		return err
+	case o == NAME:
+		return t.writeNames(w, items)
+	case strings.HasPrefix(o, JSONPATH+"="):
+		return writeJSONPath(w, items, strings.TrimPrefix(o, JSONPATH+"="))
+	case strings.HasPrefix(o, GOTEMPLATE+"="):
+		return writeGoTemplate(w, items, strings.TrimPrefix(o, GOTEMPLATE+"="))
+	case IsTableOutput():
+		return t.writeTable(w, items)
+	default:
+		return fmt.Errorf("unknown format '%s'. Valid formats are %s", o, tableFormats)
+	}
+}
+
+func (t Table[T]) writeTable(w io.Writer, items []T) error {
+	if t.Default != nil && o != WIDE && len(columns) == 0 && !noHeaders {
+		return t.Default(w, items)
+	}
+	selected, err := t.selectColumns()
+	if err != nil {
+		return err
+	}
+
+	writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if !noHeaders {
+		headers := make([]string, len(selected))
+		for i, column := range selected {
+			headers[i] = column.Header
+		}
+		fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
+	}
+	for _, item := range items {
+		// Cells that span multiple lines are printed in consecutive rows:
+		lines := make([][]string, len(selected))
+		height := 1
+		for i, column := range selected {
+			lines[i] = strings.Split(column.Value(item), "\n")
+			height = max(height, len(lines[i]))
+		}
+		for row := 0; row < height; row++ {
+			cells := make([]string, len(selected))
+			for i := range selected {
+				if row < len(lines[i]) {
+					cells[i] = lines[i][row]
+				}
+			}
+			fmt.Fprintf(writer, "%s\n", strings.Join(cells, "\t"))
+		}
+	}
+	return writer.Flush()
+}
+
+// selectColumns returns the columns requested with '--columns', or the default ones for the
+// selected output format.
+func (t Table[T]) selectColumns() ([]Column[T], error) {
+	if len(columns) == 0 {
+		result := []Column[T]{}
+		for _, column := range t.Columns {
+			if !column.Wide || o == WIDE {
+				result = append(result, column)
+			}
+		}
+		return result, nil
+	}
+
+	result := []Column[T]{}
+	for _, name := range columns {
+		found := false
+		for _, column := range t.Columns {
+			if columnKey(column.Header) == columnKey(name) {
+				result = append(result, column)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown column '%s'. Valid columns are %s", name, t.columnKeys())
+		}
+	}
+	return result, nil
+}
+
+func (t Table[T]) columnKeys() []string {
+	keys := make([]string, len(t.Columns))
+	for i, column := range t.Columns {
+		keys[i] = columnKey(column.Header)
+	}
+	return keys
+}
+
+// columnKey normalizes a column header so that 'CLUSTER ID', 'cluster-id' and 'cluster_id' all
+// select the same column.
+func columnKey(header string) string {
+	key := strings.ToLower(strings.TrimSpace(header))
+	key = strings.ReplaceAll(key, " ", "-")
+	return strings.ReplaceAll(key, "_", "-")
+}
+
+func (t Table[T]) writeNames(w io.Writer, items []T) error {
+	name := t.Name
+	if name == nil {
+		if len(t.Columns) == 0 {
+			return fmt.Errorf("format '%s' isn't supported by this command", NAME)
+		}
+		name = t.Columns[0].Value
+	}
+	for _, item := range items {
+		_, err := fmt.Fprintln(w, name(item))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeItems returns the generic JSON representation of the items, the same one printed by
+// '--output json', so that templates can refer to the documented field names.
+func decodeItems(items interface{}) (interface{}, error) {
+	b, err := marshal(items)
+	if err != nil {
+		return nil, err
+	}
+	var result interface{}
+	if b.Len() == 0 {
+		return []interface{}{}, nil
+	}
+	err = json.Unmarshal(b.Bytes(), &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// writeJSONPath evaluates the template with the JSONPath implementation of 'kubectl', so that the
+// same filters, recursive descent and 'range'/'end' blocks are supported. Templates without
+// braces, like '[*].id', are evaluated as a single expression.
+func writeJSONPath(w io.Writer, items interface{}, text string) error {
+	if !strings.Contains(text, "{") {
+		text = "{" + text + "}"
+	}
+	parser := jsonpath.New(JSONPATH).AllowMissingKeys(true)
+	err := parser.Parse(text)
+	if err != nil {
+		return fmt.Errorf("invalid jsonpath template: %v", err)
+	}
+	data, err := decodeItems(items)
+	if err != nil {
+		return err
+	}
+	return parser.Execute(w, data)
+}
+
+func writeGoTemplate(w io.Writer, items interface{}, text string) error {
+	tmpl, err := template.New(GOTEMPLATE).Parse(text)
+	if err != nil {
+		return fmt.Errorf("invalid go-template: %v", err)
+	}
+	data, err := decodeItems(items)
+	if err != nil {
+		return err
+	}
+	return tmpl.Execute(w, data)
+}
@@ -0,0 +1,178 @@
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+)
+
+type tableItem struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	State  string `json:"state"`
+	Region string `json:"region"`
+}
+
+var _ = Describe("Table", func() {
+	items := []tableItem{
+		{ID: "123", Name: "foo", State: "ready", Region: "us-east-1"},
+		{ID: "456", Name: "bar", State: "installing", Region: "eu-west-1"},
+	}
+	table := Table[tableItem]{
+		Columns: []Column[tableItem]{
+			{Header: "ID", Value: func(i tableItem) string { return i.ID }},
+			{Header: "NAME", Value: func(i tableItem) string { return i.Name }},
+			{Header: "STATE", Value: func(i tableItem) string { return i.State }},
+			{Header: "AWS REGION", Value: func(i tableItem) string { return i.Region }, Wide: true},
+		},
+	}
+	var buf *bytes.Buffer
+
+	BeforeEach(func() {
+		buf = new(bytes.Buffer)
+		SetOutput("")
+		SetColumns(nil)
+		SetNoHeaders(false)
+	})
+
+	AfterEach(func() {
+		SetOutput("")
+		SetColumns(nil)
+		SetNoHeaders(false)
+	})
+
+	It("Adds the table flags to the command", func() {
+		cmd := &cobra.Command{}
+		AddTableFlags(cmd)
+		Expect(cmd.Flag(FLAG_NAME).Usage).To(Equal(
+			"Output format. Allowed formats are [table wide name json yaml jsonpath= go-template=]"))
+		Expect(cmd.Flag(COLUMNS_FLAG_NAME)).NotTo(BeNil())
+		Expect(cmd.Flag(NO_HEADERS_FLAG_NAME)).NotTo(BeNil())
+	})
+
+	It("Prints the default columns", func() {
+		Expect(table.Write(buf, items)).To(Succeed())
+		Expect(buf.String()).To(Equal(
+			"ID   NAME  STATE\n" +
+				"123  foo   ready\n" +
+				"456  bar   installing\n"))
+	})
+
+	It("Prints the wide columns", func() {
+		SetOutput(WIDE)
+		Expect(table.Write(buf, items)).To(Succeed())
+		Expect(buf.String()).To(Equal(
+			"ID   NAME  STATE       AWS REGION\n" +
+				"123  foo   ready       us-east-1\n" +
+				"456  bar   installing  eu-west-1\n"))
+	})
+
+	It("Prints the selected columns without headers", func() {
+		SetOutput(TABLE)
+		SetColumns([]string{"aws-region", "Id"})
+		SetNoHeaders(true)
+		Expect(table.Write(buf, items)).To(Succeed())
+		Expect(buf.String()).To(Equal(
+			"us-east-1  123\n" +
+				"eu-west-1  456\n"))
+	})
+
+	It("Prints cells that span multiple lines in consecutive rows", func() {
+		multiline := Table[tableItem]{
+			Columns: []Column[tableItem]{
+				{Header: "ID", Value: func(i tableItem) string { return i.ID }},
+				{Header: "DESCRIPTION", Value: func(i tableItem) string { return i.Name + "\n" + i.State }},
+			},
+		}
+		Expect(multiline.Write(buf, items[:1])).To(Succeed())
+		Expect(buf.String()).To(Equal(
+			"ID   DESCRIPTION\n" +
+				"123  foo\n" +
+				"     ready\n"))
+	})
+
+	It("Keeps the default layout of the commands that predate the renderer", func() {
+		legacy := table
+		legacy.Default = func(w io.Writer, items []tableItem) error {
+			for _, item := range items {
+				fmt.Fprintf(w, "%s\t\t%s\n", item.ID, item.Name)
+			}
+			return nil
+		}
+		Expect(legacy.Write(buf, items[:1])).To(Succeed())
+		Expect(buf.String()).To(Equal("123\t\tfoo\n"))
+
+		buf.Reset()
+		SetOutput(WIDE)
+		Expect(legacy.Write(buf, items[:1])).To(Succeed())
+		Expect(buf.String()).To(Equal(
+			"ID   NAME  STATE  AWS REGION\n" +
+				"123  foo   ready  us-east-1\n"))
+	})
+
+	It("Fails for an unknown column", func() {
+		SetColumns([]string{"version"})
+		err := table.Write(buf, items)
+		Expect(err).To(MatchError(
+			"unknown column 'version'. Valid columns are [id name state aws-region]"))
+	})
+
+	It("Prints the names", func() {
+		SetOutput(NAME)
+		Expect(table.Write(buf, items)).To(Succeed())
+		Expect(buf.String()).To(Equal("123\n456\n"))
+	})
+
+	It("Prints JSON", func() {
+		SetOutput(JSON)
+		Expect(table.Write(buf, items[:1])).To(Succeed())
+		Expect(buf.String()).To(MatchJSON(
+			`[{"id":"123","name":"foo","state":"ready","region":"us-east-1"}]`))
+	})
+
+	It("Prints a JSONPath template", func() {
+		SetOutput(`jsonpath={range [*]}{.id}{"\t"}{.name}{"\n"}{end}`)
+		Expect(table.Write(buf, items)).To(Succeed())
+		Expect(buf.String()).To(Equal("123\tfoo\n456\tbar\n"))
+	})
+
+	It("Prints a JSONPath expression without braces", func() {
+		SetOutput("jsonpath=[*].name")
+		Expect(table.Write(buf, items)).To(Succeed())
+		Expect(buf.String()).To(Equal("foo bar"))
+	})
+
+	It("Prints the items selected with a JSONPath filter", func() {
+		SetOutput(`jsonpath={[?(@.state=="ready")].id}`)
+		Expect(table.Write(buf, items)).To(Succeed())
+		Expect(buf.String()).To(Equal("123"))
+	})
+
+	It("Prints the fields found with JSONPath recursive descent", func() {
+		SetOutput("jsonpath={..region}")
+		Expect(table.Write(buf, items)).To(Succeed())
+		Expect(buf.String()).To(Equal("us-east-1 eu-west-1"))
+	})
+
+	It("Fails for an invalid JSONPath template", func() {
+		SetOutput("jsonpath={.id")
+		Expect(table.Write(buf, items)).To(MatchError(ContainSubstring("invalid jsonpath template")))
+	})
+
+	It("Prints a go-template", func() {
+		SetOutput(`go-template={{range .}}{{.name}}={{.state}}{{"\n"}}{{end}}`)
+		Expect(table.Write(buf, items)).To(Succeed())
+		Expect(buf.String()).To(Equal("foo=ready\nbar=installing\n"))
+	})
+
+	It("Fails for an unknown format", func() {
+		SetOutput("xml")
+		err := table.Write(buf, items)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unknown format 'xml'"))
+	})
+})
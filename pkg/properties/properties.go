@@ -32,3 +32,7 @@ const UseLocalCredentials = "use_local_credentials"
 const ProvisionShardId = "provision_shard_id"
 
 const KeyringEnvKey = "OCM_KEYRING"
+
+// HibernationSchedule contains the schedule used by 'rosa hibernation run' to hibernate and resume
+// the cluster.
+const HibernationSchedule = prefix + "hibernation_schedule"
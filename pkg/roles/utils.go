@@ -7,29 +7,30 @@ import (
 
 	"github.com/openshift/rosa/pkg/aws"
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
+	"github.com/openshift/rosa/pkg/aws/iac"
 	"github.com/openshift/rosa/pkg/aws/tags"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
-const policyDocumentBody = ` \
-'{
+const policyDocumentBody = `{
   "Version": "2012-10-17",
   "Statement": {
     "Effect": "Allow",
     "Action": "sts:AssumeRole",
     "Resource": "%{shared_vpc_role_arn}"
   }
-}'`
+}`
 
 type ManualSharedVpcPolicyDetails struct {
 	Command       string
+	Policy        *iac.Policy
 	Name          string
 	AlreadyExists bool
 	Path          string
 }
 
 func GetHcpSharedVpcPolicyDetails(r *rosa.Runtime, roleArn string) (bool, string,
-	string, error) {
+	*iac.Policy, error) {
 	interpolatedPolicyDetails := aws.InterpolatePolicyDocument(r.Creator.Partition, policyDocumentBody,
 		map[string]string{
 			"shared_vpc_role_arn": roleArn,
@@ -37,11 +38,11 @@ func GetHcpSharedVpcPolicyDetails(r *rosa.Runtime, roleArn string) (bool, string
 
 	roleName, err := aws.GetResourceIdFromARN(roleArn)
 	if err != nil {
-		return false, "", "", err
+		return false, "", nil, err
 	}
 	path, err := aws.GetPathFromARN(roleArn)
 	if err != nil {
-		return false, "", "", err
+		return false, "", nil, err
 	}
 
 	policyName := fmt.Sprintf(aws.AssumeRolePolicyPrefix, roleName)
@@ -58,12 +59,18 @@ func GetHcpSharedVpcPolicyDetails(r *rosa.Runtime, roleArn string) (bool, string
 	createPolicy := awscb.NewIAMCommandBuilder().
 		SetCommand(awscb.CreatePolicy).
 		AddParam(awscb.PolicyName, policyName).
-		AddParam(awscb.PolicyDocument, interpolatedPolicyDetails).
+		AddParam(awscb.PolicyDocument, fmt.Sprintf("%s'%s'", awscb.ParamNewLineSeparator, interpolatedPolicyDetails)).
 		AddTags(iamTags).
 		AddParam(awscb.Path, path).
 		Build()
+	policy := &iac.Policy{
+		Name:    policyName,
+		Path:    path,
+		Content: interpolatedPolicyDetails,
+		Tags:    iamTags,
+	}
 
-	return existsQuery != nil, createPolicy, policyName, nil
+	return existsQuery != nil, createPolicy, policy, nil
 }
 
 func CheckIfRolesAreHcpSharedVpc(r *rosa.Runtime, roles []string) bool {
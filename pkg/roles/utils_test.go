@@ -1,6 +1,7 @@
 package roles
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -10,6 +11,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/aws/tags"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -37,13 +39,29 @@ var _ = Describe("Validate Shared VPC Inputs", func() {
 	Context("GetHcpSharedVpcPolicyDetails", func() {
 		When("getHcpSharedVpcPolicyDetails", func() {
 			It("Test that returned details + name are correct", func() {
-				exists, details, name, err := GetHcpSharedVpcPolicyDetails(runtime, testArn)
+				exists, details, policy, err := GetHcpSharedVpcPolicyDetails(runtime, testArn)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(exists).To(BeFalse())
+				name := policy.Name
 				Expect(name).To(Equal("test-assume-role"))
 				expectedDetails := strings.Replace(details, fmt.Sprintf("%%{%s}", name), name, -1)
 				Expect(details).To(Equal(expectedDetails))
 			})
+
+			It("Describes the policy created by the command", func() {
+				_, command, policy, err := GetHcpSharedVpcPolicyDetails(runtime, testArn)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(policy.Name).To(Equal("test-assume-role"))
+				Expect(policy.Path).To(BeEmpty())
+				Expect(policy.Document).To(BeEmpty())
+				Expect(json.Valid([]byte(policy.Content))).To(BeTrue())
+				Expect(policy.Content).To(ContainSubstring(testArn))
+				Expect(command).To(ContainSubstring(fmt.Sprintf("--policy-document  \\\n'%s'", policy.Content)))
+				Expect(policy.Tags).To(Equal(map[string]string{
+					tags.RedHatManaged: aws.TrueString,
+					tags.HcpSharedVpc:  aws.TrueString,
+				}))
+			})
 		})
 	})
 })
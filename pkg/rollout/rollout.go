@@ -0,0 +1,262 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rollout upgrades a fleet of clusters in waves, a canary wave first and then batches of
+// clusters, keeping the progress in a state file so that the rollout can be paused, resumed or
+// aborted.
+package rollout
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+const (
+	APIVersion = "rosa.openshift.io/v1alpha1"
+	Kind       = "UpgradeRollout"
+
+	// DefaultStateFile is the file used to keep the state of the rollout when none is given.
+	DefaultStateFile = "rosa-upgrade-clusters.json"
+)
+
+// Status is the status of the whole rollout.
+type Status string
+
+const (
+	StatusRunning   Status = "running"
+	StatusPaused    Status = "paused"
+	StatusHalted    Status = "halted"
+	StatusAborted   Status = "aborted"
+	StatusCompleted Status = "completed"
+)
+
+// TargetStatus is the status of the upgrade of one of the clusters of the rollout.
+type TargetStatus string
+
+const (
+	TargetPending   TargetStatus = "pending"
+	TargetScheduled TargetStatus = "scheduled"
+	// TargetNodePools means that the control plane of a Hosted Control Plane cluster has been
+	// upgraded and the upgrade of its machine pools has been scheduled.
+	TargetNodePools TargetStatus = "upgrading-machine-pools"
+	TargetCompleted TargetStatus = "completed"
+	TargetFailed    TargetStatus = "failed"
+	TargetCancelled TargetStatus = "cancelled"
+	TargetSkipped   TargetStatus = "skipped"
+)
+
+// Target is a cluster of the rollout.
+type Target struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Hypershift  bool         `json:"hypershift,omitempty"`
+	FromVersion string       `json:"fromVersion"`
+	Status      TargetStatus `json:"status"`
+	Message     string       `json:"message,omitempty"`
+}
+
+// Wave is a group of clusters whose upgrades are scheduled together. The next wave only starts
+// once all the clusters of the wave have been upgraded and none of them is in limited support.
+type Wave struct {
+	Name      string    `json:"name"`
+	Targets   []*Target `json:"targets"`
+	Completed bool      `json:"completed,omitempty"`
+}
+
+// Rollout is the plan and the progress of the upgrade of a fleet of clusters, as saved in the
+// state file.
+type Rollout struct {
+	APIVersion string    `json:"apiVersion"`
+	Kind       string    `json:"kind"`
+	Selector   string    `json:"selector"`
+	Version    string    `json:"version"`
+	Status     Status    `json:"status"`
+	Message    string    `json:"message,omitempty"`
+	Created    time.Time `json:"created"`
+	Updated    time.Time `json:"updated"`
+	Waves      []*Wave   `json:"waves"`
+
+	// Skipped contains the selected clusters that aren't part of any wave, and why.
+	Skipped []*Target `json:"skipped,omitempty"`
+}
+
+// New plans the rollout of the given version to the clusters. Clusters that aren't ready, that
+// already run the version or that can't be upgraded to it are skipped. The first 'canary'
+// clusters, sorted by name, form the canary wave, and the rest are split in waves of
+// 'batchSize' clusters.
+func New(selector string, version string, clusters []*cmv1.Cluster, canary int, batchSize int) (*Rollout, error) {
+	if canary < 0 {
+		return nil, fmt.Errorf("the number of canary clusters can't be negative")
+	}
+	if batchSize < 1 {
+		return nil, fmt.Errorf("the batch size must be at least 1")
+	}
+	sorted := slices.Clone(clusters)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Name() < sorted[j].Name()
+	})
+
+	now := time.Now().UTC()
+	rollout := &Rollout{
+		APIVersion: APIVersion,
+		Kind:       Kind,
+		Selector:   selector,
+		Version:    version,
+		Status:     StatusRunning,
+		Created:    now,
+		Updated:    now,
+		Waves:      []*Wave{},
+	}
+	var eligible []*Target
+	for _, cluster := range sorted {
+		target := &Target{
+			ID:          cluster.ID(),
+			Name:        cluster.Name(),
+			Hypershift:  ocm.IsHyperShiftCluster(cluster),
+			FromVersion: cluster.Version().RawID(),
+			Status:      TargetPending,
+		}
+		switch {
+		case cluster.State() != cmv1.ClusterStateReady:
+			target.Message = fmt.Sprintf("cluster is %s", cluster.State())
+		case target.FromVersion == version:
+			target.Message = "cluster already runs the version"
+		case !slices.Contains(ocm.GetAvailableUpgradesByCluster(cluster), version):
+			target.Message = "version is not an available upgrade"
+		default:
+			eligible = append(eligible, target)
+			continue
+		}
+		target.Status = TargetSkipped
+		rollout.Skipped = append(rollout.Skipped, target)
+	}
+
+	if canary > 0 && len(eligible) > 0 {
+		size := min(canary, len(eligible))
+		rollout.Waves = append(rollout.Waves, &Wave{Name: "canary", Targets: eligible[:size]})
+		eligible = eligible[size:]
+	}
+	for i := 0; len(eligible) > 0; i++ {
+		size := min(batchSize, len(eligible))
+		rollout.Waves = append(rollout.Waves, &Wave{
+			Name:    fmt.Sprintf("wave-%d", i+1),
+			Targets: eligible[:size],
+		})
+		eligible = eligible[size:]
+	}
+	return rollout, nil
+}
+
+// Load reads a rollout from a state file. The returned error wraps fs.ErrNotExist when the
+// file doesn't exist.
+func Load(path string) (*Rollout, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read state file '%s': %w", path, err)
+	}
+	rollout := &Rollout{}
+	err = json.Unmarshal(content, rollout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse state file '%s': %v", path, err)
+	}
+	if rollout.APIVersion != APIVersion || rollout.Kind != Kind {
+		return nil, fmt.Errorf("file '%s' doesn't contain the state of an upgrade rollout", path)
+	}
+	return rollout, nil
+}
+
+// Save writes the rollout to the state file. The file is replaced atomically, so that commands
+// reading it concurrently never see a partial content.
+func (r *Rollout) Save(path string) error {
+	r.Updated = time.Now().UTC()
+	content, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode rollout state: %v", err)
+	}
+	temp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to write state file '%s': %v", path, err)
+	}
+	defer os.Remove(temp.Name())
+	_, err = temp.Write(append(content, '\n'))
+	if closeErr := temp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(temp.Name(), path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write state file '%s': %v", path, err)
+	}
+	return nil
+}
+
+// Finished returns true when the rollout can't make progress anymore.
+func (r *Rollout) Finished() bool {
+	return r.Status == StatusCompleted || r.Status == StatusAborted
+}
+
+// Pause asks the process running the rollout to stop once it has saved its progress. The
+// upgrades that are already scheduled aren't affected.
+func (r *Rollout) Pause() error {
+	if r.Status != StatusRunning {
+		return fmt.Errorf("only a running rollout can be paused, this one is %s", r.Status)
+	}
+	r.Status = StatusPaused
+	r.Message = "paused by the user"
+	return nil
+}
+
+// Resume prepares a paused or halted rollout to run again. The clusters that failed to upgrade
+// in the waves that aren't completed will be retried.
+func (r *Rollout) Resume() error {
+	if r.Status != StatusPaused && r.Status != StatusHalted {
+		return fmt.Errorf("only a paused or halted rollout can be resumed, this one is %s", r.Status)
+	}
+	for _, wave := range r.Waves {
+		if wave.Completed {
+			continue
+		}
+		for _, target := range wave.Targets {
+			if target.Status == TargetFailed {
+				target.Status = TargetPending
+				target.Message = ""
+			}
+		}
+	}
+	r.Status = StatusRunning
+	r.Message = ""
+	return nil
+}
+
+// CurrentWave returns the first wave that isn't completed, or nil when all of them are.
+func (r *Rollout) CurrentWave() *Wave {
+	for _, wave := range r.Waves {
+		if !wave.Completed {
+			return wave
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package rollout
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+func buildCluster(name string, state cmv1.ClusterState, version string, upgrades ...string) *cmv1.Cluster {
+	cluster, err := cmv1.NewCluster().
+		ID(name + "-id").
+		Name(name).
+		State(state).
+		Version(cmv1.NewVersion().RawID(version).AvailableUpgrades(upgrades...)).
+		Build()
+	Expect(err).NotTo(HaveOccurred())
+	return cluster
+}
+
+var _ = Describe("Selector", func() {
+	It("Parses all the keys", func() {
+		selector, err := ParseSelector("name=prod-*-east, tag.team=payments,version=4.15,region=us-east-1," +
+			"topology=hcp")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(selector.NamePattern).To(Equal("prod-*-east"))
+		Expect(selector.Options).To(Equal(ocm.ClusterListOptions{
+			NamePrefix: "prod-",
+			Version:    "4.15",
+			Region:     "us-east-1",
+			Topology:   "hcp",
+			Tags:       map[string]string{"team": "payments"},
+		}))
+	})
+
+	DescribeTable("Rejects invalid selectors",
+		func(text string, message string) {
+			_, err := ParseSelector(text)
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("empty", " , ", "the selector must contain at least one term"),
+		Entry("missing value", "name=", "invalid selector term 'name='"),
+		Entry("unknown key", "owner=me", "invalid selector key 'owner'"),
+		Entry("bad pattern", "name=prod-[", "invalid name pattern 'prod-['"),
+	)
+
+	It("Matches the name pattern locally", func() {
+		selector, err := ParseSelector("name=prod-*-east")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(selector.Matches(buildCluster("prod-1-east", cmv1.ClusterStateReady, "4.15.9"))).To(BeTrue())
+		Expect(selector.Matches(buildCluster("prod-1-west", cmv1.ClusterStateReady, "4.15.9"))).To(BeFalse())
+	})
+})
+
+var _ = Describe("Rollout", func() {
+	It("Plans a canary wave and batches, skipping the clusters that can't be upgraded", func() {
+		clusters := []*cmv1.Cluster{
+			buildCluster("e", cmv1.ClusterStateReady, "4.15.9", "4.16.2"),
+			buildCluster("d", cmv1.ClusterStateReady, "4.15.9", "4.16.2"),
+			buildCluster("c", cmv1.ClusterStateReady, "4.15.9", "4.16.2"),
+			buildCluster("b", cmv1.ClusterStateReady, "4.15.9", "4.16.2"),
+			buildCluster("a", cmv1.ClusterStateReady, "4.15.9", "4.16.2"),
+			buildCluster("installing", cmv1.ClusterStateInstalling, "4.15.9", "4.16.2"),
+			buildCluster("current", cmv1.ClusterStateReady, "4.16.2"),
+			buildCluster("old", cmv1.ClusterStateReady, "4.14.1", "4.15.9"),
+		}
+		rollout, err := New("version=4.15", "4.16.2", clusters, 1, 3)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rollout.Status).To(Equal(StatusRunning))
+
+		names := func(wave *Wave) []string {
+			var result []string
+			for _, target := range wave.Targets {
+				result = append(result, target.Name)
+			}
+			return result
+		}
+		Expect(rollout.Waves).To(HaveLen(3))
+		Expect(rollout.Waves[0].Name).To(Equal("canary"))
+		Expect(names(rollout.Waves[0])).To(Equal([]string{"a"}))
+		Expect(rollout.Waves[1].Name).To(Equal("wave-1"))
+		Expect(names(rollout.Waves[1])).To(Equal([]string{"b", "c", "d"}))
+		Expect(names(rollout.Waves[2])).To(Equal([]string{"e"}))
+
+		Expect(rollout.Skipped).To(HaveLen(3))
+		messages := map[string]string{}
+		for _, target := range rollout.Skipped {
+			Expect(target.Status).To(Equal(TargetSkipped))
+			messages[target.Name] = target.Message
+		}
+		Expect(messages).To(Equal(map[string]string{
+			"current":    "cluster already runs the version",
+			"installing": "cluster is installing",
+			"old":        "version is not an available upgrade",
+		}))
+	})
+
+	It("Rejects an invalid batch size", func() {
+		_, err := New("name=a", "4.16.2", nil, 1, 0)
+		Expect(err).To(MatchError("the batch size must be at least 1"))
+	})
+
+	It("Saves and loads the state file", func() {
+		path := filepath.Join(GinkgoT().TempDir(), DefaultStateFile)
+		_, err := Load(path)
+		Expect(err).To(MatchError(fs.ErrNotExist))
+
+		rollout, err := New("name=a", "4.16.2",
+			[]*cmv1.Cluster{buildCluster("a", cmv1.ClusterStateReady, "4.15.9", "4.16.2")}, 1, 5)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rollout.Save(path)).To(Succeed())
+		loaded, err := Load(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loaded.Waves[0].Targets[0].ID).To(Equal("a-id"))
+		Expect(loaded.Version).To(Equal("4.16.2"))
+
+		Expect(os.WriteFile(path, []byte(`{"kind": "Cluster"}`), 0600)).To(Succeed())
+		_, err = Load(path)
+		Expect(err).To(MatchError(ContainSubstring("doesn't contain the state of an upgrade rollout")))
+	})
+
+	It("Pauses and resumes, retrying the failed clusters", func() {
+		rollout := &Rollout{Status: StatusRunning, Waves: []*Wave{
+			{Completed: true, Targets: []*Target{{Name: "a", Status: TargetCompleted}}},
+			{Targets: []*Target{
+				{Name: "b", Status: TargetFailed, Message: "the upgrade is failed"},
+				{Name: "c", Status: TargetCompleted},
+			}},
+		}}
+		Expect(rollout.Resume()).To(MatchError("only a paused or halted rollout can be resumed, this one is running"))
+		Expect(rollout.Pause()).To(Succeed())
+		Expect(rollout.Status).To(Equal(StatusPaused))
+		Expect(rollout.Pause()).To(HaveOccurred())
+
+		Expect(rollout.Resume()).To(Succeed())
+		Expect(rollout.Status).To(Equal(StatusRunning))
+		Expect(rollout.Waves[1].Targets[0].Status).To(Equal(TargetPending))
+		Expect(rollout.Waves[1].Targets[0].Message).To(BeEmpty())
+		Expect(rollout.CurrentWave()).To(Equal(rollout.Waves[1]))
+	})
+})
@@ -0,0 +1,244 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift/rosa/pkg/clierror"
+	"github.com/openshift/rosa/pkg/reporter"
+)
+
+// ErrUpgradeFailed is wrapped by the errors of the upgrader that mean that the upgrade of a
+// cluster failed for good. Other errors are considered transient and the check is retried.
+var ErrUpgradeFailed = errors.New("upgrade failed")
+
+// Upgrader performs the operations of the rollout on a single cluster.
+type Upgrader interface {
+	// Schedule schedules the upgrade of the cluster, or of the control plane of a Hosted Control
+	// Plane cluster, to the given version.
+	Schedule(target *Target, version string) error
+
+	// Progress checks the upgrade of the cluster and returns true once the cluster and all its
+	// machine pools run the version. It may move the target to the next status, for example
+	// when it schedules the upgrade of the machine pools of a Hosted Control Plane cluster.
+	Progress(target *Target, version string) (bool, error)
+
+	// Cancel cancels the scheduled upgrade of the cluster if it hasn't started yet, and returns
+	// true when it was cancelled.
+	Cancel(target *Target) (bool, error)
+
+	// LimitedSupportReasons returns the summaries of the reasons why the cluster is in limited
+	// support, if any.
+	LimitedSupportReasons(target *Target) ([]string, error)
+}
+
+// Runner runs a rollout, saving its progress to the state file after every change.
+type Runner struct {
+	Upgrader Upgrader
+	Reporter reporter.Logger
+	Path     string
+	Interval time.Duration
+}
+
+// Run runs the waves of the rollout that aren't completed yet. It returns when all the waves are
+// completed, when the rollout is paused or aborted from another process, or when a wave leaves a
+// cluster failed or in limited support, in which case the rollout is halted.
+func (r *Runner) Run(ctx context.Context, rollout *Rollout) error {
+	if rollout.Status != StatusRunning {
+		return fmt.Errorf("the rollout is %s", rollout.Status)
+	}
+	for _, wave := range rollout.Waves {
+		if wave.Completed {
+			continue
+		}
+		err := r.runWave(ctx, rollout, wave)
+		if err != nil {
+			return err
+		}
+		if rollout.Status != StatusRunning {
+			r.Reporter.Infof("Rollout is %s, stopping", rollout.Status)
+			return nil
+		}
+	}
+	// A pause or abort requested while the last wave finished takes precedence over the completion:
+	r.refresh(rollout)
+	if rollout.Status != StatusRunning {
+		r.Reporter.Infof("Rollout is %s, stopping", rollout.Status)
+		return nil
+	}
+	rollout.Status = StatusCompleted
+	rollout.Message = ""
+	r.Reporter.Infof("Rollout of version '%s' completed", rollout.Version)
+	return r.save(rollout)
+}
+
+func (r *Runner) runWave(ctx context.Context, rollout *Rollout, wave *Wave) error {
+	r.Reporter.Infof("Running wave '%s' with %d clusters", wave.Name, len(wave.Targets))
+	for _, target := range wave.Targets {
+		if target.Status != TargetPending {
+			continue
+		}
+		err := r.Upgrader.Schedule(target, rollout.Version)
+		if err != nil {
+			target.Status = TargetFailed
+			target.Message = err.Error()
+			r.Reporter.Warnf("Failed to schedule upgrade of cluster '%s': %v", target.Name, err)
+		} else {
+			target.Status = TargetScheduled
+			r.Reporter.Infof("Scheduled upgrade of cluster '%s' to version '%s'", target.Name, rollout.Version)
+		}
+		err = r.save(rollout)
+		if err != nil {
+			return err
+		}
+		if rollout.Status != StatusRunning {
+			return nil
+		}
+	}
+
+	for {
+		upgrading := 0
+		for _, target := range wave.Targets {
+			if target.Status != TargetScheduled && target.Status != TargetNodePools {
+				continue
+			}
+			done, err := r.Upgrader.Progress(target, rollout.Version)
+			switch {
+			case errors.Is(err, ErrUpgradeFailed):
+				target.Status = TargetFailed
+				target.Message = err.Error()
+				r.Reporter.Warnf("Upgrade of cluster '%s' failed: %v", target.Name, err)
+			case err != nil:
+				upgrading++
+				r.Reporter.Warnf("Failed to check upgrade of cluster '%s', will retry: %v", target.Name, err)
+			case done:
+				target.Status = TargetCompleted
+				target.Message = ""
+				r.Reporter.Infof("Cluster '%s' upgraded to version '%s'", target.Name, rollout.Version)
+			default:
+				upgrading++
+			}
+		}
+		err := r.save(rollout)
+		if err != nil {
+			return err
+		}
+		if rollout.Status != StatusRunning || upgrading == 0 {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.Interval):
+		}
+	}
+	if rollout.Status != StatusRunning {
+		return nil
+	}
+
+	var problems []string
+	for _, target := range wave.Targets {
+		if target.Status == TargetFailed {
+			problems = append(problems, fmt.Sprintf("cluster '%s' failed to upgrade: %s", target.Name, target.Message))
+			continue
+		}
+		reasons, err := r.Upgrader.LimitedSupportReasons(target)
+		if err != nil {
+			return fmt.Errorf("failed to get limited support reasons of cluster '%s': %w", target.Name, err)
+		}
+		if len(reasons) > 0 {
+			problems = append(problems, fmt.Sprintf("cluster '%s' is in limited support: %s",
+				target.Name, strings.Join(reasons, "; ")))
+		}
+	}
+	if len(problems) > 0 {
+		rollout.Status = StatusHalted
+		rollout.Message = fmt.Sprintf("wave '%s' left %d clusters failed or in limited support", wave.Name,
+			len(problems))
+		err := r.save(rollout)
+		if err != nil {
+			return err
+		}
+		return clierror.New(clierror.FailedState, fmt.Errorf(
+			"Halted the rollout after wave '%s', fix the following problems and resume it:\n  %s",
+			wave.Name, strings.Join(problems, "\n  ")))
+	}
+	wave.Completed = true
+	r.Reporter.Infof("Wave '%s' completed", wave.Name)
+	return r.save(rollout)
+}
+
+// Abort cancels the upgrades of the current wave that haven't started yet and marks the rollout
+// as aborted, which stops the process running it.
+func (r *Runner) Abort(rollout *Rollout) error {
+	if rollout.Finished() {
+		return fmt.Errorf("the rollout is already %s", rollout.Status)
+	}
+	if wave := rollout.CurrentWave(); wave != nil {
+		for _, target := range wave.Targets {
+			if target.Status != TargetScheduled {
+				continue
+			}
+			cancelled, err := r.Upgrader.Cancel(target)
+			switch {
+			case err != nil:
+				r.Reporter.Warnf("Failed to cancel upgrade of cluster '%s': %v", target.Name, err)
+			case cancelled:
+				target.Status = TargetCancelled
+				r.Reporter.Infof("Cancelled upgrade of cluster '%s'", target.Name)
+			default:
+				r.Reporter.Warnf("Upgrade of cluster '%s' has already started and will continue", target.Name)
+			}
+		}
+	}
+	rollout.Status = StatusAborted
+	rollout.Message = "aborted by the user"
+	return rollout.Save(r.Path)
+}
+
+// save saves the rollout, first picking up a pause or abort requested by another process. The
+// state written by the process that aborted the rollout is kept as is, as it records the
+// upgrades that it cancelled.
+func (r *Runner) save(rollout *Rollout) error {
+	if r.refresh(rollout) {
+		return nil
+	}
+	return rollout.Save(r.Path)
+}
+
+// refresh picks up a pause or abort of a running rollout requested by another process, and
+// returns true when it was aborted.
+func (r *Runner) refresh(rollout *Rollout) bool {
+	if rollout.Status != StatusRunning {
+		return false
+	}
+	stored, err := Load(r.Path)
+	if err != nil {
+		return false
+	}
+	switch stored.Status {
+	case StatusAborted, StatusPaused:
+		rollout.Status = stored.Status
+		rollout.Message = stored.Message
+	}
+	return rollout.Status == StatusAborted
+}
@@ -0,0 +1,208 @@
+package rollout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift/rosa/pkg/clierror"
+	"github.com/openshift/rosa/pkg/reporter"
+)
+
+// fakeUpgrader upgrades a cluster after it has been checked the given number of times.
+type fakeUpgrader struct {
+	checks         int
+	progress       map[string]int
+	failures       map[string]error
+	limitedSupport map[string][]string
+	started        map[string]bool
+	scheduled      []string
+	cancelled      []string
+	onProgress     func(target *Target)
+}
+
+func newFakeUpgrader(checks int) *fakeUpgrader {
+	return &fakeUpgrader{
+		checks:         checks,
+		progress:       map[string]int{},
+		failures:       map[string]error{},
+		limitedSupport: map[string][]string{},
+		started:        map[string]bool{},
+	}
+}
+
+func (f *fakeUpgrader) Schedule(target *Target, _ string) error {
+	f.scheduled = append(f.scheduled, target.Name)
+	return nil
+}
+
+func (f *fakeUpgrader) Progress(target *Target, _ string) (bool, error) {
+	if f.onProgress != nil {
+		f.onProgress(target)
+	}
+	if err := f.failures[target.Name]; err != nil {
+		return false, err
+	}
+	f.progress[target.Name]++
+	return f.progress[target.Name] >= f.checks, nil
+}
+
+func (f *fakeUpgrader) Cancel(target *Target) (bool, error) {
+	if f.started[target.Name] {
+		return false, nil
+	}
+	f.cancelled = append(f.cancelled, target.Name)
+	return true, nil
+}
+
+func (f *fakeUpgrader) LimitedSupportReasons(target *Target) ([]string, error) {
+	return f.limitedSupport[target.Name], nil
+}
+
+var _ = Describe("Runner", func() {
+	var (
+		upgrader *fakeUpgrader
+		runner   *Runner
+		rollout  *Rollout
+	)
+
+	BeforeEach(func() {
+		upgrader = newFakeUpgrader(2)
+		runner = &Runner{
+			Upgrader: upgrader,
+			Reporter: reporter.CreateReporter(),
+			Path:     filepath.Join(GinkgoT().TempDir(), DefaultStateFile),
+			Interval: time.Millisecond,
+		}
+		rollout = &Rollout{
+			APIVersion: APIVersion,
+			Kind:       Kind,
+			Version:    "4.16.2",
+			Status:     StatusRunning,
+			Waves: []*Wave{
+				{Name: "canary", Targets: []*Target{{Name: "a", Status: TargetPending}}},
+				{Name: "wave-1", Targets: []*Target{
+					{Name: "b", Status: TargetPending},
+					{Name: "c", Status: TargetPending},
+				}},
+			},
+		}
+		Expect(rollout.Save(runner.Path)).To(Succeed())
+	})
+
+	It("Runs all the waves in order", func() {
+		Expect(runner.Run(context.Background(), rollout)).To(Succeed())
+		Expect(upgrader.scheduled).To(Equal([]string{"a", "b", "c"}))
+		Expect(rollout.Status).To(Equal(StatusCompleted))
+
+		saved, err := Load(runner.Path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(saved.Status).To(Equal(StatusCompleted))
+		for _, wave := range saved.Waves {
+			Expect(wave.Completed).To(BeTrue())
+			for _, target := range wave.Targets {
+				Expect(target.Status).To(Equal(TargetCompleted))
+			}
+		}
+	})
+
+	It("Halts when a cluster of a wave is left in limited support", func() {
+		upgrader.limitedSupport["a"] = []string{"Cluster is unreachable"}
+		err := runner.Run(context.Background(), rollout)
+		Expect(err).To(MatchError(ContainSubstring("cluster 'a' is in limited support: Cluster is unreachable")))
+		Expect(clierror.Classify(err)).To(Equal(clierror.FailedState))
+		Expect(upgrader.scheduled).To(Equal([]string{"a"}))
+
+		saved, err := Load(runner.Path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(saved.Status).To(Equal(StatusHalted))
+		Expect(saved.Waves[0].Completed).To(BeFalse())
+
+		// Once the problem is fixed the rollout continues from the halted wave:
+		delete(upgrader.limitedSupport, "a")
+		Expect(saved.Resume()).To(Succeed())
+		Expect(runner.Run(context.Background(), saved)).To(Succeed())
+		Expect(upgrader.scheduled).To(Equal([]string{"a", "b", "c"}))
+	})
+
+	It("Halts when the upgrade of a cluster fails", func() {
+		upgrader.failures["b"] = fmt.Errorf("%w: the upgrade is failed", ErrUpgradeFailed)
+		err := runner.Run(context.Background(), rollout)
+		Expect(err).To(MatchError(ContainSubstring("cluster 'b' failed to upgrade")))
+		Expect(rollout.Status).To(Equal(StatusHalted))
+		Expect(rollout.Waves[1].Targets[0].Status).To(Equal(TargetFailed))
+		Expect(rollout.Waves[1].Targets[1].Status).To(Equal(TargetCompleted))
+	})
+
+	It("Retries the checks that fail with transient errors", func() {
+		calls := 0
+		upgrader.onProgress = func(target *Target) {
+			calls++
+			if calls == 1 {
+				upgrader.failures[target.Name] = errors.New("connection reset")
+			} else {
+				delete(upgrader.failures, target.Name)
+			}
+		}
+		Expect(runner.Run(context.Background(), rollout)).To(Succeed())
+		Expect(rollout.Status).To(Equal(StatusCompleted))
+	})
+
+	It("Stops when the rollout is paused from another process", func() {
+		upgrader.onProgress = func(target *Target) {
+			if target.Name != "a" {
+				return
+			}
+			stored, err := Load(runner.Path)
+			Expect(err).NotTo(HaveOccurred())
+			if stored.Status == StatusRunning {
+				Expect(stored.Pause()).To(Succeed())
+				Expect(stored.Save(runner.Path)).To(Succeed())
+			}
+		}
+		Expect(runner.Run(context.Background(), rollout)).To(Succeed())
+		Expect(rollout.Status).To(Equal(StatusPaused))
+		Expect(upgrader.scheduled).To(Equal([]string{"a"}))
+
+		saved, err := Load(runner.Path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(saved.Status).To(Equal(StatusPaused))
+		Expect(saved.Waves[0].Targets[0].Status).To(Equal(TargetScheduled))
+	})
+
+	It("Doesn't complete a rollout aborted after its last wave", func() {
+		for _, wave := range rollout.Waves {
+			wave.Completed = true
+		}
+		stored, err := Load(runner.Path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(runner.Abort(stored)).To(Succeed())
+
+		Expect(runner.Run(context.Background(), rollout)).To(Succeed())
+		Expect(rollout.Status).To(Equal(StatusAborted))
+		saved, err := Load(runner.Path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(saved.Status).To(Equal(StatusAborted))
+	})
+
+	It("Aborts cancelling the upgrades that haven't started", func() {
+		rollout.Waves[0].Completed = true
+		rollout.Waves[1].Targets[0].Status = TargetScheduled
+		rollout.Waves[1].Targets[1].Status = TargetScheduled
+		upgrader.started["c"] = true
+		Expect(runner.Abort(rollout)).To(Succeed())
+		Expect(upgrader.cancelled).To(Equal([]string{"b"}))
+
+		saved, err := Load(runner.Path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(saved.Status).To(Equal(StatusAborted))
+		Expect(saved.Waves[1].Targets[0].Status).To(Equal(TargetCancelled))
+		Expect(saved.Waves[1].Targets[1].Status).To(Equal(TargetScheduled))
+		Expect(runner.Abort(saved)).To(MatchError("the rollout is already aborted"))
+	})
+})
@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+const tagPrefix = "tag."
+
+// SelectorKeys are the keys accepted in a selector, besides the 'tag.<key>' ones.
+var SelectorKeys = []string{"name", "version", "region", "topology"}
+
+// Selector picks the clusters of a rollout. It's written as a comma-separated list of
+// 'key=value' terms, for example 'name=prod-*,tag.team=payments,version=4.15', and a cluster
+// is selected when it matches all of them.
+type Selector struct {
+	// NamePattern is matched against the name of the cluster, '*' and '?' are wildcards.
+	NamePattern string
+
+	// Options contains the rest of the terms, that are evaluated by OCM.
+	Options ocm.ClusterListOptions
+}
+
+// ParseSelector parses the text representation of a selector.
+func ParseSelector(text string) (*Selector, error) {
+	selector := &Selector{}
+	for _, term := range strings.Split(text, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+		key, value, found := strings.Cut(term, "=")
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		if !found || key == "" || value == "" {
+			return nil, fmt.Errorf("invalid selector term '%s', expected 'key=value'", term)
+		}
+		switch {
+		case key == "name":
+			_, err := path.Match(value, "")
+			if err != nil {
+				return nil, fmt.Errorf("invalid name pattern '%s': %v", value, err)
+			}
+			selector.NamePattern = value
+		case key == "version":
+			selector.Options.Version = value
+		case key == "region":
+			selector.Options.Region = value
+		case key == "topology":
+			selector.Options.Topology = value
+		case strings.HasPrefix(key, tagPrefix) && len(key) > len(tagPrefix):
+			if selector.Options.Tags == nil {
+				selector.Options.Tags = map[string]string{}
+			}
+			selector.Options.Tags[strings.TrimPrefix(key, tagPrefix)] = value
+		default:
+			return nil, fmt.Errorf("invalid selector key '%s'. Valid keys are %s and '%s<key>'",
+				key, strings.Join(SelectorKeys, ", "), tagPrefix)
+		}
+	}
+	if selector.NamePattern == "" && selector.Options.Version == "" && selector.Options.Region == "" &&
+		selector.Options.Topology == "" && len(selector.Options.Tags) == 0 {
+		return nil, fmt.Errorf("the selector must contain at least one term")
+	}
+
+	// The part of the pattern before the first wildcard narrows the search done by OCM, the rest
+	// is matched locally:
+	if selector.NamePattern != "" {
+		prefix := selector.NamePattern
+		if index := strings.IndexAny(prefix, "*?[\\"); index >= 0 {
+			prefix = prefix[:index]
+		}
+		selector.Options.NamePrefix = prefix
+	}
+	return selector, nil
+}
+
+// Matches checks the parts of the selector that OCM can't evaluate.
+func (s *Selector) Matches(cluster *cmv1.Cluster) bool {
+	if s.NamePattern == "" {
+		return true
+	}
+	matched, err := path.Match(s.NamePattern, cluster.Name())
+	return err == nil && matched
+}
+
+// Select returns the clusters of the organization that match the selector.
+func (s *Selector) Select(client *ocm.Client) ([]*cmv1.Cluster, error) {
+	clusters, err := client.ListClusters(nil, s.Options)
+	if err != nil {
+		return nil, err
+	}
+	result := []*cmv1.Cluster{}
+	for _, cluster := range clusters {
+		if s.Matches(cluster) {
+			result = append(result, cluster)
+		}
+	}
+	return result, nil
+}
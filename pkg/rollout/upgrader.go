@@ -0,0 +1,367 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"fmt"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+// scheduleDelay is the time between scheduling an upgrade and its start, the same default used
+// by 'rosa upgrade cluster'.
+const scheduleDelay = 10 * time.Minute
+
+type ocmUpgrader struct {
+	client    *ocm.Client
+	awsClient aws.Client
+	creator   *aws.Creator
+}
+
+var _ Upgrader = &ocmUpgrader{}
+
+// NewUpgrader returns the upgrader that schedules and checks the upgrades using OCM. The AWS
+// client is used to verify that the roles of STS clusters are compatible with the new version.
+func NewUpgrader(client *ocm.Client, awsClient aws.Client, creator *aws.Creator) Upgrader {
+	return &ocmUpgrader{
+		client:    client,
+		awsClient: awsClient,
+		creator:   creator,
+	}
+}
+
+func (u *ocmUpgrader) Schedule(target *Target, version string) error {
+	err := u.checkRoles(target, version)
+	if err != nil {
+		return err
+	}
+	nextRun := time.Now().UTC().Add(scheduleDelay)
+	if target.Hypershift {
+		existing, err := u.client.GetControlPlaneScheduledUpgrade(target.ID)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return alreadyScheduled(existing.Version(), version)
+		}
+		policy, err := cmv1.NewControlPlaneUpgradePolicy().
+			UpgradeType(cmv1.UpgradeTypeControlPlane).
+			ScheduleType(cmv1.ScheduleTypeManual).
+			Version(version).
+			NextRun(nextRun).
+			Build()
+		if err != nil {
+			return err
+		}
+		gates, err := u.client.GetMissingGateAgreementsHypershift(target.ID, policy)
+		if err != nil {
+			return err
+		}
+		err = u.acknowledgeGates(target, version, gates)
+		if err != nil {
+			return err
+		}
+		_, err = u.client.ScheduleHypershiftControlPlaneUpgrade(target.ID, policy)
+		return err
+	}
+
+	existing, _, err := u.client.GetScheduledUpgrade(target.ID)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return alreadyScheduled(existing.Version(), version)
+	}
+	policy, err := cmv1.NewUpgradePolicy().
+		ScheduleType(cmv1.ScheduleTypeManual).
+		Version(version).
+		NextRun(nextRun).
+		Build()
+	if err != nil {
+		return err
+	}
+	gates, err := u.client.GetMissingGateAgreementsClassic(target.ID, policy)
+	if err != nil {
+		return err
+	}
+	err = u.acknowledgeGates(target, version, gates)
+	if err != nil {
+		return err
+	}
+	return u.client.ScheduleUpgrade(target.ID, policy)
+}
+
+// alreadyScheduled accepts an upgrade that was already scheduled to the same version, for
+// example by a previous run of the rollout that was interrupted.
+func alreadyScheduled(scheduled string, version string) error {
+	if scheduled == version {
+		return nil
+	}
+	return fmt.Errorf("there is already an upgrade scheduled to version '%s'", scheduled)
+}
+
+// checkRoles verifies that the account and operator roles of an STS cluster are compatible with
+// the version. Unlike 'rosa upgrade cluster' it doesn't create or upgrade the roles, so the upgrade
+// of a cluster whose roles need to be upgraded fails.
+func (u *ocmUpgrader) checkRoles(target *Target, version string) error {
+	cluster, err := u.client.GetClusterByID(target.ID, nil)
+	if err != nil {
+		return err
+	}
+	if !ocm.IsSts(cluster) {
+		return nil
+	}
+	notUpgraded := func(reason string) error {
+		return fmt.Errorf("%s, run 'rosa upgrade roles -c %s --cluster-version %s' and retry",
+			reason, target.Name, version)
+	}
+
+	credRequests, err := u.client.GetCredRequests(target.Hypershift)
+	if err != nil {
+		return fmt.Errorf("failed to get operator credential requests: %v", err)
+	}
+	missing, err := u.client.FindMissingOperatorRolesForUpgrade(cluster, version, credRequests)
+	if err != nil {
+		return fmt.Errorf("failed to find the operator roles needed by version '%s': %v", version, err)
+	}
+	if len(missing) > 0 {
+		return notUpgraded(fmt.Sprintf("the cluster is missing %d operator roles needed by version '%s'",
+			len(missing), version))
+	}
+	// The managed policies attached to the roles are kept up to date by AWS:
+	if cluster.AWS().STS().ManagedPolicies() {
+		return nil
+	}
+
+	if u.awsClient == nil {
+		return notUpgraded("the account and operator role policies can't be verified without AWS credentials")
+	}
+	err = u.awsClient.ValidateRoleARNAccountIDMatchCallerAccountID(cluster.AWS().STS().RoleARN())
+	if err != nil {
+		return notUpgraded(fmt.Sprintf("the account and operator role policies can't be verified: %v", err))
+	}
+	policyVersion, err := ocm.ParseVersion(version)
+	if err != nil {
+		return err
+	}
+	policyVersion, err = u.client.GetPolicyVersion(policyVersion, cluster.Version().ChannelGroup())
+	if err != nil {
+		return fmt.Errorf("failed to get the policy version: %v", err)
+	}
+	upgradeNeeded, err := u.awsClient.IsUpgradedNeededForAccountRolePoliciesUsingCluster(cluster, policyVersion)
+	if err != nil {
+		return fmt.Errorf("failed to verify the account role policies: %v", err)
+	}
+	if upgradeNeeded {
+		return notUpgraded(fmt.Sprintf("the account role policies aren't compatible with version '%s'", version))
+	}
+	prefix, err := aws.GetOperatorRolePolicyPrefixFromCluster(cluster, u.awsClient)
+	if err != nil {
+		return fmt.Errorf("failed to get the operator role policy prefix: %v", err)
+	}
+	upgradeNeeded, err = u.awsClient.IsUpgradedNeededForOperatorRolePoliciesUsingCluster(cluster,
+		u.creator.Partition, u.creator.AccountID, policyVersion, credRequests, prefix)
+	if err != nil {
+		return fmt.Errorf("failed to verify the operator role policies: %v", err)
+	}
+	if upgradeNeeded {
+		return notUpgraded(fmt.Sprintf("the operator role policies aren't compatible with version '%s'", version))
+	}
+	return nil
+}
+
+// acknowledgeGates acknowledges the gates that only concern STS, which the roles verified by
+// checkRoles satisfy. The rest need to be read and acknowledged by the user, so they stop the
+// upgrade.
+func (u *ocmUpgrader) acknowledgeGates(target *Target, version string, gates []*cmv1.VersionGate) error {
+	for _, gate := range gates {
+		if !gate.STSOnly() {
+			return fmt.Errorf("version gate '%s' needs to be acknowledged, run 'rosa upgrade cluster "+
+				"-c %s --version %s --dry-run' to review it", gate.ID(), target.Name, version)
+		}
+	}
+	for _, gate := range gates {
+		err := u.client.AckVersionGate(target.ID, gate.ID())
+		if err != nil {
+			return fmt.Errorf("failed to acknowledge version gate '%s': %v", gate.ID(), err)
+		}
+	}
+	return nil
+}
+
+func (u *ocmUpgrader) Progress(target *Target, version string) (bool, error) {
+	cluster, err := u.client.GetClusterByID(target.ID, nil)
+	if err != nil {
+		return false, err
+	}
+	upgraded := cluster.Version().RawID() == version
+
+	if !target.Hypershift {
+		if upgraded {
+			return true, nil
+		}
+		policy, state, err := u.client.GetScheduledUpgrade(target.ID)
+		if err != nil {
+			return false, err
+		}
+		if policy == nil {
+			return false, fmt.Errorf("%w: the upgrade is no longer scheduled", ErrUpgradeFailed)
+		}
+		return false, checkState(state)
+	}
+
+	if target.Status == TargetScheduled {
+		if !upgraded {
+			policy, err := u.client.GetControlPlaneScheduledUpgrade(target.ID)
+			if err != nil {
+				return false, err
+			}
+			if policy == nil {
+				return false, fmt.Errorf("%w: the upgrade is no longer scheduled", ErrUpgradeFailed)
+			}
+			return false, checkState(policy.State())
+		}
+		done, err := u.scheduleNodePools(target, version)
+		if err != nil || done {
+			return done, err
+		}
+		target.Status = TargetNodePools
+		return false, nil
+	}
+	return u.checkNodePools(target, version)
+}
+
+// scheduleNodePools schedules the upgrade of the machine pools of a Hosted Control Plane cluster
+// once its control plane runs the version. It returns true when there is nothing to upgrade.
+func (u *ocmUpgrader) scheduleNodePools(target *Target, version string) (bool, error) {
+	nodePools, err := u.client.GetNodePools(target.ID)
+	if err != nil {
+		return false, err
+	}
+	done := true
+	for _, nodePool := range nodePools {
+		if nodePool.Version().RawID() == version {
+			continue
+		}
+		done = false
+		_, existing, err := u.client.GetHypershiftNodePoolUpgrade(target.ID, target.Name, nodePool.ID())
+		if err != nil {
+			return false, err
+		}
+		if existing != nil {
+			if existing.Version() != version {
+				return false, fmt.Errorf("%w: there is already an upgrade of machine pool '%s' scheduled "+
+					"to version '%s'", ErrUpgradeFailed, nodePool.ID(), existing.Version())
+			}
+			continue
+		}
+		policy, err := u.client.BuildNodeUpgradePolicy(version, nodePool.ID(), ocm.UpgradeScheduling{
+			NextRun: time.Now().UTC().Add(scheduleDelay),
+		})
+		if err != nil {
+			return false, err
+		}
+		_, err = u.client.ScheduleNodePoolUpgrade(target.ID, nodePool.ID(), policy)
+		if err != nil {
+			return false, fmt.Errorf("%w: failed to schedule upgrade of machine pool '%s': %v",
+				ErrUpgradeFailed, nodePool.ID(), err)
+		}
+	}
+	return done, nil
+}
+
+// checkNodePools returns true when all the machine pools of a Hosted Control Plane cluster run
+// the version.
+func (u *ocmUpgrader) checkNodePools(target *Target, version string) (bool, error) {
+	nodePools, err := u.client.GetNodePools(target.ID)
+	if err != nil {
+		return false, err
+	}
+	done := true
+	for _, nodePool := range nodePools {
+		if nodePool.Version().RawID() == version {
+			continue
+		}
+		done = false
+		_, policy, err := u.client.GetHypershiftNodePoolUpgrade(target.ID, target.Name, nodePool.ID())
+		if err != nil {
+			return false, err
+		}
+		if policy == nil {
+			return false, fmt.Errorf("%w: the upgrade of machine pool '%s' is no longer scheduled",
+				ErrUpgradeFailed, nodePool.ID())
+		}
+		err = checkState(policy.State())
+		if err != nil {
+			return false, fmt.Errorf("machine pool '%s': %w", nodePool.ID(), err)
+		}
+	}
+	return done, nil
+}
+
+func checkState(state *cmv1.UpgradePolicyState) error {
+	if state == nil {
+		return nil
+	}
+	switch state.Value() {
+	case cmv1.UpgradePolicyStateValueFailed, cmv1.UpgradePolicyStateValueCancelled:
+		if state.Description() != "" {
+			return fmt.Errorf("%w: the upgrade is %s: %s", ErrUpgradeFailed, state.Value(), state.Description())
+		}
+		return fmt.Errorf("%w: the upgrade is %s", ErrUpgradeFailed, state.Value())
+	}
+	return nil
+}
+
+func (u *ocmUpgrader) Cancel(target *Target) (bool, error) {
+	if target.Hypershift {
+		policy, err := u.client.GetControlPlaneScheduledUpgrade(target.ID)
+		if err != nil || policy == nil || !cancellable(policy.State()) {
+			return false, err
+		}
+		return u.client.CancelControlPlaneUpgrade(target.ID, policy.ID())
+	}
+	policy, state, err := u.client.GetScheduledUpgrade(target.ID)
+	if err != nil || policy == nil || !cancellable(state) {
+		return false, err
+	}
+	return u.client.CancelUpgrade(target.ID)
+}
+
+func cancellable(state *cmv1.UpgradePolicyState) bool {
+	if state == nil {
+		return true
+	}
+	return state.Value() == cmv1.UpgradePolicyStateValuePending ||
+		state.Value() == cmv1.UpgradePolicyStateValueScheduled
+}
+
+func (u *ocmUpgrader) LimitedSupportReasons(target *Target) ([]string, error) {
+	reasons, err := u.client.GetLimitedSupportReasons(target.ID)
+	if err != nil {
+		return nil, err
+	}
+	summaries := make([]string, 0, len(reasons))
+	for _, reason := range reasons {
+		summaries = append(summaries, reason.Summary())
+	}
+	return summaries, nil
+}
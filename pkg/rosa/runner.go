@@ -5,6 +5,8 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/clierror"
 )
 
 // RuntimeVisitor are functions that configure the Runtime for a command.
@@ -29,7 +31,7 @@ func DefaultRunner(visitor RuntimeVisitor, runner CommandRunner) func(command *c
 		err := runner(ctx, r, command, args)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 }
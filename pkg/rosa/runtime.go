@@ -9,6 +9,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/clierror"
 	"github.com/openshift/rosa/pkg/logging"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
@@ -48,7 +49,7 @@ func (r *Runtime) WithAWS() *Runtime {
 	err := r.OCMClient.ValidateAwsClientRegion()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	if r.AWSClient == nil {
 		r.AWSClient = aws.CreateNewClientOrExit(r.Logger, r.Reporter)
@@ -58,7 +59,7 @@ func (r *Runtime) WithAWS() *Runtime {
 		r.Creator, err = r.AWSClient.GetCreator()
 		if err != nil {
 			r.Reporter.Errorf("Failed to get AWS creator: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	return r
@@ -80,7 +81,7 @@ func (r *Runtime) WithAWSWarnInsteadOfExit() *Runtime {
 		r.Creator, err = r.AWSClient.GetCreator()
 		if err != nil {
 			_ = r.Reporter.Errorf("Failed to get AWS creator: %v", err)
-			os.Exit(1)
+			os.Exit(clierror.ExitCode(err))
 		}
 	}
 	return r
@@ -99,7 +100,7 @@ func (r *Runtime) GetClusterKey() string {
 	clusterKey, err := ocm.GetClusterKey()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	r.ClusterKey = clusterKey
 	return clusterKey
@@ -126,7 +127,7 @@ func (r *Runtime) FetchCluster() *cmv1.Cluster {
 	cluster, err := r.OCMClient.GetCluster(r.ClusterKey, r.Creator)
 	if err != nil {
 		r.Reporter.Errorf("Failed to get cluster '%s': %v", r.ClusterKey, err)
-		os.Exit(1)
+		os.Exit(clierror.ExitCode(err))
 	}
 	r.Cluster = cluster
 	return cluster
@@ -20,6 +20,7 @@ import (
 	amsv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
 	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/openshift-online/ocm-sdk-go/logging"
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
 	//nolint:staticcheck
 	. "github.com/openshift-online/ocm-sdk-go/testing"
 	"github.com/spf13/cobra"
@@ -91,6 +92,9 @@ var (
 	MockClusterName = "cluster"
 )
 
+// SelfAccessReviewPath is the path of the OCM self access reviews
+const SelfAccessReviewPath = "/api/authorizations/v1/self_access_review"
+
 func BuildBreakGlassCredential() *v1.BreakGlassCredential {
 	const breakGlassCredentialId = "test-id"
 	breakGlassCredential, err := v1.NewBreakGlassCredential().
@@ -260,6 +264,10 @@ func FormatAccessRequestList(accessRequests []*accessv1.AccessRequest) string {
 	return FormatList(accessRequests, accessv1.MarshalAccessRequestList, "AccessRequestList")
 }
 
+func FormatLogEntryList(logEntries []*slv1.LogEntry) string {
+	return FormatList(logEntries, slv1.MarshalLogEntryList, "ClusterLogList")
+}
+
 func FormatAWSSTSPolicyList(upgrades []*v1.AWSSTSPolicy) string {
 	return FormatList(upgrades, v1.MarshalAWSSTSPolicyList, "AWSSTSPolicyList")
 }
@@ -273,6 +281,10 @@ func FormatResource(resource interface{}) string {
 		if res, ok := resource.(*v1.KubeletConfig); ok {
 			err = v1.MarshalKubeletConfig(res, &outputJson)
 		}
+	case "*v1.Cluster":
+		if res, ok := resource.(*v1.Cluster); ok {
+			err = v1.MarshalCluster(res, &outputJson)
+		}
 	case "*v1.Version":
 		if res, ok := resource.(*v1.Version); ok {
 			err = v1.MarshalVersion(res, &outputJson)
@@ -313,10 +325,26 @@ func FormatResource(resource interface{}) string {
 		if res, ok := resource.(*accessv1.AccessRequest); ok {
 			err = accessv1.MarshalAccessRequest(res, &outputJson)
 		}
+	case "*v1.LogEntry":
+		if res, ok := resource.(*slv1.LogEntry); ok {
+			err = slv1.MarshalLogEntry(res, &outputJson)
+		}
 	case "*v1.ImageMirror":
 		if res, ok := resource.(*v1.ImageMirror); ok {
 			err = v1.MarshalImageMirror(res, &outputJson)
 		}
+	case "*v1.IdentityProvider":
+		if res, ok := resource.(*v1.IdentityProvider); ok {
+			err = v1.MarshalIdentityProvider(res, &outputJson)
+		}
+	case "*v1.OidcConfig":
+		if res, ok := resource.(*v1.OidcConfig); ok {
+			err = v1.MarshalOidcConfig(res, &outputJson)
+		}
+	case "*v1.User":
+		if res, ok := resource.(*v1.User); ok {
+			err = v1.MarshalUser(res, &outputJson)
+		}
 	default:
 		{
 			return "NOTIMPLEMENTED"
@@ -349,6 +377,10 @@ func (t *TestingRuntime) InitRuntime() {
 	t.ApiServer = MakeTCPServer()
 	t.ApiServer.SetAllowUnhandledRequests(true)
 	t.ApiServer.SetUnhandledRequestStatusCode(http.StatusInternalServerError)
+	// Allow the actions checked by the preflight self access reviews, tests that need a denial
+	// replace the route:
+	t.ApiServer.RouteToHandler(http.MethodPost, SelfAccessReviewPath,
+		RespondWithJSON(http.StatusOK, `{"allowed": true}`))
 
 	// Create the token:
 	claims := MakeClaims()
@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package wait contains the flags and the logic shared by the 'rosa wait' commands, which block
+// until a resource reaches a condition.
+package wait
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/clierror"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
+)
+
+const (
+	DefaultInterval = 30 * time.Second
+	DefaultTimeout  = 60 * time.Minute
+)
+
+// Options are the flags shared by the 'rosa wait' commands.
+type Options struct {
+	For      string
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+// AddFlags adds the '--for', '--interval' and '--timeout' flags to the command. The given
+// conditions are the valid values of '--for'.
+func AddFlags(cmd *cobra.Command, options *Options, conditions []string) {
+	flags := cmd.Flags()
+	flags.StringVar(
+		&options.For,
+		"for",
+		"",
+		fmt.Sprintf("Condition to wait for. Valid values are %s.", strings.Join(conditions, ", ")),
+	)
+	_ = cmd.MarkFlagRequired("for")
+	_ = cmd.RegisterFlagCompletionFunc("for", func(_ *cobra.Command, _ []string, _ string) (
+		[]string, cobra.ShellCompDirective) {
+		return conditions, cobra.ShellCompDirectiveNoFileComp
+	})
+	flags.DurationVar(
+		&options.Interval,
+		"interval",
+		DefaultInterval,
+		"Time between two checks of the condition.",
+	)
+	flags.DurationVar(
+		&options.Timeout,
+		"timeout",
+		DefaultTimeout,
+		fmt.Sprintf("Maximum time to wait for the condition. The command exits with code %d when it expires.",
+			clierror.Timeout.ExitCode()),
+	)
+}
+
+// Validate checks that the condition is one of the given ones and that the durations are valid.
+func (o *Options) Validate(conditions []string) error {
+	if !slices.Contains(conditions, o.For) {
+		return fmt.Errorf("Invalid condition '%s'. Valid values are %s", o.For, strings.Join(conditions, ", "))
+	}
+	if o.Interval <= 0 {
+		return fmt.Errorf("Interval must be greater than zero")
+	}
+	if o.Timeout < o.Interval {
+		return fmt.Errorf("Timeout must be greater than the interval")
+	}
+	return nil
+}
+
+// Condition checks a resource, which is nil once it doesn't exist. It returns true when the
+// condition is met, or an error when the resource reached a state from which the condition
+// can't be met anymore.
+type Condition[T any] func(resource T) (bool, error)
+
+// Waiter reports the progress of a resource while it's polled and remembers why the condition
+// failed, so that the command can exit with the right code.
+type Waiter[T any] struct {
+	reporter  reporter.Logger
+	options   *Options
+	target    string
+	condition Condition[T]
+	describe  func(T) string
+	status    string
+	failure   error
+}
+
+// NewWaiter creates a waiter for the given condition. The target describes what is being waited
+// for, for example "cluster 'mycluster' to be ready", and describe returns the status of the
+// resource that is reported every time it changes.
+func NewWaiter[T any](r reporter.Logger, options *Options, target string, condition Condition[T],
+	describe func(T) string) *Waiter[T] {
+	return &Waiter[T]{
+		reporter:  r,
+		options:   options,
+		target:    target,
+		condition: condition,
+		describe:  describe,
+	}
+}
+
+// Done is the predicate passed to the poll functions of the OCM client. It returns true when the
+// condition is met or has failed.
+func (w *Waiter[T]) Done(resource T) bool {
+	status := w.describe(resource)
+	if status != w.status {
+		w.reporter.Infof("%s", status)
+		w.status = status
+	}
+	met, err := w.condition(resource)
+	if err != nil {
+		w.failure = err
+		return true
+	}
+	return met
+}
+
+// Result converts the error returned by the poll function into the error returned by the
+// command, classified so that the exit code tells a timeout apart from a failed condition.
+func (w *Waiter[T]) Result(err error) error {
+	if w.failure != nil {
+		return clierror.New(clierror.FailedState,
+			fmt.Errorf("Stopped waiting for %s: %v", w.target, w.failure))
+	}
+	if errors.Is(err, ocm.ErrPollTimeout) {
+		return clierror.New(clierror.Timeout,
+			fmt.Errorf("Timed out after %s waiting for %s", w.options.Timeout, w.target))
+	}
+	if err != nil {
+		return fmt.Errorf("Failed waiting for %s: %w", w.target, err)
+	}
+	return nil
+}
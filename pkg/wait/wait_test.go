@@ -0,0 +1,86 @@
+package wait
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/clierror"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
+)
+
+var _ = Describe("Wait", func() {
+	conditions := []string{"ready", "deleted"}
+
+	It("Adds the flags with their defaults", func() {
+		cmd := &cobra.Command{}
+		options := &Options{}
+		AddFlags(cmd, options, conditions)
+		Expect(cmd.Flags().Parse([]string{"--for", "ready"})).To(Succeed())
+		Expect(options).To(Equal(&Options{For: "ready", Interval: DefaultInterval, Timeout: DefaultTimeout}))
+		Expect(options.Validate(conditions)).To(Succeed())
+	})
+
+	DescribeTable("Rejects invalid options",
+		func(options Options, message string) {
+			Expect(options.Validate(conditions)).To(MatchError(message))
+		},
+		Entry("condition", Options{For: "running", Interval: time.Second, Timeout: time.Minute},
+			"Invalid condition 'running'. Valid values are ready, deleted"),
+		Entry("interval", Options{For: "ready", Timeout: time.Minute},
+			"Interval must be greater than zero"),
+		Entry("timeout", Options{For: "ready", Interval: time.Minute, Timeout: time.Second},
+			"Timeout must be greater than the interval"),
+	)
+
+	Context("Waiter", func() {
+		var waiter *Waiter[string]
+
+		BeforeEach(func() {
+			options := &Options{For: "ready", Interval: time.Second, Timeout: time.Minute}
+			waiter = NewWaiter(reporter.CreateReporter(), options, "cluster 'c1' to be ready",
+				func(state string) (bool, error) {
+					if state == "error" {
+						return false, errors.New("cluster is in 'error' state")
+					}
+					return state == "ready", nil
+				},
+				func(state string) string {
+					return "Cluster 'c1' is " + state
+				})
+		})
+
+		It("Succeeds when the condition is met", func() {
+			Expect(waiter.Done("installing")).To(BeFalse())
+			Expect(waiter.Done("ready")).To(BeTrue())
+			Expect(waiter.Result(nil)).To(Succeed())
+		})
+
+		It("Exits with the failed code when the condition can't be met", func() {
+			Expect(waiter.Done("error")).To(BeTrue())
+			err := waiter.Result(nil)
+			Expect(err).To(MatchError("Stopped waiting for cluster 'c1' to be ready: cluster is in 'error' state"))
+			Expect(clierror.Classify(err)).To(Equal(clierror.FailedState))
+			Expect(clierror.ExitCode(err)).To(Equal(3))
+		})
+
+		It("Exits with the timeout code when the timeout expires", func() {
+			Expect(waiter.Done("installing")).To(BeFalse())
+			err := waiter.Result(ocm.ErrPollTimeout)
+			Expect(err).To(MatchError("Timed out after 1m0s waiting for cluster 'c1' to be ready"))
+			Expect(clierror.Classify(err)).To(Equal(clierror.Timeout))
+			Expect(clierror.ExitCode(err)).To(Equal(2))
+		})
+
+		It("Returns other errors with the default code", func() {
+			err := waiter.Result(errors.New("connection refused"))
+			Expect(err).To(MatchError("Failed waiting for cluster 'c1' to be ready: connection refused"))
+			Expect(clierror.Classify(err)).To(Equal(clierror.Generic))
+			Expect(clierror.ExitCode(err)).To(Equal(1))
+		})
+	})
+})